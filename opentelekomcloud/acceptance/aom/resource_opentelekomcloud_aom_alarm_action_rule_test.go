@@ -0,0 +1,115 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const actionRuleResource = "opentelekomcloud_aom_alarm_action_rule.rule"
+
+var userName = os.Getenv("OS_USERNAME")
+
+func testAccPreCheckAOMUser(t *testing.T) {
+	common.TestAccPreCheck(t)
+	if userName == "" {
+		t.Skip("OS_USERNAME must be set for AOM action rule acceptance tests")
+	}
+}
+
+func TestAccAOMAlarmActionRule_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAOMUser(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAOMAlarmActionRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAOMAlarmActionRuleBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(actionRuleResource, "name", "tf-action-rule"),
+					resource.TestCheckResourceAttr(actionRuleResource, "smn_topics.#", "1"),
+				),
+			},
+			{
+				Config: testAccAOMAlarmActionRuleUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(actionRuleResource, "description", "updated"),
+					resource.TestCheckResourceAttr(actionRuleResource, "smn_topics.#", "2"),
+				),
+			},
+			{
+				ResourceName:      actionRuleResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAOMAlarmActionRuleDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.AomV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating AOM client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_aom_alarm_action_rule" {
+			continue
+		}
+		_, err := client.Get(client.ServiceURL("alert", "action-rules", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("AOM alarm action rule still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+const testAccAOMTopics = `
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name = "tf_aom_topic_1"
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_2" {
+  name = "tf_aom_topic_2"
+}
+`
+
+func testAccAOMAlarmActionRuleBasic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_aom_alarm_action_rule" "rule" {
+  name       = "tf-action-rule"
+  user_name  = "%s"
+  smn_topics = [opentelekomcloud_smn_topic_v2.topic_1.topic_urn]
+}
+`, testAccAOMTopics, userName)
+}
+
+func testAccAOMAlarmActionRuleUpdate() string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_aom_alarm_action_rule" "rule" {
+  name        = "tf-action-rule"
+  user_name   = "%s"
+  description = "updated"
+  smn_topics = [
+    opentelekomcloud_smn_topic_v2.topic_1.topic_urn,
+    opentelekomcloud_smn_topic_v2.topic_2.topic_urn,
+  ]
+}
+`, testAccAOMTopics, userName)
+}
@@ -0,0 +1,135 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const alarmRuleResource = "opentelekomcloud_aom_alarm_rule.rule"
+
+func TestAccAOMAlarmRule_metric(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAOMUser(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAOMAlarmRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAOMAlarmRuleMetric("Major"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alarmRuleResource, "type", "metric"),
+					resource.TestCheckResourceAttrPair(alarmRuleResource, "notification.0.action_rule", actionRuleResource, "name"),
+					resource.TestCheckResourceAttr(alarmRuleResource, "metric_alarm_spec.0.trigger_condition.0.thresholds.Major", "80"),
+				),
+			},
+			{
+				Config: testAccAOMAlarmRuleMetric("Critical"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alarmRuleResource, "metric_alarm_spec.0.trigger_condition.0.thresholds.Critical", "80"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAOMAlarmRule_event(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAOMUser(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAOMAlarmRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAOMAlarmRuleEvent(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(alarmRuleResource, "type", "event"),
+					resource.TestCheckResourceAttr(alarmRuleResource, "event_alarm_spec.0.event_source", "CCE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAOMAlarmRuleDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.AomV4Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating AOM client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_aom_alarm_rule" {
+			continue
+		}
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("alarm-rules")+"?name="+rs.Primary.ID, &r.Body, nil)
+		var rules []struct {
+			Name string `json:"alarm_rule_name"`
+		}
+		if err := r.ExtractIntoSlicePtr(&rules, "alarm_rules"); err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			if rule.Name == rs.Primary.ID {
+				return fmt.Errorf("AOM alarm rule still exists")
+			}
+		}
+	}
+	return nil
+}
+
+func testAccAOMAlarmRuleMetric(level string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_aom_alarm_rule" "rule" {
+  name = "tf-metric-alarm"
+  type = "metric"
+
+  notification {
+    action_rule     = opentelekomcloud_aom_alarm_action_rule.rule.name
+    notify_resolved = true
+  }
+
+  metric_alarm_spec {
+    trigger_condition {
+      metric_name = "aom_node_cpu_usage"
+      promql      = "label_replace(avg_over_time(aom_node_cpu_usage[59999ms]),\"__name__\",\"aom_node_cpu_usage\",\"\",\"\")"
+      operator    = ">"
+      thresholds = {
+        %s = "80"
+      }
+    }
+  }
+}
+`, testAccAOMAlarmActionRuleBasic(), level)
+}
+
+func testAccAOMAlarmRuleEvent() string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_aom_alarm_rule" "rule" {
+  name = "tf-event-alarm"
+  type = "event"
+
+  notification {
+    action_rule = opentelekomcloud_aom_alarm_action_rule.rule.name
+  }
+
+  event_alarm_spec {
+    event_source = "CCE"
+
+    trigger_condition {
+      event_name = "NodeNotReady"
+    }
+  }
+}
+`, testAccAOMAlarmActionRuleBasic())
+}
@@ -0,0 +1,74 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const prometheusResource = "opentelekomcloud_aom_prometheus_instance.prometheus"
+
+func TestAccAOMPrometheusInstance_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAOMPrometheusInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAOMPrometheusInstanceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prometheusResource, "type", "REMOTE_WRITE"),
+					resource.TestCheckResourceAttrSet(prometheusResource, "remote_write_url"),
+					resource.TestCheckResourceAttrSet(prometheusResource, "remote_write_username"),
+				),
+			},
+			{
+				ResourceName:      prometheusResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAOMPrometheusInstanceDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.AomV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating AOM client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_aom_prometheus_instance" {
+			continue
+		}
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("aom", "prometheus")+"?prom_id="+rs.Primary.ID, &r.Body, nil)
+		var instances []struct {
+			ID string `json:"prom_id"`
+		}
+		if err := r.ExtractIntoSlicePtr(&instances, "prometheus"); err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			if instance.ID == rs.Primary.ID {
+				return fmt.Errorf("AOM Prometheus instance still exists")
+			}
+		}
+	}
+	return nil
+}
+
+const testAccAOMPrometheusInstanceBasic = `
+resource "opentelekomcloud_aom_prometheus_instance" "prometheus" {
+  name = "tf-prometheus"
+  type = "REMOTE_WRITE"
+}
+`
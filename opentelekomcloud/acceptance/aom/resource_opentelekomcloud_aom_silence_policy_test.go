@@ -0,0 +1,118 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const silencePolicyResource = "opentelekomcloud_aom_silence_policy.policy"
+
+func TestAccAOMSilencePolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAOMSilencePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAOMSilencePolicyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(silencePolicyResource, "match.0.condition.0.key", "event_severity"),
+					resource.TestCheckResourceAttr(silencePolicyResource, "silence_time.0.type", "WEEKLY"),
+				),
+			},
+			{
+				Config: testAccAOMSilencePolicyUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(silencePolicyResource, "description", "maintenance"),
+					resource.TestCheckResourceAttr(silencePolicyResource, "silence_time.0.scope.#", "2"),
+				),
+			},
+			{
+				ResourceName:      silencePolicyResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAOMSilencePolicyDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.AomV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating AOM client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_aom_silence_policy" {
+			continue
+		}
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("alert", "mute-rules"), &r.Body, nil)
+		var rules []struct {
+			Name string `json:"name"`
+		}
+		if err := r.ExtractInto(&rules); err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			if rule.Name == rs.Primary.ID {
+				return fmt.Errorf("AOM silence policy still exists")
+			}
+		}
+	}
+	return nil
+}
+
+const testAccAOMSilencePolicyBasic = `
+resource "opentelekomcloud_aom_silence_policy" "policy" {
+  name      = "tf-silence-policy"
+  time_zone = "Europe/Berlin"
+
+  match {
+    condition {
+      key     = "event_severity"
+      operate = "EQUALS"
+      values  = ["Info"]
+    }
+  }
+
+  silence_time {
+    type      = "WEEKLY"
+    starts_at = 0
+    ends_at   = 21600
+    scope     = [6]
+  }
+}
+`
+
+const testAccAOMSilencePolicyUpdate = `
+resource "opentelekomcloud_aom_silence_policy" "policy" {
+  name        = "tf-silence-policy"
+  description = "maintenance"
+  time_zone   = "Europe/Berlin"
+
+  match {
+    condition {
+      key     = "event_severity"
+      operate = "EQUALS"
+      values  = ["Info", "Minor"]
+    }
+  }
+
+  silence_time {
+    type      = "WEEKLY"
+    starts_at = 0
+    ends_at   = 21600
+    scope     = [6, 7]
+  }
+}
+`
@@ -0,0 +1,52 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceEnvironmentName = "opentelekomcloud_apigw_environment_v1.env"
+
+func TestAccAPIGWEnvironmentV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf_acc_env_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIGWEnvironmentV1Basic(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEnvironmentName, "name", name),
+					resource.TestCheckResourceAttr(resourceEnvironmentName, "description", "first"),
+					resource.TestCheckResourceAttrSet(resourceEnvironmentName, "created_at"),
+				),
+			},
+			{
+				Config: testAccAPIGWEnvironmentV1Basic(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEnvironmentName, "description", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceEnvironmentName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAPIGWEnvironmentV1Basic(name, description string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_apigw_environment_v1" "env" {
+  name        = "%s"
+  description = "%s"
+}
+`, name, description)
+}
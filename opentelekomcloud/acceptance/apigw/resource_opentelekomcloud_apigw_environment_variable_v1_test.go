@@ -0,0 +1,61 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+// API group used to define environment variables
+var apiGroupID = os.Getenv("OS_APIGW_GROUP_ID")
+
+const resourceEnvironmentVariableName = "opentelekomcloud_apigw_environment_variable_v1.var"
+
+func TestAccAPIGWEnvironmentVariableV1_basic(t *testing.T) {
+	postfix := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			if apiGroupID == "" {
+				t.Skip("OS_APIGW_GROUP_ID should be set for this test")
+			}
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIGWEnvironmentVariableV1Basic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEnvironmentVariableName, "name", "backend_address"),
+					resource.TestCheckResourceAttr(resourceEnvironmentVariableName, "value", "192.168.0.10"),
+					resource.TestCheckResourceAttrPair(resourceEnvironmentVariableName, "environment_id", resourceEnvironmentName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceEnvironmentVariableName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAPIGWEnvironmentVariableV1Basic(postfix string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_apigw_environment_v1" "env" {
+  name = "tf_acc_env_%s"
+}
+
+resource "opentelekomcloud_apigw_environment_variable_v1" "var" {
+  group_id       = "%s"
+  environment_id = opentelekomcloud_apigw_environment_v1.env.id
+  name           = "backend_address"
+  value          = "192.168.0.10"
+}
+`, postfix, apiGroupID)
+}
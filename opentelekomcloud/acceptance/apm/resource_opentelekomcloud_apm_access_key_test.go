@@ -0,0 +1,39 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const accessKeyResource = "opentelekomcloud_apm_access_key.key"
+
+func TestAccAPMAccessKey_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPMAccessKeyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(accessKeyResource, "access_key"),
+					resource.TestCheckResourceAttrSet(accessKeyResource, "secret_key"),
+					resource.TestCheckResourceAttrSet(accessKeyResource, "master_address"),
+				),
+			},
+			{
+				ResourceName:      accessKeyResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccAPMAccessKeyBasic = `
+resource "opentelekomcloud_apm_access_key" "key" {
+  description = "terraform acceptance"
+}
+`
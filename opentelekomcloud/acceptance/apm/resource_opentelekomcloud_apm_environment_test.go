@@ -0,0 +1,94 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	applicationResource = "opentelekomcloud_apm_application.app"
+	environmentResource = "opentelekomcloud_apm_environment.env"
+)
+
+func TestAccAPMEnvironment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAPMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPMEnvironmentBasic("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(applicationResource, "name", "tf-apm-app"),
+					resource.TestCheckResourceAttrPair(environmentResource, "application_id", applicationResource, "id"),
+					resource.TestCheckResourceAttr(environmentResource, "agent_config.apm_application", "tf-apm-app"),
+					resource.TestCheckResourceAttr(environmentResource, "agent_config.apm_env", "tf-apm-env"),
+				),
+			},
+			{
+				Config: testAccAPMEnvironmentBasic("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(applicationResource, "description", "second"),
+					resource.TestCheckResourceAttr(environmentResource, "description", "second"),
+				),
+			},
+			{
+				ResourceName:      environmentResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAPMDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.ApmV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating APM client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		var url string
+		switch rs.Type {
+		case "opentelekomcloud_apm_application":
+			url = client.ServiceURL("apm2", "openapi", "cmdb", "apps", "get-app", rs.Primary.ID)
+		case "opentelekomcloud_apm_environment":
+			url = client.ServiceURL("apm2", "openapi", "cmdb", "envs", "get-env", rs.Primary.ID)
+		default:
+			continue
+		}
+		_, err := client.Get(url, nil, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists", rs.Type)
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccAPMEnvironmentBasic(description string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_apm_application" "app" {
+  name        = "tf-apm-app"
+  description = "%[1]s"
+}
+
+resource "opentelekomcloud_apm_environment" "env" {
+  application_id = opentelekomcloud_apm_application.app.id
+  name           = "tf-apm-env"
+  type           = "TEST"
+  description    = "%[1]s"
+}
+`, description)
+}
@@ -0,0 +1,41 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceASInstancesName = "data.opentelekomcloud_as_instances.instances"
+
+func TestAccASInstancesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccFlavorPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASV1GroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccASInstancesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceASInstancesName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceASInstancesName, "instances.0.lifecycle_state", "INSERVICE"),
+					resource.TestCheckResourceAttrPair(dataSourceASInstancesName, "instances.0.scaling_configuration_id",
+						"opentelekomcloud_as_configuration_v1.config_blue", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceASInstancesName, "instances.0.health_status"),
+				),
+			},
+		},
+	})
+}
+
+var testAccASInstancesDataSource_basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_as_instances" "instances" {
+  scaling_group_id = opentelekomcloud_as_group_v1.refresh_group.id
+  lifecycle_state  = "INSERVICE"
+}
+`, testASV1Group_instanceRefresh("config_blue"))
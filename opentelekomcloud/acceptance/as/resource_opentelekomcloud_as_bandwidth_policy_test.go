@@ -0,0 +1,155 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceBandwidthPolicyName = "opentelekomcloud_as_bandwidth_policy.bw_policy"
+
+func TestAccASBandwidthPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASBandwidthPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASBandwidthPolicy_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASBandwidthPolicyExists(resourceBandwidthPolicyName),
+					resource.TestCheckResourceAttr(resourceBandwidthPolicyName, "scaling_policy_type", "RECURRENCE"),
+					resource.TestCheckResourceAttr(resourceBandwidthPolicyName, "scaling_policy_action.0.operation", "ADD"),
+					resource.TestCheckResourceAttr(resourceBandwidthPolicyName, "scaling_policy_action.0.size", "5"),
+					resource.TestCheckResourceAttrPair(resourceBandwidthPolicyName, "bandwidth_id",
+						"opentelekomcloud_vpc_eip_v1.eip", "bandwidth.0.id"),
+				),
+			},
+			{
+				Config: testASBandwidthPolicy_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceBandwidthPolicyName, "scaling_policy_name", "bw_policy_updated"),
+					resource.TestCheckResourceAttr(resourceBandwidthPolicyName, "scaling_policy_action.0.operation", "SET"),
+					resource.TestCheckResourceAttr(resourceBandwidthPolicyName, "scaling_policy_action.0.size", "20"),
+					resource.TestCheckResourceAttr(resourceBandwidthPolicyName, "cool_down_time", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceBandwidthPolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckASBandwidthPolicyDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.AutoscalingV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud autoscaling v2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_as_bandwidth_policy" {
+			continue
+		}
+
+		_, err := client.Get(client.ServiceURL("scaling_policy", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("AS bandwidth policy still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASBandwidthPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.AutoscalingV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud autoscaling v2 client: %s", err)
+		}
+
+		_, err = client.Get(client.ServiceURL("scaling_policy", rs.Primary.ID), nil, nil)
+		return err
+	}
+}
+
+const testASBandwidthPolicyEIP = `
+resource "opentelekomcloud_vpc_eip_v1" "eip" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name       = "as_bandwidth"
+    size       = 10
+    share_type = "PER"
+  }
+
+  lifecycle {
+    ignore_changes = [bandwidth[0].size]
+  }
+}
+`
+
+var testASBandwidthPolicy_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_as_bandwidth_policy" "bw_policy" {
+  scaling_policy_name = "bw_policy"
+  bandwidth_id        = opentelekomcloud_vpc_eip_v1.eip.bandwidth[0].id
+  scaling_policy_type = "RECURRENCE"
+
+  scheduled_policy {
+    launch_time      = "07:00"
+    recurrence_type  = "Daily"
+    recurrence_value = ""
+    end_time         = "2040-12-31T12:00Z"
+  }
+
+  scaling_policy_action {
+    operation = "ADD"
+    size      = 5
+    limits    = 50
+  }
+}
+`, testASBandwidthPolicyEIP)
+
+var testASBandwidthPolicy_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_as_bandwidth_policy" "bw_policy" {
+  scaling_policy_name = "bw_policy_updated"
+  bandwidth_id        = opentelekomcloud_vpc_eip_v1.eip.bandwidth[0].id
+  scaling_policy_type = "RECURRENCE"
+  cool_down_time      = 600
+
+  scheduled_policy {
+    launch_time      = "07:00"
+    recurrence_type  = "Daily"
+    recurrence_value = ""
+    end_time         = "2040-12-31T12:00Z"
+  }
+
+  scaling_policy_action {
+    operation = "SET"
+    size      = 20
+  }
+}
+`, testASBandwidthPolicyEIP)
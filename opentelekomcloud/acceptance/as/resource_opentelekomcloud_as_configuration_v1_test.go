@@ -90,6 +90,32 @@ func TestAccASV1Configuration_multipleSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccASV1Configuration_encryptedDisk(t *testing.T) {
+	var asConfig configurations.Configuration
+	resourceName := "opentelekomcloud_as_configuration_v1.as_config"
+
+	if env.OS_KMS_ID == "" {
+		t.Skip("OS_KMS_ID should be set for this test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccFlavorPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASV1ConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccASV1Configuration_encryptedDisk,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1ConfigurationExists(resourceName, &asConfig),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.0.disk.1.kms_id", env.OS_KMS_ID),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.0.agency_name", "as_config_agency"),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.0.public_ip.0.eip.0.bandwidth.0.charging_mode", "bandwidth"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckASV1ConfigurationDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	asClient, err := config.AutoscalingV1Client(env.OS_REGION_NAME)
@@ -256,3 +282,41 @@ resource "opentelekomcloud_as_configuration_v1" "as_config"{
   }
 }
 `, env.OS_KEYPAIR_NAME)
+
+var testAccASV1Configuration_encryptedDisk = fmt.Sprintf(`
+resource "opentelekomcloud_identity_agency_v3" "agency" {
+  name                  = "as_config_agency"
+  delegated_domain_name = "op_svc_ecs"
+  domain_roles          = ["OBS OperateAccess"]
+}
+
+resource "opentelekomcloud_as_configuration_v1" "as_config" {
+  scaling_configuration_name = "as_config"
+  instance_config {
+    image = "%s"
+    disk {
+      size        = 40
+      volume_type = "SATA"
+      disk_type   = "SYS"
+    }
+    disk {
+      size        = 40
+      volume_type = "SATA"
+      disk_type   = "DATA"
+      kms_id      = "%s"
+    }
+    key_name    = "%s"
+    agency_name = opentelekomcloud_identity_agency_v3.agency.name
+    public_ip {
+      eip {
+        ip_type = "5_bgp"
+        bandwidth {
+          charging_mode = "bandwidth"
+          share_type    = "PER"
+          size          = 10
+        }
+      }
+    }
+  }
+}
+`, env.OS_IMAGE_ID, env.OS_KMS_ID, env.OS_KEYPAIR_NAME)
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/instances"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
@@ -90,6 +91,65 @@ func TestAccASV1Group_WithoutSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccASV1Group_multipleLBaaSListeners(t *testing.T) {
+	var asGroup groups.Group
+
+	resourceName := "opentelekomcloud_as_group_v1.hth_as_group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccFlavorPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASV1GroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASV1Group_multipleListeners,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1GroupExists(resourceName, &asGroup),
+					resource.TestCheckResourceAttr(resourceName, "lbaas_listeners.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "lbaas_listeners.0.protocol_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "lbaas_listeners.1.protocol_port", "8443"),
+					resource.TestCheckResourceAttr(resourceName, "lbaas_listeners.1.weight", "10"),
+				),
+			},
+			{
+				Config: testASV1Group_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1GroupExists(resourceName, &asGroup),
+					resource.TestCheckResourceAttr(resourceName, "lbaas_listeners.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccASV1Group_instanceRefresh(t *testing.T) {
+	var asGroup groups.Group
+	resourceName := "opentelekomcloud_as_group_v1.refresh_group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccFlavorPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASV1GroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASV1Group_instanceRefresh("config_blue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1GroupExists(resourceName, &asGroup),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+				),
+			},
+			{
+				Config: testASV1Group_instanceRefresh("config_green"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_instance_number", "2"),
+					testAccCheckASV1GroupInstancesConfiguration(resourceName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckASV1GroupDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	asClient, err := config.AutoscalingV1Client(env.OS_REGION_NAME)
@@ -359,3 +419,162 @@ resource "opentelekomcloud_as_group_v1" "proxy_group" {
   }
 }
 `, env.OS_IMAGE_ID, env.OS_KEYPAIR_NAME, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+
+var testASV1Group_multipleListeners = fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "test-acc"
+}
+
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_2" {
+  name            = "listener_2"
+  protocol        = "TCP"
+  protocol_port   = 8443
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_2" {
+  name        = "pool_2"
+  protocol    = "TCP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_2.id
+}
+
+resource "opentelekomcloud_as_configuration_v1" "hth_as_config"{
+  scaling_configuration_name = "hth_as_config"
+  instance_config {
+    image = "%s"
+    disk {
+      size        = 40
+      volume_type = "SATA"
+      disk_type   = "SYS"
+    }
+    key_name = "%s"
+  }
+}
+
+resource "opentelekomcloud_as_group_v1" "hth_as_group"{
+  scaling_group_name       = "hth_as_group"
+  scaling_configuration_id = opentelekomcloud_as_configuration_v1.hth_as_config.id
+  networks {
+    id = "%s"
+  }
+  security_groups {
+    id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+  }
+  lbaas_listeners {
+    pool_id       = opentelekomcloud_lb_pool_v2.pool_1.id
+    protocol_port = opentelekomcloud_lb_listener_v2.listener_1.protocol_port
+  }
+  lbaas_listeners {
+    pool_id       = opentelekomcloud_lb_pool_v2.pool_2.id
+    protocol_port = opentelekomcloud_lb_listener_v2.listener_2.protocol_port
+    weight        = 10
+  }
+  vpc_id = "%s"
+}
+`, env.OS_SUBNET_ID, env.OS_IMAGE_ID, env.OS_KEYPAIR_NAME, env.OS_NETWORK_ID, env.OS_VPC_ID)
+
+func testAccCheckASV1GroupInstancesConfiguration(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.AutoscalingV1Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating opentelekomcloud autoscaling client: %s", err)
+		}
+
+		pages, err := instances.List(client, rs.Primary.ID, nil).AllPages()
+		if err != nil {
+			return err
+		}
+		instanceList, err := pages.(instances.InstancePage).Extract()
+		if err != nil {
+			return err
+		}
+		configID := rs.Primary.Attributes["scaling_configuration_id"]
+		for _, instance := range instanceList {
+			if instance.ConfigurationID != configID {
+				return fmt.Errorf("instance %s still uses configuration %s", instance.ID, instance.ConfigurationID)
+			}
+		}
+		return nil
+	}
+}
+
+func testASV1Group_instanceRefresh(configName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "sg_as_refresh"
+}
+
+resource "opentelekomcloud_as_configuration_v1" "config_blue" {
+  scaling_configuration_name = "as_config_blue"
+  instance_config {
+    image = "%[1]s"
+    disk {
+      size        = 40
+      volume_type = "SATA"
+      disk_type   = "SYS"
+    }
+    key_name = "%[2]s"
+  }
+}
+
+resource "opentelekomcloud_as_configuration_v1" "config_green" {
+  scaling_configuration_name = "as_config_green"
+  instance_config {
+    image = "%[1]s"
+    disk {
+      size        = 50
+      volume_type = "SATA"
+      disk_type   = "SYS"
+    }
+    key_name = "%[2]s"
+  }
+}
+
+resource "opentelekomcloud_as_group_v1" "refresh_group" {
+  scaling_group_name       = "as_group_refresh"
+  scaling_configuration_id = opentelekomcloud_as_configuration_v1.%[3]s.id
+  desire_instance_number   = 2
+  min_instance_number      = 1
+  max_instance_number      = 2
+  networks {
+    id = "%[4]s"
+  }
+  security_groups {
+    id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+  }
+  vpc_id           = "%[5]s"
+  delete_publicip  = true
+  delete_instances = "yes"
+
+  instance_refresh {
+    min_healthy_percentage = 50
+  }
+}
+`, env.OS_IMAGE_ID, env.OS_KEYPAIR_NAME, configName, env.OS_NETWORK_ID, env.OS_VPC_ID)
+}
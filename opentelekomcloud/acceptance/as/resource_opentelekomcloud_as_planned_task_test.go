@@ -0,0 +1,163 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourcePlannedTaskName = "opentelekomcloud_as_planned_task.task"
+
+func TestAccASPlannedTask_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccFlavorPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASPlannedTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccASPlannedTask_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePlannedTaskName, "name", "scale_up"),
+					resource.TestCheckResourceAttr(resourcePlannedTaskName, "scheduled_policy.0.recurrence_type", "Daily"),
+					resource.TestCheckResourceAttr(resourcePlannedTaskName, "instance_number.0.desire", "1"),
+					resource.TestCheckResourceAttrSet(resourcePlannedTaskName, "created_at"),
+				),
+			},
+			{
+				Config: testAccASPlannedTask_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePlannedTaskName, "scheduled_policy.0.recurrence_type", "Weekly"),
+					resource.TestCheckResourceAttr(resourcePlannedTaskName, "instance_number.0.desire", "0"),
+					resource.TestCheckResourceAttr(resourcePlannedTaskName, "instance_number.0.max", "2"),
+				),
+			},
+			{
+				ResourceName:      resourcePlannedTaskName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccASPlannedTaskImportID,
+			},
+		},
+	})
+}
+
+func testAccASPlannedTaskImportID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[resourcePlannedTaskName]
+	if !ok {
+		return "", fmt.Errorf("resource not found: %s", resourcePlannedTaskName)
+	}
+	return fmt.Sprintf("%s/%s", rs.Primary.Attributes["scaling_group_id"], rs.Primary.ID), nil
+}
+
+func testAccCheckASPlannedTaskDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.AutoscalingV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating opentelekomcloud autoscaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_as_planned_task" {
+			continue
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("scaling-groups", rs.Primary.Attributes["scaling_group_id"], "scheduled-tasks"), &r.Body, nil)
+		var tasks []struct {
+			ID string `json:"task_id"`
+		}
+		if err := r.ExtractIntoSlicePtr(&tasks, "scheduled_tasks"); err != nil {
+			// the task is gone together with the scaling group
+			continue
+		}
+		for _, task := range tasks {
+			if task.ID == rs.Primary.ID {
+				return fmt.Errorf("AS planned task still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+var testAccASPlannedTaskGroup = fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "sg_as_planned_task"
+}
+
+resource "opentelekomcloud_as_configuration_v1" "config" {
+  scaling_configuration_name = "as_config_planned_task"
+  instance_config {
+    image = "%s"
+    disk {
+      size        = 40
+      volume_type = "SATA"
+      disk_type   = "SYS"
+    }
+    key_name = "%s"
+  }
+}
+
+resource "opentelekomcloud_as_group_v1" "group" {
+  scaling_group_name       = "as_group_planned_task"
+  scaling_configuration_id = opentelekomcloud_as_configuration_v1.config.id
+  networks {
+    id = "%s"
+  }
+  security_groups {
+    id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+  }
+  vpc_id = "%s"
+}
+`, env.OS_IMAGE_ID, env.OS_KEYPAIR_NAME, env.OS_NETWORK_ID, env.OS_VPC_ID)
+
+var testAccASPlannedTask_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_as_planned_task" "task" {
+  scaling_group_id = opentelekomcloud_as_group_v1.group.id
+  name             = "scale_up"
+
+  scheduled_policy {
+    launch_time     = "08:00"
+    recurrence_type = "Daily"
+    start_time      = "2030-01-01T00:00Z"
+    end_time        = "2030-12-31T00:00Z"
+  }
+
+  instance_number {
+    desire = 1
+    min    = 0
+  }
+}
+`, testAccASPlannedTaskGroup)
+
+var testAccASPlannedTask_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_as_planned_task" "task" {
+  scaling_group_id = opentelekomcloud_as_group_v1.group.id
+  name             = "scale_up"
+
+  scheduled_policy {
+    launch_time      = "20:00"
+    recurrence_type  = "Weekly"
+    recurrence_value = "1,6,7"
+    start_time       = "2030-01-01T00:00Z"
+    end_time         = "2030-12-31T00:00Z"
+  }
+
+  instance_number {
+    desire = 0
+    min    = 0
+    max    = 2
+  }
+}
+`, testAccASPlannedTaskGroup)
@@ -0,0 +1,47 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestAccBMSV2RemoteConsoleDataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_compute_bms_remote_console_v2.console"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccBmsFlavorPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBMSV2RemoteConsoleDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "type", "serial"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+var testAccBMSV2RemoteConsoleDataSource_basic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_bms_server_v2" "instance_1" {
+  name              = "instance_1"
+  flavor_id         = "physical.o2.medium"
+  flavor_name       = "physical.o2.medium"
+  security_groups   = ["default"]
+  availability_zone = "%s"
+
+  network {
+    uuid = "%s"
+  }
+}
+
+data "opentelekomcloud_compute_bms_remote_console_v2" "console" {
+  server_id = opentelekomcloud_compute_bms_server_v2.instance_1.id
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
@@ -42,6 +42,36 @@ func TestAccComputeV2BmsInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2BmsInstance_volumesAndTags(t *testing.T) {
+	var instance servers.Server
+	resourceName := "opentelekomcloud_compute_bms_server_v2.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccBmsFlavorPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeV2BmsInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2BmsInstance_volumes,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2BmsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "network.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "volume_attached.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-create"),
+				),
+			},
+			{
+				Config: testAccComputeV2BmsInstance_volumesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2BmsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "volume_attached.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-update"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2BmsInstance_bootFromVolumeImage(t *testing.T) {
 	var instance servers.Server
 
@@ -209,3 +239,72 @@ resource "opentelekomcloud_compute_bms_server_v2" "instance_1" {
   }
 }
 `, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
+
+const testAccComputeV2BmsInstance_volumesBase = `
+resource "opentelekomcloud_blockstorage_volume_v2" "volume_1" {
+  name = "bms-volume-1"
+  size = 10
+}
+
+resource "opentelekomcloud_blockstorage_volume_v2" "volume_2" {
+  name = "bms-volume-2"
+  size = 10
+}
+`
+
+var testAccComputeV2BmsInstance_volumes = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_compute_bms_server_v2" "instance_1" {
+  name              = "instance_1"
+  flavor_id         = "physical.o2.medium"
+  flavor_name       = "physical.o2.medium"
+  security_groups   = ["default"]
+  availability_zone = "%s"
+
+  network {
+    uuid = "%s"
+  }
+  network {
+    uuid = "%s"
+  }
+
+  volume_attached {
+    volume_id = opentelekomcloud_blockstorage_volume_v2.volume_1.id
+  }
+
+  tags = {
+    muh = "value-create"
+  }
+}
+`, testAccComputeV2BmsInstance_volumesBase, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID, env.OS_NETWORK_ID)
+
+var testAccComputeV2BmsInstance_volumesUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_compute_bms_server_v2" "instance_1" {
+  name              = "instance_1"
+  flavor_id         = "physical.o2.medium"
+  flavor_name       = "physical.o2.medium"
+  security_groups   = ["default"]
+  availability_zone = "%s"
+
+  network {
+    uuid = "%s"
+  }
+  network {
+    uuid = "%s"
+  }
+
+  volume_attached {
+    volume_id = opentelekomcloud_blockstorage_volume_v2.volume_1.id
+  }
+  volume_attached {
+    volume_id = opentelekomcloud_blockstorage_volume_v2.volume_2.id
+  }
+
+  tags = {
+    muh = "value-update"
+  }
+}
+`, testAccComputeV2BmsInstance_volumesBase, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID, env.OS_NETWORK_ID)
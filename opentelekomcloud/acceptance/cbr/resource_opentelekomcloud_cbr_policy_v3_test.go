@@ -2,6 +2,8 @@ package acceptance
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -70,6 +72,64 @@ func TestAccCBRPolicyV3_minConfig(t *testing.T) {
 	})
 }
 
+func TestAccCBRPolicyV3_schedule(t *testing.T) {
+	var cbrPolicy policies.Policy
+	policyRes := "opentelekomcloud_cbr_policy_v3.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccFlavorPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCBRPolicyV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testCBRPolicyV3_invalidPattern,
+				ExpectError: regexp.MustCompile(`BYDAY.+is required for .+WEEKLY.+ frequency`),
+			},
+			{
+				Config:      testCBRPolicyV3_invalidTimezone,
+				ExpectError: regexp.MustCompile(`doesn't match .+UTC\+HH:MM.+ format`),
+			},
+			{
+				Config: testCBRPolicyV3_unnormalized,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCBRPolicyV3Exists(policyRes, &cbrPolicy),
+					resource.TestCheckResourceAttr(policyRes, "operation_definition.0.timezone", "UTC+03:00"),
+				),
+			},
+			{
+				Config:   testCBRPolicyV3_unnormalized,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCBRPolicyV3_replication(t *testing.T) {
+	destinationRegion := os.Getenv("OS_DESTINATION_REGION")
+	destinationProjectID := os.Getenv("OS_DESTINATION_PROJECT_ID")
+	if destinationRegion == "" || destinationProjectID == "" {
+		t.Skip("OS_DESTINATION_REGION and OS_DESTINATION_PROJECT_ID should be set for replication policy test")
+	}
+	var cbrPolicy policies.Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCBRPolicyV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCBRPolicyV3_replication(destinationRegion, destinationProjectID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCBRPolicyV3Exists("opentelekomcloud_cbr_policy_v3.policy", &cbrPolicy),
+					resource.TestCheckResourceAttr("opentelekomcloud_cbr_policy_v3.policy", "operation_type", "replication"),
+					resource.TestCheckResourceAttr("opentelekomcloud_cbr_policy_v3.policy", "operation_definition.0.destination_region", destinationRegion),
+					resource.TestCheckResourceAttr("opentelekomcloud_cbr_policy_v3.policy", "operation_definition.0.destination_project_id", destinationProjectID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCBRPolicyV3Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	asClient, err := config.CbrV3Client(env.OS_REGION_NAME)
@@ -122,6 +182,35 @@ func testAccCheckCBRPolicyV3Exists(n string, group *policies.Policy) resource.Te
 }
 
 const (
+	testCBRPolicyV3_invalidPattern = `
+resource opentelekomcloud_cbr_policy_v3 policy {
+  name            = "test-policy"
+  operation_type  = "backup"
+  trigger_pattern = ["FREQ=WEEKLY;BYHOUR=14;BYMINUTE=00"]
+}
+`
+	testCBRPolicyV3_invalidTimezone = `
+resource opentelekomcloud_cbr_policy_v3 policy {
+  name            = "test-policy"
+  operation_type  = "backup"
+  trigger_pattern = ["FREQ=DAILY;BYHOUR=14;BYMINUTE=00"]
+  operation_definition {
+    max_backups = 10
+    timezone    = "Europe/Berlin"
+  }
+}
+`
+	testCBRPolicyV3_unnormalized = `
+resource opentelekomcloud_cbr_policy_v3 policy {
+  name            = "test-policy-schedule"
+  operation_type  = "backup"
+  trigger_pattern = ["freq=weekly;byminute=0;byhour=3;byday=MO,FR"]
+  operation_definition {
+    max_backups = 10
+    timezone    = "UTC+3"
+  }
+}
+`
 	testCBRPolicyV3_basic = `
 resource opentelekomcloud_cbr_policy_v3 policy {
   name                 = "test-policy"
@@ -175,3 +264,20 @@ resource opentelekomcloud_cbr_policy_v3 policy {
 }
 `
 )
+
+func testCBRPolicyV3_replication(region, projectID string) string {
+	return fmt.Sprintf(`
+resource opentelekomcloud_cbr_policy_v3 policy {
+  name            = "test-policy-replication"
+  operation_type  = "replication"
+  trigger_pattern = ["FREQ=DAILY;BYHOUR=2;BYMINUTE=00"]
+
+  operation_definition {
+    max_backups            = 5
+    timezone               = "UTC+00:00"
+    destination_region     = "%s"
+    destination_project_id = "%s"
+  }
+}
+`, region, projectID)
+}
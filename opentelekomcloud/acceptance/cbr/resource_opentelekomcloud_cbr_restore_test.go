@@ -0,0 +1,42 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccCBRRestore_volume(t *testing.T) {
+	backupID := os.Getenv("OS_CBR_BACKUP_ID")
+	volumeID := os.Getenv("OS_CBR_VOLUME_ID")
+	if backupID == "" || volumeID == "" {
+		t.Skip("OS_CBR_BACKUP_ID and OS_CBR_VOLUME_ID should be set for CBR restore test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCBRRestore_volume(backupID, volumeID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_cbr_restore.restore", "status", "success"),
+					resource.TestCheckResourceAttrSet("opentelekomcloud_cbr_restore.restore", "ended_at"),
+				),
+			},
+		},
+	})
+}
+
+func testCBRRestore_volume(backupID, volumeID string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_cbr_restore" "restore" {
+  backup_id = "%s"
+  volume_id = "%s"
+}
+`, backupID, volumeID)
+}
@@ -85,6 +85,79 @@ func TestAccCBRVaultV3_instance(t *testing.T) {
 	})
 }
 
+func TestAccCBRVaultV3_migrate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCBRPolicyV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCBRVaultV3_migrate("vault_1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_cbr_vault_v3.vault_1", "resource.#", "1"),
+					resource.TestCheckResourceAttr("opentelekomcloud_cbr_vault_v3.vault_2", "resource.#", "0"),
+				),
+			},
+			{
+				Config: testCBRVaultV3_migrate("vault_2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_cbr_vault_v3.vault_1", "resource.#", "0"),
+					resource.TestCheckResourceAttr("opentelekomcloud_cbr_vault_v3.vault_2", "resource.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testCBRVaultV3_migrate binds the volume to the given vault, vault_1 depends on vault_2,
+// so the volume is migrated before vault_1 is updated
+func testCBRVaultV3_migrate(boundVault string) string {
+	resources := map[string]string{"vault_1": "", "vault_2": ""}
+	resources[boundVault] = `
+  resource {
+    id   = opentelekomcloud_blockstorage_volume_v2.volume.id
+    type = "OS::Cinder::Volume"
+  }`
+	return fmt.Sprintf(`
+resource "opentelekomcloud_blockstorage_volume_v2" "volume" {
+  name = "cbr-test-volume-migrate"
+  size = 10
+
+  volume_type = "SSD"
+}
+
+resource "opentelekomcloud_cbr_vault_v3" "vault_2" {
+  name = "cbr-vault-test-2"
+
+  billing {
+    size          = 100
+    object_type   = "disk"
+    protect_type  = "backup"
+    charging_mode = "post_paid"
+  }
+
+  migrate_resources = true
+%s
+}
+
+resource "opentelekomcloud_cbr_vault_v3" "vault_1" {
+  name = "cbr-vault-test-1"
+
+  billing {
+    size          = 100
+    object_type   = "disk"
+    protect_type  = "backup"
+    charging_mode = "post_paid"
+  }
+
+  migrate_resources = true
+%s
+
+  depends_on = [opentelekomcloud_cbr_vault_v3.vault_2]
+}
+`, resources["vault_2"], resources["vault_1"])
+}
+
 var (
 	testCBRVaultV3_basicInstance = fmt.Sprintf(`
 resource "opentelekomcloud_compute_instance_v2" "instance" {
@@ -0,0 +1,145 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/addons"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceNamespaceName = "opentelekomcloud_cce_namespace_v1.namespace"
+
+func TestAccCCENamespaceV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCENamespaceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCENamespaceV1Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENamespaceV1Exists(resourceNamespaceName),
+					resource.TestCheckResourceAttr(resourceNamespaceName, "name", "team-a"),
+					resource.TestCheckResourceAttr(resourceNamespaceName, "labels.team", "a"),
+					resource.TestCheckResourceAttr(resourceNamespaceName, "phase", "Active"),
+				),
+			},
+			{
+				Config: testAccCCENamespaceV1Updated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENamespaceV1Exists(resourceNamespaceName),
+					resource.TestCheckResourceAttr(resourceNamespaceName, "labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceNamespaceName, "labels.team", "b"),
+					resource.TestCheckResourceAttr(resourceNamespaceName, "annotations.owner", "team-b"),
+				),
+			},
+			{
+				ResourceName:      resourceNamespaceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// only the keys set by the resource are read back
+				ImportStateVerifyIgnore: []string{"labels", "annotations"},
+			},
+		},
+	})
+}
+
+func testAccCheckCCENamespaceV1Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CceV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating opentelekomcloud CCE client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_cce_namespace_v1" {
+			continue
+		}
+
+		url := addons.CCEServiceURL(client, rs.Primary.Attributes["cluster_id"], "namespaces", rs.Primary.Attributes["name"])
+		_, err := client.Get(url, nil, nil)
+		if err == nil {
+			return fmt.Errorf("namespace still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCCENamespaceV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.CceV1Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating opentelekomcloud CCE client: %w", err)
+		}
+
+		url := addons.CCEServiceURL(client, rs.Primary.Attributes["cluster_id"], "namespaces", rs.Primary.Attributes["name"])
+		_, err = client.Get(url, nil, nil)
+		return err
+	}
+}
+
+var testAccCCENamespaceV1Basic = fmt.Sprintf(`
+resource opentelekomcloud_cce_cluster_v3 cluster_1 {
+  name                    = "%s"
+  cluster_type            = "VirtualMachine"
+  flavor_id               = "cce.s1.small"
+  vpc_id                  = "%s"
+  subnet_id               = "%s"
+  container_network_type  = "overlay_l2"
+  kubernetes_svc_ip_range = "10.247.0.0/16"
+}
+
+resource "opentelekomcloud_cce_namespace_v1" "namespace" {
+  cluster_id = opentelekomcloud_cce_cluster_v3.cluster_1.id
+  name       = "team-a"
+
+  labels = {
+    team = "a"
+  }
+}
+`, clusterName, env.OS_VPC_ID, env.OS_NETWORK_ID)
+
+var testAccCCENamespaceV1Updated = fmt.Sprintf(`
+resource opentelekomcloud_cce_cluster_v3 cluster_1 {
+  name                    = "%s"
+  cluster_type            = "VirtualMachine"
+  flavor_id               = "cce.s1.small"
+  vpc_id                  = "%s"
+  subnet_id               = "%s"
+  container_network_type  = "overlay_l2"
+  kubernetes_svc_ip_range = "10.247.0.0/16"
+}
+
+resource "opentelekomcloud_cce_namespace_v1" "namespace" {
+  cluster_id = opentelekomcloud_cce_cluster_v3.cluster_1.id
+  name       = "team-a"
+
+  labels = {
+    team = "b"
+  }
+
+  annotations = {
+    owner = "team-b"
+  }
+}
+`, clusterName, env.OS_VPC_ID, env.OS_NETWORK_ID)
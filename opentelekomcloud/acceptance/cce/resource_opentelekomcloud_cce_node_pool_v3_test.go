@@ -66,6 +66,35 @@ func TestAccCCENodePoolsV3_randomAZ(t *testing.T) {
 	})
 }
 
+func TestAccCCENodePoolsV3_storage(t *testing.T) {
+	var nodePool nodepools.NodePool
+	nodePoolName := "opentelekomcloud_cce_node_pool_v3.node_pool"
+	clusterName := "opentelekomcloud_cce_cluster_v3.cluster"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCCEKeyPairPreCheck(t)
+			if env.OS_KMS_ID == "" {
+				t.Skip("OS_KMS_ID must be set for encrypted volume acceptance tests")
+			}
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCENodePoolV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCENodePoolV3_storage,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodePoolV3Exists(nodePoolName, clusterName, &nodePool),
+					resource.TestCheckResourceAttr(nodePoolName, "runtime", "containerd"),
+					resource.TestCheckResourceAttr(nodePoolName, "data_volumes.0.kms_id", env.OS_KMS_ID),
+					resource.TestCheckResourceAttr(nodePoolName, "storage.0.groups.0.virtual_spaces.#", "2"),
+					resource.TestCheckResourceAttr(nodePoolName, "storage.0.groups.0.virtual_spaces.0.lvm_lv_type", "striped"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCCENodePoolV3Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	cceClient, err := config.CceV3Client(env.OS_REGION_NAME)
@@ -252,3 +281,64 @@ resource "opentelekomcloud_cce_node_pool_v3" "node_pool" {
   }
 }`, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_KEYPAIR_NAME)
 )
+
+var testAccCCENodePoolV3_storage = fmt.Sprintf(`
+resource "opentelekomcloud_cce_cluster_v3" "cluster" {
+  name         = "opentelekomcloud-cce-np"
+  cluster_type = "VirtualMachine"
+  flavor_id    = "cce.s1.small"
+  vpc_id       = "%s"
+  subnet_id    = "%s"
+
+  container_network_type = "overlay_l2"
+  authentication_mode    = "rbac"
+}
+
+resource "opentelekomcloud_cce_node_pool_v3" "node_pool" {
+  cluster_id         = opentelekomcloud_cce_cluster_v3.cluster.id
+  name               = "opentelekomcloud-cce-node-pool"
+  os                 = "EulerOS 2.5"
+  flavor             = "s2.xlarge.2"
+  initial_node_count = 1
+  availability_zone  = "%s"
+  key_pair           = "%s"
+  runtime            = "containerd"
+
+  root_volume {
+    size       = 40
+    volumetype = "SSD"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SSD"
+    kms_id     = "%s"
+  }
+
+  storage {
+    selectors {
+      name                           = "cceUse"
+      match_label_size               = "100"
+      match_label_volume_type        = "SSD"
+      match_label_metadata_encrypted = "1"
+      match_label_metadata_cmkid     = "%[5]s"
+      match_label_count              = "1"
+    }
+
+    groups {
+      name           = "vgpaas"
+      cce_managed    = true
+      selector_names = ["cceUse"]
+
+      virtual_spaces {
+        name        = "kubernetes"
+        size        = "10%%"
+        lvm_lv_type = "striped"
+      }
+      virtual_spaces {
+        name            = "runtime"
+        size            = "90%%"
+        runtime_lv_type = "striped"
+      }
+    }
+  }
+}`, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE, env.OS_KEYPAIR_NAME, env.OS_KMS_ID)
@@ -249,6 +249,49 @@ func TestAccCCENodesV3EncryptedVolume(t *testing.T) {
 	})
 }
 
+func TestAccCCENodesV3Rebuild(t *testing.T) {
+	var node, rebuilt nodes.Nodes
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccCCEKeyPairPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCENodeV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCENodeV3Rebuild("v1", "echo first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodeV3Exists(resourceNameNode, "opentelekomcloud_cce_cluster_v3.cluster_1", &node),
+					resource.TestCheckResourceAttr(resourceNameNode, "rebuild_trigger", "v1"),
+				),
+			},
+			{
+				Config: testAccCCENodeV3Rebuild("v1", "echo second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodeV3Exists(resourceNameNode, "opentelekomcloud_cce_cluster_v3.cluster_1", &rebuilt),
+					testAccCheckCCENodeV3SameID(&node, &rebuilt),
+				),
+			},
+			{
+				Config: testAccCCENodeV3Rebuild("v2", "echo second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodeV3Exists(resourceNameNode, "opentelekomcloud_cce_cluster_v3.cluster_1", &rebuilt),
+					testAccCheckCCENodeV3SameID(&node, &rebuilt),
+					resource.TestCheckResourceAttr(resourceNameNode, "rebuild_trigger", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCCENodeV3SameID(before, after *nodes.Nodes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.Metadata.Id != after.Metadata.Id {
+			return fmt.Errorf("CCE node was replaced instead of being reinstalled")
+		}
+		return nil
+	}
+}
+
 func testAccCheckCCENodeV3Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.CceV3Client(env.OS_REGION_NAME)
@@ -730,3 +773,41 @@ resource "opentelekomcloud_cce_node_v3" "node_1" {
 }
 `, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE, env.OS_KEYPAIR_NAME, env.OS_KMS_ID)
 )
+
+func testAccCCENodeV3Rebuild(trigger, postInstall string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_cce_cluster_v3" "cluster_1" {
+  name         = "opentelekomcloud-cce"
+  cluster_type = "VirtualMachine"
+  flavor_id    = "cce.s1.small"
+  vpc_id       = "%s"
+  subnet_id    = "%s"
+
+  container_network_type = "overlay_l2"
+  authentication_mode    = "rbac"
+}
+
+resource "opentelekomcloud_cce_node_v3" "node_1" {
+  cluster_id = opentelekomcloud_cce_cluster_v3.cluster_1.id
+  name       = "test-node"
+  flavor_id  = "s2.large.2"
+  os         = "EulerOS 2.5"
+
+  availability_zone = "%s"
+  key_pair          = "%s"
+
+  postinstall     = "%s"
+  rebuild_trigger = "%s"
+
+  root_volume {
+    size       = 40
+    volumetype = "SATA"
+  }
+
+  data_volumes {
+    size       = 100
+    volumetype = "SATA"
+  }
+}
+`, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE, env.OS_KEYPAIR_NAME, postInstall, trigger)
+}
@@ -0,0 +1,59 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceQuotaName = "opentelekomcloud_cce_resource_quota_v1.quota"
+
+func TestAccCCEResourceQuotaV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCENamespaceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEResourceQuotaV1(10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceQuotaName, "hard.pods", "10"),
+					resource.TestCheckResourceAttr(resourceQuotaName, "hard.requests.cpu", "2"),
+					resource.TestCheckResourceAttr(resourceQuotaName, "used.pods", "0"),
+				),
+			},
+			{
+				Config: testAccCCEResourceQuotaV1(20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceQuotaName, "hard.pods", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceQuotaName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCCEResourceQuotaV1(pods int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cce_resource_quota_v1" "quota" {
+  cluster_id = opentelekomcloud_cce_cluster_v3.cluster_1.id
+  namespace  = opentelekomcloud_cce_namespace_v1.namespace.name
+  name       = "team-a-quota"
+
+  hard = {
+    "pods"            = "%d"
+    "requests.cpu"    = "2"
+    "requests.memory" = "4Gi"
+  }
+}
+`, testAccCCENamespaceV1Basic, pods)
+}
@@ -0,0 +1,62 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceCdnAccessControlName = "opentelekomcloud_cdn_access_control_v1.acl"
+
+func TestAccCdnAccessControlV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckCdn(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCdnDomainV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnAccessControlV1Basic("whitelist"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCdnAccessControlName, "referer.0.type", "whitelist"),
+					resource.TestCheckResourceAttr(resourceCdnAccessControlName, "referer.0.list.#", "2"),
+					resource.TestCheckResourceAttr(resourceCdnAccessControlName, "ip_acl.0.ip_list.#", "1"),
+				),
+			},
+			{
+				Config: testAccCdnAccessControlV1Basic("blacklist"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCdnAccessControlName, "referer.0.type", "blacklist"),
+				),
+			},
+			{
+				ResourceName:      resourceCdnAccessControlName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCdnAccessControlV1Basic(refererType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cdn_access_control_v1" "acl" {
+  domain_id = opentelekomcloud_cdn_domain_v1.domain_1.id
+
+  referer {
+    type          = "%s"
+    list          = ["www.example.com", "*.example.org"]
+    include_empty = true
+  }
+
+  ip_acl {
+    type    = "blacklist"
+    ip_list = ["192.168.0.0/24"]
+  }
+}
+`, testAccCdnDomainV1Basic("100.125.0.10"), refererType)
+}
@@ -0,0 +1,67 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceCdnCacheRulesName = "opentelekomcloud_cdn_cache_rules_v1.rules"
+
+func TestAccCdnCacheRulesV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckCdn(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCdnDomainV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnCacheRulesV1Basic(1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCdnCacheRulesName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceCdnCacheRulesName, "rule.1.type", "file_extension"),
+					resource.TestCheckResourceAttr(resourceCdnCacheRulesName, "rule.1.ttl", "1"),
+				),
+			},
+			{
+				Config: testAccCdnCacheRulesV1Basic(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCdnCacheRulesName, "rule.1.ttl", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceCdnCacheRulesName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCdnCacheRulesV1Basic(ttl int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cdn_cache_rules_v1" "rules" {
+  domain_id            = opentelekomcloud_cdn_domain_v1.domain_1.id
+  ignore_url_parameter = true
+
+  rule {
+    type     = "all"
+    ttl      = 30
+    ttl_unit = "d"
+    priority = 1
+  }
+
+  rule {
+    type     = "file_extension"
+    content  = ".jpg;.png"
+    ttl      = %d
+    ttl_unit = "h"
+    priority = 2
+  }
+}
+`, testAccCdnDomainV1Basic("100.125.0.10"), ttl)
+}
@@ -0,0 +1,101 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceCdnDomainName = "opentelekomcloud_cdn_domain_v1.domain_1"
+
+func TestAccCdnDomainV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckCdn(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCdnDomainV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnDomainV1Basic("100.125.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCdnDomainV1Exists(resourceCdnDomainName),
+					resource.TestCheckResourceAttr(resourceCdnDomainName, "name", cdnDomainName),
+					resource.TestCheckResourceAttr(resourceCdnDomainName, "domain_status", "online"),
+					resource.TestCheckResourceAttr(resourceCdnDomainName, "sources.0.origin", "100.125.0.10"),
+					resource.TestCheckResourceAttrSet(resourceCdnDomainName, "cname"),
+				),
+			},
+			{
+				Config: testAccCdnDomainV1Basic("100.125.0.20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCdnDomainName, "sources.0.origin", "100.125.0.20"),
+				),
+			},
+			{
+				ResourceName:      resourceCdnDomainName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getCdnDomain(id string) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CdnV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CDNv1 client: %w", err)
+	}
+	_, err = client.Get(client.ServiceURL("cdn", "domains", id, "detail"), nil, nil)
+	return err
+}
+
+func testAccCheckCdnDomainV1Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_cdn_domain_v1" {
+			continue
+		}
+		err := getCdnDomain(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("CDN domain still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckCdnDomainV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		return getCdnDomain(rs.Primary.ID)
+	}
+}
+
+func testAccCdnDomainV1Basic(origin string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_cdn_domain_v1" "domain_1" {
+  name         = "%s"
+  type         = "web"
+  service_area = "outside_mainland_china"
+
+  sources {
+    origin      = "%s"
+    origin_type = "ipaddr"
+  }
+}
+`, cdnDomainName, origin)
+}
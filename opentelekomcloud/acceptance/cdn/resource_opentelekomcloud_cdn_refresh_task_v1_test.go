@@ -0,0 +1,40 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccCdnRefreshTaskV1_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_cdn_refresh_task_v1.refresh"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckCdn(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCdnDomainV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnRefreshTaskV1Basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "task_done"),
+					resource.TestCheckResourceAttr(resourceName, "total", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCdnRefreshTaskV1Basic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cdn_refresh_task_v1" "refresh" {
+  type = "directory"
+  urls = ["http://${opentelekomcloud_cdn_domain_v1.domain_1.name}/static/"]
+}
+`, testAccCdnDomainV1Basic("100.125.0.10"))
+}
@@ -0,0 +1,17 @@
+package acceptance
+
+import (
+	"os"
+	"testing"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+var cdnDomainName = os.Getenv("OS_CDN_DOMAIN_NAME")
+
+func testAccPreCheckCdn(t *testing.T) {
+	common.TestAccPreCheck(t)
+	if cdnDomainName == "" {
+		t.Skip("OS_CDN_DOMAIN_NAME should be set to the owned domain for CDN acceptance tests")
+	}
+}
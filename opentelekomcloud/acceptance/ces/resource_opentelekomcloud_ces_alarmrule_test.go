@@ -38,6 +38,50 @@ func TestCESAlarmRule_basic(t *testing.T) {
 	})
 }
 
+func TestCESAlarmRule_autoscaling(t *testing.T) {
+	var ar alarmrule.AlarmRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testCESAlarmRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCESAlarmRule_autoscaling,
+				Check: resource.ComposeTestCheckFunc(
+					testCESAlarmRuleExists("opentelekomcloud_ces_alarmrule.alarmrule_1", &ar),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "alarm_actions.0.type", "autoscaling"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "alarm_actions.0.notification_list.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestCESAlarmRule_event(t *testing.T) {
+	var ar alarmrule.AlarmRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testCESAlarmRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCESAlarmRule_event,
+				Check: resource.ComposeTestCheckFunc(
+					testCESAlarmRuleExists("opentelekomcloud_ces_alarmrule.alarmrule_1", &ar),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "alarm_type", "EVENT.SYS"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "condition.0.period", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCESAlarmRuleDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.CesV1Client(env.OS_REGION_NAME)
@@ -175,3 +219,103 @@ resource "opentelekomcloud_ces_alarmrule" "alarmrule_1" {
   }
 }
 `, env.OS_NETWORK_ID)
+
+var testCESAlarmRule_autoscaling = fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name        = "acc-test-sg"
+  description = "Security group for AS alarm test"
+}
+
+resource "opentelekomcloud_as_configuration_v1" "as_config" {
+  scaling_configuration_name = "as_config"
+  instance_config {
+    image = "%s"
+    disk {
+      size        = 40
+      volume_type = "SATA"
+      disk_type   = "SYS"
+    }
+  }
+}
+
+resource "opentelekomcloud_as_group_v1" "as_group" {
+  scaling_group_name       = "as_group"
+  scaling_configuration_id = opentelekomcloud_as_configuration_v1.as_config.id
+  networks {
+    id = "%s"
+  }
+  security_groups {
+    id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+  }
+  vpc_id = "%s"
+}
+
+resource "opentelekomcloud_ces_alarmrule" "alarmrule_1" {
+  alarm_name = "alarm_rule_as"
+
+  metric {
+    namespace   = "SYS.AS"
+    metric_name = "cpu_util"
+    dimensions {
+      name  = "AutoScalingGroup"
+      value = opentelekomcloud_as_group_v1.as_group.id
+    }
+  }
+  condition {
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 80
+    unit                = "%%"
+    count               = 1
+  }
+
+  alarm_actions {
+    type              = "autoscaling"
+    notification_list = []
+  }
+}
+
+resource "opentelekomcloud_as_policy_v1" "as_policy" {
+  scaling_policy_name = "as_policy"
+  scaling_group_id    = opentelekomcloud_as_group_v1.as_group.id
+  scaling_policy_type = "ALARM"
+  alarm_id            = opentelekomcloud_ces_alarmrule.alarmrule_1.id
+  scaling_policy_action {
+    operation       = "ADD"
+    instance_number = 1
+  }
+}
+`, env.OS_IMAGE_ID, env.OS_NETWORK_ID, env.OS_VPC_ID)
+
+const testCESAlarmRule_event = `
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_1"
+  display_name = "The display name of topic_1"
+}
+
+resource "opentelekomcloud_ces_alarmrule" "alarmrule_1" {
+  alarm_name = "alarm_rule_event"
+  alarm_type = "EVENT.SYS"
+
+  metric {
+    namespace   = "SYS.ECS"
+    metric_name = "deleteServer"
+  }
+  condition {
+    period              = 0
+    filter              = "average"
+    comparison_operator = ">="
+    value               = 1
+    unit                = "count"
+    count               = 1
+  }
+
+  alarm_actions {
+    type = "notification"
+    notification_list = [
+      opentelekomcloud_smn_topic_v2.topic_1.topic_urn
+    ]
+  }
+}
+`
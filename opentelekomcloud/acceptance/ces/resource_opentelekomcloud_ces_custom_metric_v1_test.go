@@ -0,0 +1,89 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cloudeyeservice/alarmrule"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const customMetricResource = "opentelekomcloud_ces_custom_metric_v1.metric"
+
+func TestCESCustomMetric_basic(t *testing.T) {
+	var ar alarmrule.AlarmRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testCESAlarmRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCESCustomMetric_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(customMetricResource, "id", "TF.test/queue_length/queue=orders"),
+					resource.TestCheckResourceAttrSet(customMetricResource, "collect_time"),
+					testCESAlarmRuleExists("opentelekomcloud_ces_alarmrule.alarmrule_1", &ar),
+				),
+			},
+			{
+				Config: testCESCustomMetric_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(customMetricResource, "value", "20"),
+				),
+			},
+		},
+	})
+}
+
+const testCESCustomMetric_alarm = `
+resource "opentelekomcloud_ces_alarmrule" "alarmrule_1" {
+  alarm_name = "alarm_rule_custom"
+
+  metric {
+    namespace   = opentelekomcloud_ces_custom_metric_v1.metric.namespace
+    metric_name = opentelekomcloud_ces_custom_metric_v1.metric.metric_name
+    dimensions {
+      name  = "queue"
+      value = "orders"
+    }
+  }
+  condition {
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 100
+    count               = 1
+  }
+  alarm_action_enabled = false
+}
+`
+
+var testCESCustomMetric_basic = `
+resource "opentelekomcloud_ces_custom_metric_v1" "metric" {
+  namespace   = "TF.test"
+  metric_name = "queue_length"
+  value       = 10
+  type        = "int"
+
+  dimensions {
+    name  = "queue"
+    value = "orders"
+  }
+}
+` + testCESCustomMetric_alarm
+
+var testCESCustomMetric_update = `
+resource "opentelekomcloud_ces_custom_metric_v1" "metric" {
+  namespace   = "TF.test"
+  metric_name = "queue_length"
+  value       = 20
+  type        = "int"
+
+  dimensions {
+    name  = "queue"
+    value = "orders"
+  }
+}
+` + testCESCustomMetric_alarm
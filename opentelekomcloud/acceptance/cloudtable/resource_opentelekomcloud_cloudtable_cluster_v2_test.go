@@ -0,0 +1,94 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceCloudTableClusterName = "opentelekomcloud_cloudtable_cluster_v2.cluster"
+
+func TestAccCloudTableClusterV2_basic(t *testing.T) {
+	postfix := tools.RandomString("ct", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCloudTableClusterV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTableClusterV2Basic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCloudTableClusterName, "compute_units", "2"),
+					resource.TestCheckResourceAttr(resourceCloudTableClusterName, "storage_size", "400"),
+					resource.TestCheckResourceAttr(resourceCloudTableClusterName, "iam_auth_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceCloudTableClusterName, "opentsdb_units", "2"),
+					resource.TestCheckResourceAttr(resourceCloudTableClusterName, "status", "200"),
+					resource.TestCheckResourceAttrSet(resourceCloudTableClusterName, "hbase_access_address"),
+					resource.TestCheckResourceAttrSet(resourceCloudTableClusterName, "opentsdb_access_address"),
+				),
+			},
+			{
+				ResourceName:      resourceCloudTableClusterName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudTableClusterV2Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CloudTableV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating CloudTable client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_cloudtable_cluster_v2" {
+			continue
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("clusters", rs.Primary.ID), &r.Body, nil)
+		if r.Err == nil {
+			var cluster struct {
+				Status string `json:"status"`
+			}
+			if err := r.ExtractInto(&cluster); err == nil && cluster.Status != "400" {
+				return fmt.Errorf("CloudTable cluster %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudTableClusterV2Basic(postfix string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "cloudtable" {
+  name = "sg-cloudtable-%[1]s"
+}
+
+resource "opentelekomcloud_cloudtable_cluster_v2" "cluster" {
+  name              = "cloudtable-%[1]s"
+  availability_zone = "%[2]s"
+  vpc_id            = "%[3]s"
+  subnet_id         = "%[4]s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.cloudtable.id
+  compute_units     = 2
+  storage_size      = 400
+  iam_auth_enabled  = true
+  opentsdb_enabled  = true
+  opentsdb_units    = 2
+}
+`, postfix, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+}
@@ -0,0 +1,45 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataCsmsSecretVersionName = "data.opentelekomcloud_csms_secret_version_v1.current"
+
+func TestAccCsmsSecretVersionV1DataSource_basic(t *testing.T) {
+	var name = fmt.Sprintf("secret-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCsmsSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCsmsSecretVersionV1DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataCsmsSecretVersionName, "secret_string", "secret-value"),
+					resource.TestCheckResourceAttrPair(dataCsmsSecretVersionName, "version_id", resourceCsmsSecretName, "latest_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCsmsSecretVersionV1DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_csms_secret_v1" "secret_1" {
+  name          = "%s"
+  secret_string = "secret-value"
+}
+
+data "opentelekomcloud_csms_secret_version_v1" "current" {
+  secret_name = opentelekomcloud_csms_secret_v1.secret_1.name
+}
+`, name)
+}
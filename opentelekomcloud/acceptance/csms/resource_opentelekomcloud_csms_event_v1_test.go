@@ -0,0 +1,94 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceCsmsEventName = "opentelekomcloud_csms_event_v1.event_1"
+
+func TestAccCsmsEventV1_basic(t *testing.T) {
+	var name = fmt.Sprintf("event-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCsmsEventV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCsmsEventV1Basic(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCsmsEventName, "name", name),
+					resource.TestCheckResourceAttr(resourceCsmsEventName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceCsmsEventName, "event_types.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceCsmsEventName, "event_id"),
+					resource.TestCheckResourceAttr(resourceCsmsSecretName, "event_subscriptions.0", name),
+				),
+			},
+			{
+				Config: testAccCsmsEventV1Basic(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCsmsEventName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceCsmsEventName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCsmsEventV1Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CsmsV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSMSv1 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_csms_event_v1" {
+			continue
+		}
+		_, err := client.Get(client.ServiceURL("csms", "events", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("CSMS event still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCsmsEventV1Basic(name string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name = "%[1]s"
+}
+
+resource "opentelekomcloud_csms_event_v1" "event_1" {
+  name           = "%[1]s"
+  event_types    = ["SECRET_ROTATED", "SECRET_VERSION_CREATED"]
+  enabled        = %[2]t
+  smn_topic_urn  = opentelekomcloud_smn_topic_v2.topic_1.topic_urn
+  smn_topic_name = opentelekomcloud_smn_topic_v2.topic_1.name
+}
+
+resource "opentelekomcloud_csms_secret_v1" "secret_1" {
+  name                = "%[1]s"
+  secret_string       = "secret-value"
+  event_subscriptions = [opentelekomcloud_csms_event_v1.event_1.name]
+}
+`, name, enabled)
+}
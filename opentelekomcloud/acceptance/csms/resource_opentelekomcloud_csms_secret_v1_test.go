@@ -0,0 +1,106 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceCsmsSecretName = "opentelekomcloud_csms_secret_v1.secret_1"
+
+func TestAccCsmsSecretV1_basic(t *testing.T) {
+	var name = fmt.Sprintf("secret-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCsmsSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCsmsSecretV1Basic(name, "first", "value-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCsmsSecretV1Exists(resourceCsmsSecretName),
+					resource.TestCheckResourceAttr(resourceCsmsSecretName, "name", name),
+					resource.TestCheckResourceAttr(resourceCsmsSecretName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceCsmsSecretName, "tags.muh", "value-create"),
+					resource.TestCheckResourceAttrSet(resourceCsmsSecretName, "kms_key_id"),
+					resource.TestCheckResourceAttrSet(resourceCsmsSecretName, "latest_version"),
+				),
+			},
+			{
+				Config: testAccCsmsSecretV1Basic(name, "second", "value-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCsmsSecretName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceCsmsSecretName, "secret_string", "value-2"),
+				),
+			},
+			{
+				ResourceName:            resourceCsmsSecretName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_string"},
+			},
+		},
+	})
+}
+
+func getCsmsSecret(name string) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CsmsV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSMSv1 client: %w", err)
+	}
+	_, err = client.Get(client.ServiceURL("secrets", name), nil, nil)
+	return err
+}
+
+func testAccCheckCsmsSecretV1Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_csms_secret_v1" {
+			continue
+		}
+		err := getCsmsSecret(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("CSMS secret still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckCsmsSecretV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		return getCsmsSecret(rs.Primary.ID)
+	}
+}
+
+func testAccCsmsSecretV1Basic(name, description, value string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_csms_secret_v1" "secret_1" {
+  name          = "%s"
+  description   = "%s"
+  secret_string = "%s"
+
+  tags = {
+    muh = "value-create"
+  }
+}
+`, name, description, value)
+}
@@ -0,0 +1,60 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceCsmsSecretVersionName = "opentelekomcloud_csms_secret_version_v1.version_1"
+
+func TestAccCsmsSecretVersionV1_basic(t *testing.T) {
+	var name = fmt.Sprintf("secret-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCsmsSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCsmsSecretVersionV1Basic(name, "STAGING"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCsmsSecretVersionName, "secret_name", name),
+					resource.TestCheckResourceAttr(resourceCsmsSecretVersionName, "secret_string", "new-value"),
+					resource.TestCheckResourceAttr(resourceCsmsSecretVersionName, "version_stages.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceCsmsSecretVersionName, "version_id"),
+				),
+			},
+			{
+				Config: testAccCsmsSecretVersionV1Basic(name, "TESTING"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceCsmsSecretVersionName, "version_stages.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceCsmsSecretVersionName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCsmsSecretVersionV1Basic(name, stage string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_csms_secret_v1" "secret_1" {
+  name          = "%s"
+  secret_string = "initial-value"
+}
+
+resource "opentelekomcloud_csms_secret_version_v1" "version_1" {
+  secret_name    = opentelekomcloud_csms_secret_v1.secret_1.name
+  secret_string  = "new-value"
+  version_stages = ["%s"]
+}
+`, name, stage)
+}
@@ -0,0 +1,41 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccCTSUnmanagedResourcesV1DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_cts_unmanaged_resources_v1.unmanaged"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCTSUnmanagedResourcesV1DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ids.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCTSUnmanagedResourcesV1DataSourceBasic = `
+resource "opentelekomcloud_vpc_v1" "vpc" {
+  name = "tf-acc-unmanaged-vpc"
+  cidr = "192.168.0.0/16"
+}
+
+data "opentelekomcloud_cts_unmanaged_resources_v1" "unmanaged" {
+  resource_types = ["vpc", "subnet"]
+  managed_ids    = [opentelekomcloud_vpc_v1.vpc.id]
+  with_traces    = true
+}
+`
@@ -0,0 +1,118 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const notificationResource = "opentelekomcloud_cts_event_notification_v3.notification"
+
+func TestAccCTSEventNotificationV3_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCTSEventNotificationV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCTSEventNotificationV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(notificationResource, "notification_name", "tf_key_events"),
+					resource.TestCheckResourceAttr(notificationResource, "operation_type", "customized"),
+					resource.TestCheckResourceAttr(notificationResource, "operations.0.trace_names.#", "2"),
+					resource.TestCheckResourceAttr(notificationResource, "status", "enabled"),
+					resource.TestCheckResourceAttrPair(notificationResource, "topic_id", "opentelekomcloud_smn_topic_v2.topic", "id"),
+				),
+			},
+			{
+				Config: testAccCTSEventNotificationV3Update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(notificationResource, "operation_type", "complete"),
+					resource.TestCheckResourceAttr(notificationResource, "operations.#", "0"),
+					resource.TestCheckResourceAttr(notificationResource, "status", "disabled"),
+				),
+			},
+			{
+				ResourceName:      notificationResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCTSEventNotificationV3Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CtsV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating CTS v3 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_cts_event_notification_v3" {
+			continue
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("notifications", "smn"), &r.Body, nil)
+		var notifications []struct {
+			ID string `json:"notification_id"`
+		}
+		if err := r.ExtractIntoSlicePtr(&notifications, "notifications"); err != nil {
+			return err
+		}
+		for _, n := range notifications {
+			if n.ID == rs.Primary.ID {
+				return fmt.Errorf("CTS event notification still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+const testAccCTSEventNotificationV3Topic = `
+resource "opentelekomcloud_smn_topic_v2" "topic" {
+  name         = "tf_cts_key_events"
+  display_name = "The display name of topic"
+}
+`
+
+var testAccCTSEventNotificationV3Basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cts_event_notification_v3" "notification" {
+  notification_name = "tf_key_events"
+  operation_type    = "customized"
+  topic_id          = opentelekomcloud_smn_topic_v2.topic.id
+
+  operations {
+    service_type  = "ECS"
+    resource_type = "ecs"
+    trace_names   = ["createServer", "deleteServer"]
+  }
+
+  notify_user_list {
+    user_group = "admin"
+    user_list  = ["admin"]
+  }
+}
+`, testAccCTSEventNotificationV3Topic)
+
+var testAccCTSEventNotificationV3Update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cts_event_notification_v3" "notification" {
+  notification_name = "tf_key_events"
+  operation_type    = "complete"
+  topic_id          = opentelekomcloud_smn_topic_v2.topic.id
+  enabled           = false
+}
+`, testAccCTSEventNotificationV3Topic)
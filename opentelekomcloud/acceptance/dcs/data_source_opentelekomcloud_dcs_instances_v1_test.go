@@ -0,0 +1,49 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceDcsInstancesName = "data.opentelekomcloud_dcs_instances_v1.instances"
+
+func TestAccDcsInstancesV1DataSource_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDcs(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV1Instance_basic(instanceName),
+			},
+			{
+				Config: testAccDcsInstancesV1DataSourceBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceDcsInstancesName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceDcsInstancesName, "ids.0", "opentelekomcloud_dcs_instance_v1.instance_1", "id"),
+					resource.TestCheckResourceAttr(dataSourceDcsInstancesName, "instances.0.engine", "Redis"),
+					resource.TestCheckResourceAttrSet(dataSourceDcsInstancesName, "instances.0.ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDcsInstancesV1DataSourceBasic(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_dcs_instances_v1" "instances" {
+  name           = opentelekomcloud_dcs_instance_v1.instance_1.name
+  engine         = "Redis"
+  engine_version = "3.0"
+  status         = "RUNNING"
+}
+`, testAccDcsV1Instance_basic(instanceName))
+}
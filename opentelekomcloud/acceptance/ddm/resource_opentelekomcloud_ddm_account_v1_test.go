@@ -0,0 +1,60 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceDdmAccountName = "opentelekomcloud_ddm_account_v1.account"
+
+func TestAccDdmAccountV1_basic(t *testing.T) {
+	postfix := tools.RandomString("ddm", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDdmInstanceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdmAccountV1Basic(postfix, `["SELECT"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDdmAccountName, "name", "reader"),
+					resource.TestCheckResourceAttr(resourceDdmAccountName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceDdmAccountName, "schemas.#", "1"),
+				),
+			},
+			{
+				Config: testAccDdmAccountV1Basic(postfix, `["SELECT", "INSERT", "UPDATE"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDdmAccountName, "permissions.#", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceDdmAccountName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccDdmAccountV1Basic(postfix, permissions string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ddm_account_v1" "account" {
+  instance_id = opentelekomcloud_ddm_instance_v1.instance.id
+  name        = "reader"
+  password    = "Ddm!120521pass"
+  permissions = %s
+  schemas     = [opentelekomcloud_ddm_schema_v1.schema.name]
+  description = "read access to the orders schema"
+}
+`, testAccDdmSchemaV1Basic(postfix), permissions)
+}
@@ -0,0 +1,102 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceDdmInstanceName = "opentelekomcloud_ddm_instance_v1.instance"
+
+func TestAccDdmInstanceV1_basic(t *testing.T) {
+	postfix := tools.RandomString("ddm", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDdmInstanceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdmInstanceV1Basic(postfix, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDdmInstanceName, "node_num", "2"),
+					resource.TestCheckResourceAttr(resourceDdmInstanceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceDdmInstanceName, "access_ip"),
+				),
+			},
+			{
+				Config: testAccDdmInstanceV1Basic(postfix, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDdmInstanceName, "node_num", "3"),
+					resource.TestCheckResourceAttr(resourceDdmInstanceName, "nodes.#", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceDdmInstanceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_user", "admin_password", "delete_rds_data", "flavor_id", "engine_id", "availability_zones", "param_group_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckDdmInstanceV1Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DdmV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating DDM v1 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ddm_instance_v1" {
+			continue
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("instances", rs.Primary.ID), &r.Body, nil)
+		if r.Err == nil {
+			var instance struct {
+				Status string `json:"status"`
+			}
+			if err := r.ExtractInto(&instance); err == nil && instance.Status != "DELETED" {
+				return fmt.Errorf("DDM instance %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testAccDdmInstance is the DDM instance the schemas and accounts are managed in
+func testAccDdmInstance(postfix string, nodeNum int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "ddm" {
+  name = "sg-ddm-%s"
+}
+
+resource "opentelekomcloud_ddm_instance_v1" "instance" {
+  name               = "ddm-%s"
+  flavor_id          = "941b5a6d-3485-3e8a-9ed5-e8a0e4ad0ac9"
+  node_num           = %d
+  engine_id          = "367b68a3-a8e7-3e4f-bd4b-9d0d5b1e0b3e"
+  availability_zones = ["%s"]
+  vpc_id             = "%s"
+  subnet_id          = "%s"
+  security_group_id  = opentelekomcloud_networking_secgroup_v2.ddm.id
+  delete_rds_data    = true
+}
+`, postfix, postfix, nodeNum, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+}
+
+func testAccDdmInstanceV1Basic(postfix string, nodeNum int) string {
+	return testAccDdmInstance(postfix, nodeNum)
+}
@@ -0,0 +1,88 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const resourceDdmSchemaName = "opentelekomcloud_ddm_schema_v1.schema"
+
+func TestAccDdmSchemaV1_basic(t *testing.T) {
+	postfix := tools.RandomString("ddm", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDdmInstanceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdmSchemaV1Basic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDdmSchemaName, "shard_mode", "cluster"),
+					resource.TestCheckResourceAttr(resourceDdmSchemaName, "shard_number", "8"),
+					resource.TestCheckResourceAttr(resourceDdmSchemaName, "status", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceDdmSchemaName, "data_nodes.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceDdmSchemaName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_nodes", "delete_rds_data"},
+			},
+		},
+	})
+}
+
+// testAccDdmDataNode is the RDS instance used as the data node of the schemas
+func testAccDdmDataNode(postfix string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_rds_instance_v3" "data_node" {
+  name              = "tf_ddm_node_%s"
+  availability_zone = ["%s"]
+  db {
+    password = "MySql!120521"
+    type     = "MySQL"
+    version  = "5.7"
+    port     = "3306"
+  }
+  security_group_id = opentelekomcloud_networking_secgroup_v2.ddm.id
+  subnet_id         = "%s"
+  vpc_id            = "%s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor = "rds.mysql.c2.medium"
+}
+`, postfix, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID, env.OS_VPC_ID)
+}
+
+func testAccDdmSchemaV1Basic(postfix string) string {
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "opentelekomcloud_ddm_schema_v1" "schema" {
+  instance_id  = opentelekomcloud_ddm_instance_v1.instance.id
+  name         = "orders"
+  shard_mode   = "cluster"
+  shard_number = 8
+
+  data_nodes {
+    id             = opentelekomcloud_rds_instance_v3.data_node.id
+    admin_user     = "root"
+    admin_password = "MySql!120521"
+  }
+
+  delete_rds_data = true
+}
+`, testAccDdmInstance(postfix, 2), testAccDdmDataNode(postfix))
+}
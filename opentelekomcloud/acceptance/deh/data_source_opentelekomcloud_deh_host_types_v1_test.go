@@ -0,0 +1,36 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestAccOTCDedicatedHostTypesV1DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_deh_host_types_v1.types"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOTCDedicatedHostTypesV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedHostV1DataSourceID(dataSourceName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "host_types.0.host_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "host_types.0.host_type_name"),
+				),
+			},
+		},
+	})
+}
+
+var testAccOTCDedicatedHostTypesV1DataSource_basic = fmt.Sprintf(`
+data "opentelekomcloud_deh_host_types_v1" "types" {
+  availability_zone = "%s"
+}
+`, env.OS_AVAILABILITY_ZONE)
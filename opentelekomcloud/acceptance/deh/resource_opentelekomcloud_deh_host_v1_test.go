@@ -90,6 +90,66 @@ func TestAccOTCDedicatedHostV1_timeout(t *testing.T) {
 	})
 }
 
+func TestAccOTCDedicatedHostV1_quantity(t *testing.T) {
+	var host hosts.Host
+	resourceName := "opentelekomcloud_deh_host_v1.deh1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckOTCDeHV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeHV1_quantity,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCDeHV1Exists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "quantity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "host_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-create"),
+				),
+			},
+			{
+				Config: testAccDeHV1_quantityUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCDeHV1Exists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "host_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-update"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test-deh-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOTCDedicatedHostV1_schedulerHints(t *testing.T) {
+	resourceName := "opentelekomcloud_compute_instance_v2.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckOTCDeHV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeHV1_schedulerHints,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scheduler_hints.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "scheduler_hints.0.deh_id", "opentelekomcloud_deh_host_v1.deh1", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"stop_before_destroy",
+					"force_delete",
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckOTCDeHV1Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	dehClient, err := config.DehV1Client(env.OS_REGION_NAME)
@@ -172,3 +232,55 @@ resource "opentelekomcloud_deh_host_v1" "deh1" {
     delete = "5m"
   }
 }`, env.OS_AVAILABILITY_ZONE)
+
+var testAccDeHV1_quantity = fmt.Sprintf(`
+resource "opentelekomcloud_deh_host_v1" "deh1" {
+  availability_zone = "%s"
+  auto_placement    = "off"
+  host_type         = "h1"
+  name              = "test-deh-1"
+  quantity          = 2
+
+  tags = {
+    muh = "value-create"
+  }
+}
+`, env.OS_AVAILABILITY_ZONE)
+
+var testAccDeHV1_quantityUpdate = fmt.Sprintf(`
+resource "opentelekomcloud_deh_host_v1" "deh1" {
+  availability_zone = "%s"
+  auto_placement    = "off"
+  host_type         = "h1"
+  name              = "test-deh-2"
+  quantity          = 2
+
+  tags = {
+    muh = "value-update"
+  }
+}
+`, env.OS_AVAILABILITY_ZONE)
+
+var testAccDeHV1_schedulerHints = fmt.Sprintf(`
+resource "opentelekomcloud_deh_host_v1" "deh1" {
+  availability_zone = "%s"
+  auto_placement    = "off"
+  host_type         = "s2-medium"
+  name              = "test-deh-hints"
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name              = "instance-deh-hints"
+  flavor_name       = "s2.medium.1"
+  availability_zone = "%s"
+
+  network {
+    uuid = "%s"
+  }
+
+  scheduler_hints {
+    tenancy = "dedicated"
+    deh_id  = opentelekomcloud_deh_host_v1.deh1.id
+  }
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
@@ -0,0 +1,52 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceDisAppName = "data.opentelekomcloud_dis_app_v2.app"
+
+var (
+	// DIS app consuming the stream, apps are created by the consumers with DIS SDK
+	appName       = os.Getenv("OS_DIS_APP_NAME")
+	appStreamName = os.Getenv("OS_DIS_STREAM_NAME")
+)
+
+func testAccPreCheckDisApp(t *testing.T) {
+	common.TestAccPreCheck(t)
+	if appName == "" || appStreamName == "" {
+		t.Skip("OS_DIS_APP_NAME and OS_DIS_STREAM_NAME should be set for this test")
+	}
+}
+
+func TestAccDisAppV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDisApp(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDisAppV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceDisAppName, "app_id"),
+					resource.TestCheckResourceAttrSet(dataSourceDisAppName, "partitions.0.partition_id"),
+					resource.TestCheckResourceAttrSet(dataSourceDisAppName, "total_lag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDisAppV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_dis_app_v2" "app" {
+  name        = "%s"
+  stream_name = "%s"
+}
+`, appName, appStreamName)
+}
@@ -0,0 +1,37 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceDisCheckpointName = "data.opentelekomcloud_dis_checkpoint_v2.checkpoint"
+
+func TestAccDisCheckpointV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDisApp(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDisCheckpointV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceDisCheckpointName, "sequence_number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDisCheckpointV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_dis_checkpoint_v2" "checkpoint" {
+  app_name     = "%s"
+  stream_name  = "%s"
+  partition_id = "shardId-0000000000"
+}
+`, appName, appStreamName)
+}
@@ -0,0 +1,73 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceDisDumpTaskName = "opentelekomcloud_dis_dump_task_v2.task_1"
+
+// agencyName is an IAM agency delegating OBS access to DIS
+var agencyName = os.Getenv("OS_DIS_AGENCY_NAME")
+
+func TestAccDisDumpTaskV2_basic(t *testing.T) {
+	var name = fmt.Sprintf("dis-dump-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			if agencyName == "" {
+				t.Skip("OS_DIS_AGENCY_NAME should be set for this test")
+			}
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDisDumpTaskV2Basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDisDumpTaskName, "task_name", name),
+					resource.TestCheckResourceAttr(resourceDisDumpTaskName, "destination_type", "OBS"),
+					resource.TestCheckResourceAttrSet(resourceDisDumpTaskName, "state"),
+				),
+			},
+			{
+				ResourceName:      resourceDisDumpTaskName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"agency_name", "deliver_time_interval", "consumer_strategy", "obs_bucket_path", "obs_destination",
+				},
+			},
+		},
+	})
+}
+
+func testAccDisDumpTaskV2Basic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%[1]s"
+}
+
+resource "opentelekomcloud_dis_stream_v2" "stream_1" {
+  name            = "%[1]s"
+  partition_count = 1
+}
+
+resource "opentelekomcloud_dis_dump_task_v2" "task_1" {
+  stream_name     = opentelekomcloud_dis_stream_v2.stream_1.name
+  task_name       = "%[1]s"
+  agency_name     = "%[2]s"
+  obs_bucket_path = opentelekomcloud_obs_bucket.bucket.bucket
+
+  obs_destination {
+    partition_format = "yyyy/MM/dd"
+  }
+}
+`, name, agencyName)
+}
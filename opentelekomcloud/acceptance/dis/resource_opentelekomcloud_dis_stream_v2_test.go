@@ -0,0 +1,111 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceDisStreamName = "opentelekomcloud_dis_stream_v2.stream_1"
+
+func TestAccDisStreamV2_basic(t *testing.T) {
+	var streamName = fmt.Sprintf("dis-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDisStreamV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDisStreamV2Basic(streamName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDisStreamV2Exists(resourceDisStreamName),
+					resource.TestCheckResourceAttr(resourceDisStreamName, "name", streamName),
+					resource.TestCheckResourceAttr(resourceDisStreamName, "partition_count", "1"),
+					resource.TestCheckResourceAttr(resourceDisStreamName, "stream_type", "COMMON"),
+					resource.TestCheckResourceAttr(resourceDisStreamName, "retention_period", "48"),
+					resource.TestCheckResourceAttr(resourceDisStreamName, "status", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceDisStreamName, "tags.muh", "value-create"),
+				),
+			},
+			{
+				Config: testAccDisStreamV2Basic(streamName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDisStreamName, "partition_count", "2"),
+					resource.TestCheckResourceAttr(resourceDisStreamName, "readable_partition_count", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceDisStreamName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getDisStreamStatus(name string) (string, error) {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DisV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return "", fmt.Errorf("error creating OpenTelekomCloud DISv2 client: %w", err)
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("streams", name), &r.Body, nil)
+	var stream struct {
+		Status string `json:"status"`
+	}
+	if err := r.ExtractInto(&stream); err != nil {
+		return "", err
+	}
+	return stream.Status, nil
+}
+
+func testAccCheckDisStreamV2Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dis_stream_v2" {
+			continue
+		}
+		if _, err := getDisStreamStatus(rs.Primary.ID); err == nil {
+			return fmt.Errorf("DIS stream still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDisStreamV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		_, err := getDisStreamStatus(rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccDisStreamV2Basic(streamName string, partitions int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dis_stream_v2" "stream_1" {
+  name             = "%s"
+  partition_count  = %d
+  retention_period = 48
+
+  tags = {
+    muh = "value-create"
+  }
+}
+`, streamName, partitions)
+}
@@ -0,0 +1,48 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceDmsInstancesName = "data.opentelekomcloud_dms_instances_v1.instances"
+
+func TestAccDmsInstancesV1DataSource_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dms_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDms(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsV1Instance_basic(instanceName),
+			},
+			{
+				Config: testAccDmsInstancesV1DataSourceBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceDmsInstancesName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceDmsInstancesName, "ids.0", "opentelekomcloud_dms_instance_v1.instance_1", "id"),
+					resource.TestCheckResourceAttr(dataSourceDmsInstancesName, "instances.0.engine", "rabbitmq"),
+					resource.TestCheckResourceAttrSet(dataSourceDmsInstancesName, "instances.0.connect_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsInstancesV1DataSourceBasic(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_dms_instances_v1" "instances" {
+  name   = opentelekomcloud_dms_instance_v1.instance_1.name
+  engine = "rabbitmq"
+  status = "RUNNING"
+}
+`, testAccDmsV1Instance_basic(instanceName))
+}
@@ -0,0 +1,93 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceDmsConsumerGroupName = "opentelekomcloud_dms_consumer_group_v2.group_1"
+
+func TestAccDmsConsumerGroupV2_basic(t *testing.T) {
+	var groupName = fmt.Sprintf("group_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDmsKafka(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDmsConsumerGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsConsumerGroupV2Basic(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsConsumerGroupV2Exists(resourceDmsConsumerGroupName),
+					resource.TestCheckResourceAttr(resourceDmsConsumerGroupName, "name", groupName),
+					resource.TestCheckResourceAttr(resourceDmsConsumerGroupName, "description", "test consumer group"),
+					resource.TestCheckResourceAttrSet(resourceDmsConsumerGroupName, "state"),
+				),
+			},
+			{
+				ResourceName:      resourceDmsConsumerGroupName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getDmsConsumerGroup(rs *terraform.ResourceState) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DmsV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+	url := client.ServiceURL("instances", rs.Primary.Attributes["instance_id"], "groups", rs.Primary.Attributes["name"])
+	_, err = client.Get(url, nil, nil)
+	return err
+}
+
+func testAccCheckDmsConsumerGroupV2Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dms_consumer_group_v2" {
+			continue
+		}
+		err := getDmsConsumerGroup(rs)
+		if err == nil {
+			return fmt.Errorf("DMS consumer group still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckDmsConsumerGroupV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		return getDmsConsumerGroup(rs)
+	}
+}
+
+func testAccDmsConsumerGroupV2Basic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dms_consumer_group_v2" "group_1" {
+  instance_id = "%s"
+  name        = "%s"
+  description = "test consumer group"
+}
+`, kafkaInstanceID, name)
+}
@@ -0,0 +1,73 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const (
+	resourceDmsSmartConnectName = "opentelekomcloud_dms_smart_connect_v2.connector"
+	resourceDmsDumpTaskName     = "opentelekomcloud_dms_dump_task_v2.task_1"
+)
+
+func TestAccDmsDumpTaskV2_basic(t *testing.T) {
+	var bucketName = fmt.Sprintf("dms-dump-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDmsKafka(t)
+			if kafkaTopic == "" {
+				t.Skip("OS_DMS_KAFKA_TOPIC should be set for this test")
+			}
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsDumpTaskV2Basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceDmsSmartConnectName, "connector_id"),
+					resource.TestCheckResourceAttr(resourceDmsDumpTaskName, "task_name", "dump-"+bucketName),
+					resource.TestCheckResourceAttr(resourceDmsDumpTaskName, "partition_format", "yyyy/MM/dd"),
+					resource.TestCheckResourceAttr(resourceDmsDumpTaskName, "topics.0", kafkaTopic),
+					resource.TestCheckResourceAttrSet(resourceDmsDumpTaskName, "status"),
+				),
+			},
+			{
+				ResourceName:            resourceDmsDumpTaskName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "secret_key"},
+			},
+		},
+	})
+}
+
+func testAccDmsDumpTaskV2Basic(bucketName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%[1]s"
+}
+
+resource "opentelekomcloud_dms_smart_connect_v2" "connector" {
+  instance_id = "%[2]s"
+}
+
+resource "opentelekomcloud_dms_dump_task_v2" "task_1" {
+  instance_id      = opentelekomcloud_dms_smart_connect_v2.connector.instance_id
+  task_name        = "dump-%[1]s"
+  topics           = ["%[3]s"]
+  obs_bucket_name  = opentelekomcloud_obs_bucket.bucket.bucket
+  obs_path         = "kafka"
+  partition_format = "yyyy/MM/dd"
+  record_delimiter = ";"
+  access_key       = "%[4]s"
+  secret_key       = "%[5]s"
+}
+`, bucketName, kafkaInstanceID, kafkaTopic, env.OS_ACCESS_KEY, env.OS_SECRET_KEY)
+}
@@ -0,0 +1,135 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceRabbitMQInstanceName = "opentelekomcloud_dms_rabbitmq_instance_v2.instance_1"
+
+func TestAccDmsRabbitMQInstanceV2_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dms_rabbitmq_%s", acctest.RandString(5))
+	var instanceUpdate = fmt.Sprintf("dms_rabbitmq_update_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDms(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDmsRabbitMQInstanceV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitMQInstanceV2Basic(instanceName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsRabbitMQInstanceV2Exists(resourceRabbitMQInstanceName),
+					resource.TestCheckResourceAttr(resourceRabbitMQInstanceName, "name", instanceName),
+					resource.TestCheckResourceAttr(resourceRabbitMQInstanceName, "type", "single"),
+					resource.TestCheckResourceAttr(resourceRabbitMQInstanceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceRabbitMQInstanceName, "connect_address"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dms_rabbitmq_plugin_v2.shovel", "running", "true"),
+				),
+			},
+			{
+				Config: testAccDmsRabbitMQInstanceV2Basic(instanceUpdate, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsRabbitMQInstanceV2Exists(resourceRabbitMQInstanceName),
+					resource.TestCheckResourceAttr(resourceRabbitMQInstanceName, "name", instanceUpdate),
+					resource.TestCheckResourceAttr(resourceRabbitMQInstanceName, "description", "updated description"),
+				),
+			},
+			{
+				ResourceName:            resourceRabbitMQInstanceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "public_bandwidth"},
+			},
+		},
+	})
+}
+
+func testAccCheckDmsRabbitMQInstanceV2Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DmsV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dms_rabbitmq_instance_v2" {
+			continue
+		}
+
+		_, err := client.Get(client.ServiceURL("instances", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("DMS RabbitMQ instance still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckDmsRabbitMQInstanceV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.DmsV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+		}
+
+		_, err = client.Get(client.ServiceURL("instances", rs.Primary.ID), nil, nil)
+		return err
+	}
+}
+
+func testAccDmsRabbitMQInstanceV2Basic(name, description string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_1"
+  description = "secgroup_1"
+}
+
+data "opentelekomcloud_dms_az_v1" "az_1" {}
+
+data "opentelekomcloud_dms_product_v1" "product_1" {
+  engine        = "rabbitmq"
+  instance_type = "single"
+  version       = "3.7.17"
+}
+
+resource "opentelekomcloud_dms_rabbitmq_instance_v2" "instance_1" {
+  name              = "%s"
+  description       = "%s"
+  engine_version    = data.opentelekomcloud_dms_product_v1.product_1.version
+  product_id        = data.opentelekomcloud_dms_product_v1.product_1.id
+  storage_space     = data.opentelekomcloud_dms_product_v1.product_1.storage
+  access_user       = "user"
+  password          = "Dmstest@123"
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+  available_zones   = [data.opentelekomcloud_dms_az_v1.az_1.id]
+}
+
+resource "opentelekomcloud_dms_rabbitmq_plugin_v2" "shovel" {
+  instance_id = opentelekomcloud_dms_rabbitmq_instance_v2.instance_1.id
+  name        = "rabbitmq_shovel"
+}
+`, name, description, env.OS_VPC_ID, env.OS_NETWORK_ID)
+}
@@ -0,0 +1,68 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceDmsUserPermissionName = "opentelekomcloud_dms_user_permission_v1.permission_1"
+
+func TestAccDmsUserPermissionV1_basic(t *testing.T) {
+	var username = fmt.Sprintf("user_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDmsKafka(t)
+			if kafkaTopic == "" {
+				t.Skip("OS_DMS_KAFKA_TOPIC should be set for this test")
+			}
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsUserPermissionV1Basic(username, "pub"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDmsUserPermissionName, "topic_name", kafkaTopic),
+					resource.TestCheckResourceAttr(resourceDmsUserPermissionName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceDmsUserPermissionName, "policies.0.access_policy", "pub"),
+				),
+			},
+			{
+				Config: testAccDmsUserPermissionV1Basic(username, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDmsUserPermissionName, "policies.0.access_policy", "all"),
+				),
+			},
+			{
+				ResourceName:      resourceDmsUserPermissionName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsUserPermissionV1Basic(username, accessPolicy string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dms_user_v2" "user_1" {
+  instance_id = "%[1]s"
+  username    = "%[3]s"
+  password    = "Dmstest@123"
+}
+
+resource "opentelekomcloud_dms_user_permission_v1" "permission_1" {
+  instance_id = "%[1]s"
+  topic_name  = "%[2]s"
+
+  policies {
+    username      = opentelekomcloud_dms_user_v2.user_1.username
+    access_policy = "%[4]s"
+  }
+}
+`, kafkaInstanceID, kafkaTopic, username, accessPolicy)
+}
@@ -0,0 +1,118 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceDmsUserName = "opentelekomcloud_dms_user_v2.user_1"
+
+func TestAccDmsUserV2_basic(t *testing.T) {
+	var username = fmt.Sprintf("user_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDmsKafka(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDmsUserV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsUserV2Basic(username, "Dmstest@123"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsUserV2Exists(resourceDmsUserName),
+					resource.TestCheckResourceAttr(resourceDmsUserName, "username", username),
+					resource.TestCheckResourceAttr(resourceDmsUserName, "default_app", "false"),
+				),
+			},
+			{
+				Config: testAccDmsUserV2Basic(username, "Dmstest@321"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsUserV2Exists(resourceDmsUserName),
+				),
+			},
+			{
+				ResourceName:            resourceDmsUserName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func findDmsUser(rs *terraform.ResourceState) (bool, error) {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DmsV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return false, fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("instances", rs.Primary.Attributes["instance_id"], "users"), &r.Body, nil)
+	var users []struct {
+		Username string `json:"user_name"`
+	}
+	if err := r.ExtractIntoSlicePtr(&users, "users"); err != nil {
+		return false, err
+	}
+	for _, user := range users {
+		if user.Username == rs.Primary.Attributes["username"] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccCheckDmsUserV2Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dms_user_v2" {
+			continue
+		}
+		found, err := findDmsUser(rs)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("DMS user still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDmsUserV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		found, err := findDmsUser(rs)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("DMS user not found")
+		}
+		return nil
+	}
+}
+
+func testAccDmsUserV2Basic(username, password string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dms_user_v2" "user_1" {
+  instance_id = "%s"
+  username    = "%s"
+  password    = "%s"
+}
+`, kafkaInstanceID, username, password)
+}
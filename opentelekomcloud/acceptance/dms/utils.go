@@ -1,12 +1,18 @@
 package acceptance
 
 import (
+	"os"
 	"testing"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
 )
 
+var (
+	kafkaInstanceID = os.Getenv("OS_DMS_KAFKA_INSTANCE_ID")
+	kafkaTopic      = os.Getenv("OS_DMS_KAFKA_TOPIC")
+)
+
 func testAccPreCheckDms(t *testing.T) {
 	common.TestAccPreCheckRequiredEnvVars(t)
 
@@ -14,3 +20,13 @@ func testAccPreCheckDms(t *testing.T) {
 		t.Skip("This environment does not support DMS tests")
 	}
 }
+
+// testAccPreCheckDmsKafka checks that a SASL-enabled Kafka instance is provided,
+// creation of such instance takes too long to be done for each test
+func testAccPreCheckDmsKafka(t *testing.T) {
+	testAccPreCheckDms(t)
+
+	if kafkaInstanceID == "" {
+		t.Skip("OS_DMS_KAFKA_INSTANCE_ID should be set for this test")
+	}
+}
@@ -0,0 +1,118 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceEndpointName = "opentelekomcloud_dns_endpoint.endpoint_1"
+
+func TestAccDNSEndpoint_basic(t *testing.T) {
+	name := fmt.Sprintf("endpoint-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSEndpoint_basic(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV21ResourceExists(resourceEndpointName, "endpoints"),
+					resource.TestCheckResourceAttr(resourceEndpointName, "name", name),
+					resource.TestCheckResourceAttr(resourceEndpointName, "direction", "outbound"),
+					resource.TestCheckResourceAttr(resourceEndpointName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceEndpointName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceEndpointName, "vpc_id", env.OS_VPC_ID),
+				),
+			},
+			{
+				Config: testAccDNSEndpoint_basic(name+"-updated", 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEndpointName, "name", name+"-updated"),
+					resource.TestCheckResourceAttr(resourceEndpointName, "ip_addresses.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceEndpointName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSEndpointDestroy(s *terraform.State) error {
+	return testAccCheckDNSV21ResourceDestroy(s, "opentelekomcloud_dns_endpoint", "endpoints")
+}
+
+// testAccCheckDNSV21ResourceDestroy checks the DNS v2.1 resources of the type are gone
+func testAccCheckDNSV21ResourceDestroy(s *terraform.State, resourceType, path string) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DnsV21Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		_, err := client.Get(client.ServiceURL(path, rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists", resourceType)
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV21ResourceExists(n, path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.DnsV21Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+		}
+
+		_, err = client.Get(client.ServiceURL(path, rs.Primary.ID), nil, nil)
+		return err
+	}
+}
+
+func testAccDNSEndpoint_basic(name string, ipCount int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_endpoint" "endpoint_1" {
+  name      = "%s"
+  direction = "outbound"
+
+  dynamic "ip_addresses" {
+    for_each = range(%d)
+    content {
+      subnet_id = "%s"
+    }
+  }
+}
+`, name, ipCount, env.OS_SUBNET_ID)
+}
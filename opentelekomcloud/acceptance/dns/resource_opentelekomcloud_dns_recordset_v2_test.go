@@ -118,6 +118,34 @@ func TestAccDNSV2RecordSet_readTTL(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2RecordSet_line(t *testing.T) {
+	var recordset recordsets.RecordSet
+	zoneName := randomZoneName()
+	resourceName := "opentelekomcloud_dns_recordset_v2.recordset_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSet_line(zoneName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists(resourceName, &recordset),
+					resource.TestCheckResourceAttr(resourceName, "line", "default_view"),
+					resource.TestCheckResourceAttr(resourceName, "weight", "1"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSet_line(zoneName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "weight", "10"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDNSV2RecordSet_timeout(t *testing.T) {
 	var recordset recordsets.RecordSet
 	zoneName := randomZoneName()
@@ -299,6 +327,25 @@ resource "opentelekomcloud_dns_recordset_v2" "recordset_1" {
 `, zoneName)
 }
 
+func testAccDNSV2RecordSet_line(zoneName string, weight int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email2@example.com"
+  ttl   = 6000
+}
+
+resource "opentelekomcloud_dns_recordset_v2" "recordset_1" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+  name    = "%[1]s"
+  type    = "A"
+  records = ["10.1.0.3"]
+  line    = "default_view"
+  weight  = %[2]d
+}
+`, zoneName, weight)
+}
+
 func testAccDNSV2RecordSet_timeout(zoneName string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_dns_zone_v2" "zone_1" {
@@ -0,0 +1,86 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const resourceResolverRuleName = "opentelekomcloud_dns_resolver_rule.rule_1"
+
+func TestAccDNSResolverRule_basic(t *testing.T) {
+	name := fmt.Sprintf("rule-%s", acctest.RandString(5))
+	domainName := fmt.Sprintf("onprem%s.example.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSResolverRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSResolverRule_basic(name, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV21ResourceExists(resourceResolverRuleName, "resolverrules"),
+					resource.TestCheckResourceAttr(resourceResolverRuleName, "name", name),
+					resource.TestCheckResourceAttr(resourceResolverRuleName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceResolverRuleName, "ip_addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceResolverRuleName, "router.#", "1"),
+					resource.TestCheckResourceAttr(resourceResolverRuleName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccDNSResolverRule_update(name+"-updated", domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceResolverRuleName, "name", name+"-updated"),
+					resource.TestCheckResourceAttr(resourceResolverRuleName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceResolverRuleName, "router.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceResolverRuleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSResolverRuleDestroy(s *terraform.State) error {
+	return testAccCheckDNSV21ResourceDestroy(s, "opentelekomcloud_dns_resolver_rule", "resolverrules")
+}
+
+func testAccDNSResolverRule_basic(name, domainName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dns_resolver_rule" "rule_1" {
+  name         = "%s"
+  domain_name  = "%s"
+  endpoint_id  = opentelekomcloud_dns_endpoint.endpoint_1.id
+  ip_addresses = ["10.0.0.53"]
+
+  router {
+    router_id = "%s"
+  }
+}
+`, testAccDNSEndpoint_basic(name, 2), name, domainName, env.OS_VPC_ID)
+}
+
+func testAccDNSResolverRule_update(name, domainName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dns_resolver_rule" "rule_1" {
+  name         = "%s"
+  domain_name  = "%s"
+  endpoint_id  = opentelekomcloud_dns_endpoint.endpoint_1.id
+  ip_addresses = ["10.0.0.53", "10.0.1.53"]
+}
+`, testAccDNSEndpoint_basic(name, 2), name, domainName)
+}
@@ -101,6 +101,45 @@ func TestAccDNSV2Zone_private(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2Zone_privateRouters(t *testing.T) {
+	var zone zones.Zone
+	var zoneName = fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+	resourceName := "opentelekomcloud_dns_zone_v2.zone_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2Zone_privateRouters(zoneName, fmt.Sprintf(`"%s"`, env.OS_VPC_ID)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "router.#", "1"),
+				),
+			},
+			{
+				Config: testAccDNSV2Zone_privateRouters(zoneName, fmt.Sprintf(`"%s", opentelekomcloud_vpc_v1.vpc_2.id`, env.OS_VPC_ID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "router.#", "2"),
+				),
+			},
+			{
+				Config: testAccDNSV2Zone_privateRouters(zoneName, `opentelekomcloud_vpc_v1.vpc_2.id`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "router.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "router.*.router_id", "opentelekomcloud_vpc_v1.vpc_2", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDNSV2Zone_readTTL(t *testing.T) {
 	var zone zones.Zone
 	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
@@ -235,6 +274,31 @@ resource "opentelekomcloud_dns_zone_v2" "zone_1" {
 `, zoneName, env.OS_VPC_ID, env.OS_REGION_NAME)
 }
 
+// testAccDNSV2Zone_privateRouters associates the zone with the VPCs from the comma-separated router IDs expression
+func testAccDNSV2Zone_privateRouters(zoneName, routers string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_v1" "vpc_2" {
+  name = "vpc_dns_zone_routers"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name        = "%s"
+  email       = "email1@example.com"
+  description = "a private zone"
+  type        = "private"
+
+  dynamic "router" {
+    for_each = [%s]
+    content {
+      router_id     = router.value
+      router_region = "%s"
+    }
+  }
+}
+`, zoneName, routers, env.OS_REGION_NAME)
+}
+
 func testAccDNSV2Zone_update(zoneName string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_dns_zone_v2" "zone_1" {
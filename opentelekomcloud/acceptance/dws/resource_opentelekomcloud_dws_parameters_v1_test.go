@@ -0,0 +1,48 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceDwsParametersName = "opentelekomcloud_dws_parameters_v1.params"
+
+func TestAccDwsParametersV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDws(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDwsParametersV1Basic("600"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDwsParametersName, "parameter.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceDwsParametersName, "configuration_id"),
+				),
+			},
+			{
+				Config: testAccDwsParametersV1Basic("900"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDwsParametersName, "parameter.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDwsParametersV1Basic(timeout string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dws_parameters_v1" "params" {
+  cluster_id = "%s"
+
+  parameter {
+    name  = "session_timeout"
+    type  = "cn"
+    value = "%s"
+  }
+}
+`, dwsClusterID, timeout)
+}
@@ -0,0 +1,55 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceDwsSnapshotPolicyName = "opentelekomcloud_dws_snapshot_policy_v1.policy"
+
+func TestAccDwsSnapshotPolicyV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDws(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDwsSnapshotPolicyV1Basic(3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDwsSnapshotPolicyName, "keep_days", "3"),
+					resource.TestCheckResourceAttr(resourceDwsSnapshotPolicyName, "strategy.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceDwsSnapshotPolicyName, "strategy.0.id"),
+				),
+			},
+			{
+				Config: testAccDwsSnapshotPolicyV1Basic(7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDwsSnapshotPolicyName, "keep_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceDwsSnapshotPolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDwsSnapshotPolicyV1Basic(keepDays int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dws_snapshot_policy_v1" "policy" {
+  cluster_id = "%s"
+  keep_days  = %d
+
+  strategy {
+    name     = "daily"
+    type     = "full"
+    schedule = "0 0 2 * * ?"
+  }
+}
+`, dwsClusterID, keepDays)
+}
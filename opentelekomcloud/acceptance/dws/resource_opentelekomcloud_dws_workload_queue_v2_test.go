@@ -0,0 +1,61 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceDwsWorkloadQueueName = "opentelekomcloud_dws_workload_queue_v2.queue"
+
+func TestAccDwsWorkloadQueueV2_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckDws(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDwsWorkloadQueueV2Basic(20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDwsWorkloadQueueName, "cpu_share", "20"),
+					resource.TestCheckResourceAttr(resourceDwsWorkloadQueueName, "active_statements", "10"),
+				),
+			},
+			{
+				Config: testAccDwsWorkloadQueueV2Basic(40),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDwsWorkloadQueueName, "cpu_share", "40"),
+				),
+			},
+			{
+				ResourceName:      resourceDwsWorkloadQueueName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDwsWorkloadQueueV2ImportStateIdFunc,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDwsWorkloadQueueV2ImportStateIdFunc(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[resourceDwsWorkloadQueueName]
+	if !ok {
+		return "", fmt.Errorf("resource not found: %s", resourceDwsWorkloadQueueName)
+	}
+	return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+}
+
+func testAccDwsWorkloadQueueV2Basic(cpuShare int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dws_workload_queue_v2" "queue" {
+  cluster_id        = "%s"
+  name              = "tf_acc_queue"
+  cpu_share         = %d
+  memory            = 20
+  active_statements = 10
+}
+`, dwsClusterID, cpuShare)
+}
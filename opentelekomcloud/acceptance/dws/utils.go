@@ -0,0 +1,18 @@
+package acceptance
+
+import (
+	"os"
+	"testing"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+var dwsClusterID = os.Getenv("OS_DWS_CLUSTER_ID")
+
+func testAccPreCheckDws(t *testing.T) {
+	common.TestAccPreCheck(t)
+
+	if dwsClusterID == "" {
+		t.Skip("OS_DWS_CLUSTER_ID should be set for DWS tests")
+	}
+}
@@ -27,3 +27,30 @@ func TestAccOpenStackAvailabilityZonesV2_basic(t *testing.T) {
 const testAccOpenStackAvailabilityZonesConfig = `
 data "opentelekomcloud_compute_availability_zones_v2" "zones" {}
 `
+
+func TestAccOpenStackAvailabilityZonesV2_capabilities(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_compute_availability_zones_v2.zones"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackAvailabilityZonesConfigCapabilities,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "names.#", regexp.MustCompile("[1-9]\\d*")),
+					resource.TestMatchResourceAttr(dataSourceName, "zones.#", regexp.MustCompile("[1-9]\\d*")),
+					resource.TestCheckResourceAttrSet(dataSourceName, "zones.0.name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackAvailabilityZonesConfigCapabilities = `
+data "opentelekomcloud_compute_availability_zones_v2" "zones" {
+  flavor_generations = ["s2"]
+  volume_types       = ["SSD"]
+  rds_engines        = ["PostgreSQL"]
+}
+`
@@ -0,0 +1,40 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataFlavorName = "data.opentelekomcloud_compute_flavor_v2.flavor"
+
+func TestAccComputeV2FlavorDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2FlavorDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataFlavorName, "name", "s2.medium.1"),
+					resource.TestCheckResourceAttr(dataFlavorName, "vcpus", "1"),
+					resource.TestCheckResourceAttr(dataFlavorName, "ram", "1024"),
+					resource.TestCheckResourceAttr(dataFlavorName, "gpu_passthrough", "false"),
+					resource.TestCheckResourceAttrSet(dataFlavorName, "performance_type"),
+					resource.TestCheckResourceAttrSet(dataFlavorName, "virtualization_type"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2FlavorDataSourceBasic = fmt.Sprintf(`
+data "opentelekomcloud_compute_flavor_v2" "flavor" {
+  name              = "s2.medium.1"
+  availability_zone = "%s"
+}
+`, env.OS_AVAILABILITY_ZONE)
@@ -0,0 +1,47 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataInstanceName = "data.opentelekomcloud_compute_instance_v2.instance"
+
+func TestAccComputeV2InstanceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      TestAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataInstanceName, "id", "opentelekomcloud_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttr(dataInstanceName, "availability_zone", env.OS_AVAILABILITY_ZONE),
+					resource.TestCheckResourceAttrSet(dataInstanceName, "flavor_id"),
+					resource.TestCheckResourceAttrSet(dataInstanceName, "virtualization_type"),
+					resource.TestCheckResourceAttr(dataInstanceName, "gpu_passthrough", "false"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2InstanceDataSourceBasic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name              = "instance_ds"
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+data "opentelekomcloud_compute_instance_v2" "instance" {
+  name = opentelekomcloud_compute_instance_v2.instance_1.name
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
@@ -25,6 +25,8 @@ func TestAccComputeV2InstanceImportBasic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"stop_before_destroy",
+					"shutdown_timeout",
+					"resize_policy",
 					"force_delete",
 				},
 			},
@@ -49,6 +51,8 @@ func TestAccComputeV2InstanceImportBootFromVolumeImage(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"stop_before_destroy",
+					"shutdown_timeout",
+					"resize_policy",
 					"force_delete",
 				},
 			},
@@ -26,6 +26,7 @@ func TestAccEcsV1Instance_importBasic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"password",
+					"resize_policy",
 				},
 			},
 		},
@@ -11,6 +11,7 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/secgroups"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/volumeattach"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
@@ -121,6 +122,27 @@ func TestAccComputeV2Instance_bootFromVolume(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_encryptedTaggedVolumes(t *testing.T) {
+	var instance servers.Server
+	resourceName := "opentelekomcloud_compute_instance_v2.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      TestAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_encryptedTaggedVolumes,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "block_device.1.kms_key_id", env.OS_KMS_ID),
+					resource.TestCheckResourceAttr(resourceName, "block_device.1.tags.muh", "kuh"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_changeFixedIP(t *testing.T) {
 	var instance servers.Server
 
@@ -278,6 +300,48 @@ func TestAccComputeV2Instance_crazyNICs(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_nicSecgroups(t *testing.T) {
+	var instance servers.Server
+	resourceName := "opentelekomcloud_compute_instance_v2.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      TestAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_nicSecgroups,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "network.0.security_groups.#", "1"),
+				),
+			},
+			{
+				Config: testAccComputeV2Instance_nicSecgroupsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "network.0.security_groups.#", "2"),
+				),
+			},
+			{
+				// security group attached out-of-band is detected as a drift
+				Config: testAccComputeV2Instance_nicSecgroups,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "network.0.security_groups.#", "1"),
+					testAccCheckComputeV2InstanceAttachPortSecGroup(&instance, "opentelekomcloud_compute_secgroup_v2.secgroup_2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccComputeV2Instance_nicSecgroups,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "network.0.security_groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_initialStateActive(t *testing.T) {
 	var instance servers.Server
 
@@ -360,6 +424,78 @@ func TestAccComputeV2Instance_initialStateShutoff(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_powerManagement(t *testing.T) {
+	var instance servers.Server
+	resourceName := "opentelekomcloud_compute_instance_v2.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      TestAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_powerState("active", "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "shutdown_timeout", "120"),
+				),
+			},
+			{
+				Config: testAccComputeV2Instance_powerState("active", "rebooted"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_trigger", "rebooted"),
+					testAccCheckComputeV2InstanceState(&instance, "active"),
+				),
+			},
+			{
+				Config: testAccComputeV2Instance_powerState("shutoff", "rebooted"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "shutoff"),
+					testAccCheckComputeV2InstanceState(&instance, "shutoff"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_resizeStopStart(t *testing.T) {
+	var instance servers.Server
+	resourceName := "opentelekomcloud_compute_instance_v2.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      TestAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_resize("s2.medium.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "flavor_name", "s2.medium.1"),
+					resource.TestCheckResourceAttr(resourceName, "resize_policy", "stop_start"),
+				),
+			},
+			{
+				Config: testAccComputeV2Instance_resize("s2.large.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "flavor_name", "s2.large.1"),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "active"),
+					testAccCheckComputeV2InstanceState(&instance, "active"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2InstanceExists(n string, instance *servers.Server) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -392,6 +528,38 @@ func testAccCheckComputeV2InstanceExists(n string, instance *servers.Server) res
 	}
 }
 
+func testAccCheckComputeV2InstanceAttachPortSecGroup(instance *servers.Server, secGroupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[secGroupName]
+		if !ok {
+			return fmt.Errorf("not found: %s", secGroupName)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+		}
+
+		pages, err := ports.List(client, ports.ListOpts{DeviceID: instance.ID}).AllPages()
+		if err != nil {
+			return err
+		}
+		allPorts, err := ports.ExtractPorts(pages)
+		if err != nil {
+			return err
+		}
+		if len(allPorts) == 0 {
+			return fmt.Errorf("no ports found for instance %s", instance.ID)
+		}
+
+		port := allPorts[0]
+		securityGroups := append(port.SecurityGroups, rs.Primary.ID)
+		_, err = ports.Update(client, port.ID, ports.UpdateOpts{SecurityGroups: &securityGroups}).Extract()
+		return err
+	}
+}
+
 func testAccCheckComputeV2InstanceMetadata(instance *servers.Server, k string, v string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if instance.Metadata == nil {
@@ -560,6 +728,73 @@ resource "opentelekomcloud_compute_instance_v2" "instance_1" {
 }
 `, env.OS_NETWORK_ID)
 
+var testAccComputeV2Instance_nicSecgroups = fmt.Sprintf(`
+resource "opentelekomcloud_compute_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_1"
+  description = "a security group"
+  rule {
+    from_port   = 22
+    to_port     = 22
+    ip_protocol = "tcp"
+    cidr        = "0.0.0.0/0"
+  }
+}
+
+resource "opentelekomcloud_compute_secgroup_v2" "secgroup_2" {
+  name        = "secgroup_2"
+  description = "another security group"
+  rule {
+    from_port   = 80
+    to_port     = 80
+    ip_protocol = "tcp"
+    cidr        = "0.0.0.0/0"
+  }
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  network {
+    uuid            = "%s"
+    security_groups = [opentelekomcloud_compute_secgroup_v2.secgroup_1.id]
+  }
+}
+`, env.OS_NETWORK_ID)
+
+var testAccComputeV2Instance_nicSecgroupsUpdate = fmt.Sprintf(`
+resource "opentelekomcloud_compute_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_1"
+  description = "a security group"
+  rule {
+    from_port   = 22
+    to_port     = 22
+    ip_protocol = "tcp"
+    cidr        = "0.0.0.0/0"
+  }
+}
+
+resource "opentelekomcloud_compute_secgroup_v2" "secgroup_2" {
+  name        = "secgroup_2"
+  description = "another security group"
+  rule {
+    from_port   = 80
+    to_port     = 80
+    ip_protocol = "tcp"
+    cidr        = "0.0.0.0/0"
+  }
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  network {
+    uuid = "%s"
+    security_groups = [
+      opentelekomcloud_compute_secgroup_v2.secgroup_1.id,
+      opentelekomcloud_compute_secgroup_v2.secgroup_2.id,
+    ]
+  }
+}
+`, env.OS_NETWORK_ID)
+
 var testAccComputeV2Instance_bootFromVolumeImage = fmt.Sprintf(`
 resource "opentelekomcloud_compute_instance_v2" "instance_1" {
   name              = "instance_1"
@@ -620,6 +855,36 @@ resource "opentelekomcloud_compute_instance_v2" "instance_1" {
 }
 `, env.OS_NETWORK_ID, env.OS_IMAGE_ID)
 
+var testAccComputeV2Instance_encryptedTaggedVolumes = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+  block_device {
+    uuid                  = "%s"
+    source_type           = "image"
+    volume_size           = 50
+    boot_index            = 0
+    destination_type      = "volume"
+    delete_on_termination = true
+  }
+  block_device {
+    source_type           = "blank"
+    volume_size           = 10
+    boot_index            = 1
+    destination_type      = "volume"
+    delete_on_termination = true
+    kms_key_id            = "%s"
+
+    tags = {
+      muh = "kuh"
+    }
+  }
+}
+`, env.OS_NETWORK_ID, env.OS_IMAGE_ID, env.OS_KMS_ID)
+
 var testAccComputeV2Instance_fixedIP = fmt.Sprintf(`
 resource "opentelekomcloud_compute_instance_v2" "instance_1" {
   name            = "instance_1"
@@ -824,6 +1089,36 @@ resource "opentelekomcloud_compute_instance_v2" "instance_1" {
 }
 `, env.OS_NETWORK_ID)
 
+func testAccComputeV2Instance_powerState(powerState, rebootTrigger string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name             = "instance_1"
+  security_groups  = ["default"]
+  power_state      = "%s"
+  shutdown_timeout = 120
+  reboot_trigger   = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+`, powerState, rebootTrigger, env.OS_NETWORK_ID)
+}
+
+func testAccComputeV2Instance_resize(flavor string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name             = "instance_1"
+  security_groups  = ["default"]
+  flavor_name      = "%s"
+  resize_policy    = "stop_start"
+  shutdown_timeout = 120
+  network {
+    uuid = "%s"
+  }
+}
+`, flavor, env.OS_NETWORK_ID)
+}
+
 func testAccCheckComputeV2InstanceState(
 	instance *servers.Server, state string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
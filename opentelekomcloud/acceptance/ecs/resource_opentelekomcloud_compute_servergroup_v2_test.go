@@ -54,6 +54,33 @@ func TestAccComputeV2ServerGroup_affinity(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2ServerGroup_members(t *testing.T) {
+	var sg servergroups.ServerGroup
+	resourceName := "opentelekomcloud_compute_servergroup_v2.sg_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2ServerGroup_members,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists(resourceName, &sg),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+				),
+			},
+			{
+				Config: testAccComputeV2ServerGroup_membersUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists(resourceName, &sg),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2ServerGroupDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	computeClient, err := config.ComputeV2Client(env.OS_REGION_NAME)
@@ -145,3 +172,44 @@ resource "opentelekomcloud_compute_instance_v2" "instance_1" {
   }
 }
 `, env.OS_NETWORK_ID)
+
+var testAccComputeV2ServerGroup_membersInstances = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%[1]s"
+  }
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_2" {
+  name = "instance_2"
+  security_groups = ["default"]
+  network {
+    uuid = "%[1]s"
+  }
+}
+`, env.OS_NETWORK_ID)
+
+var testAccComputeV2ServerGroup_members = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_compute_servergroup_v2" "sg_1" {
+  name     = "sg_1"
+  policies = ["anti-affinity"]
+  members  = [opentelekomcloud_compute_instance_v2.instance_1.id]
+}
+`, testAccComputeV2ServerGroup_membersInstances)
+
+var testAccComputeV2ServerGroup_membersUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_compute_servergroup_v2" "sg_1" {
+  name     = "sg_1"
+  policies = ["anti-affinity"]
+  members = [
+    opentelekomcloud_compute_instance_v2.instance_1.id,
+    opentelekomcloud_compute_instance_v2.instance_2.id,
+  ]
+}
+`, testAccComputeV2ServerGroup_membersInstances)
@@ -70,6 +70,54 @@ func TestAccComputeV2VolumeAttach_timeout(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2VolumeAttach_forceDetach(t *testing.T) {
+	var va volumeattach.VolumeAttachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeV2VolumeAttachDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2VolumeAttach_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2VolumeAttachExists("opentelekomcloud_compute_volume_attach_v2.va_1", &va),
+					resource.TestCheckResourceAttr("opentelekomcloud_compute_volume_attach_v2.va_1", "force_detach", "false"),
+				),
+			},
+			{
+				Config: testAccComputeV2VolumeAttach_forceDetach,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2VolumeAttachExists("opentelekomcloud_compute_volume_attach_v2.va_1", &va),
+					resource.TestCheckResourceAttr("opentelekomcloud_compute_volume_attach_v2.va_1", "force_detach", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2VolumeAttach_multiattach(t *testing.T) {
+	var va1, va2 volumeattach.VolumeAttachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeV2VolumeAttachDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2VolumeAttach_multiattach,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2VolumeAttachExists("opentelekomcloud_compute_volume_attach_v2.va_1", &va1),
+					testAccCheckComputeV2VolumeAttachExists("opentelekomcloud_compute_volume_attach_v2.va_2", &va2),
+					resource.TestCheckResourceAttr("opentelekomcloud_compute_volume_attach_v2.va_1", "multiattach", "true"),
+					resource.TestCheckResourceAttr("opentelekomcloud_compute_volume_attach_v2.va_1", "device_type", "SCSI"),
+					resource.TestCheckResourceAttr("opentelekomcloud_compute_volume_attach_v2.va_2", "device_type", "SCSI"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2VolumeAttachDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	computeClient, err := config.ComputeV2Client(env.OS_REGION_NAME)
@@ -210,3 +258,56 @@ resource "opentelekomcloud_compute_volume_attach_v2" "va_1" {
   }
 }
 `, env.OS_NETWORK_ID)
+
+var testAccComputeV2VolumeAttach_forceDetach = fmt.Sprintf(`
+resource "opentelekomcloud_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_compute_volume_attach_v2" "va_1" {
+  instance_id  = opentelekomcloud_compute_instance_v2.instance_1.id
+  volume_id    = opentelekomcloud_blockstorage_volume_v2.volume_1.id
+  force_detach = true
+}
+`, env.OS_NETWORK_ID)
+
+var testAccComputeV2VolumeAttach_multiattach = fmt.Sprintf(`
+resource "opentelekomcloud_evs_volume_v3" "volume_1" {
+  name              = "volume_1"
+  availability_zone = "%[1]s"
+  volume_type       = "SSD"
+  size              = 10
+  device_type       = "SCSI"
+  multiattach       = true
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance" {
+  count             = 2
+  name              = "instance_${count.index}"
+  availability_zone = "%[1]s"
+  security_groups   = ["default"]
+  network {
+    uuid = "%[2]s"
+  }
+}
+
+resource "opentelekomcloud_compute_volume_attach_v2" "va_1" {
+  instance_id = opentelekomcloud_compute_instance_v2.instance[0].id
+  volume_id   = opentelekomcloud_evs_volume_v3.volume_1.id
+  device_type = "SCSI"
+}
+
+resource "opentelekomcloud_compute_volume_attach_v2" "va_2" {
+  instance_id = opentelekomcloud_compute_instance_v2.instance[1].id
+  volume_id   = opentelekomcloud_evs_volume_v3.volume_1.id
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
@@ -48,6 +48,41 @@ func TestAccEcsV1InstanceBasic(t *testing.T) {
 	})
 }
 
+func TestAccEcsV1InstancePasswordReset(t *testing.T) {
+	var instance cloudservers.CloudServer
+	resourceName := "opentelekomcloud_ecs_instance_v1.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEcsV1InstancePassword("Password@123"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists(resourceName, &instance),
+				),
+			},
+			{
+				Config: testAccEcsV1InstancePassword("Password@456"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &instance.ID),
+					resource.TestCheckResourceAttr(resourceName, "password", "Password@456"),
+				),
+			},
+			{
+				Config: testAccEcsV1InstancePassword(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &instance.ID),
+					resource.TestCheckResourceAttr(resourceName, "password", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEcsV1InstanceDiskTypeValidation(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { common.TestAccPreCheck(t) },
@@ -380,3 +415,21 @@ resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
   }
 }
 `, env.OS_IMAGE_ID, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE, env.OS_KMS_ID)
+
+func testAccEcsV1InstancePassword(password string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name     = "server_1"
+  image_id = "%s"
+  flavor   = "s2.medium.1"
+  vpc_id   = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  password          = "%s"
+  availability_zone = "%s"
+}
+`, env.OS_IMAGE_ID, env.OS_VPC_ID, env.OS_NETWORK_ID, password, env.OS_AVAILABILITY_ZONE)
+}
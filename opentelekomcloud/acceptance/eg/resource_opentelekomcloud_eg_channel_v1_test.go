@@ -0,0 +1,97 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceEgChannelName = "opentelekomcloud_eg_channel_v1.channel_1"
+
+func TestAccEgChannelV1_basic(t *testing.T) {
+	var name = fmt.Sprintf("channel-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEg(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEgChannelV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEgChannelV1Basic(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEgResourceExists(resourceEgChannelName, "channels"),
+					resource.TestCheckResourceAttr(resourceEgChannelName, "name", name),
+					resource.TestCheckResourceAttr(resourceEgChannelName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceEgChannelName, "provider_type", "CUSTOM"),
+				),
+			},
+			{
+				Config: testAccEgChannelV1Basic(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEgChannelName, "description", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceEgChannelName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getEgResource(resourceType, id string) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.EgV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EGv1 client: %w", err)
+	}
+	_, err = client.Get(client.ServiceURL(resourceType, id), nil, nil)
+	return err
+}
+
+func testAccCheckEgChannelV1Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_eg_channel_v1" {
+			continue
+		}
+		err := getEgResource("channels", rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("EG channel still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckEgResourceExists(n, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		return getEgResource(resourceType, rs.Primary.ID)
+	}
+}
+
+func testAccEgChannelV1Basic(name, description string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_eg_channel_v1" "channel_1" {
+  name        = "%s"
+  description = "%s"
+}
+`, name, description)
+}
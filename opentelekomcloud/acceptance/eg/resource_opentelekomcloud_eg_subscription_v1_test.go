@@ -0,0 +1,101 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceEgSubscriptionName = "opentelekomcloud_eg_subscription_v1.subscription_1"
+
+func TestAccEgSubscriptionV1_basic(t *testing.T) {
+	var name = fmt.Sprintf("subscription-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEg(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEgSubscriptionV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEgSubscriptionV1Basic(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEgResourceExists(resourceEgSubscriptionName, "subscriptions"),
+					resource.TestCheckResourceAttr(resourceEgSubscriptionName, "name", name),
+					resource.TestCheckResourceAttr(resourceEgSubscriptionName, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceEgSubscriptionName, "target.0.name", "HC.SMN"),
+					resource.TestCheckResourceAttr(resourceEgSubscriptionName, "target.0.smn.0.subject", "EG event"),
+				),
+			},
+			{
+				Config: testAccEgSubscriptionV1Basic(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEgSubscriptionName, "status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceEgSubscriptionName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEgSubscriptionV1Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_eg_subscription_v1" {
+			continue
+		}
+		err := getEgResource("subscriptions", rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("EG subscription still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccEgSubscriptionV1Basic(name string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name = "%[1]s"
+}
+
+resource "opentelekomcloud_eg_channel_v1" "channel_1" {
+  name = "%[1]s"
+}
+
+resource "opentelekomcloud_eg_subscription_v1" "subscription_1" {
+  channel_id = opentelekomcloud_eg_channel_v1.channel_1.id
+  name       = "%[1]s"
+  enabled    = %[2]t
+
+  source {
+    name          = opentelekomcloud_eg_channel_v1.channel_1.name
+    provider_type = "CUSTOM"
+    filter = jsonencode({
+      source = [{
+        op     = "StringIn"
+        values = ["orders"]
+      }]
+    })
+  }
+
+  target {
+    smn {
+      urn         = opentelekomcloud_smn_topic_v2.topic_1.id
+      agency_name = "EG_TARGET_AGENCY"
+      subject     = "EG event"
+    }
+  }
+}
+`, name, enabled)
+}
@@ -0,0 +1,15 @@
+package acceptance
+
+import (
+	"os"
+	"testing"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func testAccPreCheckEg(t *testing.T) {
+	common.TestAccPreCheck(t)
+	if os.Getenv("OS_EG_ENABLED") == "" {
+		t.Skip("EventGrid is not available in all regions, set OS_EG_ENABLED to run the test")
+	}
+}
@@ -0,0 +1,32 @@
+package acceptance
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccELBQuotas_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_elb_quotas.quotas"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccELBQuotasConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "loadbalancers", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "loadbalancers_used"),
+				),
+			},
+		},
+	})
+}
+
+const testAccELBQuotasConfig = `
+data "opentelekomcloud_elb_quotas" "quotas" {}
+`
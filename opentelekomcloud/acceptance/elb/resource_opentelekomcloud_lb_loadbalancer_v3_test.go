@@ -0,0 +1,169 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceLBV3Name = "opentelekomcloud_lb_loadbalancer_v3.lb"
+
+func TestAccLBV3LoadBalancer_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3LoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3LoadBalancerConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3LoadBalancerExists(resourceLBV3Name),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "name", "lb-v3-tf"),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "autoscaling.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceLBV3Name, "access_log.0.id"),
+					resource.TestCheckResourceAttrSet(resourceLBV3Name, "public_ip.0.address"),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "tags.muh", "value-create"),
+				),
+			},
+			{
+				Config: testAccLBV3LoadBalancerConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3LoadBalancerExists(resourceLBV3Name),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "name", "lb-v3-tf-updated"),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "autoscaling.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "access_log.#", "0"),
+					resource.TestCheckResourceAttr(resourceLBV3Name, "tags.muh", "value-update"),
+				),
+			},
+			{
+				ResourceName:            resourceLBV3Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"public_ip"},
+			},
+		},
+	})
+}
+
+func testAccCheckLBV3LoadBalancerDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELB v3 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_loadbalancer_v3" {
+			continue
+		}
+
+		_, err := client.Get(client.ServiceURL("elb", "loadbalancers", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("load balancer still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV3LoadBalancerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELB v3 client: %w", err)
+		}
+
+		_, err = client.Get(client.ServiceURL("elb", "loadbalancers", rs.Primary.ID), nil, nil)
+		return err
+	}
+}
+
+const testAccLBV3LogTank = `
+resource "opentelekomcloud_logtank_group_v2" "group" {
+  group_name = "lb-v3-access-logs"
+}
+
+resource "opentelekomcloud_logtank_topic_v2" "topic" {
+  group_id   = opentelekomcloud_logtank_group_v2.group.id
+  topic_name = "lb-v3-access-logs"
+}
+`
+
+var testAccLBV3LoadBalancerConfigBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v3" "lb" {
+  name               = "lb-v3-tf"
+  router_id          = "%s"
+  subnet_id          = "%s"
+  network_ids        = ["%s"]
+  availability_zones = ["eu-de-01", "eu-de-02"]
+
+  autoscaling {
+    enabled = true
+  }
+
+  public_ip {
+    ip_type               = "5_bgp"
+    bandwidth_name        = "lb-v3-bandwidth"
+    bandwidth_size        = 10
+    bandwidth_charge_mode = "traffic"
+    bandwidth_share_type  = "PER"
+  }
+
+  access_log {
+    log_group_id = opentelekomcloud_logtank_group_v2.group.id
+    log_topic_id = opentelekomcloud_logtank_topic_v2.topic.id
+  }
+
+  tags = {
+    muh = "value-create"
+  }
+}
+`, testAccLBV3LogTank, env.OS_VPC_ID, env.OS_SUBNET_ID, env.OS_NETWORK_ID)
+
+var testAccLBV3LoadBalancerConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v3" "lb" {
+  name               = "lb-v3-tf-updated"
+  description        = "updated"
+  router_id          = "%s"
+  subnet_id          = "%s"
+  network_ids        = ["%s"]
+  availability_zones = ["eu-de-01", "eu-de-02"]
+
+  autoscaling {
+    enabled = false
+  }
+
+  public_ip {
+    ip_type               = "5_bgp"
+    bandwidth_name        = "lb-v3-bandwidth"
+    bandwidth_size        = 10
+    bandwidth_charge_mode = "traffic"
+    bandwidth_share_type  = "PER"
+  }
+
+  tags = {
+    muh = "value-update"
+  }
+}
+`, testAccLBV3LogTank, env.OS_VPC_ID, env.OS_SUBNET_ID, env.OS_NETWORK_ID)
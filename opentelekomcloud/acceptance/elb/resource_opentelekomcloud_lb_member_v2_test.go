@@ -42,6 +42,35 @@ func TestAccLBV2Member_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Member_slowStartDraining(t *testing.T) {
+	var member pools.Member
+	resourceName := "opentelekomcloud_lb_member_v2.member_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2MemberConfigSlowStart(20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "weight", "20"),
+					resource.TestCheckResourceAttr(resourceName, "slow_start_duration", "30"),
+					resource.TestCheckResourceAttr(resourceName, "drain_timeout", "15"),
+				),
+			},
+			{
+				Config: testAccLBV2MemberConfigSlowStart(40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "weight", "40"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2MemberDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(env.OS_REGION_NAME)
@@ -194,3 +223,36 @@ resource "opentelekomcloud_lb_member_v2" "member_2" {
   }
 }
 `, env.OS_SUBNET_ID)
+
+func testAccLBV2MemberConfigSlowStart(weight int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%[1]s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+
+resource "opentelekomcloud_lb_member_v2" "member_1" {
+  address             = "192.168.0.10"
+  protocol_port       = 8080
+  weight              = %[2]d
+  pool_id             = opentelekomcloud_lb_pool_v2.pool_1.id
+  subnet_id           = "%[1]s"
+  slow_start_duration = 30
+  drain_timeout       = 15
+}
+`, env.OS_SUBNET_ID, weight)
+}
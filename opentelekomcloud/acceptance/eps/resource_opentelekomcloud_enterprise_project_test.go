@@ -0,0 +1,70 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceEnterpriseProjectName = "opentelekomcloud_enterprise_project.project_1"
+
+func TestAccEnterpriseProject_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-ep-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheckAdminOnly(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnterpriseProjectBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEnterpriseProjectName, "name", name),
+					resource.TestCheckResourceAttr(resourceEnterpriseProjectName, "enable", "true"),
+					resource.TestCheckResourceAttr(resourceEnterpriseProjectName, "status", "1"),
+					resource.TestCheckResourceAttrPair("opentelekomcloud_vpc_v1.vpc_1", "enterprise_project_id", resourceEnterpriseProjectName, "id"),
+				),
+			},
+			{
+				ResourceName:      "opentelekomcloud_vpc_v1.vpc_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnterpriseProjectDisabled(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEnterpriseProjectName, "description", "disabled"),
+					resource.TestCheckResourceAttr(resourceEnterpriseProjectName, "enable", "false"),
+					resource.TestCheckResourceAttr(resourceEnterpriseProjectName, "status", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnterpriseProjectBasic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_enterprise_project" "project_1" {
+  name = "%s"
+}
+
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name                  = "%[1]s"
+  cidr                  = "192.168.0.0/16"
+  enterprise_project_id = opentelekomcloud_enterprise_project.project_1.id
+}
+`, name)
+}
+
+func testAccEnterpriseProjectDisabled(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_enterprise_project" "project_1" {
+  name        = "%s"
+  description = "disabled"
+  enable      = false
+}
+`, name)
+}
@@ -0,0 +1,32 @@
+package acceptance
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccBlockStorageQuotasV2_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_blockstorage_quotas_v2.quotas"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageQuotasV2Config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "volumes", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "volumes_used"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBlockStorageQuotasV2Config = `
+data "opentelekomcloud_blockstorage_quotas_v2" "quotas" {}
+`
@@ -0,0 +1,45 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestAccProviderPreventReplacement(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEvsStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderPreventReplacement("SATA"),
+			},
+			{
+				Config:      testAccProviderPreventReplacement("SSD"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is protected from replacement, but changes of volume_type require it`),
+			},
+		},
+	})
+}
+
+func testAccProviderPreventReplacement(volumeType string) string {
+	return fmt.Sprintf(`
+provider "opentelekomcloud" {
+  prevent_replacement = true
+}
+
+resource "opentelekomcloud_evs_volume_v3" "volume_1" {
+  name              = "volume_protected"
+  availability_zone = "%s"
+  volume_type       = "%s"
+  size              = 10
+}
+`, env.OS_AVAILABILITY_ZONE, volumeType)
+}
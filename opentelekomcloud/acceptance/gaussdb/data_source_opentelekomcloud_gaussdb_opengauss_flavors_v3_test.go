@@ -0,0 +1,35 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataGaussdbFlavorsName = "data.opentelekomcloud_gaussdb_opengauss_flavors_v3.flavors"
+
+func TestAccGaussdbOpenGaussFlavorsV3DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussdbOpenGaussFlavorsV3DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataGaussdbFlavorsName, "flavors.#"),
+					resource.TestCheckResourceAttr(dataGaussdbFlavorsName, "flavors.0.ha_mode", "enterprise"),
+					resource.TestCheckResourceAttr(dataGaussdbFlavorsName, "flavors.0.vcpus", "8"),
+				),
+			},
+		},
+	})
+}
+
+const testAccGaussdbOpenGaussFlavorsV3DataSourceBasic = `
+data "opentelekomcloud_gaussdb_opengauss_flavors_v3" "flavors" {
+  ha_mode = "enterprise"
+  vcpus   = 8
+}
+`
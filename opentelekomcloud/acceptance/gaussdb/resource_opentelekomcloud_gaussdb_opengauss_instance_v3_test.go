@@ -0,0 +1,179 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceGaussdbInstanceName = "opentelekomcloud_gaussdb_opengauss_instance_v3.instance"
+
+func TestAccGaussdbOpenGaussInstanceV3_distributed(t *testing.T) {
+	postfix := tools.RandomString("gauss", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckGaussdbOpenGaussInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussdbOpenGaussInstanceV3Distributed(postfix, 3, 2, 160),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "ha.0.mode", "enterprise"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "sharding_num", "3"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "coordinator_num", "2"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "volume.0.size", "160"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "backup_strategy.0.keep_days", "7"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceGaussdbInstanceName, "private_ips.#"),
+				),
+			},
+			{
+				Config: testAccGaussdbOpenGaussInstanceV3Distributed(postfix, 4, 3, 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "sharding_num", "4"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "coordinator_num", "3"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "volume.0.size", "200"),
+				),
+			},
+			{
+				ResourceName:            resourceGaussdbInstanceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "availability_zone", "sharding_num", "coordinator_num", "replica_num", "configuration_id"},
+			},
+		},
+	})
+}
+
+func TestAccGaussdbOpenGaussInstanceV3_centralized(t *testing.T) {
+	postfix := tools.RandomString("gauss", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckGaussdbOpenGaussInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussdbOpenGaussInstanceV3Centralized(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "ha.0.mode", "centralization_standard"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceGaussdbInstanceName, "nodes.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGaussdbOpenGaussInstanceV3Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.GaussDBOpenGaussV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating GaussDB client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_gaussdb_opengauss_instance_v3" {
+			continue
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("instances")+"?id="+rs.Primary.ID, &r.Body, nil)
+		var instances []struct {
+			ID string `json:"id"`
+		}
+		if err := r.ExtractIntoSlicePtr(&instances, "instances"); err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			if instance.ID == rs.Primary.ID {
+				return fmt.Errorf("GaussDB instance %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccGaussdbOpenGaussInstanceV3Distributed(postfix string, shards, coordinators, size int) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_gaussdb_opengauss_flavors_v3" "flavors" {
+  ha_mode = "enterprise"
+  vcpus   = 8
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "gauss" {
+  name = "sg-gauss-%[1]s"
+}
+
+resource "opentelekomcloud_gaussdb_opengauss_instance_v3" "instance" {
+  name              = "gauss-%[1]s"
+  flavor            = data.opentelekomcloud_gaussdb_opengauss_flavors_v3.flavors.flavors[0].spec_code
+  password          = "Gauss@12345"
+  availability_zone = "%[2]s,%[2]s,%[2]s"
+  vpc_id            = "%[3]s"
+  subnet_id         = "%[4]s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.gauss.id
+
+  sharding_num    = %[5]d
+  coordinator_num = %[6]d
+
+  ha {
+    mode             = "enterprise"
+    replication_mode = "sync"
+    consistency      = "strong"
+  }
+
+  volume {
+    type = "ULTRAHIGH"
+    size = %[7]d
+  }
+
+  backup_strategy {
+    start_time = "08:00-09:00"
+    keep_days  = 7
+  }
+}
+`, postfix, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID, shards, coordinators, size)
+}
+
+func testAccGaussdbOpenGaussInstanceV3Centralized(postfix string) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_gaussdb_opengauss_flavors_v3" "flavors" {
+  ha_mode = "centralization_standard"
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "gauss" {
+  name = "sg-gauss-%[1]s"
+}
+
+resource "opentelekomcloud_gaussdb_opengauss_instance_v3" "instance" {
+  name              = "gauss-%[1]s"
+  flavor            = data.opentelekomcloud_gaussdb_opengauss_flavors_v3.flavors.flavors[0].spec_code
+  password          = "Gauss@12345"
+  availability_zone = "%[2]s,%[2]s,%[2]s"
+  vpc_id            = "%[3]s"
+  subnet_id         = "%[4]s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.gauss.id
+
+  ha {
+    mode             = "centralization_standard"
+    replication_mode = "sync"
+  }
+
+  volume {
+    type = "ULTRAHIGH"
+    size = 40
+  }
+}
+`, postfix, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+}
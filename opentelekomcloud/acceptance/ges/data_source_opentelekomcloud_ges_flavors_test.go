@@ -0,0 +1,33 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataFlavorsName = "data.opentelekomcloud_ges_flavors.flavors"
+
+func TestAccGesFlavorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGesFlavorsDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataFlavorsName, "flavors.#", "3"),
+					resource.TestCheckResourceAttr(dataFlavorsName, "flavors.0.graph_size_type_index", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccGesFlavorsDataSource_basic = `
+data "opentelekomcloud_ges_flavors" "flavors" {
+  min_edges = 5000000
+}
+`
@@ -0,0 +1,99 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	resourceGraphName  = "opentelekomcloud_ges_graph.graph"
+	resourceBackupName = "opentelekomcloud_ges_backup.backup"
+)
+
+func TestAccGesGraph_basic(t *testing.T) {
+	name := fmt.Sprintf("graph_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckGesGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGesGraph_basic(name, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGraphName, "name", name),
+					resource.TestCheckResourceAttr(resourceGraphName, "graph_size_type_index", "0"),
+					resource.TestCheckResourceAttr(resourceGraphName, "status", "200"),
+					resource.TestCheckResourceAttrSet(resourceGraphName, "private_ip"),
+					resource.TestCheckResourceAttr(resourceBackupName, "status", "success"),
+				),
+			},
+			{
+				Config: testAccGesGraph_basic(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceGraphName, "graph_size_type_index", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceGraphName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_backup"},
+			},
+		},
+	})
+}
+
+func testAccCheckGesGraphDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.GesV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud GES client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ges_graph" {
+			continue
+		}
+
+		var r struct {
+			Graph struct {
+				Status string `json:"status"`
+			} `json:"graph"`
+		}
+		_, err := client.Get(client.ServiceURL("graphs", rs.Primary.ID), &r, nil)
+		if err == nil && r.Graph.Status != "400" {
+			return fmt.Errorf("GES graph still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccGesGraph_basic(name, sizeIndex string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "%[1]s"
+}
+
+resource "opentelekomcloud_ges_graph" "graph" {
+  name                  = "%[1]s"
+  graph_size_type_index = "%[2]s"
+  vpc_id                = "%[3]s"
+  subnet_id             = "%[4]s"
+  security_group_id     = opentelekomcloud_networking_secgroup_v2.secgroup.id
+}
+
+resource "opentelekomcloud_ges_backup" "backup" {
+  graph_id = opentelekomcloud_ges_graph.graph.id
+}
+`, name, sizeIndex, env.OS_VPC_ID, env.OS_NETWORK_ID)
+}
@@ -0,0 +1,126 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/groups"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const groupRoleAssignmentsResource = "opentelekomcloud_identity_group_role_assignments_v3.assignments"
+
+func TestAccIdentityV3GroupRoleAssignments_basic(t *testing.T) {
+	postfix := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityV3GroupRoleAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3GroupRoleAssignmentsBasic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupRoleAssignmentsResource, "role_assignment.#", "2"),
+				),
+			},
+			{
+				Config: testAccIdentityV3GroupRoleAssignmentsUpdate(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupRoleAssignmentsResource, "role_assignment.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3GroupRoleAssignmentsDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity v3 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_identity_group_v3" {
+			continue
+		}
+
+		if _, err := groups.Get(client, rs.Primary.ID).Extract(); err == nil {
+			return fmt.Errorf("group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccIdentityV3GroupRoleAssignmentsBasic(postfix string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_project_v3" "project_1" {
+  name = "eu-de_project_%[1]s1"
+}
+
+resource "opentelekomcloud_identity_project_v3" "project_2" {
+  name = "eu-de_project_%[1]s2"
+}
+
+resource "opentelekomcloud_identity_group_v3" "group_1" {
+  name = "group-%[1]s"
+}
+
+data "opentelekomcloud_identity_role_v3" "role_1" {
+  name = "system_all_4"
+}
+
+resource "opentelekomcloud_identity_group_role_assignments_v3" "assignments" {
+  group_id = opentelekomcloud_identity_group_v3.group_1.id
+
+  role_assignment {
+    role_id    = data.opentelekomcloud_identity_role_v3.role_1.id
+    project_id = opentelekomcloud_identity_project_v3.project_1.id
+  }
+
+  role_assignment {
+    role_id    = data.opentelekomcloud_identity_role_v3.role_1.id
+    project_id = opentelekomcloud_identity_project_v3.project_2.id
+  }
+}
+`, postfix)
+}
+
+func testAccIdentityV3GroupRoleAssignmentsUpdate(postfix string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_project_v3" "project_1" {
+  name = "eu-de_project_%[1]s1"
+}
+
+resource "opentelekomcloud_identity_project_v3" "project_2" {
+  name = "eu-de_project_%[1]s2"
+}
+
+resource "opentelekomcloud_identity_group_v3" "group_1" {
+  name = "group-%[1]s"
+}
+
+data "opentelekomcloud_identity_role_v3" "role_1" {
+  name = "system_all_4"
+}
+
+resource "opentelekomcloud_identity_group_role_assignments_v3" "assignments" {
+  group_id = opentelekomcloud_identity_group_v3.group_1.id
+
+  role_assignment {
+    role_id    = data.opentelekomcloud_identity_role_v3.role_1.id
+    project_id = opentelekomcloud_identity_project_v3.project_2.id
+  }
+}
+`, postfix)
+}
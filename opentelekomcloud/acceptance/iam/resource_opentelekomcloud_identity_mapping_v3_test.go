@@ -39,6 +39,31 @@ func TestAccIdentityV3MappingBasic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityV3MappingTypedRules(t *testing.T) {
+	resourceName := "opentelekomcloud_identity_mapping_v3.mapping"
+	mappingID := tools.RandomString("mapping-", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityV3MappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3MappingTypedRules(mappingID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.local.0.user", "{0}"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.remote.0.type", "uid"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityV3MappingDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.IdentityV3Client(env.OS_REGION_NAME)
@@ -77,3 +102,27 @@ resource "opentelekomcloud_identity_mapping_v3" "mapping" {
 }
 `, mappingID)
 }
+
+func testAccIdentityV3MappingTypedRules(mappingID string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_mapping_v3" "mapping" {
+  mapping_id = "%s"
+
+  rule {
+    local {
+      user = "{0}"
+    }
+    local {
+      group = "admin"
+    }
+    remote {
+      type = "uid"
+    }
+    remote {
+      type       = "groups"
+      any_one_of = ["admins"]
+    }
+  }
+}
+`, mappingID)
+}
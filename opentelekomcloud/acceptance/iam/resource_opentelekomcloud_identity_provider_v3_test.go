@@ -48,6 +48,36 @@ func TestAccIdentityV3ProviderBasic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityV3ProviderOIDC(t *testing.T) {
+	fullName := fmt.Sprintf("%s.%s", providerResource, "provider")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityV3ProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3ProviderOIDC,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "protocol", "oidc"),
+					resource.TestCheckResourceAttrPair(fullName, "mapping_id", "opentelekomcloud_identity_mapping_v3.mapping", "id"),
+					resource.TestCheckResourceAttr(fullName, "access_config.0.access_type", "program"),
+					resource.TestCheckResourceAttr(fullName, "access_config.0.client_id", "client-id"),
+				),
+			},
+			{
+				Config: testAccIdentityV3ProviderOIDCUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullName, "access_config.0.client_id", "client-id-updated"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityV3ProviderDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	identityClient, err := config.IdentityV3Client(env.OS_REGION_NAME)
@@ -83,6 +113,68 @@ resource "opentelekomcloud_identity_provider_v3" "provider" {
 }
 `, providerName, providerDescription)
 
+	testAccIdentityV3ProviderOIDC = fmt.Sprintf(`
+resource "opentelekomcloud_identity_mapping_v3" "mapping" {
+  mapping_id = "%[1]s"
+
+  rule {
+    local {
+      user = "{0}"
+    }
+    remote {
+      type = "sub"
+    }
+  }
+}
+
+resource "opentelekomcloud_identity_provider_v3" "provider" {
+  name       = "%[1]s"
+  enabled    = true
+  protocol   = "oidc"
+  mapping_id = opentelekomcloud_identity_mapping_v3.mapping.id
+
+  access_config {
+    access_type  = "program"
+    provider_url = "https://accounts.example.com"
+    client_id    = "client-id"
+    signing_key = jsonencode({
+      keys = [{ kid = "d05ef20c4512645vv1", n = "cws_cnjiwsbvweolwn_-vnl", e = "AQAB", kty = "RSA", use = "sig", alg = "RS256" }]
+    })
+  }
+}
+`, providerName)
+
+	testAccIdentityV3ProviderOIDCUpdated = fmt.Sprintf(`
+resource "opentelekomcloud_identity_mapping_v3" "mapping" {
+  mapping_id = "%[1]s"
+
+  rule {
+    local {
+      user = "{0}"
+    }
+    remote {
+      type = "sub"
+    }
+  }
+}
+
+resource "opentelekomcloud_identity_provider_v3" "provider" {
+  name       = "%[1]s"
+  enabled    = true
+  protocol   = "oidc"
+  mapping_id = opentelekomcloud_identity_mapping_v3.mapping.id
+
+  access_config {
+    access_type  = "program"
+    provider_url = "https://accounts.example.com"
+    client_id    = "client-id-updated"
+    signing_key = jsonencode({
+      keys = [{ kid = "d05ef20c4512645vv1", n = "cws_cnjiwsbvweolwn_-vnl", e = "AQAB", kty = "RSA", use = "sig", alg = "RS256" }]
+    })
+  }
+}
+`, providerName)
+
 	testAccIdentityV3ProviderUpdated = fmt.Sprintf(`
 resource "opentelekomcloud_identity_provider_v3" "provider" {
   name        = "%s"
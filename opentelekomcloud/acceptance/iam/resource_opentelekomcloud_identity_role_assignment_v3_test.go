@@ -48,6 +48,32 @@ func TestAccIdentityV3RoleAssignment_basic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityV3RoleAssignment_federated(t *testing.T) {
+	var role roles.Role
+	var group groups.Group
+	var project projects.Project
+	resourceName := "opentelekomcloud_identity_role_assignment_v3.role_assignment_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityV3RoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3RoleAssignment_federated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3RoleAssignmentExists(resourceName, &role, &group, &project),
+					resource.TestCheckResourceAttrPair(resourceName, "identity_provider_id",
+						"opentelekomcloud_identity_provider_v3.provider", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityV3RoleAssignmentDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	identityClient, err := config.IdentityV3Client(env.OS_REGION_NAME)
@@ -157,3 +183,47 @@ resource "opentelekomcloud_identity_role_assignment_v3" "role_assignment_1" {
   role_id    = data.opentelekomcloud_identity_role_v3.role_1.id
 }
 `
+
+const testAccIdentityV3RoleAssignment_federated = `
+resource "opentelekomcloud_identity_project_v3" "project_1" {
+  name = "eu-de_project_fed"
+}
+
+resource "opentelekomcloud_identity_group_v3" "group_1" {
+  name = "federated_group_1"
+}
+
+resource "opentelekomcloud_identity_mapping_v3" "mapping" {
+  mapping_id = "tf-acc-federated-mapping"
+
+  rule {
+    local {
+      user = "{0}"
+    }
+    local {
+      group = opentelekomcloud_identity_group_v3.group_1.name
+    }
+    remote {
+      type = "uid"
+    }
+  }
+}
+
+resource "opentelekomcloud_identity_provider_v3" "provider" {
+  name       = "tf-acc-federated-provider"
+  enabled    = true
+  protocol   = "saml"
+  mapping_id = opentelekomcloud_identity_mapping_v3.mapping.id
+}
+
+data "opentelekomcloud_identity_role_v3" "role_1" {
+  name = "system_all_4"
+}
+
+resource "opentelekomcloud_identity_role_assignment_v3" "role_assignment_1" {
+  group_id             = opentelekomcloud_identity_group_v3.group_1.id
+  project_id           = opentelekomcloud_identity_project_v3.project_1.id
+  role_id              = data.opentelekomcloud_identity_role_v3.role_1.id
+  identity_provider_id = opentelekomcloud_identity_provider_v3.provider.id
+}
+`
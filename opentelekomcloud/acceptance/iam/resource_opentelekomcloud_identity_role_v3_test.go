@@ -44,6 +44,49 @@ func TestAccIdentityRoleV3_basic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityRoleV3_policy(t *testing.T) {
+	resourceName := "opentelekomcloud_identity_role_v3.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acc.TestAccPreCheck(t) },
+		ProviderFactories: acc.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityRoleV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityRoleV3_policy(acctest.RandString(10)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityRoleV3Exists,
+					resource.TestCheckResourceAttr(resourceName, "display_layer", "project"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.resource.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.condition.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityRoleV3_policy(val string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_role_v3" "role" {
+  description   = "role"
+  display_name  = "custom_role%s"
+  display_layer = "project"
+
+  statement {
+    effect   = "Allow"
+    action   = ["obs:object:GetObject"]
+    resource = ["obs:*:*:object:my-bucket/*"]
+
+    condition {
+      operator = "StringStartWith"
+      key      = "g:UserName"
+      values   = ["admin"]
+    }
+  }
+}
+`, val)
+}
+
 func testAccIdentityRoleV3_basic(val string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_identity_role_v3" "role" {
@@ -0,0 +1,71 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccImagesImagesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_images_images.images"
+	recentName := "data.opentelekomcloud_images_images.recent"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImagesDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.visibility", "private"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "opentelekomcloud_images_image_v2.build_2", "id"),
+					resource.TestCheckResourceAttr(recentName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(recentName, "images.0.id", "opentelekomcloud_images_image_v2.build_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccImagesImagesDataSourceImages = `
+resource "opentelekomcloud_images_image_v2" "build_1" {
+  name             = "golden-tf-1"
+  container_format = "bare"
+  disk_format      = "qcow2"
+  image_source_url = "https://download.cirros-cloud.net/0.3.5/cirros-0.3.5-x86_64-disk.img"
+  tags             = ["golden-tf", "build"]
+}
+
+resource "opentelekomcloud_images_image_v2" "build_2" {
+  name             = "golden-tf-2"
+  container_format = "bare"
+  disk_format      = "qcow2"
+  image_source_url = "https://download.cirros-cloud.net/0.3.5/cirros-0.3.5-x86_64-disk.img"
+  tags             = ["golden-tf", "build"]
+
+  depends_on = [opentelekomcloud_images_image_v2.build_1]
+}
+`
+
+var testAccImagesImagesDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_images_images" "images" {
+  name_regex = "^golden-tf-"
+  visibility = "private"
+  tags       = ["golden-tf", "build"]
+
+  depends_on = [opentelekomcloud_images_image_v2.build_2]
+}
+
+data "opentelekomcloud_images_images" "recent" {
+  name_regex  = "^golden-tf-"
+  most_recent = true
+
+  depends_on = [opentelekomcloud_images_image_v2.build_2]
+}
+`, testAccImagesImagesDataSourceImages)
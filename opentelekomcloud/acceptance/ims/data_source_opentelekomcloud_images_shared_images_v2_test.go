@@ -0,0 +1,33 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccImagesSharedImagesV2DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_images_shared_images_v2.shared"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesSharedImagesV2DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "member_status", "all"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ids.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccImagesSharedImagesV2DataSourceBasic = `
+data "opentelekomcloud_images_shared_images_v2" "shared" {
+  member_status = "all"
+}
+`
@@ -0,0 +1,57 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+// image shared with the current project by another project
+var sharedImageID = os.Getenv("OS_SHARED_IMAGE_ID")
+
+func TestAccImagesImageAccessAcceptV2_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_images_image_access_accept_v2.accept"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			if sharedImageID == "" {
+				t.Skip("OS_SHARED_IMAGE_ID should be set for this test")
+			}
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageAccessAcceptV2Basic("accepted"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "accepted"),
+					resource.TestCheckResourceAttrSet(resourceName, "member_id"),
+				),
+			},
+			{
+				Config: testAccImagesImageAccessAcceptV2Basic("rejected"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "rejected"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccImagesImageAccessAcceptV2Basic(status string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_images_image_access_accept_v2" "accept" {
+  image_id = "%s"
+  status   = "%s"
+}
+`, sharedImageID, status)
+}
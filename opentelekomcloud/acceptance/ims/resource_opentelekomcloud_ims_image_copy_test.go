@@ -0,0 +1,76 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ims"
+)
+
+const resourceImsImageCopyName = "opentelekomcloud_ims_image_copy.copy"
+
+func TestAccImsImageCopy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckImsImageCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImsImageCopyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceImsImageCopyName, "image_id"),
+					resource.TestCheckResourceAttr(resourceImsImageCopyName, "target_region", env.OS_REGION_NAME),
+					resource.TestCheckResourceAttr(resourceImsImageCopyName, "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImsImageCopyDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.ImageV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ims_image_copy" {
+			continue
+		}
+
+		if _, err := ims.GetCloudImage(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("copied image %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccImsImageCopyBasic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name              = "instance_copy"
+  security_groups   = ["default"]
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_ims_image_v2" "source" {
+  name        = "TFTest_image_source"
+  instance_id = opentelekomcloud_compute_instance_v2.instance_1.id
+}
+
+resource "opentelekomcloud_ims_image_copy" "copy" {
+  source_image_id = opentelekomcloud_ims_image_v2.source.id
+  name            = "TFTest_image_copy"
+  description     = "copy of the golden image"
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
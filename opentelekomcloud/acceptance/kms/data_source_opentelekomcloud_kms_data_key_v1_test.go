@@ -33,6 +33,27 @@ func TestAccKmsDataKeyV1DataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccKmsDataKeyV1DataSource_decrypt(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsDataKeyV1DataSource_decrypt,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_kms_data_key_v1.kms_datakey1", "plain_text", ""),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_kms_data_key_v1.kms_datakey2", "cipher_text",
+						"data.opentelekomcloud_kms_data_key_v1.kms_datakey1", "cipher_text"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_kms_data_key_v1.kms_datakey2", "plain_text"),
+				),
+			},
+		},
+	})
+}
+
 var testAccKmsDataKeyV1DataSource_key = fmt.Sprintf(`
 resource "opentelekomcloud_kms_key_v1" "key1" {
   key_alias    = "%s"
@@ -46,3 +67,18 @@ data "opentelekomcloud_kms_data_key_v1" "kms_datakey1" {
   datakey_length   =   "512"
 }
 `, testAccKmsDataKeyV1DataSource_key)
+
+var testAccKmsDataKeyV1DataSource_decrypt = fmt.Sprintf(`
+%s
+data "opentelekomcloud_kms_data_key_v1" "kms_datakey1" {
+  key_id            = opentelekomcloud_kms_key_v1.key1.id
+  datakey_length    = "512"
+  without_plaintext = true
+}
+
+data "opentelekomcloud_kms_data_key_v1" "kms_datakey2" {
+  key_id         = opentelekomcloud_kms_key_v1.key1.id
+  datakey_length = "512"
+  cipher_text    = data.opentelekomcloud_kms_data_key_v1.kms_datakey1.cipher_text
+}
+`, testAccKmsDataKeyV1DataSource_key)
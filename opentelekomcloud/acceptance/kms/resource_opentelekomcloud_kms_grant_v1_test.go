@@ -0,0 +1,69 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccKmsGrantV1_basic(t *testing.T) {
+	rName := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_kms_grant_v1.grant_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsGrantV1Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "operations.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuing_principal"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccKmsGrantV1ImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccKmsGrantV1ImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["key_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccKmsGrantV1Basic(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias = "%[1]s"
+}
+
+resource "opentelekomcloud_identity_user_v3" "user_1" {
+  name     = "%[1]s"
+  password = "Password@123!"
+}
+
+resource "opentelekomcloud_kms_grant_v1" "grant_1" {
+  key_id            = opentelekomcloud_kms_key_v1.key_1.id
+  name              = "%[1]s"
+  grantee_principal = opentelekomcloud_identity_user_v3.user_1.id
+  operations        = ["encrypt-datakey", "decrypt-datakey"]
+}
+`, rName)
+}
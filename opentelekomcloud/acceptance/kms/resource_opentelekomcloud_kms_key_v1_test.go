@@ -136,6 +136,57 @@ func TestAccKmsKey_isEnabled(t *testing.T) {
 	})
 }
 
+func TestAccKmsKeyV1_rotation(t *testing.T) {
+	var key keys.Key
+	rName := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_kms_key_v1.key_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsV1Key_rotation(rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_interval", "100"),
+				),
+			},
+			{
+				Config: testAccKmsV1Key_rotation(rName, 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_interval", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKmsKeyV1_external(t *testing.T) {
+	var key keys.Key
+	rName := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_kms_key_v1.key_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsV1Key_external(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "origin", "external"),
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_kms_import_parameters_v1.params", "public_key"),
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_kms_import_parameters_v1.params", "import_token"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKmsKeyIsEnabled(key *keys.Key, isEnabled bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if (key.KeyState == kms.EnabledState) != isEnabled {
@@ -189,3 +240,26 @@ resource "opentelekomcloud_kms_key_v1" "bar" {
   is_enabled      = false
 }`, prefix)
 }
+
+func testAccKmsV1Key_rotation(rName string, interval int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias         = "%s"
+  rotation_enabled  = true
+  rotation_interval = %d
+}
+`, rName, interval)
+}
+
+func testAccKmsV1Key_external(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias = "%s"
+  origin    = "external"
+}
+
+data "opentelekomcloud_kms_import_parameters_v1" "params" {
+  key_id = opentelekomcloud_kms_key_v1.key_1.id
+}
+`, rName)
+}
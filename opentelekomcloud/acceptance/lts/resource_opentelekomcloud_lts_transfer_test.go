@@ -0,0 +1,110 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceTransferName = "opentelekomcloud_logtank_transfer_v2.transfer"
+
+func TestAccLogTankTransferV2_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-lts-transfer-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLogTankTransferV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogTankTransferV2Basic(bucketName, 5, "min", "RAW"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTransferName, "period", "5"),
+					resource.TestCheckResourceAttr(resourceTransferName, "period_unit", "min"),
+					resource.TestCheckResourceAttr(resourceTransferName, "storage_format", "RAW"),
+					resource.TestCheckResourceAttr(resourceTransferName, "topic_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccLogTankTransferV2Basic(bucketName, 3, "hour", "JSON"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTransferName, "period", "3"),
+					resource.TestCheckResourceAttr(resourceTransferName, "period_unit", "hour"),
+					resource.TestCheckResourceAttr(resourceTransferName, "storage_format", "JSON"),
+				),
+			},
+			{
+				ResourceName:      resourceTransferName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLogTankTransferV2Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.LtsV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_logtank_transfer_v2" {
+			continue
+		}
+
+		var body struct {
+			Transfers []struct {
+				ID string `json:"log_dump_obs_id"`
+			} `json:"log_dump_obs"`
+		}
+		_, err = client.Get(client.ServiceURL("log-dump", "obs"), &body, nil)
+		if err != nil {
+			return err
+		}
+		for _, transfer := range body.Transfers {
+			if transfer.ID == rs.Primary.ID {
+				return fmt.Errorf("log transfer (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccLogTankTransferV2Basic(bucketName string, period int, unit, format string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%s"
+  acl    = "private"
+}
+
+resource "opentelekomcloud_logtank_group_v2" "group" {
+  group_name = "testacc_transfer_group"
+}
+
+resource "opentelekomcloud_logtank_topic_v2" "topic" {
+  group_id   = opentelekomcloud_logtank_group_v2.group.id
+  topic_name = "testacc_transfer_topic"
+}
+
+resource "opentelekomcloud_logtank_transfer_v2" "transfer" {
+  group_id        = opentelekomcloud_logtank_group_v2.group.id
+  topic_ids       = [opentelekomcloud_logtank_topic_v2.topic.id]
+  obs_bucket_name = opentelekomcloud_obs_bucket.bucket.bucket
+  period          = %d
+  period_unit     = "%s"
+  storage_format  = "%s"
+  prefix_name     = "app"
+  dir_prefix_name = "logs/"
+  compress_type   = "gzip"
+}
+`, bucketName, period, unit, format)
+}
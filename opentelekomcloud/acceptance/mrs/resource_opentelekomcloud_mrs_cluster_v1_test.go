@@ -35,6 +35,38 @@ func TestAccMRSV1Cluster_basic(t *testing.T) {
 	})
 }
 
+func TestAccMRSV1Cluster_update(t *testing.T) {
+	var clusterGet cluster.Cluster
+	resourceName := "opentelekomcloud_mrs_cluster_v1.cluster1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckMrs(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckMRSV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccMRSV1ClusterConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMRSV1ClusterExists(resourceName, &clusterGet),
+					resource.TestCheckResourceAttr(resourceName, "core_node_num", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_scripts.0.fail_action", "continue"),
+				),
+			},
+			{
+				Config: TestAccMRSV1ClusterConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMRSV1ClusterExists(resourceName, &clusterGet),
+					resource.TestCheckResourceAttr(resourceName, "core_node_num", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+					resource.TestCheckNoResourceAttr(resourceName, "tags.key"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_state", "running"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMRSV1ClusterDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	mrsClient, err := config.MrsV1Client(env.OS_REGION_NAME)
@@ -140,3 +172,48 @@ resource "opentelekomcloud_mrs_cluster_v1" "cluster1" {
     key = "value"
   }
 }`, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+
+var TestAccMRSV1ClusterConfig_update = fmt.Sprintf(`
+resource "opentelekomcloud_mrs_cluster_v1" "cluster1" {
+  cluster_name = "mrs-cluster-acc"
+  billing_type = 12
+  master_node_num = 2
+  core_node_num = 4
+  master_node_size = "h1.2xlarge.4.linux.mrs"
+  core_node_size = "h1.2xlarge.4.linux.mrs"
+  available_zone_id = "%s"
+  vpc_id = "%s"
+  subnet_id = "%s"
+  cluster_version = "MRS 1.7.2"
+  master_data_volume_type = "SAS"
+  master_data_volume_size = 100
+  master_data_volume_count = 1
+  core_data_volume_type = "SATA"
+  core_data_volume_size = 100
+  core_data_volume_count = 2
+  safe_mode = 0
+  cluster_type = 0
+  node_public_cert_name = "KeyPair-ci"
+  cluster_admin_secret = ""
+  component_list {
+      component_name = "Hadoop"
+  }
+  component_list {
+      component_name = "Spark"
+  }
+  component_list {
+      component_name = "Hive"
+  }
+  bootstrap_scripts {
+    name = "Modify os config"
+    uri = "s3a://bootstrap/modify_os_config.sh"
+    parameters = "param1 param2"
+    nodes = ["master", "core", "task"]
+	active_master = true
+	before_component_start = true
+    fail_action = "continue"
+  }
+  tags = {
+    foo = "baz"
+  }
+}`, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
@@ -0,0 +1,71 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataNatGatewaysName = "data.opentelekomcloud_nat_gateways.gateways"
+
+func TestAccNatGatewaysDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatGatewaysDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataNatGatewaysName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataNatGatewaysName, "ids.0",
+						"opentelekomcloud_nat_gateway_v2.nat_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNatGatewaysDataSourceBasic = `
+resource "opentelekomcloud_networking_router_v2" "router_1" {
+  name           = "router_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_subnet_v2" "subnet_1" {
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+}
+
+resource "opentelekomcloud_networking_router_interface_v2" "int_1" {
+  subnet_id = opentelekomcloud_networking_subnet_v2.subnet_1.id
+  router_id = opentelekomcloud_networking_router_v2.router_1.id
+}
+
+resource "opentelekomcloud_nat_gateway_v2" "nat_1" {
+  name                = "nat_gateways_ds"
+  spec                = "1"
+  internal_network_id = opentelekomcloud_networking_network_v2.network_1.id
+  router_id           = opentelekomcloud_networking_router_v2.router_1.id
+  depends_on          = [opentelekomcloud_networking_router_interface_v2.int_1]
+
+  tags = {
+    discovery = "nat-ds"
+  }
+}
+
+data "opentelekomcloud_nat_gateways" "gateways" {
+  router_id = opentelekomcloud_nat_gateway_v2.nat_1.router_id
+
+  tags = {
+    discovery = "nat-ds"
+  }
+}
+`
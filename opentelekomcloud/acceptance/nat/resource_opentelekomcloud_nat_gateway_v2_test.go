@@ -36,6 +36,7 @@ func TestAccNatGateway_basic(t *testing.T) {
 					vpc.TestAccCheckNetworkingV2RouterExists("opentelekomcloud_networking_router_v2.router_1", &router),
 					vpc.TestAccCheckNetworkingV2RouterInterfaceExists("opentelekomcloud_networking_router_interface_v2.int_1"),
 					testAccCheckNatV2GatewayExists("opentelekomcloud_nat_gateway_v2.nat_1"),
+					resource.TestCheckResourceAttr("opentelekomcloud_nat_gateway_v2.nat_1", "tags.muh", "value-create"),
 				),
 			},
 			{
@@ -44,6 +45,8 @@ func TestAccNatGateway_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("opentelekomcloud_nat_gateway_v2.nat_1", "name", "nat_1_updated"),
 					resource.TestCheckResourceAttr("opentelekomcloud_nat_gateway_v2.nat_1", "description", "nat_1 updated"),
 					resource.TestCheckResourceAttr("opentelekomcloud_nat_gateway_v2.nat_1", "spec", "2"),
+					resource.TestCheckResourceAttr("opentelekomcloud_nat_gateway_v2.nat_1", "tags.muh", "value-update"),
+					resource.TestCheckResourceAttr("opentelekomcloud_nat_gateway_v2.nat_1", "tags.kuh", "value-new"),
 				),
 			},
 		},
@@ -130,6 +133,10 @@ resource "opentelekomcloud_nat_gateway_v2" "nat_1" {
   internal_network_id = opentelekomcloud_networking_network_v2.network_1.id
   router_id = opentelekomcloud_networking_router_v2.router_1.id
   depends_on = ["opentelekomcloud_networking_router_interface_v2.int_1"]
+
+  tags = {
+    muh = "value-create"
+  }
 }
 `
 
@@ -162,5 +169,10 @@ resource "opentelekomcloud_nat_gateway_v2" "nat_1" {
   internal_network_id = opentelekomcloud_networking_network_v2.network_1.id
   router_id = opentelekomcloud_networking_router_v2.router_1.id
   depends_on = ["opentelekomcloud_networking_router_interface_v2.int_1"]
+
+  tags = {
+    muh = "value-update"
+    kuh = "value-new"
+  }
 }
 `
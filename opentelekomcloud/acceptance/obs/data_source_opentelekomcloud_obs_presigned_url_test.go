@@ -0,0 +1,83 @@
+package acceptance
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataPresignedUrlName = "data.opentelekomcloud_obs_presigned_url.url"
+
+func TestAccDataSourceObsPresignedUrl_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-presigned-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceObsPresignedUrlConfig(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataPresignedUrlName, "url", regexp.MustCompile(`^https://`)),
+					resource.TestCheckResourceAttrSet(dataPresignedUrlName, "expires_at"),
+					testAccCheckObsPresignedUrlBody(dataPresignedUrlName, "bootstrap"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObsPresignedUrlBody(n, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		resp, err := http.Get(rs.Primary.Attributes["url"])
+		if err != nil {
+			return fmt.Errorf("error downloading object using presigned URL: %s", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected status downloading object using presigned URL: %s", resp.Status)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if string(body) != expected {
+			return fmt.Errorf("expected object body %q, got %q", expected, string(body))
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceObsPresignedUrlConfig(bucketName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%s"
+}
+
+resource "opentelekomcloud_obs_bucket_object" "object" {
+  bucket  = opentelekomcloud_obs_bucket.bucket.bucket
+  key     = "bootstrap.sh"
+  content = "bootstrap"
+}
+
+data "opentelekomcloud_obs_presigned_url" "url" {
+  bucket  = opentelekomcloud_obs_bucket.bucket.bucket
+  key     = opentelekomcloud_obs_bucket_object.object.key
+  expires = 600
+}
+`, bucketName)
+}
@@ -0,0 +1,106 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const inventoryResourceName = "opentelekomcloud_obs_bucket_inventory.inventory"
+
+func TestAccObsBucketInventory_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketInventoryBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(inventoryResourceName, "name", "daily"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "frequency", "Daily"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "destination.0.format", "CSV"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "destination.0.prefix", "inventory/"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "optional_fields.#", "2"),
+				),
+			},
+			{
+				Config: testAccObsBucketInventoryUpdate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(inventoryResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "frequency", "Weekly"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "included_object_versions", "Current"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "filter_prefix", "data/"),
+					resource.TestCheckResourceAttr(inventoryResourceName, "optional_fields.#", "3"),
+				),
+			},
+			{
+				ResourceName:      inventoryResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketInventoryBasic(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "target" {
+  bucket        = "tf-test-inventory-target-%[1]d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%[1]d"
+}
+
+resource "opentelekomcloud_obs_bucket_inventory" "inventory" {
+  bucket    = opentelekomcloud_obs_bucket.bucket.bucket
+  name      = "daily"
+  frequency = "Daily"
+
+  destination {
+    bucket = opentelekomcloud_obs_bucket.target.bucket
+    prefix = "inventory/"
+  }
+
+  optional_fields = ["Size", "LastModifiedDate"]
+}
+`, randInt)
+}
+
+func testAccObsBucketInventoryUpdate(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "target" {
+  bucket        = "tf-test-inventory-target-%[1]d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%[1]d"
+}
+
+resource "opentelekomcloud_obs_bucket_inventory" "inventory" {
+  bucket                   = opentelekomcloud_obs_bucket.bucket.bucket
+  name                     = "daily"
+  enabled                  = false
+  frequency                = "Weekly"
+  included_object_versions = "Current"
+  filter_prefix            = "data/"
+
+  destination {
+    bucket = opentelekomcloud_obs_bucket.target.bucket
+    prefix = "inventory/"
+  }
+
+  optional_fields = ["Size", "LastModifiedDate", "StorageClass"]
+}
+`, randInt)
+}
@@ -0,0 +1,104 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const notificationResourceName = "opentelekomcloud_obs_bucket_notification.notification"
+
+func TestAccObsBucketNotification_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketNotificationBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(notificationResourceName, "topic.#", "1"),
+					resource.TestCheckResourceAttr(notificationResourceName, "topic.0.id", "created"),
+					resource.TestCheckResourceAttr(notificationResourceName, "topic.0.events.#", "1"),
+					resource.TestCheckResourceAttr(notificationResourceName, "topic.0.filter_prefix", "images/"),
+					resource.TestCheckResourceAttr(notificationResourceName, "topic.0.filter_suffix", ".jpg"),
+					resource.TestCheckResourceAttrPair(notificationResourceName, "topic.0.topic_urn",
+						"opentelekomcloud_smn_topic_v2.topic", "topic_urn"),
+				),
+			},
+			{
+				Config: testAccObsBucketNotificationUpdate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(notificationResourceName, "topic.#", "2"),
+					resource.TestCheckResourceAttr(notificationResourceName, "topic.1.id", "removed"),
+					resource.TestCheckResourceAttr(notificationResourceName, "topic.1.events.#", "2"),
+				),
+			},
+			{
+				ResourceName:      notificationResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketNotificationBasic(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic" {
+  name = "tf-test-obs-notification-%[1]d"
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%[1]d"
+}
+
+resource "opentelekomcloud_obs_bucket_notification" "notification" {
+  bucket = opentelekomcloud_obs_bucket.bucket.bucket
+
+  topic {
+    id            = "created"
+    topic_urn     = opentelekomcloud_smn_topic_v2.topic.topic_urn
+    events        = ["ObjectCreated:*"]
+    filter_prefix = "images/"
+    filter_suffix = ".jpg"
+  }
+}
+`, randInt)
+}
+
+func testAccObsBucketNotificationUpdate(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic" {
+  name = "tf-test-obs-notification-%[1]d"
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%[1]d"
+}
+
+resource "opentelekomcloud_obs_bucket_notification" "notification" {
+  bucket = opentelekomcloud_obs_bucket.bucket.bucket
+
+  topic {
+    id            = "created"
+    topic_urn     = opentelekomcloud_smn_topic_v2.topic.topic_urn
+    events        = ["ObjectCreated:*"]
+    filter_prefix = "images/"
+    filter_suffix = ".jpg"
+  }
+
+  topic {
+    id        = "removed"
+    topic_urn = opentelekomcloud_smn_topic_v2.topic.topic_urn
+    events    = ["ObjectRemoved:Delete", "ObjectRemoved:DeleteMarkerCreated"]
+  }
+}
+`, randInt)
+}
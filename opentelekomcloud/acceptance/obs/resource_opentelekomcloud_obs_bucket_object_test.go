@@ -1,6 +1,9 @@
 package acceptance
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -61,6 +64,69 @@ func TestAccObsBucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccObsBucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-obs-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// 12 MiB file is uploaded in 3 parts
+	err = ioutil.WriteFile(tmpFile.Name(), bytes.Repeat([]byte("0123456789abcdef"), 12*1024*1024/16), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := fileSha256Hex(tmpFile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObject_configMultipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketObjectExists("opentelekomcloud_obs_bucket_object.object"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_obs_bucket_object.object", "size", "12582912"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_obs_bucket_object.object", "content_sha256", hash),
+				),
+			},
+			{
+				PreConfig: func() {
+					// same size, different content
+					err := ioutil.WriteFile(tmpFile.Name(), bytes.Repeat([]byte("fedcba9876543210"), 12*1024*1024/16), 0644)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObsBucketObject_configMultipart(rInt, tmpFile.Name()),
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["opentelekomcloud_obs_bucket_object.object"]
+					if rs.Primary.Attributes["content_sha256"] == hash {
+						return fmt.Errorf("content_sha256 is expected to change")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func fileSha256Hex(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 func TestAccObsBucketObject_content(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -231,6 +297,21 @@ resource "opentelekomcloud_obs_bucket_object" "object" {
 `, randInt, source)
 }
 
+func testAccObsBucketObject_configMultipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+resource "opentelekomcloud_obs_bucket_object" "object" {
+  bucket             = opentelekomcloud_obs_bucket.object_bucket.bucket
+  key                = "test-key"
+  source             = "%s"
+  part_size          = 5
+  upload_concurrency = 2
+}
+`, randInt, source)
+}
+
 func testAccObsBucketObject_configContent(randInt int) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_obs_bucket" "object_bucket" {
@@ -75,6 +75,38 @@ func TestAccObsBucketPolicyUpdate(t *testing.T) {
 	})
 }
 
+func TestAccObsBucketPolicyStatements(t *testing.T) {
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	policyName := "opentelekomcloud_obs_bucket_policy.bucket"
+
+	expectedPolicyText := fmt.Sprintf(
+		`{"Version":"2008-10-17","Statement":[{"Sid":"PublicRead","Effect":"Allow","Principal":"*","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::%s/*"],"Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8"]}}}]}`,
+		name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketPolicyConfigStatements(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					testAccCheckObsBucketHasPolicy(resourceName, expectedPolicyText),
+					resource.TestCheckResourceAttr(policyName, "statement.#", "1"),
+					resource.TestCheckResourceAttr(policyName, "statement.0.principal.0.all", "true"),
+				),
+			},
+			{
+				ResourceName:            policyName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"statement"},
+			},
+		},
+	})
+}
+
 func TestAccObsBucketPolicyMalformed(t *testing.T) {
 	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 
@@ -188,6 +220,36 @@ POLICY
 `, bucketName, bucketName, bucketName)
 }
 
+func testAccObsBucketPolicyConfigStatements(bucketName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%s"
+  acl    = "private"
+}
+
+resource "opentelekomcloud_obs_bucket_policy" "bucket" {
+  bucket = opentelekomcloud_obs_bucket.bucket.bucket
+
+  statement {
+    sid     = "PublicRead"
+    actions = ["s3:GetObject"]
+
+    principal {
+      all = true
+    }
+
+    resources = ["${opentelekomcloud_obs_bucket.bucket.bucket}/*"]
+
+    condition {
+      operator = "IpAddress"
+      key      = "aws:SourceIp"
+      values   = ["10.0.0.0/8"]
+    }
+  }
+}
+`, bucketName)
+}
+
 func testAccObsBucketPolicyConfigWrongPolicy(bucketName string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_obs_bucket" "bucket" {
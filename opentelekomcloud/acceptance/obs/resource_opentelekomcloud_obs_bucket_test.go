@@ -115,6 +115,36 @@ func TestAccObsBucket_logging(t *testing.T) {
 					testAccCheckObsBucketLogging(resourceName, targetBucket, "log/"),
 				),
 			},
+			{
+				Config: testAccObsBucketConfigWithLoggingGrants(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.target_grant.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_loggingTargetACL(t *testing.T) {
+	rInt := acctest.RandInt()
+	targetBucket := fmt.Sprintf("tf-test-log-bucket-%d", rInt)
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithLoggingTargetACL(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					testAccCheckObsBucketLogging(resourceName, targetBucket, "log/"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.manage_target_acl", "true"),
+				),
+			},
 		},
 	})
 }
@@ -165,6 +195,19 @@ func TestAccObsBucket_website(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "website.0.error_document", "error.html"),
 				),
 			},
+			{
+				Config: testAccObsBucketWebsiteConfigWithRoutingRuleBlocks(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "website.0.routing_rules", ""),
+					resource.TestCheckResourceAttr(resourceName, "website.0.routing_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "website.0.routing_rule.0.condition.0.key_prefix_equals", "docs/"),
+					resource.TestCheckResourceAttr(resourceName, "website.0.routing_rule.0.redirect.0.replace_key_prefix_with", "documents/"),
+					resource.TestCheckResourceAttr(resourceName, "website.0.routing_rule.1.condition.0.http_error_code_returned_equals", "404"),
+					resource.TestCheckResourceAttr(resourceName, "website.0.routing_rule.1.redirect.0.host_name", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "website.0.routing_rule.1.redirect.0.http_redirect_code", "302"),
+				),
+			},
 		},
 	})
 }
@@ -187,6 +230,7 @@ func TestAccObsBucket_cors(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_headers.0", "*"),
 					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.expose_headers.1", "ETag"),
 					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.id", "example"),
 				),
 			},
 		},
@@ -367,6 +411,51 @@ resource "opentelekomcloud_obs_bucket" "bucket" {
 `, randInt, randInt)
 }
 
+func testAccObsBucketConfigWithLoggingGrants(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "log_bucket" {
+  bucket        = "tf-test-log-bucket-%d"
+  acl           = "log-delivery-write"
+  force_destroy = "true"
+}
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+
+  logging {
+    target_bucket = opentelekomcloud_obs_bucket.log_bucket.id
+    target_prefix = "log/"
+
+    target_grant {
+      grantee_type = "Group"
+      grantee_uri  = "AllUsers"
+      permission   = "READ"
+    }
+  }
+}
+`, randInt, randInt)
+}
+
+func testAccObsBucketConfigWithLoggingTargetACL(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "log_bucket" {
+  bucket        = "tf-test-log-bucket-%d"
+  acl           = "private"
+  force_destroy = "true"
+}
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+
+  logging {
+    target_bucket     = opentelekomcloud_obs_bucket.log_bucket.id
+    target_prefix     = "log/"
+    manage_target_acl = true
+  }
+}
+`, randInt, randInt)
+}
+
 func testAccObsBucketConfigWithLifecycle(randInt int) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_obs_bucket" "bucket" {
@@ -447,6 +536,40 @@ EOF
 `, randInt)
 }
 
+func testAccObsBucketWebsiteConfigWithRoutingRuleBlocks(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "public-read"
+
+  website {
+    index_document = "index.html"
+    error_document = "error.html"
+
+    routing_rule {
+      condition {
+        key_prefix_equals = "docs/"
+      }
+      redirect {
+        replace_key_prefix_with = "documents/"
+      }
+    }
+
+    routing_rule {
+      condition {
+        http_error_code_returned_equals = "404"
+      }
+      redirect {
+        protocol           = "https"
+        host_name          = "example.com"
+        http_redirect_code = "302"
+      }
+    }
+  }
+}
+`, randInt)
+}
+
 func testAccObsBucketConfigWithCORS(randInt int) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_obs_bucket" "bucket" {
@@ -454,6 +577,7 @@ resource "opentelekomcloud_obs_bucket" "bucket" {
   acl    = "public-read"
 
   cors_rule {
+    id              = "example"
     allowed_headers = ["*"]
     allowed_methods = ["PUT", "POST"]
     allowed_origins = ["https://www.example.com"]
@@ -0,0 +1,47 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceServiceTicketName = "opentelekomcloud_service_ticket.quota"
+
+// filed tickets reach the support and can't be withdrawn, so the test runs for the admin tenant only
+func TestAccServiceTicket_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			common.TestAccPreCheck(t)
+			common.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTicketBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceServiceTicketName, "ticket_id"),
+					resource.TestCheckResourceAttrSet(resourceServiceTicketName, "status"),
+				),
+			},
+		},
+	})
+}
+
+const testAccServiceTicketBasic = `
+resource "opentelekomcloud_service_ticket" "quota" {
+  quotas {
+    resource = "instances"
+    limit    = 60
+  }
+
+  quotas {
+    resource = "cores"
+    limit    = 240
+  }
+
+  justification = "Acceptance test of the quota increase automation, please close without changes"
+}
+`
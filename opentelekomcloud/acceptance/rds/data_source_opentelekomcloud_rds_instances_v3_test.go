@@ -0,0 +1,54 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceRdsInstancesName = "data.opentelekomcloud_rds_instances_v3.instances"
+
+func TestAccRdsInstancesV3DataSource_basic(t *testing.T) {
+	postfix := acctest.RandString(3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstanceV3Basic(postfix),
+			},
+			{
+				Config: testAccRdsInstancesV3DataSourceBasic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceRdsInstancesName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceRdsInstancesName, "ids.0", "opentelekomcloud_rds_instance_v3.instance", "id"),
+					resource.TestCheckResourceAttr(dataSourceRdsInstancesName, "instances.0.datastore_type", "PostgreSQL"),
+					resource.TestCheckResourceAttr(dataSourceRdsInstancesName, "instances.0.tags.muh", "value-create"),
+					resource.TestCheckResourceAttrSet(dataSourceRdsInstancesName, "instances.0.private_ips.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsInstancesV3DataSourceBasic(postfix string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_rds_instances_v3" "instances" {
+  datastore_type = "PostgreSQL"
+  status         = "ACTIVE"
+
+  tags = {
+    muh = "value-create"
+  }
+
+  name = opentelekomcloud_rds_instance_v3.instance.name
+}
+`, testAccRdsInstanceV3Basic(postfix))
+}
@@ -0,0 +1,32 @@
+package acceptance
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccRdsQuotasV3_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_rds_quotas_v3.quotas"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsQuotasV3Config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "instances", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances_used"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRdsQuotasV3Config = `
+data "opentelekomcloud_rds_quotas_v3" "quotas" {}
+`
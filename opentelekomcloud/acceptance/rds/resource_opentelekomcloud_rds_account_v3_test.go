@@ -0,0 +1,67 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceRdsAccountName = "opentelekomcloud_rds_account_v3.account"
+
+func TestAccRdsAccountV3_basic(t *testing.T) {
+	postfix := tools.RandomString("acc", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsAccountV3Basic(postfix, "Shop!2021pass", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRdsAccountName, "name", "shop_user"),
+					resource.TestCheckResourceAttr(resourceRdsAccountName, "privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceRdsAccountName, "privileges.0.readonly", "false"),
+				),
+			},
+			{
+				Config: testAccRdsAccountV3Basic(postfix, "Shop!2021changed", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRdsAccountName, "privileges.0.readonly", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceRdsAccountName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccRdsAccountV3Basic(postfix, password string, readonly bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_rds_database_v3" "database" {
+  instance_id = opentelekomcloud_rds_instance_v3.instance.id
+  name        = "shop"
+}
+
+resource "opentelekomcloud_rds_account_v3" "account" {
+  instance_id = opentelekomcloud_rds_instance_v3.instance.id
+  name        = "shop_user"
+  password    = "%s"
+
+  privileges {
+    database_name = opentelekomcloud_rds_database_v3.database.name
+    readonly      = %t
+  }
+}
+`, testAccRdsMySQLInstance(postfix), password, readonly)
+}
@@ -0,0 +1,78 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const resourceRdsDatabaseName = "opentelekomcloud_rds_database_v3.database"
+
+func TestAccRdsDatabaseV3_basic(t *testing.T) {
+	postfix := tools.RandomString("db", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsDatabaseV3Basic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRdsDatabaseName, "name", "shop"),
+					resource.TestCheckResourceAttr(resourceRdsDatabaseName, "character_set", "utf8mb4"),
+				),
+			},
+			{
+				ResourceName:      resourceRdsDatabaseName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccRdsMySQLInstance is the MySQL instance the databases and accounts are managed in
+func testAccRdsMySQLInstance(postfix string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg" {
+  name = "sg-rds-%s"
+}
+
+resource "opentelekomcloud_rds_instance_v3" "instance" {
+  name              = "tf_rds_instance_%s"
+  availability_zone = ["%s"]
+  db {
+    password = "MySql!120521"
+    type     = "MySQL"
+    version  = "8.0"
+    port     = "3306"
+  }
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg.id
+  subnet_id         = "%s"
+  vpc_id            = "%s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor = "rds.mysql.c2.medium"
+}
+`, postfix, postfix, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID, env.OS_VPC_ID)
+}
+
+func testAccRdsDatabaseV3Basic(postfix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_rds_database_v3" "database" {
+  instance_id   = opentelekomcloud_rds_instance_v3.instance.id
+  name          = "shop"
+  character_set = "utf8mb4"
+}
+`, testAccRdsMySQLInstance(postfix))
+}
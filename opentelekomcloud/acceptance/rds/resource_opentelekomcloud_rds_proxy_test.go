@@ -0,0 +1,116 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceRdsProxyName = "opentelekomcloud_rds_proxy.proxy"
+
+func TestAccRdsProxy_basic(t *testing.T) {
+	postfix := tools.RandomString("proxy", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsProxyBasic(postfix, 2, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRdsProxyName, "node_num", "2"),
+					resource.TestCheckResourceAttr(resourceRdsProxyName, "master_weight", "100"),
+					resource.TestCheckResourceAttrSet(resourceRdsProxyName, "address"),
+					resource.TestCheckResourceAttr(resourceRdsProxyName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccRdsProxyBasic(postfix, 3, 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRdsProxyName, "node_num", "3"),
+					resource.TestCheckResourceAttr(resourceRdsProxyName, "master_weight", "200"),
+				),
+			},
+			{
+				ResourceName:      resourceRdsProxyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRdsProxyDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.RdsV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating RDSv3 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_rds_proxy" {
+			continue
+		}
+
+		var proxy struct {
+			Proxy struct {
+				ID string `json:"pool_id"`
+			} `json:"proxy"`
+		}
+		_, err := client.Get(client.ServiceURL("instances", rs.Primary.ID, "proxy"), &proxy, nil)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+		if proxy.Proxy.ID != "" {
+			return fmt.Errorf("RDS proxy of instance %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccRdsProxyBasic(postfix string, nodeNum, masterWeight int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg" {
+  name = "sg-rds-proxy-test"
+}
+
+resource "opentelekomcloud_rds_instance_v3" "instance" {
+  name              = "tf_rds_instance_%s"
+  availability_zone = ["%s"]
+  db {
+    password = "MySql!120521"
+    type     = "MySQL"
+    version  = "8.0"
+    port     = "3306"
+  }
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg.id
+  subnet_id         = "%s"
+  vpc_id            = "%s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor = "rds.mysql.c2.medium"
+}
+
+resource "opentelekomcloud_rds_proxy" "proxy" {
+  instance_id   = opentelekomcloud_rds_instance_v3.instance.id
+  flavor_ref    = "rds.proxy.large.2"
+  node_num      = %d
+  master_weight = %d
+}
+`, postfix, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID, env.OS_VPC_ID, nodeNum, masterWeight)
+}
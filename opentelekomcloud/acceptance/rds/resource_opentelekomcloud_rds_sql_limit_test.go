@@ -0,0 +1,80 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const resourceRdsSqlLimitName = "opentelekomcloud_rds_sql_limit.limit"
+
+func TestAccRdsSqlLimit_basic(t *testing.T) {
+	postfix := tools.RandomString("limit", 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsSqlLimitBasic(postfix, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRdsSqlLimitName, "sql_type", "SELECT"),
+					resource.TestCheckResourceAttr(resourceRdsSqlLimitName, "max_concurrency", "10"),
+				),
+			},
+			{
+				Config: testAccRdsSqlLimitBasic(postfix, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRdsSqlLimitName, "max_concurrency", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceRdsSqlLimitName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRdsSqlLimitBasic(postfix string, maxConcurrency int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg" {
+  name = "sg-rds-sql-limit-test"
+}
+
+resource "opentelekomcloud_rds_instance_v3" "instance" {
+  name              = "tf_rds_instance_%s"
+  availability_zone = ["%s"]
+  db {
+    password = "MySql!120521"
+    type     = "MySQL"
+    version  = "8.0"
+    port     = "3306"
+  }
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg.id
+  subnet_id         = "%s"
+  vpc_id            = "%s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor = "rds.mysql.c2.medium"
+}
+
+resource "opentelekomcloud_rds_sql_limit" "limit" {
+  instance_id     = opentelekomcloud_rds_instance_v3.instance.id
+  db_name         = "mysql"
+  sql_type        = "SELECT"
+  pattern         = "select~from~t1"
+  max_concurrency = %d
+  max_waiting     = 5
+}
+`, postfix, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID, env.OS_VPC_ID, maxConcurrency)
+}
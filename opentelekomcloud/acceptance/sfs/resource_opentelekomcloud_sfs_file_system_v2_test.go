@@ -107,6 +107,28 @@ func TestAccSFSFileSystemV2_clean(t *testing.T) {
 	})
 }
 
+func TestAccSFSFileSystemV2_kms(t *testing.T) {
+	var share shares.Share
+	resourceName := "opentelekomcloud_sfs_file_system_v2.sfs_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckSFSFileSystemV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSFileSystemV2_kms,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSFileSystemV2Exists(resourceName, &share),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "opentelekomcloud_kms_key_v1.key_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_alias", "sfs-kms-key"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSFSFileSystemV2Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.SfsV2Client(env.OS_REGION_NAME)
@@ -219,3 +241,18 @@ resource "opentelekomcloud_sfs_file_system_v2" "sfs_1" {
   availability_zone = "eu-de-01"
 }
 `)
+
+const testAccSFSFileSystemV2_kms = `
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias    = "sfs-kms-key"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_sfs_file_system_v2" "sfs_1" {
+  share_proto       = "NFS"
+  size              = 1
+  name              = "sfs-test-kms"
+  availability_zone = "eu-de-01"
+  kms_key_id        = opentelekomcloud_kms_key_v1.key_1.id
+}
+`
@@ -0,0 +1,64 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceShareReplicaName = "opentelekomcloud_sfs_share_replica.replica_1"
+
+func TestAccSFSShareReplica_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckSFSFileSystemV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSShareReplicaBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceShareReplicaName, "availability_zone", "eu-de-02"),
+					resource.TestCheckResourceAttr(resourceShareReplicaName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceShareReplicaName, "replica_state", "in_sync"),
+				),
+			},
+			{
+				Config: testAccSFSShareReplicaPromoted,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceShareReplicaName, "replica_state", "active"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSFSShareReplicaBasic = `
+resource "opentelekomcloud_sfs_file_system_v2" "sfs_1" {
+  share_proto       = "NFS"
+  size              = 1
+  name              = "sfs-test-replica"
+  availability_zone = "eu-de-01"
+}
+
+resource "opentelekomcloud_sfs_share_replica" "replica_1" {
+  share_id          = opentelekomcloud_sfs_file_system_v2.sfs_1.id
+  availability_zone = "eu-de-02"
+}
+`
+
+const testAccSFSShareReplicaPromoted = `
+resource "opentelekomcloud_sfs_file_system_v2" "sfs_1" {
+  share_proto       = "NFS"
+  size              = 1
+  name              = "sfs-test-replica"
+  availability_zone = "eu-de-01"
+}
+
+resource "opentelekomcloud_sfs_share_replica" "replica_1" {
+  share_id          = opentelekomcloud_sfs_file_system_v2.sfs_1.id
+  availability_zone = "eu-de-02"
+  promote           = true
+}
+`
@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -42,6 +43,10 @@ func TestAccSFSTurboShareV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "size", "600"),
 				),
 			},
+			{
+				Config:      testAccSFSTurboShareV1_basic(shareName),
+				ExpectError: regexp.MustCompile(`shrinking OpenTelekomCloud SFS Turbo size is not supported`),
+			},
 		},
 	})
 }
@@ -71,6 +76,29 @@ func TestAccSFSTurboShareV1_withKMS(t *testing.T) {
 	})
 }
 
+func TestAccSFSTurboShareV1_enhanced(t *testing.T) {
+	shareName := tools.RandomString("sfs-turbo-", 3)
+	resourceName := "opentelekomcloud_sfs_turbo_share_v1.sfs-turbo"
+	var turbo shares.Turbo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckSFSTurboShareV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSTurboShareV1_enhanced(shareName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSTurboShareV1Exists(resourceName, &turbo),
+					resource.TestCheckResourceAttr(resourceName, "share_type", "PERFORMANCE"),
+					resource.TestCheckResourceAttr(resourceName, "enhanced", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "export_location"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSFSTurboShareV1Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.SfsTurboV1Client(env.OS_REGION_NAME)
@@ -185,3 +213,24 @@ resource "opentelekomcloud_sfs_turbo_share_v1" "sfs-turbo" {
 }
 `, postfix, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
 }
+
+func testAccSFSTurboShareV1_enhanced(shareName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg" {
+  name = "sg-sfs-turbo-acc"
+}
+
+resource "opentelekomcloud_sfs_turbo_share_v1" "sfs-turbo" {
+  name        = "%s"
+  size        = 500
+  share_proto = "NFS"
+  share_type  = "PERFORMANCE"
+  enhanced    = true
+  vpc_id      = "%s"
+  subnet_id   = "%s"
+
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg.id
+  availability_zone = "%s"
+}
+`, shareName, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
+}
@@ -0,0 +1,95 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/subscriptions"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceTopicSubscriptionsName = "opentelekomcloud_smn_topic_subscriptions_v2.subscriptions"
+
+func TestAccSMNV2TopicSubscriptions_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckSMNTopicV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMNV2TopicSubscriptionsConfig(`["mailtest@gmail.com", "mailtest2@gmail.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTopicSubscriptionsName, "subscription.#", "2"),
+					testAccCheckSMNV2TopicSubscriptionsCount(resourceTopicSubscriptionsName, 2),
+				),
+			},
+			{
+				Config: testAccSMNV2TopicSubscriptionsConfig(`["mailtest2@gmail.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTopicSubscriptionsName, "subscription.#", "1"),
+					testAccCheckSMNV2TopicSubscriptionsCount(resourceTopicSubscriptionsName, 1),
+				),
+			},
+			{
+				ResourceName:      resourceTopicSubscriptionsName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSMNV2TopicSubscriptionsCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.SmnV2Client(env.OS_TENANT_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud smn client: %w", err)
+		}
+
+		found, err := subscriptions.ListFromTopic(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+		if len(found) != expected {
+			return fmt.Errorf("expected %d subscriptions, got %d", expected, len(found))
+		}
+		return nil
+	}
+}
+
+func testAccSMNV2TopicSubscriptionsConfig(emails string) string {
+	return fmt.Sprintf(`
+locals {
+  emails = %s
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_subscriptions"
+  display_name = "The display name of topic_subscriptions"
+}
+
+resource "opentelekomcloud_smn_topic_subscriptions_v2" "subscriptions" {
+  topic_urn = opentelekomcloud_smn_topic_v2.topic_1.id
+
+  dynamic "subscription" {
+    for_each = local.emails
+    content {
+      endpoint = subscription.value
+      protocol = "email"
+      remark   = "O&M"
+    }
+  }
+}
+`, emails)
+}
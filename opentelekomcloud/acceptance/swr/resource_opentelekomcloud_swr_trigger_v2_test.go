@@ -0,0 +1,110 @@
+package swr
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/swr"
+)
+
+func TestSwrTriggerV2Basic(t *testing.T) {
+	clusterID := os.Getenv("OS_CCE_CLUSTER_ID")
+	if clusterID == "" {
+		t.Skip("OS_CCE_CLUSTER_ID is empty")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testSwrTriggerV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testSwrTriggerV2Basic(name, clusterID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTriggerName, "workload_type", "deployments"),
+					resource.TestCheckResourceAttr(resourceTriggerName, "condition", ".*"),
+					resource.TestCheckResourceAttr(resourceTriggerName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testSwrTriggerV2Basic(name, clusterID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTriggerName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceTriggerName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceTriggerName]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["organization"],
+						rs.Primary.Attributes["repository"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testSwrTriggerV2Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.SwrV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf(swr.ClientError, err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_swr_trigger_v2" {
+			continue
+		}
+
+		url := client.ServiceURL("manage", "namespaces", rs.Primary.Attributes["organization"],
+			"repos", rs.Primary.Attributes["repository"], "triggers", rs.Primary.ID)
+		_, err := client.Get(url, nil, nil)
+		if err == nil {
+			return fmt.Errorf("SWR trigger still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	resourceTriggerName = "opentelekomcloud_swr_trigger_v2.trigger_1"
+)
+
+func testSwrTriggerV2Basic(name, clusterID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource opentelekomcloud_swr_organization_v2 org_1 {
+  name = "%[1]s"
+}
+
+resource opentelekomcloud_swr_repository_v2 repo_1 {
+  organization = opentelekomcloud_swr_organization_v2.org_1.name
+  name         = "%[1]s"
+  description  = "Test repository"
+  category     = "linux"
+  is_public    = false
+}
+
+resource opentelekomcloud_swr_trigger_v2 trigger_1 {
+  organization  = opentelekomcloud_swr_organization_v2.org_1.name
+  repository    = opentelekomcloud_swr_repository_v2.repo_1.name
+  name          = "trigger-1"
+  cluster_id    = "%[2]s"
+  workload_type = "deployments"
+  workload_name = "nginx"
+  enabled       = %[3]t
+}
+`, name, clusterID, enabled)
+}
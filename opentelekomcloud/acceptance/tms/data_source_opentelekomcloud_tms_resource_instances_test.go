@@ -0,0 +1,54 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataResourceInstancesName = "data.opentelekomcloud_tms_resource_instances.instances"
+
+func TestAccTmsResourceInstancesDataSource_basic(t *testing.T) {
+	value := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTmsResourceInstancesDataSourceBasic(value),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataResourceInstancesName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataResourceInstancesName, "resources.0.resource_id", "opentelekomcloud_vpc_v1.vpc_1", "id"),
+					resource.TestCheckResourceAttr(dataResourceInstancesName, "resources.0.resource_type", "vpcs"),
+					resource.TestCheckResourceAttr(dataResourceInstancesName, "resources.0.tags.tf-acc-env", value),
+				),
+			},
+		},
+	})
+}
+
+func testAccTmsResourceInstancesDataSourceBasic(value string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "tf-acc-tms-%s"
+  cidr = "192.168.0.0/16"
+
+  tags = {
+    tf-acc-env = "%[1]s"
+  }
+}
+
+data "opentelekomcloud_tms_resource_instances" "instances" {
+  resource_types = ["vpcs"]
+
+  tags = {
+    tf-acc-env = opentelekomcloud_vpc_v1.vpc_1.tags["tf-acc-env"]
+  }
+}
+`, value)
+}
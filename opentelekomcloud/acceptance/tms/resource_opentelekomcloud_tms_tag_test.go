@@ -0,0 +1,49 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceTmsTagName = "opentelekomcloud_tms_tag.tag_1"
+
+func TestAccTmsTag_basic(t *testing.T) {
+	value := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTmsTagBasic(value),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTmsTagName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceTmsTagName, "tags.*", map[string]string{
+						"key":   "tf-acc-env",
+						"value": value,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccTmsTagBasic(value string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_tms_tag" "tag_1" {
+  tags {
+    key   = "tf-acc-env"
+    value = "%s"
+  }
+  tags {
+    key   = "tf-acc-owner"
+    value = "%[1]s"
+  }
+}
+`, value)
+}
@@ -0,0 +1,54 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataEipsName = "data.opentelekomcloud_eips.eips"
+
+func TestAccEipsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEipsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataEipsName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataEipsName, "ids.0",
+						"opentelekomcloud_vpc_eip_v1.eip", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEipsDataSourceBasic = `
+resource "opentelekomcloud_vpc_eip_v1" "eip" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name        = "eips-ds"
+    size        = 8
+    share_type  = "PER"
+    charge_mode = "traffic"
+  }
+
+  tags = {
+    discovery = "eips-ds"
+  }
+}
+
+data "opentelekomcloud_eips" "eips" {
+  tags = {
+    discovery = "eips-ds"
+  }
+
+  depends_on = [opentelekomcloud_vpc_eip_v1.eip]
+}
+`
@@ -0,0 +1,66 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSubnetsName = "data.opentelekomcloud_subnets.subnets"
+
+func TestAccSubnetsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSubnetsName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSubnetsName, "ids.0",
+						"opentelekomcloud_vpc_subnet_v1.tagged", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSubnetsDataSourceBasic = `
+resource "opentelekomcloud_vpc_v1" "vpc" {
+  name = "subnets-ds"
+  cidr = "10.0.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "tagged" {
+  name       = "subnets-ds-tagged"
+  cidr       = "10.0.1.0/24"
+  gateway_ip = "10.0.1.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc.id
+
+  tags = {
+    tier = "app"
+  }
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "untagged" {
+  name       = "subnets-ds-untagged"
+  cidr       = "10.0.2.0/24"
+  gateway_ip = "10.0.2.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc.id
+}
+
+data "opentelekomcloud_subnets" "subnets" {
+  vpc_id = opentelekomcloud_vpc_v1.vpc.id
+
+  tags = {
+    tier = "app"
+  }
+
+  depends_on = [
+    opentelekomcloud_vpc_subnet_v1.tagged,
+    opentelekomcloud_vpc_subnet_v1.untagged,
+  ]
+}
+`
@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataEipPoolName = "data.opentelekomcloud_vpc_eip_pool_v3.pool"
+
+func TestAccVpcEipPoolV3DataSource_basic(t *testing.T) {
+	poolName := os.Getenv("OS_EIP_POOL_NAME")
+	if poolName == "" {
+		poolName = "5_bgp"
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEipPoolV3DataSourceBasic(poolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataEipPoolName, "name", poolName),
+					resource.TestCheckResourceAttrSet(dataEipPoolName, "pool_id"),
+					resource.TestCheckResourceAttrSet(dataEipPoolName, "size"),
+					resource.TestCheckResourceAttrSet(dataEipPoolName, "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcEipPoolV3DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_vpc_eip_pool_v3" "pool" {
+  name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,52 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccOTCVpcPeeringConnectionRequestsV2DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_vpc_peering_connection_requests_v2.requests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// peering within the same tenant is not an incoming request
+				Config: testAccDataSourceOTCVpcPeeringConnectionRequestsV2Config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "requests.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceOTCVpcPeeringConnectionRequestsV2Config = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_v1" "vpc_2" {
+  name = "vpc_test1"
+  cidr = "172.16.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "opentelekomcloud_peering"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
+}
+
+data "opentelekomcloud_vpc_peering_connection_requests_v2" "requests" {
+  vpc_id = opentelekomcloud_vpc_peering_connection_v2.peering_1.peer_vpc_id
+  status = "ACTIVE"
+}
+`
@@ -0,0 +1,32 @@
+package acceptance
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccVpcQuotasV1_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_vpc_quotas_v1.quotas"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcQuotasV1Config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "vpcs", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpcs_used"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVpcQuotasV1Config = `
+data "opentelekomcloud_vpc_quotas_v1" "quotas" {}
+`
@@ -15,6 +15,7 @@ func TestAccVpcSubnetV1DataSource_basic(t *testing.T) {
 	dataSourceNameByCIDR := "data.opentelekomcloud_vpc_subnet_v1.by_cidr"
 	dataSourceNameByName := "data.opentelekomcloud_vpc_subnet_v1.by_name"
 	dataSourceNameByVPC := "data.opentelekomcloud_vpc_subnet_v1.by_vpc_id"
+	dataSourceNameByTags := "data.opentelekomcloud_vpc_subnet_v1.by_tags"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { common.TestAccPreCheck(t) },
@@ -31,6 +32,9 @@ func TestAccVpcSubnetV1DataSource_basic(t *testing.T) {
 						"10.0.0.1", "eu-de-02"),
 					testAccDataSourceVpcSubnetV1Check(dataSourceNameByVPC, "test_subnet", "10.0.0.0/24",
 						"10.0.0.1", "eu-de-02"),
+					testAccDataSourceVpcSubnetV1Check(dataSourceNameByTags, "test_subnet", "10.0.0.0/24",
+						"10.0.0.1", "eu-de-02"),
+					resource.TestCheckResourceAttr(dataSourceNameByID, "tags.environment", "testing"),
 					resource.TestCheckResourceAttr(dataSourceNameByID, "status", "ACTIVE"),
 					resource.TestCheckResourceAttr(dataSourceNameByID, "dhcp_enable", "true"),
 				),
@@ -86,6 +90,10 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
   gateway_ip        = "10.0.0.1"
   vpc_id            = opentelekomcloud_vpc_v1.vpc_1.id
   availability_zone = "eu-de-02"
+
+  tags = {
+    environment = "testing"
+  }
 }
 
 data "opentelekomcloud_vpc_subnet_v1" "by_id" {
@@ -103,4 +111,9 @@ data "opentelekomcloud_vpc_subnet_v1" "by_name" {
 data "opentelekomcloud_vpc_subnet_v1" "by_vpc_id" {
   vpc_id = opentelekomcloud_vpc_subnet_v1.subnet_1.vpc_id
 }
+
+data "opentelekomcloud_vpc_subnet_v1" "by_tags" {
+  vpc_id = opentelekomcloud_vpc_subnet_v1.subnet_1.vpc_id
+  tags   = opentelekomcloud_vpc_subnet_v1.subnet_1.tags
+}
 `
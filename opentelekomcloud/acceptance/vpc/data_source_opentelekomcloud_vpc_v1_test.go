@@ -27,6 +27,9 @@ func TestAccOTCVpcV1DataSource_basic(t *testing.T) {
 					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_id", name, cidr),
 					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_cidr", name, cidr),
 					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_name", name, cidr),
+					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_tags", name, cidr),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_vpc_v1.by_id", "tags.owner", name),
 					resource.TestCheckResourceAttr(
 						"data.opentelekomcloud_vpc_v1.by_id", "shared", "false"),
 					resource.TestCheckResourceAttr(
@@ -73,8 +76,12 @@ func testAccDataSourceOTCVpcV1Check(n, name, cidr string) resource.TestCheckFunc
 func testAccDataSourceOTCVpcV1Config(name, cidr string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_vpc_v1" "vpc_1" {
-	name = "%s"
-	cidr= "%s"
+	name = "%[1]s"
+	cidr= "%[2]s"
+
+  tags = {
+    owner = "%[1]s"
+  }
 }
 
 data "opentelekomcloud_vpc_v1" "by_id" {
@@ -88,5 +95,9 @@ data "opentelekomcloud_vpc_v1" "by_cidr" {
 data "opentelekomcloud_vpc_v1" "by_name" {
 	name = opentelekomcloud_vpc_v1.vpc_1.name
 }
+
+data "opentelekomcloud_vpc_v1" "by_tags" {
+  tags = opentelekomcloud_vpc_v1.vpc_1.tags
+}
 `, name, cidr)
 }
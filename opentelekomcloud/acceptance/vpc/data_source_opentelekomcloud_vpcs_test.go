@@ -0,0 +1,54 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataVpcsName = "data.opentelekomcloud_vpcs.by_tags"
+
+func TestAccVpcsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataVpcsName, "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_vpcs.by_name", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opentelekomcloud_vpcs.by_name", "ids.0",
+						"opentelekomcloud_vpc_v1.vpc.0", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVpcsDataSourceBasic = `
+resource "opentelekomcloud_vpc_v1" "vpc" {
+  count = 2
+
+  name = "vpcs-ds-${count.index}"
+  cidr = "172.16.${count.index}.0/24"
+
+  tags = {
+    discovery = "vpcs-ds"
+  }
+}
+
+data "opentelekomcloud_vpcs" "by_tags" {
+  tags = {
+    discovery = "vpcs-ds"
+  }
+
+  depends_on = [opentelekomcloud_vpc_v1.vpc]
+}
+
+data "opentelekomcloud_vpcs" "by_name" {
+  name = opentelekomcloud_vpc_v1.vpc[0].name
+}
+`
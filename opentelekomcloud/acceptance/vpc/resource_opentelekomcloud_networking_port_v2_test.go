@@ -90,6 +90,75 @@ func TestAccNetworkingV2Port_allowedAddressPairs(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Port_updateAllowedAddressPairs(t *testing.T) {
+	var port ports.Port
+	resourceName := "opentelekomcloud_networking_port_v2.port_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2Port_withAddressPairs,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists(resourceName, &port),
+					resource.TestCheckResourceAttr(resourceName, "allowed_address_pairs.#", "1"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2Port_updatedAddressPairs,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists(resourceName, &port),
+					resource.TestCheckResourceAttr(resourceName, "allowed_address_pairs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "allowed_address_pairs.*", map[string]string{
+						"ip_address": "192.168.199.101",
+					}),
+				),
+			},
+			{
+				Config: testAccNetworkingV2Port_withoutAddressPairs,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists(resourceName, &port),
+					testAccCheckNetworkingV2PortCountAddressPairs(&port, 0),
+					resource.TestCheckResourceAttr(resourceName, "allowed_address_pairs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_extraDHCPOptions(t *testing.T) {
+	var port ports.Port
+	resourceName := "opentelekomcloud_networking_port_v2.port_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2Port_extraDHCPOptions,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists(resourceName, &port),
+					resource.TestCheckResourceAttr(resourceName, "extra_dhcp_option.#", "2"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2Port_extraDHCPOptionsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists(resourceName, &port),
+					resource.TestCheckResourceAttr(resourceName, "extra_dhcp_option.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "extra_dhcp_option.*", map[string]string{
+						"name":  "domain-name",
+						"value": "example.org",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Port_portSecurity_enabled(t *testing.T) {
 	var port testPortWithExtensions
 	resourceName := "opentelekomcloud_networking_port_v2.port_1"
@@ -247,6 +316,16 @@ func testAccCheckNetworkingV2PortCountSecurityGroups(port *ports.Port, expected
 	}
 }
 
+func testAccCheckNetworkingV2PortCountAddressPairs(port *ports.Port, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(port.AllowedAddressPairs) != expected {
+			return fmt.Errorf("expected %d Allowed Address Pairs, got %d", expected, len(port.AllowedAddressPairs))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNetworkingV2PortPortSecurity(port *testPortWithExtensions, expected bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if port.PortSecurityEnabled != expected {
@@ -667,3 +746,109 @@ resource "opentelekomcloud_networking_port_v2" "port_1" {
   }
 }
 `
+
+const testAccNetworkingV2Port_network = `
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "opentelekomcloud_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+}
+`
+
+var testAccNetworkingV2Port_withAddressPairs = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name       = "port_1"
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_networking_subnet_v2.subnet_1.id
+    ip_address = "192.168.199.23"
+  }
+
+  allowed_address_pairs {
+    ip_address = "192.168.199.100"
+  }
+}
+`, testAccNetworkingV2Port_network)
+
+var testAccNetworkingV2Port_updatedAddressPairs = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name       = "port_1"
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_networking_subnet_v2.subnet_1.id
+    ip_address = "192.168.199.23"
+  }
+
+  allowed_address_pairs {
+    ip_address = "192.168.199.101"
+  }
+}
+`, testAccNetworkingV2Port_network)
+
+var testAccNetworkingV2Port_withoutAddressPairs = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name       = "port_1"
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_networking_subnet_v2.subnet_1.id
+    ip_address = "192.168.199.23"
+  }
+}
+`, testAccNetworkingV2Port_network)
+
+var testAccNetworkingV2Port_extraDHCPOptions = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name       = "port_1"
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_networking_subnet_v2.subnet_1.id
+    ip_address = "192.168.199.23"
+  }
+
+  extra_dhcp_option {
+    name  = "domain-name"
+    value = "example.com"
+  }
+
+  extra_dhcp_option {
+    name  = "ntp-server"
+    value = "192.168.199.1"
+  }
+}
+`, testAccNetworkingV2Port_network)
+
+var testAccNetworkingV2Port_extraDHCPOptionsUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name       = "port_1"
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_networking_subnet_v2.subnet_1.id
+    ip_address = "192.168.199.23"
+  }
+
+  extra_dhcp_option {
+    name  = "domain-name"
+    value = "example.org"
+  }
+}
+`, testAccNetworkingV2Port_network)
@@ -0,0 +1,119 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceQoSPolicyName = "opentelekomcloud_networking_qos_policy_v2.qos_policy_1"
+
+func TestAccNetworkingV2QoSPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2QoSPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSPolicyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceQoSPolicyName, "name", "qos_policy_1"),
+					resource.TestCheckResourceAttr(resourceQoSPolicyName, "shared", "false"),
+					resource.TestCheckResourceAttr("opentelekomcloud_networking_qos_bandwidth_limit_rule_v2.bw_limit_rule_1", "max_kbps", "3000"),
+					resource.TestCheckResourceAttr("opentelekomcloud_networking_qos_dscp_marking_rule_v2.dscp_marking_rule_1", "dscp_mark", "26"),
+					resource.TestCheckResourceAttrPair("opentelekomcloud_networking_port_v2.port_1", "qos_policy_id", resourceQoSPolicyName, "id"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2QoSPolicyUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceQoSPolicyName, "description", "updated"),
+					resource.TestCheckResourceAttr("opentelekomcloud_networking_qos_bandwidth_limit_rule_v2.bw_limit_rule_1", "max_kbps", "5000"),
+					resource.TestCheckResourceAttrPair("opentelekomcloud_networking_port_v2.port_1", "qos_policy_id", resourceQoSPolicyName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QoSPolicyDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_networking_qos_policy_v2" {
+			continue
+		}
+
+		_, err := client.Get(client.ServiceURL("qos", "policies", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("QoS policy still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingV2QoSPolicyBasic = `
+resource "opentelekomcloud_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "opentelekomcloud_networking_qos_bandwidth_limit_rule_v2" "bw_limit_rule_1" {
+  qos_policy_id  = opentelekomcloud_networking_qos_policy_v2.qos_policy_1.id
+  max_kbps       = 3000
+  max_burst_kbps = 300
+}
+
+resource "opentelekomcloud_networking_qos_dscp_marking_rule_v2" "dscp_marking_rule_1" {
+  qos_policy_id = opentelekomcloud_networking_qos_policy_v2.qos_policy_1.id
+  dscp_mark     = 26
+}
+
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name          = "port_1"
+  network_id    = opentelekomcloud_networking_network_v2.network_1.id
+  qos_policy_id = opentelekomcloud_networking_qos_policy_v2.qos_policy_1.id
+}
+`
+
+const testAccNetworkingV2QoSPolicyUpdate = `
+resource "opentelekomcloud_networking_qos_policy_v2" "qos_policy_1" {
+  name        = "qos_policy_1"
+  description = "updated"
+}
+
+resource "opentelekomcloud_networking_qos_bandwidth_limit_rule_v2" "bw_limit_rule_1" {
+  qos_policy_id  = opentelekomcloud_networking_qos_policy_v2.qos_policy_1.id
+  max_kbps       = 5000
+  max_burst_kbps = 300
+}
+
+resource "opentelekomcloud_networking_qos_dscp_marking_rule_v2" "dscp_marking_rule_1" {
+  qos_policy_id = opentelekomcloud_networking_qos_policy_v2.qos_policy_1.id
+  dscp_mark     = 26
+}
+
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name          = "port_1"
+  network_id    = opentelekomcloud_networking_network_v2.network_1.id
+  qos_policy_id = opentelekomcloud_networking_qos_policy_v2.qos_policy_1.id
+}
+`
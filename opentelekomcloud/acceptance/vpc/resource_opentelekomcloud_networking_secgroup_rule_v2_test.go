@@ -67,6 +67,27 @@ func TestAccNetworkingV2SecGroupRule_timeout(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroupRule_parallel(t *testing.T) {
+	var secgroup_rule rules.SecGroupRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRule_parallel,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupRuleExists(
+						"opentelekomcloud_networking_secgroup_rule_v2.secgroup_rule.0", &secgroup_rule),
+					testAccCheckNetworkingV2SecGroupRuleExists(
+						"opentelekomcloud_networking_secgroup_rule_v2.secgroup_rule.9", &secgroup_rule),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2SecGroupRule_numericProtocol(t *testing.T) {
 	var secgroup_1 groups.SecGroup
 	var secgroup_rule_1 rules.SecGroupRule
@@ -234,6 +255,25 @@ resource "opentelekomcloud_networking_secgroup_rule_v2" "secgroup_rule_2" {
 }
 `
 
+const testAccNetworkingV2SecGroupRule_parallel = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_1"
+  description = "terraform security group rule acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "secgroup_rule" {
+  count = 10
+
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  port_range_max    = 8000 + count.index
+  port_range_min    = 8000 + count.index
+  protocol          = "tcp"
+  remote_ip_prefix  = "0.0.0.0/0"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+}
+`
+
 const testAccNetworkingV2SecGroupRule_protocols = `
 resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
   name = "secgroup_1"
@@ -11,6 +11,7 @@ import (
 )
 
 func TestAccOTCVpcPeeringConnectionAccepterV2_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_vpc_peering_connection_accepter_v2.peer"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { common.TestAccPreCheck(t) },
@@ -18,7 +19,26 @@ func TestAccOTCVpcPeeringConnectionAccepterV2_basic(t *testing.T) {
 		CheckDestroy:      testAccCheckOTCVpcPeeringConnectionAccepterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccOTCVpcPeeringConnectionAccepterV2_basic, // TODO: Research why normal scenario with peer tenant id is not working in acceptance tests
+				// peering within the same tenant is accepted automatically, so the accepter just adopts it
+				Config: testAccOTCVpcPeeringConnectionAccepterV2_basic, // TODO: Research why normal scenario with peer tenant id is not working in acceptance tests
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "route_destinations.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_vpc_id", "opentelekomcloud_vpc_v1.vpc_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOTCVpcPeeringConnectionAccepterV2_reject(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckOTCVpcPeeringConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOTCVpcPeeringConnectionAccepterV2_reject,
 				ExpectError: regexp.MustCompile(`VPC peering action not permitted: Can not accept/reject peering request not in PENDING_ACCEPTANCE state.`),
 			},
 		},
@@ -37,16 +57,37 @@ resource "opentelekomcloud_vpc_v1" "vpc_1" {
 }
 resource "opentelekomcloud_vpc_v1" "vpc_2" {
   name = "otc_vpc_2"
-  cidr = "192.168.0.0/16"
+  cidr = "172.16.0.0/16"
 }
 resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
-    name = "opentelekomcloud"
-    vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
-    peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
-  }
+  name        = "opentelekomcloud"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
+}
 resource "opentelekomcloud_vpc_peering_connection_accepter_v2" "peer" {
   vpc_peering_connection_id = opentelekomcloud_vpc_peering_connection_v2.peering_1.id
-  accept = true
+  accept                    = true
+
+  route_destinations = [opentelekomcloud_vpc_v1.vpc_1.cidr]
+}
+`
 
+const testAccOTCVpcPeeringConnectionAccepterV2_reject = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "otc_vpc_1"
+  cidr = "192.168.0.0/16"
+}
+resource "opentelekomcloud_vpc_v1" "vpc_2" {
+  name = "otc_vpc_2"
+  cidr = "192.168.0.0/16"
+}
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "opentelekomcloud"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
+}
+resource "opentelekomcloud_vpc_peering_connection_accepter_v2" "peer" {
+  vpc_peering_connection_id = opentelekomcloud_vpc_peering_connection_v2.peering_1.id
+  accept                    = false
 }
 `
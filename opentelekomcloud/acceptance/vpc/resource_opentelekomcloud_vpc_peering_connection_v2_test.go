@@ -42,6 +42,35 @@ func TestAccOTCVpcPeeringConnectionV2_basic(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcPeeringConnectionV2_routes(t *testing.T) {
+	var peering peerings.Peering
+	resourceName := "opentelekomcloud_vpc_peering_connection_v2.peering_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckOTCVpcPeeringConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOTCVpcPeeringConnectionV2_routes,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcPeeringConnectionV2Exists(resourceName, &peering),
+					resource.TestCheckResourceAttr(resourceName, "route_destinations.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "route_destinations.*", "172.16.0.0/16"),
+				),
+			},
+			{
+				Config: testAccOTCVpcPeeringConnectionV2_routesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route_destinations.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "route_destinations.*", "172.16.10.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "route_destinations.*", "172.16.20.0/24"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOTCVpcPeeringConnectionV2_timeout(t *testing.T) {
 	var peering peerings.Peering
 
@@ -169,3 +198,43 @@ resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
   }
 }
 `
+
+const testAccOTCVpcPeeringConnectionV2_routes = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_v1" "vpc_2" {
+  name = "vpc_test1"
+  cidr = "172.16.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "opentelekomcloud_peering"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
+
+  route_destinations = [opentelekomcloud_vpc_v1.vpc_2.cidr]
+}
+`
+
+const testAccOTCVpcPeeringConnectionV2_routesUpdate = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_v1" "vpc_2" {
+  name = "vpc_test1"
+  cidr = "172.16.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "opentelekomcloud_peering"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
+
+  route_destinations = ["172.16.10.0/24", "172.16.20.0/24"]
+}
+`
@@ -37,6 +37,20 @@ func TestAccVpnSiteConnectionV2_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPtr("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "local_id", &conn.LocalID),
 					resource.TestCheckResourceAttrPtr("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "peer_ep_group_id", &conn.PeerEPGroupID),
 					resource.TestCheckResourceAttrPtr("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "name", &conn.Name),
+					resource.TestCheckResourceAttrSet("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "status"),
+				),
+			},
+			{
+				Config: testAccSiteConnectionV2_dpd,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSiteConnectionV2Exists(
+						"opentelekomcloud_vpnaas_site_connection_v2.conn_1", &conn),
+					resource.TestCheckTypeSetElemNestedAttrs("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "dpd.*", map[string]string{
+						"action":   "restart",
+						"interval": "20",
+						"timeout":  "60",
+					}),
+					resource.TestCheckResourceAttrSet("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "status"),
 				),
 			},
 		},
@@ -154,3 +168,71 @@ var testAccSiteConnectionV2_basic = fmt.Sprintf(`
 		depends_on = ["opentelekomcloud_networking_router_interface_v2.router_interface_1"]
 	}
 	`, env.OS_EXTGW_ID)
+
+var testAccSiteConnectionV2_dpd = fmt.Sprintf(`
+	resource "opentelekomcloud_networking_network_v2" "network_1" {
+		name           = "tf_test_network"
+  		admin_state_up = "true"
+	}
+
+	resource "opentelekomcloud_networking_subnet_v2" "subnet_1" {
+  		network_id = opentelekomcloud_networking_network_v2.network_1.id
+  		cidr       = "192.168.199.0/24"
+  		ip_version = 4
+	}
+
+	resource "opentelekomcloud_networking_router_v2" "router_1" {
+  		name             = "my_router"
+  		external_gateway = "%s"
+	}
+
+	resource "opentelekomcloud_networking_router_interface_v2" "router_interface_1" {
+  		router_id = opentelekomcloud_networking_router_v2.router_1.id
+  		subnet_id = opentelekomcloud_networking_subnet_v2.subnet_1.id
+	}
+
+	resource "opentelekomcloud_vpnaas_service_v2" "service_1" {
+		router_id = opentelekomcloud_networking_router_v2.router_1.id
+		admin_state_up = "false"
+	}
+
+	resource "opentelekomcloud_vpnaas_ipsec_policy_v2" "policy_1" {
+	}
+
+	resource "opentelekomcloud_vpnaas_ike_policy_v2" "policy_2" {
+	}
+
+	resource "opentelekomcloud_vpnaas_endpoint_group_v2" "group_1" {
+		type = "cidr"
+		endpoints = ["10.2.0.0/24", "10.3.0.0/24"]
+	}
+	resource "opentelekomcloud_vpnaas_endpoint_group_v2" "group_2" {
+		type = "subnet"
+		endpoints = [ opentelekomcloud_networking_subnet_v2.subnet_1.id ]
+	}
+
+	resource "opentelekomcloud_vpnaas_site_connection_v2" "conn_1" {
+		name = "connection_1"
+		ikepolicy_id = opentelekomcloud_vpnaas_ike_policy_v2.policy_2.id
+		ipsecpolicy_id = opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1.id
+		vpnservice_id = opentelekomcloud_vpnaas_service_v2.service_1.id
+		psk = "secret"
+		peer_address = "192.168.10.1"
+		peer_id = "192.168.10.1"
+		local_ep_group_id = opentelekomcloud_vpnaas_endpoint_group_v2.group_2.id
+		peer_ep_group_id = opentelekomcloud_vpnaas_endpoint_group_v2.group_1.id
+
+		dpd {
+		  action   = "restart"
+		  interval = 20
+		  timeout  = 60
+		}
+
+		tags = {
+		  foo = "bar"
+		  key = "value"
+		}
+
+		depends_on = ["opentelekomcloud_networking_router_interface_v2.router_interface_1"]
+	}
+	`, env.OS_EXTGW_ID)
@@ -0,0 +1,66 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceCertificateName = "data.opentelekomcloud_waf_certificate_v1.certificate"
+
+func TestAccWafCertificateV1DataSource_byHostname(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckWafDomainV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafCertificateV1DataSourceByHostname,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceCertificateName, "id", "opentelekomcloud_waf_certificate_v1.certificate_1", "id"),
+					resource.TestCheckResourceAttr(dataSourceCertificateName, "name", "cert_1"),
+					resource.TestCheckResourceAttr(dataSourceCertificateName, "domains.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceCertificateName, "domains.0.hostname", "www.b.com"),
+					resource.TestCheckResourceAttrSet(dataSourceCertificateName, "expires"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWafCertificateV1DataSource_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckWafCertificateV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafCertificateV1DataSourceByName,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceCertificateName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceCertificateName, "expires", "2022-05-31 09:25:52 UTC"),
+					resource.TestCheckResourceAttr(dataSourceCertificateName, "domains.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccWafCertificateV1DataSourceByHostname = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_waf_certificate_v1" "certificate" {
+  hostname = opentelekomcloud_waf_domain_v1.domain_1.hostname
+}
+`, testAccWafDomainV1Basic)
+
+var testAccWafCertificateV1DataSourceByName = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_waf_certificate_v1" "certificate" {
+  name = opentelekomcloud_waf_certificate_v1.certificate_1.name
+}
+`, testAccWafCertificateV1Basic)
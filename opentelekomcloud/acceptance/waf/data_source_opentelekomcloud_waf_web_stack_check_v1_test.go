@@ -0,0 +1,51 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSourceWebStackCheckName = "data.opentelekomcloud_waf_web_stack_check_v1.check"
+
+func TestAccWafWebStackCheckV1DataSource_basic(t *testing.T) {
+	listenerID := os.Getenv("OS_WEB_STACK_LISTENER_ID")
+	domainID := os.Getenv("OS_WEB_STACK_WAF_DOMAIN_ID")
+	zoneID := os.Getenv("OS_WEB_STACK_DNS_ZONE_ID")
+	recordsetID := os.Getenv("OS_WEB_STACK_DNS_RECORDSET_ID")
+	if listenerID == "" || domainID == "" || zoneID == "" || recordsetID == "" {
+		t.Skip("OS_WEB_STACK_LISTENER_ID, OS_WEB_STACK_WAF_DOMAIN_ID, OS_WEB_STACK_DNS_ZONE_ID " +
+			"and OS_WEB_STACK_DNS_RECORDSET_ID are required for this test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafWebStackCheckV1DataSourceBasic(listenerID, domainID, zoneID, recordsetID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceWebStackCheckName, "hostname"),
+					resource.TestCheckResourceAttrSet(dataSourceWebStackCheckName, "listener_addresses.#"),
+					resource.TestCheckResourceAttr(dataSourceWebStackCheckName, "passed", "true"),
+					resource.TestCheckResourceAttr(dataSourceWebStackCheckName, "issues.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWafWebStackCheckV1DataSourceBasic(listenerID, domainID, zoneID, recordsetID string) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_waf_web_stack_check_v1" "check" {
+  listener_id      = "%s"
+  waf_domain_id    = "%s"
+  dns_zone_id      = "%s"
+  dns_recordset_id = "%s"
+}
+`, listenerID, domainID, zoneID, recordsetID)
+}
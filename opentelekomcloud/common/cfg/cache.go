@@ -0,0 +1,87 @@
+package cfg
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// responseCacheTTL limits how long the catalog data can be stale within a long apply
+const responseCacheTTL = time.Minute
+
+type cachedResponse struct {
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// ResponseCache keeps successful GET responses in memory for a short time,
+// so a single plan or apply doesn't request the same catalog data repeatedly.
+// Responses are keyed by the request URL, the host of which identifies the service.
+type ResponseCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	responses map[string]cachedResponse
+}
+
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{ttl: responseCacheTTL, responses: make(map[string]cachedResponse)}
+}
+
+func isCacheable(request *http.Request) bool {
+	return request.Method == http.MethodGet
+}
+
+// get returns a copy of the cached response for the request, if any
+func (c *ResponseCache) get(request *http.Request) *http.Response {
+	if c == nil || !isCacheable(request) {
+		return nil
+	}
+	c.mu.Lock()
+	cached, ok := c.responses[request.URL.String()]
+	if ok && time.Now().After(cached.expires) {
+		delete(c.responses, request.URL.String())
+		ok = false
+	}
+	c.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return &http.Response{
+		Status:        http.StatusText(cached.status),
+		StatusCode:    cached.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cached.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(cached.body)),
+		ContentLength: int64(len(cached.body)),
+		Request:       request,
+	}
+}
+
+// store saves successful response and replaces its body, as the body can be read only once
+func (c *ResponseCache) store(request *http.Request, response *http.Response) error {
+	if c == nil || !isCacheable(request) || response.StatusCode != http.StatusOK {
+		return nil
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[request.URL.String()] = cachedResponse{
+		status:  response.StatusCode,
+		header:  response.Header.Clone(),
+		body:    body,
+		expires: time.Now().Add(c.ttl),
+	}
+	return nil
+}
@@ -59,13 +59,29 @@ type Config struct {
 	DelegatedProject string
 	MaxRetries       int
 
+	// ServiceParallelism limits concurrent Create, Update and Delete operations per service,
+	// a slot is held until the operation returns, including its waits
+	ServiceParallelism map[string]int
+
+	// PreventReplacement fails plans replacing resources of ProtectedResourceTypes
+	PreventReplacement     bool
+	ProtectedResourceTypes []string
+
+	// CacheDataSourceReads enables the in-memory cache of GET responses for the data sources
+	CacheDataSourceReads bool
+
 	UserAgent string
 
 	HwClient *golangsdk.ProviderClient
 	s3sess   *session.Session
+	limiter  *ServiceLimiter
 
 	DomainClient *golangsdk.ProviderClient
 
+	// cachedClient is authenticated separately from HwClient, its responses are cached
+	cachedClient *golangsdk.ProviderClient
+	cache        *ResponseCache
+
 	environment *openstack.Env
 }
 
@@ -92,6 +108,14 @@ func (c *Config) LoadAndValidate() error {
 		return err
 	}
 
+	// limiter is shared between the configs of different projects
+	if c.limiter == nil && len(c.ServiceParallelism) > 0 {
+		c.limiter = NewServiceLimiter(c.ServiceParallelism)
+	}
+	if c.cache == nil && c.CacheDataSourceReads {
+		c.cache = NewResponseCache()
+	}
+
 	var err error
 	switch {
 	case c.Token != "":
@@ -481,6 +505,14 @@ func (c *Config) genClients(pao, dao golangsdk.AuthOptionsProvider) error {
 	}
 	c.HwClient = client
 
+	if c.cache != nil {
+		client, err = c.genCachedClient(pao)
+		if err != nil {
+			return fmt.Errorf("error generating cached project client: %w", err)
+		}
+		c.cachedClient = client
+	}
+
 	client, err = c.genClient(dao)
 	if err != nil {
 		return fmt.Errorf("error generating domain client: %w", err)
@@ -490,6 +522,14 @@ func (c *Config) genClients(pao, dao golangsdk.AuthOptionsProvider) error {
 }
 
 func (c *Config) genClient(ao golangsdk.AuthOptionsProvider) (*golangsdk.ProviderClient, error) {
+	return c.newProviderClient(ao, nil)
+}
+
+func (c *Config) genCachedClient(ao golangsdk.AuthOptionsProvider) (*golangsdk.ProviderClient, error) {
+	return c.newProviderClient(ao, c.cache)
+}
+
+func (c *Config) newProviderClient(ao golangsdk.AuthOptionsProvider, cache *ResponseCache) (*golangsdk.ProviderClient, error) {
 	client, err := openstack.NewClient(ao.GetIdentityEndpoint())
 	if err != nil {
 		return nil, err
@@ -515,6 +555,7 @@ func (c *Config) genClient(ao golangsdk.AuthOptionsProvider) (*golangsdk.Provide
 			Rt:         transport,
 			OsDebug:    osDebug,
 			MaxRetries: c.MaxRetries,
+			Cache:      cache,
 		},
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			if client.AKSKAuthOptions.AccessKey != "" {
@@ -614,6 +655,23 @@ func (c *Config) issueTemporaryCredentials() (*credentials.TemporaryCredential,
 	return credential, nil
 }
 
+// ObsCredentials returns AK/SK used to sign OBS requests which are not supported by the SDK
+func (c *Config) ObsCredentials() (*credentials.TemporaryCredential, error) {
+	return c.issueTemporaryCredentials()
+}
+
+// ObsEndpoint returns OBS endpoint of the region, e.g. `https://obs.eu-de.otc.t-systems.com/`
+func (c *Config) ObsEndpoint(region string) (string, error) {
+	client, err := openstack.NewOBSService(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return client.Endpoint, nil
+}
+
 func (c *Config) NewObjectStorageClient(region string) (*obs.ObsClient, error) {
 	cred, err := c.issueTemporaryCredentials()
 	if err != nil {
@@ -682,6 +740,16 @@ func (c *Config) DnsV2Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// DnsV21Client returns the client for DNS v2.1 API supporting line-based resolution of the record sets
+func (c *Config) DnsV21Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.DnsV2Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = client.Endpoint + "v2.1/"
+	return client, nil
+}
+
 func (c *Config) IdentityV3Client(_ ...string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewIdentityV3(c.DomainClient, golangsdk.EndpointOpts{
 		Availability: c.getEndpointType(),
@@ -728,6 +796,15 @@ func (c *Config) NetworkingV2Client(region string) (*golangsdk.ServiceClient, er
 	})
 }
 
+func (c *Config) NetworkingV3Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.NetworkingV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = client.Endpoint + "v3/" + c.HwClient.ProjectID + "/"
+	return client, nil
+}
+
 func (c *Config) SmnV2Client(projectName ProjectName) (*golangsdk.ServiceClient, error) {
 	newConfig, err := reconfigProjectName(*c, projectName)
 	if err != nil {
@@ -805,6 +882,17 @@ func (c *Config) AutoscalingV1Client(region string) (*golangsdk.ServiceClient, e
 	})
 }
 
+// AutoscalingV2Client returns the client for AS v2 API supporting the bandwidth scaling policies
+func (c *Config) AutoscalingV2Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.AutoscalingV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.Endpoint = strings.Replace(client.Endpoint, "/v1/", "/v2/", 1)
+	client.ResourceBase = strings.Replace(client.ResourceBase, "/v1/", "/v2/", 1)
+	return client, nil
+}
+
 func (c *Config) CsbsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewCSBSService(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -826,6 +914,15 @@ func (c *Config) DmsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+func (c *Config) DmsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.DmsV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = client.Endpoint + "v2/" + c.HwClient.ProjectID + "/"
+	return client, nil
+}
+
 func (c *Config) MrsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewMapReduceV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -833,6 +930,11 @@ func (c *Config) MrsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// MrsV2Client returns the client for MRS API v2, which is not covered by the SDK
+func (c *Config) MrsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "mrs", "v2")
+}
+
 func (c *Config) ElbV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewELBV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -888,6 +990,17 @@ func (c *Config) CceV3AddonClient(region string) (*golangsdk.ServiceClient, erro
 	return client, nil
 }
 
+// CceV1Client returns a client for the Kubernetes core API of CCE clusters,
+// cluster-specific URLs should be built with `addons.CCEServiceURL`
+func (c *Config) CceV1Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.CceV3Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sapi/v1/", client.Endpoint)
+	return client, nil
+}
+
 func (c *Config) DcsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewDCSServiceV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -944,12 +1057,161 @@ func (c *Config) SwrV2Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// commonServiceClient is a workaround for services missing in the SDK and in the catalog.
+// The endpoint is derived from the EVS one: https://{srv}.{region}.{domain}/{version}/{project_id}/
+func (c *Config) commonServiceClient(region, srv, version string) (*golangsdk.ServiceClient, error) {
+	client, err := c.BlockStorageV2Client(region)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := strings.Replace(client.Endpoint, "v2", version, 1)
+	client.Endpoint = strings.Replace(endpoint, "evs", srv, 1)
+	client.ResourceBase = client.Endpoint
+	return client, nil
+}
+
+func (c *Config) DisV2Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "dis", "v2")
+}
+
+func (c *Config) EgV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "events", "v1")
+}
+
+// CsmsV1Client returns the client for Cloud Secret Management Service, which is served by the KMS endpoint
+func (c *Config) CsmsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "kms", "v1")
+}
+
+// DwsV1Client returns the client for Data Warehouse Service
+func (c *Config) DwsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "dws", "v1.0")
+}
+
+// DwsV2Client returns the client for Data Warehouse Service v2 API
+func (c *Config) DwsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "dws", "v2")
+}
+
+// KpsV3Client returns the client for Key Pair Service, which is served by the KMS endpoint
+func (c *Config) KpsV3Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "kms", "v3")
+}
+
+// CtsV3Client returns the client for Cloud Trace Service v3 API, used for key event notifications
+func (c *Config) CtsV3Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "cts", "v3")
+}
+
+// AomV1Client returns the client for Application Operations Management v1 API, used for Prometheus instances
+func (c *Config) AomV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "aom", "v1")
+}
+
+// AomV2Client returns the client for Application Operations Management v2 API, used for alarm actions and silence
+func (c *Config) AomV2Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "aom", "v2")
+}
+
+// AomV4Client returns the client for Application Operations Management v4 API, used for alarm rules
+func (c *Config) AomV4Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "aom", "v4")
+}
+
+// DdmV1Client returns the client for Distributed Database Middleware
+func (c *Config) DdmV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "ddm", "v1")
+}
+
+// GesV1Client returns the client for Graph Engine Service
+func (c *Config) GesV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "ges", "v1.0")
+}
+
+// ElbV3Client returns the client for dedicated Elastic Load Balance v3 API
+func (c *Config) ElbV3Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "elb", "v3")
+}
+
+// GaussDBOpenGaussV3Client returns the client for GaussDB(for openGauss)
+func (c *Config) GaussDBOpenGaussV3Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "gaussdb-opengauss", "v3")
+}
+
+// CloudTableV2Client returns the client for CloudTable Service
+func (c *Config) CloudTableV2Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonServiceClient(region, "cloudtable", "v2")
+}
+
+// commonGlobalServiceClient is the same as commonServiceClient for services without project ID in the URL:
+// https://{srv}.{region}.{domain}/{version}/
+func (c *Config) commonGlobalServiceClient(region, srv, version string) (*golangsdk.ServiceClient, error) {
+	client, err := c.commonServiceClient(region, srv, version)
+	if err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf("/%s/", version)
+	if i := strings.Index(client.Endpoint, prefix); i != -1 {
+		client.Endpoint = client.Endpoint[:i+len(prefix)]
+	}
+	client.ResourceBase = client.Endpoint
+	return client, nil
+}
+
+// CdnV1Client returns the client for the global Content Delivery Network service
+func (c *Config) CdnV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonGlobalServiceClient(region, "cdn", "v1.0")
+}
+
+// ScmV3Client returns the client for the global SSL Certificate Manager service
+func (c *Config) ScmV3Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonGlobalServiceClient(region, "scm", "v3")
+}
+
+// EpsV1Client returns the client for the global Enterprise Project Service
+func (c *Config) EpsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonGlobalServiceClient(region, "eps", "v1.0")
+}
+
+// TmsV1Client returns the client for the global Tag Management Service
+func (c *Config) TmsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonGlobalServiceClient(region, "tms", "v1.0")
+}
+
+// ApigwV1Client returns the client for the shared API Gateway, its API paths don't contain the project ID
+func (c *Config) ApigwV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonGlobalServiceClient(region, "apig", "v1.0")
+}
+
+// ApmV1Client returns the client for Application Performance Management, its API paths don't contain the project ID
+func (c *Config) ApmV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonGlobalServiceClient(region, "apm", "v1")
+}
+
+// OsmV2Client returns the client for Operation Support Management, used for the service tickets
+func (c *Config) OsmV2Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.commonGlobalServiceClient(region, "osm", "v2")
+}
+
+// CachedClient returns the copy of the service client with the responses of GET requests cached
+// for a minute. It's meant for data sources reading catalog data not changed by the provider, e.g. flavors,
+// and returns the client as is if caching is disabled.
+func (c *Config) CachedClient(client *golangsdk.ServiceClient) *golangsdk.ServiceClient {
+	if c.cachedClient == nil {
+		return client
+	}
+	cached := *client
+	cached.ProviderClient = c.cachedClient
+	return &cached
+}
+
 func reconfigProjectName(src Config, projectName ProjectName) (*Config, error) {
 	config := &Config{}
 	if err := copier.Copy(config, &src); err != nil {
 		return nil, err
 	}
 	config.TenantName = string(projectName)
+	config.limiter = src.limiter
 	if err := config.LoadAndValidate(); err != nil {
 		return nil, err
 	}
@@ -14,6 +14,8 @@ import (
 	"time"
 
 	"github.com/unknwon/com"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
 
 var maxTimeout = 10 * time.Minute
@@ -24,6 +26,58 @@ type RoundTripper struct {
 	Rt         http.RoundTripper
 	OsDebug    bool
 	MaxRetries int
+	Cache      *ResponseCache
+}
+
+// requestIDHeaders are the response headers containing the ID of the request,
+// different services use different headers
+var requestIDHeaders = []string{
+	"X-Request-Id",
+	"X-Openstack-Request-Id",
+	"X-Compute-Request-Id",
+	"X-Obs-Request-Id",
+	"X-Amz-Request-Id",
+}
+
+// requestID returns the ID of the request the support can look up
+func requestID(header http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// addRequestID adds the request ID to the body of the failed API call response, so the ID is
+// a part of the error returned by golangsdk. Only empty and JSON object bodies are changed.
+func addRequestID(response *http.Response, id string) error {
+	body, err := ioutil.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	fields := make(map[string]json.RawMessage)
+	if len(bytes.TrimSpace(body)) != 0 {
+		if err := json.Unmarshal(body, &fields); err != nil {
+			return nil
+		}
+	}
+	if _, ok := fields[fmterr.RequestIDField]; ok {
+		return nil
+	}
+	if fields[fmterr.RequestIDField], err = json.Marshal(id); err != nil {
+		return err
+	}
+	if body, err = json.Marshal(fields); err != nil {
+		return err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	response.ContentLength = int64(len(body))
+	response.Header.Del("Content-Length")
+	return nil
 }
 
 func retryTimeout(count int) time.Duration {
@@ -48,6 +102,11 @@ func (lrt *RoundTripper) RoundTrip(request *http.Request) (*http.Response, error
 
 	var err error
 
+	if cached := lrt.Cache.get(request); cached != nil {
+		log.Printf("[DEBUG] OpenTelekomCloud API call served from cache: method=%s url=%s", request.Method, request.URL)
+		return cached, nil
+	}
+
 	if lrt.OsDebug {
 		log.Printf("[DEBUG] OpenTelekomCloud Request URL: %s %s", request.Method, request.URL)
 		log.Printf("[DEBUG] OpenTelekomCloud Request Headers:\n%s", formatHeaders(request.Header, "\n"))
@@ -60,27 +119,40 @@ func (lrt *RoundTripper) RoundTrip(request *http.Request) (*http.Response, error
 		}
 	}
 
+	start := time.Now()
 	response, err := lrt.Rt.RoundTrip(request)
 	// Retrying connection
 	retry := 1
 	for response == nil {
 
 		if retry > lrt.MaxRetries {
-			if lrt.OsDebug {
-				log.Printf("[DEBUG] OpenTelecomCloud connection error, retries exhausted. Aborting")
-			}
+			log.Printf("[WARN] OpenTelekomCloud API call failed: method=%s url=%s attempts=%d error=%q",
+				request.Method, request.URL, retry, err)
 			err = fmt.Errorf("OpenTelecomCloud connection error, retries exhausted. Aborting. Last error was: %s", err)
 			return nil, err
 		}
 
-		if lrt.OsDebug {
-			log.Printf("[DEBUG] OpenTelecomCloud connection error, retry number %d: %s", retry, err)
-		}
-		time.Sleep(retryTimeout(retry))
+		timeout := retryTimeout(retry)
+		log.Printf("[WARN] OpenTelekomCloud API call retry: method=%s url=%s attempt=%d/%d backoff=%s error=%q",
+			request.Method, request.URL, retry, lrt.MaxRetries, timeout, err)
+		time.Sleep(timeout)
 		response, err = lrt.Rt.RoundTrip(request)
 		retry += 1
 	}
 
+	id := requestID(response.Header)
+	log.Printf("[DEBUG] OpenTelekomCloud API call: method=%s url=%s status=%d request_id=%s attempts=%d duration=%s",
+		request.Method, request.URL, response.StatusCode, id, retry, time.Since(start).Round(time.Millisecond))
+	if response.StatusCode >= 400 && id != "" {
+		if err := addRequestID(response, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := lrt.Cache.store(request, response); err != nil {
+		return nil, err
+	}
+
 	if lrt.OsDebug {
 		log.Printf("[DEBUG] OpenTelekomCloud Response Code: %d", response.StatusCode)
 		log.Printf("[DEBUG] OpenTelekomCloud Response Headers:\n%s", formatHeaders(response.Header, "\n"))
@@ -0,0 +1,68 @@
+package cfg
+
+import (
+	"context"
+	"log"
+	"strings"
+)
+
+const resourceTypePrefix = "opentelekomcloud_"
+
+// ServiceLimiter limits the number of concurrent create, update and delete operations per service.
+// The slot is held for the whole operation, including waiting for asynchronous jobs.
+// Service is identified by the first part of the resource type name, e.g. `rds` for `opentelekomcloud_rds_instance_v3`
+// and `lb` for `opentelekomcloud_lb_member_v2`.
+type ServiceLimiter struct {
+	semaphores map[string]chan struct{}
+}
+
+// NewServiceLimiter creates limiter from the map of service name to the number of concurrent operations.
+// Non-positive limits are ignored.
+func NewServiceLimiter(limits map[string]int) *ServiceLimiter {
+	semaphores := make(map[string]chan struct{}, len(limits))
+	for service, limit := range limits {
+		if limit <= 0 {
+			continue
+		}
+		semaphores[strings.ToLower(service)] = make(chan struct{}, limit)
+	}
+	return &ServiceLimiter{semaphores: semaphores}
+}
+
+// ResourceService returns the service of the resource type used as the limiter key
+func ResourceService(resourceType string) string {
+	service := strings.TrimPrefix(resourceType, resourceTypePrefix)
+	if i := strings.Index(service, "_"); i != -1 {
+		service = service[:i]
+	}
+	return service
+}
+
+// Acquire blocks until the operation on the resource type is allowed to start
+// and returns function releasing the slot.
+func (l *ServiceLimiter) Acquire(ctx context.Context, resourceType string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	service := ResourceService(resourceType)
+	sem, ok := l.semaphores[service]
+	if !ok {
+		return func() {}, nil
+	}
+	select {
+	case sem <- struct{}{}:
+	default:
+		log.Printf("[DEBUG] Waiting for a free slot of service %s for %s", service, resourceType)
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return func() { <-sem }, nil
+}
+
+// AcquireOperation blocks until the operation on the resource type fits the configured `service_parallelism`
+func (c *Config) AcquireOperation(ctx context.Context, resourceType string) (func(), error) {
+	return c.limiter.Acquire(ctx, resourceType)
+}
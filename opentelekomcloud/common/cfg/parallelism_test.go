@@ -0,0 +1,66 @@
+package cfg
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestResourceService(t *testing.T) {
+	th.AssertEquals(t, "rds", ResourceService("opentelekomcloud_rds_instance_v3"))
+	th.AssertEquals(t, "lb", ResourceService("opentelekomcloud_lb_member_v2"))
+	th.AssertEquals(t, "vpc", ResourceService("opentelekomcloud_vpc_v1"))
+}
+
+func TestServiceLimiter(t *testing.T) {
+	limiter := NewServiceLimiter(map[string]int{"rds": 2})
+
+	run := func(resourceType string) int32 {
+		var current, maxConcurrent int32
+		var wg sync.WaitGroup
+		for i := 0; i < 6; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				release, err := limiter.Acquire(context.Background(), resourceType)
+				th.AssertNoErr(t, err)
+				defer release()
+
+				n := atomic.AddInt32(&current, 1)
+				for {
+					m := atomic.LoadInt32(&maxConcurrent)
+					if n <= m || atomic.CompareAndSwapInt32(&maxConcurrent, m, n) {
+						break
+					}
+				}
+				time.Sleep(50 * time.Millisecond)
+				atomic.AddInt32(&current, -1)
+			}()
+		}
+		wg.Wait()
+		return atomic.LoadInt32(&maxConcurrent)
+	}
+
+	th.AssertEquals(t, int32(2), run("opentelekomcloud_rds_instance_v3"))
+
+	// other services are not limited
+	if m := run("opentelekomcloud_vpc_v1"); m <= 2 {
+		t.Errorf("expected VPC operations not to be limited, max concurrency: %d", m)
+	}
+}
+
+func TestServiceLimiterCanceled(t *testing.T) {
+	limiter := NewServiceLimiter(map[string]int{"rds": 1})
+	release, err := limiter.Acquire(context.Background(), "opentelekomcloud_rds_instance_v3")
+	th.AssertNoErr(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Acquire(ctx, "opentelekomcloud_rds_instance_v3")
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,27 @@
+package cfg
+
+// DefaultProtectedResourceTypes are resources holding data which is lost on replacement
+var DefaultProtectedResourceTypes = []string{
+	"opentelekomcloud_blockstorage_volume_v2",
+	"opentelekomcloud_evs_volume_v3",
+	"opentelekomcloud_obs_bucket",
+	"opentelekomcloud_rds_instance_v1",
+	"opentelekomcloud_rds_instance_v3",
+}
+
+// IsReplacementProtected checks if the replacement of the resource type has to fail the plan
+func (c *Config) IsReplacementProtected(resourceType string) bool {
+	if !c.PreventReplacement {
+		return false
+	}
+	protected := c.ProtectedResourceTypes
+	if len(protected) == 0 {
+		protected = DefaultProtectedResourceTypes
+	}
+	for _, t := range protected {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
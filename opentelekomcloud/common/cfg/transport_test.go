@@ -3,11 +3,15 @@ package cfg
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
 
 type failHandler struct {
@@ -82,3 +86,135 @@ func TestRoundTripperRetry(t *testing.T) {
 	th.CheckNoErr(t, err)
 	th.AssertEquals(t, failHandler.ExpectedFailures, failHandler.FailCount)
 }
+
+func TestRoundTripperRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-42")
+		w.WriteHeader(404)
+	})
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{
+			HTTPClient: http.Client{
+				Transport: &RoundTripper{Rt: http.DefaultTransport},
+			},
+		},
+		Endpoint: th.Endpoint(),
+	}
+
+	_, err := client.Get(client.ServiceURL("missing"), nil, nil)
+	th.AssertEquals(t, "req-42", fmterr.RequestID(err))
+
+	diags := fmterr.Errorf("error reading resource: %s", err)
+	th.AssertEquals(t, true, strings.HasSuffix(diags[0].Summary, "(request ID: req-42)"))
+
+	wrapped := fmt.Errorf("error reading resource: %w", err)
+	th.AssertEquals(t, "req-42", fmterr.RequestID(wrapped))
+
+	notFound := err.(golangsdk.ErrDefault404)
+	th.AssertEquals(t, "req-42", fmterr.RequestID(&notFound))
+}
+
+func TestRoundTripperRequestIDJSONBody(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/conflict", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-43")
+		w.WriteHeader(409)
+		_, _ = fmt.Fprint(w, `{"error_code": "VPC.0001", "error_msg": "conflict"}`)
+	})
+	th.Mux.HandleFunc("/text", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-44")
+		w.WriteHeader(502)
+		_, _ = fmt.Fprint(w, "bad gateway")
+	})
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{
+			HTTPClient: http.Client{
+				Transport: &RoundTripper{Rt: http.DefaultTransport},
+			},
+		},
+		Endpoint: th.Endpoint(),
+	}
+
+	_, err := client.Get(client.ServiceURL("conflict"), nil, nil)
+	th.AssertEquals(t, "req-43", fmterr.RequestID(err))
+	th.AssertEquals(t, true, strings.Contains(err.Error(), `"error_code":"VPC.0001"`))
+
+	_, err = client.Get(client.ServiceURL("text"), nil, nil)
+	th.AssertEquals(t, "", fmterr.RequestID(err))
+	th.AssertEquals(t, true, strings.Contains(err.Error(), "bad gateway"))
+}
+
+func TestRoundTripperCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/flavors", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"flavors": [{"id": "s2.medium.1"}]}`)
+	})
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{
+			HTTPClient: http.Client{
+				Transport: &RoundTripper{Rt: http.DefaultTransport, Cache: NewResponseCache()},
+			},
+		},
+		Endpoint: th.Endpoint(),
+	}
+
+	for i := 0; i < 3; i++ {
+		var body struct {
+			Flavors []struct {
+				ID string `json:"id"`
+			} `json:"flavors"`
+		}
+		_, err := client.Get(client.ServiceURL("flavors"), &body, nil)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "s2.medium.1", body.Flavors[0].ID)
+	}
+	th.AssertEquals(t, 1, calls)
+
+	_, err := client.Get(client.ServiceURL("flavors")+"?availability_zone=eu-de-01", nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, calls)
+}
+
+func TestRoundTripperCacheExpired(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/flavors", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"flavors": []}`)
+	})
+
+	cache := NewResponseCache()
+	cache.ttl = 10 * time.Millisecond
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{
+			HTTPClient: http.Client{
+				Transport: &RoundTripper{Rt: http.DefaultTransport, Cache: cache},
+			},
+		},
+		Endpoint: th.Endpoint(),
+	}
+
+	_, err := client.Get(client.ServiceURL("flavors"), nil, nil)
+	th.AssertNoErr(t, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = client.Get(client.ServiceURL("flavors"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, calls)
+}
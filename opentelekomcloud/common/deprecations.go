@@ -0,0 +1,65 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	// flavorStatusSpec is the flavor extra spec containing the sale status of the flavor
+	flavorStatusSpec = "cond:operation:status"
+	// flavorAzStatusSpec is the flavor extra spec containing per-AZ sale status,
+	// e.g. `eu-de-01(abandon),eu-de-02(normal)`
+	flavorAzStatusSpec = "cond:operation:az"
+	// flavorStatusAbandon means the flavor is discontinued
+	flavorStatusAbandon = "abandon"
+
+	// imageStatusDeactivated is the status of the images which can't be used anymore
+	imageStatusDeactivated = "deactivated"
+	// imageDeprecatedProperty is set to `true` for images reaching the end of life
+	imageDeprecatedProperty = "deprecated"
+)
+
+// FlavorDeprecationWarning returns a warning if the flavor is discontinued in general or in the given AZ
+func FlavorDeprecationWarning(flavor string, specs map[string]string, availabilityZone string) diag.Diagnostics {
+	deprecated := specs[flavorStatusSpec] == flavorStatusAbandon
+	if availabilityZone != "" {
+		for _, azStatus := range strings.Split(specs[flavorAzStatusSpec], ",") {
+			if strings.TrimSpace(azStatus) == fmt.Sprintf("%s(%s)", availabilityZone, flavorStatusAbandon) {
+				deprecated = true
+			}
+		}
+	}
+	if !deprecated {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Flavor %s is deprecated", flavor),
+			Detail: fmt.Sprintf("The flavor %s is discontinued and can be removed soon. "+
+				"Consider migrating to a flavor of a newer generation.", flavor),
+		},
+	}
+}
+
+// ImageDeprecationWarning returns a warning if the image is deactivated or marked as deprecated
+func ImageDeprecationWarning(image, status string, properties map[string]interface{}) diag.Diagnostics {
+	deprecated := strings.EqualFold(status, imageStatusDeactivated)
+	if value, ok := properties[imageDeprecatedProperty]; ok && fmt.Sprint(value) == "true" {
+		deprecated = true
+	}
+	if !deprecated {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Image %s is deprecated", image),
+			Detail: fmt.Sprintf("The image %s reached the end of life and can be removed soon. "+
+				"Consider migrating to a newer image.", image),
+		},
+	}
+}
@@ -54,5 +54,13 @@ var Descriptions = map[string]string{
 
 	"max_retries": "How many times HTTP connection should be retried until giving up.",
 
+	"service_parallelism": "Maximum number of concurrent create, update and delete operations per service, including their waits, e.g. `rds = 2`.",
+
+	"prevent_replacement": "Fail plans replacing resources of the protected types.",
+
+	"protected_resource_types": "Resource types protected from replacement, RDS instances, EVS volumes and OBS buckets by default.",
+
+	"cache_data_source_reads": "Cache the responses of the flavor and availability zone data sources in memory for a minute.",
+
 	"passcode": "One-time MFA passcode",
 }
@@ -0,0 +1,167 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// EnterpriseProjectIDSchema returns the schema to use for enterprise_project_id.
+func EnterpriseProjectIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+}
+
+// MigrateEnterpriseProject is a helper to move the resource to the configured enterprise project.
+// It expects the enterprise project field to be named "enterprise_project_id".
+// The associated resources, e.g. disks and EIPs of the ECS, are not moved with the resource.
+func MigrateEnterpriseProject(config *cfg.Config, d *schema.ResourceData, resourceType, resourceID string) error {
+	if !d.HasChange("enterprise_project_id") {
+		return nil
+	}
+	epsID := d.Get("enterprise_project_id").(string)
+	if epsID == "" {
+		return nil
+	}
+
+	region := config.GetRegion(d)
+	client, err := config.EpsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EPS client: %w", err)
+	}
+	body := map[string]interface{}{
+		"project_id":    client.ProjectID,
+		"region_id":     region,
+		"resource_type": resourceType,
+		"resource_id":   resourceID,
+		"associated":    false,
+	}
+	_, err = client.Post(client.ServiceURL("enterprise-projects", epsID, "resources-migrate"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return fmt.Errorf("error migrating %s %s to enterprise project %s: %w", resourceType, resourceID, epsID, err)
+	}
+	return nil
+}
+
+// globalEnterpriseProjectTypes are the resource types not bound to the project
+var globalEnterpriseProjectTypes = map[string]bool{
+	"bucket": true,
+}
+
+const enterpriseProjectPageLimit = 1000
+
+// ReadEnterpriseProject sets enterprise_project_id of the resource not returned by the service API.
+// EPS is requested only when enterprise_project_id is set, so the resources
+// outside of enterprise projects are read without additional requests.
+func ReadEnterpriseProject(config *cfg.Config, d *schema.ResourceData, resourceType, resourceID string) error {
+	if d.Get("enterprise_project_id").(string) == "" {
+		return nil
+	}
+	epsID, err := GetEnterpriseProjectID(config, d, resourceType, resourceID)
+	if err != nil {
+		return fmt.Errorf("error looking up enterprise project of %s %s: %w", resourceType, resourceID, err)
+	}
+	return d.Set("enterprise_project_id", epsID)
+}
+
+// GetEnterpriseProjectID looks up the enterprise project containing the resource in EPS.
+// The enterprise project from the state is checked first, so usually a single request is made.
+func GetEnterpriseProjectID(config *cfg.Config, d *schema.ResourceData, resourceType, resourceID string) (string, error) {
+	region := config.GetRegion(d)
+	client, err := config.EpsV1Client(region)
+	if err != nil {
+		return "", fmt.Errorf("error creating OpenTelekomCloud EPS client: %w", err)
+	}
+
+	current := d.Get("enterprise_project_id").(string)
+	if current != "" {
+		found, err := enterpriseProjectContains(client, current, resourceType, resourceID)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return current, nil
+		}
+	}
+
+	projectIDs, err := listEnterpriseProjectIDs(client)
+	if err != nil {
+		return "", err
+	}
+	for _, epsID := range projectIDs {
+		if epsID == current {
+			continue
+		}
+		found, err := enterpriseProjectContains(client, epsID, resourceType, resourceID)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return epsID, nil
+		}
+	}
+	return "", nil
+}
+
+func listEnterpriseProjectIDs(client *golangsdk.ServiceClient) ([]string, error) {
+	var ids []string
+	for offset := 0; ; offset += enterpriseProjectPageLimit {
+		var page struct {
+			EnterpriseProjects []struct {
+				ID string `json:"id"`
+			} `json:"enterprise_projects"`
+			TotalCount int `json:"total_count"`
+		}
+		url := client.ServiceURL("enterprise-projects") + fmt.Sprintf("?limit=%d&offset=%d", enterpriseProjectPageLimit, offset)
+		if _, err := client.Get(url, &page, nil); err != nil {
+			return nil, fmt.Errorf("error listing enterprise projects: %w", err)
+		}
+		for _, project := range page.EnterpriseProjects {
+			ids = append(ids, project.ID)
+		}
+		if len(page.EnterpriseProjects) == 0 || len(ids) >= page.TotalCount {
+			return ids, nil
+		}
+	}
+}
+
+func enterpriseProjectContains(client *golangsdk.ServiceClient, epsID, resourceType, resourceID string) (bool, error) {
+	for offset := 0; ; offset += enterpriseProjectPageLimit {
+		body := map[string]interface{}{
+			"resource_types": []string{resourceType},
+			"limit":          enterpriseProjectPageLimit,
+			"offset":         offset,
+		}
+		if !globalEnterpriseProjectTypes[resourceType] {
+			body["projects"] = []string{client.ProjectID}
+		}
+		var page struct {
+			Resources []struct {
+				ResourceID string `json:"resource_id"`
+			} `json:"resources"`
+			TotalCount int `json:"total_count"`
+		}
+		_, err := client.Post(client.ServiceURL("enterprise-projects", epsID, "resources", "filter"), body, &page, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return false, fmt.Errorf("error listing resources of enterprise project %s: %w", epsID, err)
+		}
+		for _, resource := range page.Resources {
+			if resource.ResourceID == resourceID {
+				return true, nil
+			}
+		}
+		if len(page.Resources) == 0 || offset+len(page.Resources) >= page.TotalCount {
+			return false, nil
+		}
+	}
+}
@@ -7,8 +7,18 @@ import (
 )
 
 // Errorf wraps fmt.Errorf into diag.Diagnostics
+// The request ID of the failed API call is appended to the message if any of the arguments is the API error
 func Errorf(format string, a ...interface{}) diag.Diagnostics {
-	return diag.FromErr(
-		fmt.Errorf(format, a...),
-	)
+	err := fmt.Errorf(format, a...)
+	for _, arg := range a {
+		argErr, ok := arg.(error)
+		if !ok {
+			continue
+		}
+		if id := RequestID(argErr); id != "" {
+			err = fmt.Errorf("%w (request ID: %s)", err, id)
+			break
+		}
+	}
+	return diag.FromErr(err)
 }
@@ -0,0 +1,68 @@
+package fmterr
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// RequestIDField is the field of the failed API call response body holding the request ID.
+// The provider transport adds it to the body if the service returns the ID in the headers only.
+const RequestIDField = "request_id"
+
+// responseErrorTypes are the errors returned by golangsdk for the failed API calls
+var responseErrorTypes = []reflect.Type{
+	reflect.TypeOf(golangsdk.ErrUnexpectedResponseCode{}),
+	reflect.TypeOf(golangsdk.ErrDefault400{}),
+	reflect.TypeOf(golangsdk.ErrDefault401{}),
+	reflect.TypeOf(golangsdk.ErrDefault403{}),
+	reflect.TypeOf(golangsdk.ErrDefault404{}),
+	reflect.TypeOf(golangsdk.ErrDefault405{}),
+	reflect.TypeOf(golangsdk.ErrDefault408{}),
+	reflect.TypeOf(golangsdk.ErrDefault409{}),
+	reflect.TypeOf(golangsdk.ErrDefault429{}),
+	reflect.TypeOf(golangsdk.ErrDefault500{}),
+	reflect.TypeOf(golangsdk.ErrDefault503{}),
+}
+
+// unexpectedResponse finds the details of the failed API call returned by golangsdk in the error chain.
+// Both values and pointers of the golangsdk errors are matched.
+func unexpectedResponse(err error) (golangsdk.ErrUnexpectedResponseCode, bool) {
+	for _, errType := range responseErrorTypes {
+		for _, targetType := range []reflect.Type{errType, reflect.PtrTo(errType)} {
+			target := reflect.New(targetType)
+			if !errors.As(err, target.Interface()) {
+				continue
+			}
+			value := reflect.Indirect(target.Elem())
+			if !value.IsValid() {
+				continue
+			}
+			if resp, ok := value.Interface().(golangsdk.ErrUnexpectedResponseCode); ok {
+				return resp, true
+			}
+			return value.FieldByName("ErrUnexpectedResponseCode").Interface().(golangsdk.ErrUnexpectedResponseCode), true
+		}
+	}
+	return golangsdk.ErrUnexpectedResponseCode{}, false
+}
+
+// RequestID returns the request ID of the failed API call the error is returned for
+func RequestID(err error) string {
+	resp, ok := unexpectedResponse(err)
+	if !ok {
+		return ""
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		return ""
+	}
+	var id string
+	if err := json.Unmarshal(body[RequestIDField], &id); err != nil {
+		return ""
+	}
+	return id
+}
@@ -0,0 +1,92 @@
+package common
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/mutexkv"
+)
+
+// Kinds of the parent resources serializing the changes of their children
+const (
+	// ParentRouter is the Neutron router, which is the VPC in the VPC API
+	ParentRouter        = "router"
+	ParentSubnet        = "subnet"
+	ParentSecurityGroup = "security_group"
+	ParentPort          = "port"
+	ParentNatGateway    = "nat_gateway"
+	ParentLBPool        = "lb_pool"
+	ParentVolume        = "volume"
+)
+
+// parentMutexKV is shared by the resources of all services, e.g. VPC route and NAT SNAT rule
+var parentMutexKV = mutexkv.NewMutexKV()
+
+// Parent identifies the resource whose children can't be changed concurrently
+type Parent struct {
+	Kind string
+	ID   string
+}
+
+func (p Parent) key() string {
+	return p.Kind + "/" + p.ID
+}
+
+// LockParents locks the given parents and returns the function unlocking them.
+// Parents with empty ID are skipped. The locks are always taken in the same order,
+// so the resources locking several parents can't deadlock each other.
+func LockParents(parents ...Parent) func() {
+	keys := make([]string, 0, len(parents))
+	seen := make(map[string]bool, len(parents))
+	for _, parent := range parents {
+		if parent.ID == "" || seen[parent.key()] {
+			continue
+		}
+		seen[parent.key()] = true
+		keys = append(keys, parent.key())
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		parentMutexKV.Lock(key)
+	}
+	return func() {
+		for i := len(keys) - 1; i >= 0; i-- {
+			parentMutexKV.Unlock(keys[i])
+		}
+	}
+}
+
+// IsConflict checks if the API responded with 409, the error type depends on the request options
+func IsConflict(err error) bool {
+	switch e := err.(type) {
+	case golangsdk.ErrDefault409:
+		return true
+	case golangsdk.ErrUnexpectedResponseCode:
+		return e.Actual == 409
+	case *golangsdk.ErrUnexpectedResponseCode:
+		return e.Actual == 409
+	}
+	return false
+}
+
+// RetryOnConflict calls f until it succeeds, fails with other error than 409 or the timeout expires.
+// Conflicts are caused by changes made outside of the provider locks, e.g. by the cloud itself.
+func RetryOnConflict(ctx context.Context, timeout time.Duration, f func() error) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if IsConflict(err) {
+			log.Printf("[DEBUG] Conflicting change, retrying: %s", err)
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// LimitParallelism wraps create, update and delete of the resource, so the number of concurrent
+// operations per service doesn't exceed the provider `service_parallelism`
+func LimitParallelism(resourceType string, r *schema.Resource) {
+	if r.CreateContext != nil {
+		r.CreateContext = limitOperation(resourceType, r.CreateContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = limitOperation(resourceType, r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = limitOperation(resourceType, r.DeleteContext)
+	}
+}
+
+type operationFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func limitOperation(resourceType string, operation operationFunc) operationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		config, ok := meta.(*cfg.Config)
+		if !ok {
+			return operation(ctx, d, meta)
+		}
+		release, err := config.AcquireOperation(ctx, resourceType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer release()
+		return operation(ctx, d, meta)
+	}
+}
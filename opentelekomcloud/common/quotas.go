@@ -0,0 +1,57 @@
+package common
+
+import (
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceQuota is the common quota format of the VPC, ELB and RDS APIs
+type ResourceQuota struct {
+	Type  string `json:"type"`
+	Used  int    `json:"used"`
+	Quota int    `json:"quota"`
+}
+
+// QuotaSchema returns the schema with `<name>` limit and `<name>_used` usage attributes for each quota name
+func QuotaSchema(names ...string) map[string]*schema.Schema {
+	quotaSchema := map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+	for _, name := range names {
+		quotaSchema[name] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+		quotaSchema[name+"_used"] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+	}
+	return quotaSchema
+}
+
+// SetQuota sets limit and usage of the quota
+func SetQuota(d *schema.ResourceData, name string, limit, used int) error {
+	mErr := multierror.Append(
+		d.Set(name, limit),
+		d.Set(name+"_used", used),
+	)
+	return mErr.ErrorOrNil()
+}
+
+// SetResourceQuotas sets limits and usage of the quotas, `names` maps API quota type to the attribute name
+func SetResourceQuotas(d *schema.ResourceData, quotas []ResourceQuota, names map[string]string) error {
+	mErr := &multierror.Error{}
+	for _, quota := range quotas {
+		name, ok := names[quota.Type]
+		if !ok {
+			continue
+		}
+		mErr = multierror.Append(mErr, SetQuota(d, name, quota.Quota, quota.Used))
+	}
+	return mErr.ErrorOrNil()
+}
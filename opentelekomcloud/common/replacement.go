@@ -0,0 +1,98 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// replacementDataChecks report if the resource holds any data, resources without data can be replaced safely
+var replacementDataChecks = map[string]func(*cfg.Config, *schema.ResourceDiff) (bool, error){
+	"opentelekomcloud_obs_bucket": obsBucketHasObjects,
+}
+
+// PreventReplacement extends CustomizeDiff of the resource to fail the plan replacing the resource,
+// when the provider is configured to protect the resource type
+func PreventReplacement(resourceType string, r *schema.Resource) {
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+		config, ok := meta.(*cfg.Config)
+		if !ok || d.Id() == "" || !config.IsReplacementProtected(resourceType) {
+			return nil
+		}
+		keys := replacementKeys(r.Schema, d)
+		if len(keys) == 0 {
+			return nil
+		}
+		if check, ok := replacementDataChecks[resourceType]; ok {
+			hasData, err := check(config, d)
+			if err != nil {
+				return err
+			}
+			if !hasData {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s %s is protected from replacement, but changes of %s require it.\n"+
+			"Revert the changes, or remove %s from `protected_resource_types` or disable `prevent_replacement` "+
+			"in the provider configuration to allow the replacement",
+			resourceType, d.Id(), strings.Join(keys, ", "), resourceType)
+	}
+}
+
+// replacementKeys returns changed attributes forcing the resource replacement
+func replacementKeys(resourceSchema map[string]*schema.Schema, d *schema.ResourceDiff) []string {
+	var keys []string
+	for key, s := range resourceSchema {
+		if s.ForceNew {
+			if d.HasChange(key) {
+				keys = append(keys, key)
+			}
+			continue
+		}
+		// nested attributes are checked for single-item blocks only
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok || s.Type != schema.TypeList || s.MaxItems != 1 {
+			continue
+		}
+		for subKey, subSchema := range elem.Schema {
+			path := fmt.Sprintf("%s.0.%s", key, subKey)
+			if subSchema.ForceNew && d.HasChange(path) {
+				keys = append(keys, path)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func obsBucketHasObjects(config *cfg.Config, d *schema.ResourceDiff) (bool, error) {
+	region := config.Region
+	if v, ok := d.Get("region").(string); ok && v != "" {
+		region = v
+	}
+	client, err := config.NewObjectStorageClient(region)
+	if err != nil {
+		return false, fmt.Errorf("error creating OpenTelekomCloud OBS client: %w", err)
+	}
+	bucket, _ := d.GetChange("bucket")
+	output, err := client.ListObjects(&obs.ListObjectsInput{
+		Bucket:        bucket.(string),
+		ListObjsInput: obs.ListObjsInput{MaxKeys: 1},
+	})
+	if err != nil {
+		return false, fmt.Errorf("error listing objects of OBS bucket %s: %w", bucket, err)
+	}
+	return len(output.Contents) > 0, nil
+}
@@ -78,6 +78,20 @@ func ExpandResourceTags(tagMap map[string]interface{}) []tags.ResourceTag {
 	return tagList
 }
 
+// ResourceTagsMatch checks that the resource has all the given tags
+func ResourceTagsMatch(client *golangsdk.ServiceClient, resourceType, id string, tagRaw map[string]interface{}) (bool, error) {
+	resourceTags, err := tags.Get(client, resourceType, id).Extract()
+	if err != nil {
+		return false, err
+	}
+	for _, tag := range ExpandResourceTags(tagRaw) {
+		if !Contains(resourceTags, tag) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func Contains(tagSlice []tags.ResourceTag, tag tags.ResourceTag) bool {
 	for _, v := range tagSlice {
 		if v == tag {
@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"log"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -159,11 +160,31 @@ func convertToStr(v interface{}) (string, error) {
 	return "", fmt.Errorf("can't convert to string")
 }
 
+// LogStateTransitions logs the changes of the state returned by the refresh function, together with
+// the time spent in the previous state. The errors of the refresh are logged as well.
+func LogStateTransitions(name string, refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	lastState := ""
+	since := time.Now()
+	return func() (interface{}, string, error) {
+		result, state, err := refresh()
+		if err != nil {
+			log.Printf("[WARN] %s: waiting failed in state %q: %s", name, lastState, err)
+			return result, state, err
+		}
+		if state != lastState {
+			log.Printf("[DEBUG] %s: state transition %q -> %q after %s", name, lastState, state, time.Since(since).Round(time.Second))
+			lastState = state
+			since = time.Now()
+		}
+		return result, state, err
+	}
+}
+
 func WaitToFinish(target, pending []string, timeout, interval time.Duration, f resource.StateRefreshFunc) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Target:     target,
 		Pending:    pending,
-		Refresh:    f,
+		Refresh:    LogStateTransitions("OpenTelekomCloud resource", f),
 		Timeout:    timeout,
 		Delay:      5 * time.Second,
 		MinTimeout: interval,
@@ -8,23 +8,36 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/antiddos"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/aom"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/apigw"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/apm"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/as"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/bms"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/cbr"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/cce"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/cdn"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ces"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/cloudtable"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/csbs"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/csms"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/css"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/cts"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dcs"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ddm"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dds"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/deh"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dis"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dms"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dns"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dws"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ecs"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/evs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/fw"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/gaussdb"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ges"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/iam"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ims"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/kms"
@@ -32,13 +45,16 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/mrs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/nat"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/obs"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/osm"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/rds"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/rts"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/s3"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/scm"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/sdrs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/sfs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/smn"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/swr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/tms"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vbs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpc"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpn"
@@ -224,206 +240,340 @@ func Provider() *schema.Provider {
 				Default:     1,
 				Description: common.Descriptions["max_retries"],
 			},
+			"service_parallelism": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: common.Descriptions["service_parallelism"],
+			},
+			"prevent_replacement": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_PREVENT_REPLACEMENT", false),
+				Description: common.Descriptions["prevent_replacement"],
+			},
+			"protected_resource_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: common.Descriptions["protected_resource_types"],
+			},
+			"cache_data_source_reads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_CACHE_DATA_SOURCE_READS", false),
+				Description: common.Descriptions["cache_data_source_reads"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"opentelekomcloud_antiddos_v1":                   antiddos.DataSourceAntiDdosV1(),
-			"opentelekomcloud_cce_cluster_v3":                cce.DataSourceCCEClusterV3(),
-			"opentelekomcloud_cce_node_ids_v3":               cce.DataSourceCceNodeIdsV3(),
-			"opentelekomcloud_cce_node_v3":                   cce.DataSourceCceNodesV3(),
-			"opentelekomcloud_compute_availability_zones_v2": ecs.DataSourceComputeAvailabilityZonesV2(),
-			"opentelekomcloud_compute_bms_flavors_v2":        bms.DataSourceBMSFlavorV2(),
-			"opentelekomcloud_compute_bms_keypairs_v2":       bms.DataSourceBMSKeyPairV2(),
-			"opentelekomcloud_compute_bms_nic_v2":            bms.DataSourceBMSNicV2(),
-			"opentelekomcloud_compute_bms_server_v2":         bms.DataSourceBMSServersV2(),
-			"opentelekomcloud_csbs_backup_v1":                csbs.DataSourceCSBSBackupV1(),
-			"opentelekomcloud_csbs_backup_policy_v1":         csbs.DataSourceCSBSBackupPolicyV1(),
-			"opentelekomcloud_css_flavor_v1":                 css.DataSourceCSSFlavorV1(),
-			"opentelekomcloud_cts_tracker_v1":                cts.DataSourceCTSTrackerV1(),
-			"opentelekomcloud_dcs_az_v1":                     dcs.DataSourceDcsAZV1(),
-			"opentelekomcloud_dcs_maintainwindow_v1":         dcs.DataSourceDcsMaintainWindowV1(),
-			"opentelekomcloud_dcs_product_v1":                dcs.DataSourceDcsProductV1(),
-			"opentelekomcloud_deh_host_v1":                   deh.DataSourceDEHHostV1(),
-			"opentelekomcloud_deh_server_v1":                 deh.DataSourceDEHServersV1(),
-			"opentelekomcloud_dds_flavors_v3":                dds.DataSourceDdsFlavorV3(),
-			"opentelekomcloud_dds_instance_v3":               dds.DataSourceDdsInstanceV3(),
-			"opentelekomcloud_dms_az_v1":                     dms.DataSourceDmsAZV1(),
-			"opentelekomcloud_dms_product_v1":                dms.DataSourceDmsProductV1(),
-			"opentelekomcloud_dms_maintainwindow_v1":         dms.DataSourceDmsMaintainWindowV1(),
-			"opentelekomcloud_dns_zone_v2":                   dns.DataSourceDNSZoneV2(),
-			"opentelekomcloud_identity_auth_scope_v3":        iam.DataSourceIdentityAuthScopeV3(),
-			"opentelekomcloud_identity_credential_v3":        iam.DataSourceIdentityCredentialV3(),
-			"opentelekomcloud_identity_group_v3":             iam.DataSourceIdentityGroupV3(),
-			"opentelekomcloud_identity_project_v3":           iam.DataSourceIdentityProjectV3(),
-			"opentelekomcloud_identity_role_v3":              iam.DataSourceIdentityRoleV3(),
-			"opentelekomcloud_identity_user_v3":              iam.DataSourceIdentityUserV3(),
-			"opentelekomcloud_images_image_v2":               ims.DataSourceImagesImageV2(),
-			"opentelekomcloud_kms_key_v1":                    kms.DataSourceKmsKeyV1(),
-			"opentelekomcloud_kms_data_key_v1":               kms.DataSourceKmsDataKeyV1(),
-			"opentelekomcloud_networking_network_v2":         vpc.DataSourceNetworkingNetworkV2(),
-			"opentelekomcloud_networking_port_v2":            vpc.DataSourceNetworkingPortV2(),
-			"opentelekomcloud_networking_secgroup_v2":        vpc.DataSourceNetworkingSecGroupV2(),
-			"opentelekomcloud_obs_bucket_object":             obs.DataSourceObsBucketObject(),
-			"opentelekomcloud_rds_flavors_v1":                rds.DataSourceRdsFlavorV1(),
-			"opentelekomcloud_rds_flavors_v3":                rds.DataSourceRdsFlavorV3(),
-			"opentelekomcloud_rds_versions_v3":               rds.DataSourceRdsVersionsV3(),
-			"opentelekomcloud_rts_software_deployment_v1":    rts.DataSourceRtsSoftwareDeploymentV1(),
-			"opentelekomcloud_rts_software_config_v1":        rts.DataSourceRtsSoftwareConfigV1(),
-			"opentelekomcloud_rts_stack_resource_v1":         rts.DataSourceRTSStackResourcesV1(),
-			"opentelekomcloud_rts_stack_v1":                  rts.DataSourceRTSStackV1(),
-			"opentelekomcloud_s3_bucket_object":              s3.DataSourceS3BucketObject(),
-			"opentelekomcloud_sfs_file_system_v2":            sfs.DataSourceSFSFileSystemV2(),
-			"opentelekomcloud_sdrs_domain_v1":                sdrs.DataSourceSdrsDomainV1(),
-			"opentelekomcloud_vpc_eip_v1":                    vpc.DataSourceVPCEipV1(),
-			"opentelekomcloud_vpc_v1":                        vpc.DataSourceVirtualPrivateCloudVpcV1(),
-			"opentelekomcloud_vpc_bandwidth":                 vpc.DataSourceBandWidth(),
-			"opentelekomcloud_vbs_backup_v2":                 vbs.DataSourceVBSBackupV2(),
-			"opentelekomcloud_vbs_backup_policy_v2":          vbs.DataSourceVBSBackupPolicyV2(),
-			"opentelekomcloud_vpc_peering_connection_v2":     vpc.DataSourceVpcPeeringConnectionV2(),
-			"opentelekomcloud_vpc_route_v2":                  vpc.DataSourceVPCRouteV2(),
-			"opentelekomcloud_vpc_route_ids_v2":              vpc.DataSourceVPCRouteIdsV2(),
-			"opentelekomcloud_vpc_subnet_v1":                 vpc.DataSourceVpcSubnetV1(),
-			"opentelekomcloud_vpc_subnet_ids_v1":             vpc.DataSourceVpcSubnetIdsV1(),
-			"opentelekomcloud_vpnaas_service_v2":             vpn.DataSourceVpnServiceV2(),
+			"opentelekomcloud_antiddos_v1":                        antiddos.DataSourceAntiDdosV1(),
+			"opentelekomcloud_as_instances":                       as.DataSourceASInstances(),
+			"opentelekomcloud_blockstorage_quotas_v2":             evs.DataSourceBlockStorageQuotasV2(),
+			"opentelekomcloud_cce_cluster_v3":                     cce.DataSourceCCEClusterV3(),
+			"opentelekomcloud_cce_node_ids_v3":                    cce.DataSourceCceNodeIdsV3(),
+			"opentelekomcloud_cce_node_v3":                        cce.DataSourceCceNodesV3(),
+			"opentelekomcloud_compute_availability_zones_v2":      ecs.DataSourceComputeAvailabilityZonesV2(),
+			"opentelekomcloud_compute_bms_flavors_v2":             bms.DataSourceBMSFlavorV2(),
+			"opentelekomcloud_compute_bms_keypairs_v2":            bms.DataSourceBMSKeyPairV2(),
+			"opentelekomcloud_compute_bms_nic_v2":                 bms.DataSourceBMSNicV2(),
+			"opentelekomcloud_compute_bms_remote_console_v2":      bms.DataSourceBMSRemoteConsoleV2(),
+			"opentelekomcloud_compute_bms_server_v2":              bms.DataSourceBMSServersV2(),
+			"opentelekomcloud_compute_flavor_v2":                  ecs.DataSourceComputeFlavorV2(),
+			"opentelekomcloud_compute_flavors_v2":                 ecs.DataSourceComputeFlavorsV2(),
+			"opentelekomcloud_compute_instance_v2":                ecs.DataSourceComputeInstanceV2(),
+			"opentelekomcloud_compute_quotas_v2":                  ecs.DataSourceComputeQuotasV2(),
+			"opentelekomcloud_csbs_backup_v1":                     csbs.DataSourceCSBSBackupV1(),
+			"opentelekomcloud_csbs_backup_policy_v1":              csbs.DataSourceCSBSBackupPolicyV1(),
+			"opentelekomcloud_css_flavor_v1":                      css.DataSourceCSSFlavorV1(),
+			"opentelekomcloud_cts_tracker_v1":                     cts.DataSourceCTSTrackerV1(),
+			"opentelekomcloud_cts_unmanaged_resources_v1":         cts.DataSourceCTSUnmanagedResourcesV1(),
+			"opentelekomcloud_csms_secret_version_v1":             csms.DataSourceCsmsSecretVersionV1(),
+			"opentelekomcloud_dcs_az_v1":                          dcs.DataSourceDcsAZV1(),
+			"opentelekomcloud_dcs_maintainwindow_v1":              dcs.DataSourceDcsMaintainWindowV1(),
+			"opentelekomcloud_dcs_product_v1":                     dcs.DataSourceDcsProductV1(),
+			"opentelekomcloud_dcs_instances_v1":                   dcs.DataSourceDcsInstancesV1(),
+			"opentelekomcloud_deh_host_v1":                        deh.DataSourceDEHHostV1(),
+			"opentelekomcloud_deh_host_types_v1":                  deh.DataSourceDEHHostTypesV1(),
+			"opentelekomcloud_deh_server_v1":                      deh.DataSourceDEHServersV1(),
+			"opentelekomcloud_dds_flavors_v3":                     dds.DataSourceDdsFlavorV3(),
+			"opentelekomcloud_dds_instance_v3":                    dds.DataSourceDdsInstanceV3(),
+			"opentelekomcloud_dis_app_v2":                         dis.DataSourceDisAppV2(),
+			"opentelekomcloud_dis_checkpoint_v2":                  dis.DataSourceDisCheckpointV2(),
+			"opentelekomcloud_dms_az_v1":                          dms.DataSourceDmsAZV1(),
+			"opentelekomcloud_dms_product_v1":                     dms.DataSourceDmsProductV1(),
+			"opentelekomcloud_dms_maintainwindow_v1":              dms.DataSourceDmsMaintainWindowV1(),
+			"opentelekomcloud_dms_instances_v1":                   dms.DataSourceDmsInstancesV1(),
+			"opentelekomcloud_dns_zone_v2":                        dns.DataSourceDNSZoneV2(),
+			"opentelekomcloud_gaussdb_opengauss_flavors_v3":       gaussdb.DataSourceGaussdbOpenGaussFlavorsV3(),
+			"opentelekomcloud_ges_flavors":                        ges.DataSourceGesFlavors(),
+			"opentelekomcloud_elb_quotas":                         elb.DataSourceELBQuotas(),
+			"opentelekomcloud_identity_auth_scope_v3":             iam.DataSourceIdentityAuthScopeV3(),
+			"opentelekomcloud_identity_credential_v3":             iam.DataSourceIdentityCredentialV3(),
+			"opentelekomcloud_identity_group_v3":                  iam.DataSourceIdentityGroupV3(),
+			"opentelekomcloud_identity_project_v3":                iam.DataSourceIdentityProjectV3(),
+			"opentelekomcloud_identity_role_v3":                   iam.DataSourceIdentityRoleV3(),
+			"opentelekomcloud_identity_user_v3":                   iam.DataSourceIdentityUserV3(),
+			"opentelekomcloud_images_image_v2":                    ims.DataSourceImagesImageV2(),
+			"opentelekomcloud_images_images":                      ims.DataSourceImagesImages(),
+			"opentelekomcloud_images_shared_images_v2":            ims.DataSourceImagesSharedImagesV2(),
+			"opentelekomcloud_kms_key_v1":                         kms.DataSourceKmsKeyV1(),
+			"opentelekomcloud_kms_data_key_v1":                    kms.DataSourceKmsDataKeyV1(),
+			"opentelekomcloud_kms_import_parameters_v1":           kms.DataSourceKmsImportParametersV1(),
+			"opentelekomcloud_nat_gateways":                       nat.DataSourceNatGateways(),
+			"opentelekomcloud_networking_network_v2":              vpc.DataSourceNetworkingNetworkV2(),
+			"opentelekomcloud_networking_port_v2":                 vpc.DataSourceNetworkingPortV2(),
+			"opentelekomcloud_networking_secgroup_v2":             vpc.DataSourceNetworkingSecGroupV2(),
+			"opentelekomcloud_obs_bucket_object":                  obs.DataSourceObsBucketObject(),
+			"opentelekomcloud_obs_presigned_url":                  obs.DataSourceObsPresignedUrl(),
+			"opentelekomcloud_rds_flavors_v1":                     rds.DataSourceRdsFlavorV1(),
+			"opentelekomcloud_rds_flavors_v3":                     rds.DataSourceRdsFlavorV3(),
+			"opentelekomcloud_rds_versions_v3":                    rds.DataSourceRdsVersionsV3(),
+			"opentelekomcloud_rds_instances_v3":                   rds.DataSourceRdsInstancesV3(),
+			"opentelekomcloud_rds_quotas_v3":                      rds.DataSourceRdsQuotasV3(),
+			"opentelekomcloud_rts_software_deployment_v1":         rts.DataSourceRtsSoftwareDeploymentV1(),
+			"opentelekomcloud_rts_software_config_v1":             rts.DataSourceRtsSoftwareConfigV1(),
+			"opentelekomcloud_rts_stack_resource_v1":              rts.DataSourceRTSStackResourcesV1(),
+			"opentelekomcloud_rts_stack_v1":                       rts.DataSourceRTSStackV1(),
+			"opentelekomcloud_s3_bucket_object":                   s3.DataSourceS3BucketObject(),
+			"opentelekomcloud_scm_certificate_v3":                 scm.DataSourceScmCertificateV3(),
+			"opentelekomcloud_sfs_file_system_v2":                 sfs.DataSourceSFSFileSystemV2(),
+			"opentelekomcloud_sdrs_domain_v1":                     sdrs.DataSourceSdrsDomainV1(),
+			"opentelekomcloud_tms_resource_instances":             tms.DataSourceTmsResourceInstances(),
+			"opentelekomcloud_vpc_eip_v1":                         vpc.DataSourceVPCEipV1(),
+			"opentelekomcloud_vpc_eip_pool_v3":                    vpc.DataSourceVpcEipPoolV3(),
+			"opentelekomcloud_eips":                               vpc.DataSourceEips(),
+			"opentelekomcloud_vpc_v1":                             vpc.DataSourceVirtualPrivateCloudVpcV1(),
+			"opentelekomcloud_vpcs":                               vpc.DataSourceVpcs(),
+			"opentelekomcloud_vpc_bandwidth":                      vpc.DataSourceBandWidth(),
+			"opentelekomcloud_vbs_backup_v2":                      vbs.DataSourceVBSBackupV2(),
+			"opentelekomcloud_vbs_backup_policy_v2":               vbs.DataSourceVBSBackupPolicyV2(),
+			"opentelekomcloud_vpc_peering_connection_v2":          vpc.DataSourceVpcPeeringConnectionV2(),
+			"opentelekomcloud_vpc_peering_connection_requests_v2": vpc.DataSourceVpcPeeringConnectionRequestsV2(),
+			"opentelekomcloud_vpc_quotas_v1":                      vpc.DataSourceVpcQuotasV1(),
+			"opentelekomcloud_vpc_route_v2":                       vpc.DataSourceVPCRouteV2(),
+			"opentelekomcloud_vpc_route_ids_v2":                   vpc.DataSourceVPCRouteIdsV2(),
+			"opentelekomcloud_vpc_subnet_v1":                      vpc.DataSourceVpcSubnetV1(),
+			"opentelekomcloud_vpc_subnet_ids_v1":                  vpc.DataSourceVpcSubnetIdsV1(),
+			"opentelekomcloud_subnets":                            vpc.DataSourceSubnets(),
+			"opentelekomcloud_vpnaas_service_v2":                  vpn.DataSourceVpnServiceV2(),
+			"opentelekomcloud_waf_certificate_v1":                 waf.DataSourceWafCertificateV1(),
+			"opentelekomcloud_waf_web_stack_check_v1":             waf.DataSourceWafWebStackCheckV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"opentelekomcloud_antiddos_v1":                        antiddos.ResourceAntiDdosV1(),
-			"opentelekomcloud_as_configuration_v1":                as.ResourceASConfiguration(),
-			"opentelekomcloud_as_group_v1":                        as.ResourceASGroup(),
-			"opentelekomcloud_as_policy_v1":                       as.ResourceASPolicy(),
-			"opentelekomcloud_blockstorage_volume_v2":             evs.ResourceBlockStorageVolumeV2(),
-			"opentelekomcloud_cbr_policy_v3":                      cbr.ResourceCBRPolicyV3(),
-			"opentelekomcloud_cbr_vault_v3":                       cbr.ResourceCBRVaultV3(),
-			"opentelekomcloud_cce_addon_v3":                       cce.ResourceCCEAddonV3(),
-			"opentelekomcloud_cce_cluster_v3":                     cce.ResourceCCEClusterV3(),
-			"opentelekomcloud_cce_node_v3":                        cce.ResourceCCENodeV3(),
-			"opentelekomcloud_cce_node_pool_v3":                   cce.ResourceCCENodePoolV3(),
-			"opentelekomcloud_ces_alarmrule":                      ces.ResourceAlarmRule(),
-			"opentelekomcloud_compute_bms_server_v2":              bms.ResourceComputeBMSInstanceV2(),
-			"opentelekomcloud_compute_bms_tags_v2":                bms.ResourceBMSTagsV2(),
-			"opentelekomcloud_compute_secgroup_v2":                ecs.ResourceComputeSecGroupV2(),
-			"opentelekomcloud_compute_servergroup_v2":             ecs.ResourceComputeServerGroupV2(),
-			"opentelekomcloud_compute_floatingip_v2":              ecs.ResourceComputeFloatingIPV2(),
-			"opentelekomcloud_compute_floatingip_associate_v2":    ecs.ResourceComputeFloatingIPAssociateV2(),
-			"opentelekomcloud_compute_instance_v2":                ecs.ResourceComputeInstanceV2(),
-			"opentelekomcloud_compute_keypair_v2":                 ecs.ResourceComputeKeypairV2(),
-			"opentelekomcloud_compute_volume_attach_v2":           ecs.ResourceComputeVolumeAttachV2(),
-			"opentelekomcloud_csbs_backup_v1":                     csbs.ResourceCSBSBackupV1(),
-			"opentelekomcloud_csbs_backup_policy_v1":              csbs.ResourceCSBSBackupPolicyV1(),
-			"opentelekomcloud_cts_tracker_v1":                     cts.ResourceCTSTrackerV1(),
-			"opentelekomcloud_css_cluster_v1":                     css.ResourceCssClusterV1(),
-			"opentelekomcloud_dcs_instance_v1":                    dcs.ResourceDcsInstanceV1(),
-			"opentelekomcloud_dds_instance_v3":                    dds.ResourceDdsInstanceV3(),
-			"opentelekomcloud_deh_host_v1":                        deh.ResourceDeHHostV1(),
-			"opentelekomcloud_dns_ptrrecord_v2":                   dns.ResourceDNSPtrRecordV2(),
-			"opentelekomcloud_dns_recordset_v2":                   dns.ResourceDNSRecordSetV2(),
-			"opentelekomcloud_dns_zone_v2":                        dns.ResourceDNSZoneV2(),
-			"opentelekomcloud_dms_group_v1":                       dms.ResourceDmsGroupsV1(),
-			"opentelekomcloud_dms_instance_v1":                    dms.ResourceDmsInstancesV1(),
-			"opentelekomcloud_dms_queue_v1":                       dms.ResourceDmsQueuesV1(),
-			"opentelekomcloud_ecs_instance_v1":                    ecs.ResourceEcsInstanceV1(),
-			"opentelekomcloud_elb_backend":                        elb.ResourceBackend(),
-			"opentelekomcloud_elb_health":                         elb.ResourceHealth(),
-			"opentelekomcloud_elb_loadbalancer":                   elb.ResourceELoadBalancer(),
-			"opentelekomcloud_elb_listener":                       elb.ResourceEListener(),
-			"opentelekomcloud_evs_volume_v3":                      evs.ResourceEvsStorageVolumeV3(),
-			"opentelekomcloud_fw_firewall_group_v2":               fw.ResourceFWFirewallGroupV2(),
-			"opentelekomcloud_fw_policy_v2":                       fw.ResourceFWPolicyV2(),
-			"opentelekomcloud_fw_rule_v2":                         fw.ResourceFWRuleV2(),
-			"opentelekomcloud_identity_agency_v3":                 iam.ResourceIdentityAgencyV3(),
-			"opentelekomcloud_identity_credential_v3":             iam.ResourceIdentityCredentialV3(),
-			"opentelekomcloud_identity_group_v3":                  iam.ResourceIdentityGroupV3(),
-			"opentelekomcloud_identity_group_membership_v3":       iam.ResourceIdentityGroupMembershipV3(),
-			"opentelekomcloud_identity_mapping_v3":                iam.ResourceIdentityMappingV3(),
-			"opentelekomcloud_identity_project_v3":                iam.ResourceIdentityProjectV3(),
-			"opentelekomcloud_identity_provider_v3":               iam.ResourceIdentityProviderV3(),
-			"opentelekomcloud_identity_role_v3":                   iam.ResourceIdentityRoleV3(),
-			"opentelekomcloud_identity_role_assignment_v3":        iam.ResourceIdentityRoleAssignmentV3(),
-			"opentelekomcloud_identity_user_v3":                   iam.ResourceIdentityUserV3(),
-			"opentelekomcloud_images_image_v2":                    ims.ResourceImagesImageV2(),
-			"opentelekomcloud_ims_data_image_v2":                  ims.ResourceImsDataImageV2(),
-			"opentelekomcloud_ims_image_v2":                       ims.ResourceImsImageV2(),
-			"opentelekomcloud_kms_key_v1":                         kms.ResourceKmsKeyV1(),
-			"opentelekomcloud_lb_certificate_v2":                  elb.ResourceCertificateV2(),
-			"opentelekomcloud_lb_l7policy_v2":                     elb.ResourceL7PolicyV2(),
-			"opentelekomcloud_lb_l7rule_v2":                       elb.ResourceL7RuleV2(),
-			"opentelekomcloud_lb_loadbalancer_v2":                 elb.ResourceLoadBalancerV2(),
-			"opentelekomcloud_lb_listener_v2":                     elb.ResourceListenerV2(),
-			"opentelekomcloud_lb_member_v2":                       elb.ResourceMemberV2(),
-			"opentelekomcloud_lb_monitor_v2":                      elb.ResourceMonitorV2(),
-			"opentelekomcloud_lb_pool_v2":                         elb.ResourceLBPoolV2(),
-			"opentelekomcloud_lb_whitelist_v2":                    elb.ResourceWhitelistV2(),
-			"opentelekomcloud_logtank_group_v2":                   lts.ResourceLTSGroupV2(),
-			"opentelekomcloud_logtank_topic_v2":                   lts.ResourceLTSTopicV2(),
-			"opentelekomcloud_mrs_cluster_v1":                     mrs.ResourceMRSClusterV1(),
-			"opentelekomcloud_mrs_job_v1":                         mrs.ResourceMRSJobV1(),
-			"opentelekomcloud_nat_gateway_v2":                     nat.ResourceNatGatewayV2(),
-			"opentelekomcloud_nat_dnat_rule_v2":                   nat.ResourceNatDnatRuleV2(),
-			"opentelekomcloud_nat_snat_rule_v2":                   nat.ResourceNatSnatRuleV2(),
-			"opentelekomcloud_networking_floatingip_v2":           vpc.ResourceNetworkingFloatingIPV2(),
-			"opentelekomcloud_networking_floatingip_associate_v2": vpc.ResourceNetworkingFloatingIPAssociateV2(),
-			"opentelekomcloud_networking_network_v2":              vpc.ResourceNetworkingNetworkV2(),
-			"opentelekomcloud_networking_port_v2":                 vpc.ResourceNetworkingPortV2(),
-			"opentelekomcloud_networking_router_v2":               vpc.ResourceNetworkingRouterV2(),
-			"opentelekomcloud_networking_router_interface_v2":     vpc.ResourceNetworkingRouterInterfaceV2(),
-			"opentelekomcloud_networking_router_route_v2":         vpc.ResourceNetworkingRouterRouteV2(),
-			"opentelekomcloud_networking_secgroup_v2":             vpc.ResourceNetworkingSecGroupV2(),
-			"opentelekomcloud_networking_secgroup_rule_v2":        vpc.ResourceNetworkingSecGroupRuleV2(),
-			"opentelekomcloud_networking_subnet_v2":               vpc.ResourceNetworkingSubnetV2(),
-			"opentelekomcloud_networking_vip_v2":                  vpc.ResourceNetworkingVIPV2(),
-			"opentelekomcloud_networking_vip_associate_v2":        vpc.ResourceNetworkingVIPAssociateV2(),
-			"opentelekomcloud_obs_bucket":                         obs.ResourceObsBucket(),
-			"opentelekomcloud_obs_bucket_object":                  obs.ResourceObsBucketObject(),
-			"opentelekomcloud_obs_bucket_policy":                  obs.ResourceObsBucketPolicy(),
-			"opentelekomcloud_rds_instance_v1":                    rds.ResourceRdsInstance(),
-			"opentelekomcloud_rds_instance_v3":                    rds.ResourceRdsInstanceV3(),
-			"opentelekomcloud_rds_parametergroup_v3":              rds.ResourceRdsConfigurationV3(),
-			"opentelekomcloud_rds_read_replica_v3":                rds.ResourceRdsReadReplicaV3(),
-			"opentelekomcloud_rts_software_deployment_v1":         rts.ResourceRtsSoftwareDeploymentV1(),
-			"opentelekomcloud_rts_software_config_v1":             rts.ResourceSoftwareConfigV1(),
-			"opentelekomcloud_rts_stack_v1":                       rts.ResourceRTSStackV1(),
-			"opentelekomcloud_s3_bucket":                          s3.ResourceS3Bucket(),
-			"opentelekomcloud_s3_bucket_policy":                   s3.ResourceS3BucketPolicy(),
-			"opentelekomcloud_s3_bucket_object":                   s3.ResourceS3BucketObject(),
-			"opentelekomcloud_sfs_file_system_v2":                 sfs.ResourceSFSFileSystemV2(),
-			"opentelekomcloud_sfs_share_access_rules_v2":          sfs.ResourceSFSShareAccessRulesV2(),
-			"opentelekomcloud_sfs_turbo_share_v1":                 sfs.ResourceSFSTurboShareV1(),
-			"opentelekomcloud_smn_topic_v2":                       smn.ResourceTopic(),
-			"opentelekomcloud_smn_subscription_v2":                smn.ResourceSubscription(),
-			"opentelekomcloud_swr_domain_v2":                      swr.ResourceSwrDomainV2(),
-			"opentelekomcloud_swr_organization_permissions_v2":    swr.ResourceSwrOrganizationPermissionsV2(),
-			"opentelekomcloud_swr_organization_v2":                swr.ResourceSwrOrganizationV2(),
-			"opentelekomcloud_swr_repository_v2":                  swr.ResourceSwrRepositoryV2(),
-			"opentelekomcloud_vpc_eip_v1":                         vpc.ResourceVpcEIPV1(),
-			"opentelekomcloud_vpc_v1":                             vpc.ResourceVirtualPrivateCloudV1(),
-			"opentelekomcloud_vpc_peering_connection_v2":          vpc.ResourceVpcPeeringConnectionV2(),
-			"opentelekomcloud_vpc_peering_connection_accepter_v2": vpc.ResourceVpcPeeringConnectionAccepterV2(),
-			"opentelekomcloud_vpc_route_v2":                       vpc.ResourceVPCRouteV2(),
-			"opentelekomcloud_vpc_subnet_v1":                      vpc.ResourceVpcSubnetV1(),
-			"opentelekomcloud_vpc_flow_log_v1":                    vpc.ResourceVpcFlowLogV1(),
-			"opentelekomcloud_vbs_backup_policy_v2":               vbs.ResourceVBSBackupPolicyV2(),
-			"opentelekomcloud_vbs_backup_v2":                      vbs.ResourceVBSBackupV2(),
-			"opentelekomcloud_vbs_backup_share_v2":                vbs.ResourceVBSBackupShareV2(),
-			"opentelekomcloud_sdrs_protected_instance_v1":         sdrs.ResourceSdrsProtectedInstanceV1(),
-			"opentelekomcloud_sdrs_protectiongroup_v1":            sdrs.ResourceSdrsProtectiongroupV1(),
-			"opentelekomcloud_vpnaas_ipsec_policy_v2":             vpn.ResourceVpnIPSecPolicyV2(),
-			"opentelekomcloud_vpnaas_service_v2":                  vpn.ResourceVpnServiceV2(),
-			"opentelekomcloud_vpnaas_ike_policy_v2":               vpn.ResourceVpnIKEPolicyV2(),
-			"opentelekomcloud_vpnaas_endpoint_group_v2":           vpn.ResourceVpnEndpointGroupV2(),
-			"opentelekomcloud_vpnaas_site_connection_v2":          vpn.ResourceVpnSiteConnectionV2(),
-			"opentelekomcloud_waf_certificate_v1":                 waf.ResourceWafCertificateV1(),
-			"opentelekomcloud_waf_domain_v1":                      waf.ResourceWafDomainV1(),
-			"opentelekomcloud_waf_policy_v1":                      waf.ResourceWafPolicyV1(),
-			"opentelekomcloud_waf_whiteblackip_rule_v1":           waf.ResourceWafWhiteBlackIpRuleV1(),
-			"opentelekomcloud_waf_datamasking_rule_v1":            waf.ResourceWafDataMaskingRuleV1(),
-			"opentelekomcloud_waf_falsealarmmasking_rule_v1":      waf.ResourceWafFalseAlarmMaskingRuleV1(),
-			"opentelekomcloud_waf_ccattackprotection_rule_v1":     waf.ResourceWafCcAttackProtectionRuleV1(),
-			"opentelekomcloud_waf_preciseprotection_rule_v1":      waf.ResourceWafPreciseProtectionRuleV1(),
-			"opentelekomcloud_waf_webtamperprotection_rule_v1":    waf.ResourceWafWebTamperProtectionRuleV1(),
+			"opentelekomcloud_antiddos_v1":                            antiddos.ResourceAntiDdosV1(),
+			"opentelekomcloud_aom_alarm_action_rule":                  aom.ResourceAOMAlarmActionRule(),
+			"opentelekomcloud_aom_alarm_rule":                         aom.ResourceAOMAlarmRule(),
+			"opentelekomcloud_aom_prometheus_instance":                aom.ResourceAOMPrometheusInstance(),
+			"opentelekomcloud_apm_application":                        apm.ResourceAPMApplication(),
+			"opentelekomcloud_apm_environment":                        apm.ResourceAPMEnvironment(),
+			"opentelekomcloud_apm_access_key":                         apm.ResourceAPMAccessKey(),
+			"opentelekomcloud_aom_silence_policy":                     aom.ResourceAOMSilencePolicy(),
+			"opentelekomcloud_apigw_environment_v1":                   apigw.ResourceAPIGWEnvironmentV1(),
+			"opentelekomcloud_apigw_environment_variable_v1":          apigw.ResourceAPIGWEnvironmentVariableV1(),
+			"opentelekomcloud_as_configuration_v1":                    as.ResourceASConfiguration(),
+			"opentelekomcloud_as_group_v1":                            as.ResourceASGroup(),
+			"opentelekomcloud_as_policy_v1":                           as.ResourceASPolicy(),
+			"opentelekomcloud_as_planned_task":                        as.ResourceASPlannedTask(),
+			"opentelekomcloud_as_bandwidth_policy":                    as.ResourceASBandwidthPolicy(),
+			"opentelekomcloud_blockstorage_volume_v2":                 evs.ResourceBlockStorageVolumeV2(),
+			"opentelekomcloud_cbr_policy_v3":                          cbr.ResourceCBRPolicyV3(),
+			"opentelekomcloud_cbr_vault_v3":                           cbr.ResourceCBRVaultV3(),
+			"opentelekomcloud_cbr_restore":                            cbr.ResourceCBRRestore(),
+			"opentelekomcloud_cce_addon_v3":                           cce.ResourceCCEAddonV3(),
+			"opentelekomcloud_cce_cluster_v3":                         cce.ResourceCCEClusterV3(),
+			"opentelekomcloud_cce_node_v3":                            cce.ResourceCCENodeV3(),
+			"opentelekomcloud_cce_node_pool_v3":                       cce.ResourceCCENodePoolV3(),
+			"opentelekomcloud_cce_namespace_v1":                       cce.ResourceCCENamespaceV1(),
+			"opentelekomcloud_cce_resource_quota_v1":                  cce.ResourceCCEResourceQuotaV1(),
+			"opentelekomcloud_ces_alarmrule":                          ces.ResourceAlarmRule(),
+			"opentelekomcloud_ces_custom_metric_v1":                   ces.ResourceCustomMetricV1(),
+			"opentelekomcloud_compute_bms_server_v2":                  bms.ResourceComputeBMSInstanceV2(),
+			"opentelekomcloud_compute_bms_tags_v2":                    bms.ResourceBMSTagsV2(),
+			"opentelekomcloud_compute_secgroup_v2":                    ecs.ResourceComputeSecGroupV2(),
+			"opentelekomcloud_compute_servergroup_v2":                 ecs.ResourceComputeServerGroupV2(),
+			"opentelekomcloud_compute_floatingip_v2":                  ecs.ResourceComputeFloatingIPV2(),
+			"opentelekomcloud_compute_floatingip_associate_v2":        ecs.ResourceComputeFloatingIPAssociateV2(),
+			"opentelekomcloud_compute_instance_v2":                    ecs.ResourceComputeInstanceV2(),
+			"opentelekomcloud_compute_keypair_v2":                     ecs.ResourceComputeKeypairV2(),
+			"opentelekomcloud_compute_volume_attach_v2":               ecs.ResourceComputeVolumeAttachV2(),
+			"opentelekomcloud_csbs_backup_v1":                         csbs.ResourceCSBSBackupV1(),
+			"opentelekomcloud_csbs_backup_policy_v1":                  csbs.ResourceCSBSBackupPolicyV1(),
+			"opentelekomcloud_cts_tracker_v1":                         cts.ResourceCTSTrackerV1(),
+			"opentelekomcloud_cts_event_notification_v3":              cts.ResourceCTSEventNotificationV3(),
+			"opentelekomcloud_css_cluster_v1":                         css.ResourceCssClusterV1(),
+			"opentelekomcloud_cdn_access_control_v1":                  cdn.ResourceCdnAccessControlV1(),
+			"opentelekomcloud_cdn_cache_rules_v1":                     cdn.ResourceCdnCacheRulesV1(),
+			"opentelekomcloud_cdn_domain_v1":                          cdn.ResourceCdnDomainV1(),
+			"opentelekomcloud_cdn_preheat_task_v1":                    cdn.ResourceCdnPreheatTaskV1(),
+			"opentelekomcloud_cdn_refresh_task_v1":                    cdn.ResourceCdnRefreshTaskV1(),
+			"opentelekomcloud_cloudtable_cluster_v2":                  cloudtable.ResourceCloudTableClusterV2(),
+			"opentelekomcloud_csms_event_v1":                          csms.ResourceCsmsEventV1(),
+			"opentelekomcloud_csms_secret_v1":                         csms.ResourceCsmsSecretV1(),
+			"opentelekomcloud_csms_secret_version_v1":                 csms.ResourceCsmsSecretVersionV1(),
+			"opentelekomcloud_dcs_instance_v1":                        dcs.ResourceDcsInstanceV1(),
+			"opentelekomcloud_ddm_account_v1":                         ddm.ResourceDdmAccountV1(),
+			"opentelekomcloud_ddm_instance_v1":                        ddm.ResourceDdmInstanceV1(),
+			"opentelekomcloud_ddm_schema_v1":                          ddm.ResourceDdmSchemaV1(),
+			"opentelekomcloud_dds_instance_v3":                        dds.ResourceDdsInstanceV3(),
+			"opentelekomcloud_dws_logical_cluster_v2":                 dws.ResourceDwsLogicalClusterV2(),
+			"opentelekomcloud_dws_parameters_v1":                      dws.ResourceDwsParametersV1(),
+			"opentelekomcloud_dws_snapshot_policy_v1":                 dws.ResourceDwsSnapshotPolicyV1(),
+			"opentelekomcloud_dws_workload_queue_v2":                  dws.ResourceDwsWorkloadQueueV2(),
+			"opentelekomcloud_deh_host_v1":                            deh.ResourceDeHHostV1(),
+			"opentelekomcloud_dis_dump_task_v2":                       dis.ResourceDisDumpTaskV2(),
+			"opentelekomcloud_dis_stream_v2":                          dis.ResourceDisStreamV2(),
+			"opentelekomcloud_dns_ptrrecord_v2":                       dns.ResourceDNSPtrRecordV2(),
+			"opentelekomcloud_dns_recordset_v2":                       dns.ResourceDNSRecordSetV2(),
+			"opentelekomcloud_dns_zone_v2":                            dns.ResourceDNSZoneV2(),
+			"opentelekomcloud_dns_endpoint":                           dns.ResourceDNSEndpoint(),
+			"opentelekomcloud_dns_resolver_rule":                      dns.ResourceDNSResolverRule(),
+			"opentelekomcloud_eg_channel_v1":                          eg.ResourceEgChannelV1(),
+			"opentelekomcloud_eg_subscription_v1":                     eg.ResourceEgSubscriptionV1(),
+			"opentelekomcloud_gaussdb_opengauss_instance_v3":          gaussdb.ResourceGaussdbOpenGaussInstanceV3(),
+			"opentelekomcloud_ges_graph":                              ges.ResourceGesGraph(),
+			"opentelekomcloud_ges_backup":                             ges.ResourceGesBackup(),
+			"opentelekomcloud_dms_group_v1":                           dms.ResourceDmsGroupsV1(),
+			"opentelekomcloud_dms_instance_v1":                        dms.ResourceDmsInstancesV1(),
+			"opentelekomcloud_dms_queue_v1":                           dms.ResourceDmsQueuesV1(),
+			"opentelekomcloud_dms_rabbitmq_instance_v2":               dms.ResourceDmsRabbitMQInstanceV2(),
+			"opentelekomcloud_dms_rabbitmq_plugin_v2":                 dms.ResourceDmsRabbitMQPluginV2(),
+			"opentelekomcloud_dms_user_v2":                            dms.ResourceDmsUserV2(),
+			"opentelekomcloud_dms_user_permission_v1":                 dms.ResourceDmsUserPermissionV1(),
+			"opentelekomcloud_dms_consumer_group_v2":                  dms.ResourceDmsConsumerGroupV2(),
+			"opentelekomcloud_dms_smart_connect_v2":                   dms.ResourceDmsSmartConnectV2(),
+			"opentelekomcloud_dms_dump_task_v2":                       dms.ResourceDmsDumpTaskV2(),
+			"opentelekomcloud_ecs_instance_v1":                        ecs.ResourceEcsInstanceV1(),
+			"opentelekomcloud_elb_backend":                            elb.ResourceBackend(),
+			"opentelekomcloud_elb_health":                             elb.ResourceHealth(),
+			"opentelekomcloud_elb_loadbalancer":                       elb.ResourceELoadBalancer(),
+			"opentelekomcloud_elb_listener":                           elb.ResourceEListener(),
+			"opentelekomcloud_enterprise_project":                     eps.ResourceEnterpriseProject(),
+			"opentelekomcloud_evs_volume_v3":                          evs.ResourceEvsStorageVolumeV3(),
+			"opentelekomcloud_fw_firewall_group_v2":                   fw.ResourceFWFirewallGroupV2(),
+			"opentelekomcloud_fw_policy_v2":                           fw.ResourceFWPolicyV2(),
+			"opentelekomcloud_fw_rule_v2":                             fw.ResourceFWRuleV2(),
+			"opentelekomcloud_identity_agency_v3":                     iam.ResourceIdentityAgencyV3(),
+			"opentelekomcloud_identity_credential_v3":                 iam.ResourceIdentityCredentialV3(),
+			"opentelekomcloud_identity_group_v3":                      iam.ResourceIdentityGroupV3(),
+			"opentelekomcloud_identity_group_membership_v3":           iam.ResourceIdentityGroupMembershipV3(),
+			"opentelekomcloud_identity_group_role_assignments_v3":     iam.ResourceIdentityGroupRoleAssignmentsV3(),
+			"opentelekomcloud_identity_mapping_v3":                    iam.ResourceIdentityMappingV3(),
+			"opentelekomcloud_identity_project_v3":                    iam.ResourceIdentityProjectV3(),
+			"opentelekomcloud_identity_provider_v3":                   iam.ResourceIdentityProviderV3(),
+			"opentelekomcloud_identity_role_v3":                       iam.ResourceIdentityRoleV3(),
+			"opentelekomcloud_identity_role_assignment_v3":            iam.ResourceIdentityRoleAssignmentV3(),
+			"opentelekomcloud_identity_user_v3":                       iam.ResourceIdentityUserV3(),
+			"opentelekomcloud_images_image_v2":                        ims.ResourceImagesImageV2(),
+			"opentelekomcloud_images_image_access_accept_v2":          ims.ResourceImagesImageAccessAcceptV2(),
+			"opentelekomcloud_ims_data_image_v2":                      ims.ResourceImsDataImageV2(),
+			"opentelekomcloud_ims_image_copy":                         ims.ResourceImsImageCopy(),
+			"opentelekomcloud_ims_image_v2":                           ims.ResourceImsImageV2(),
+			"opentelekomcloud_kms_key_v1":                             kms.ResourceKmsKeyV1(),
+			"opentelekomcloud_kms_grant_v1":                           kms.ResourceKmsGrantV1(),
+			"opentelekomcloud_kms_key_material_v1":                    kms.ResourceKmsKeyMaterialV1(),
+			"opentelekomcloud_lb_certificate_v2":                      elb.ResourceCertificateV2(),
+			"opentelekomcloud_lb_l7policy_v2":                         elb.ResourceL7PolicyV2(),
+			"opentelekomcloud_lb_l7rule_v2":                           elb.ResourceL7RuleV2(),
+			"opentelekomcloud_lb_loadbalancer_v2":                     elb.ResourceLoadBalancerV2(),
+			"opentelekomcloud_lb_loadbalancer_v3":                     elb.ResourceLoadBalancerV3(),
+			"opentelekomcloud_lb_listener_v2":                         elb.ResourceListenerV2(),
+			"opentelekomcloud_lb_member_v2":                           elb.ResourceMemberV2(),
+			"opentelekomcloud_lb_monitor_v2":                          elb.ResourceMonitorV2(),
+			"opentelekomcloud_lb_pool_v2":                             elb.ResourceLBPoolV2(),
+			"opentelekomcloud_lb_whitelist_v2":                        elb.ResourceWhitelistV2(),
+			"opentelekomcloud_logtank_group_v2":                       lts.ResourceLTSGroupV2(),
+			"opentelekomcloud_logtank_topic_v2":                       lts.ResourceLTSTopicV2(),
+			"opentelekomcloud_logtank_transfer_v2":                    lts.ResourceLTSTransferV2(),
+			"opentelekomcloud_mrs_cluster_v1":                         mrs.ResourceMRSClusterV1(),
+			"opentelekomcloud_mrs_job_v1":                             mrs.ResourceMRSJobV1(),
+			"opentelekomcloud_nat_gateway_v2":                         nat.ResourceNatGatewayV2(),
+			"opentelekomcloud_nat_dnat_rule_v2":                       nat.ResourceNatDnatRuleV2(),
+			"opentelekomcloud_nat_snat_rule_v2":                       nat.ResourceNatSnatRuleV2(),
+			"opentelekomcloud_networking_floatingip_v2":               vpc.ResourceNetworkingFloatingIPV2(),
+			"opentelekomcloud_networking_floatingip_associate_v2":     vpc.ResourceNetworkingFloatingIPAssociateV2(),
+			"opentelekomcloud_networking_network_v2":                  vpc.ResourceNetworkingNetworkV2(),
+			"opentelekomcloud_networking_port_v2":                     vpc.ResourceNetworkingPortV2(),
+			"opentelekomcloud_networking_qos_policy_v2":               vpc.ResourceNetworkingQoSPolicyV2(),
+			"opentelekomcloud_networking_qos_bandwidth_limit_rule_v2": vpc.ResourceNetworkingQoSBandwidthLimitRuleV2(),
+			"opentelekomcloud_networking_qos_dscp_marking_rule_v2":    vpc.ResourceNetworkingQoSDSCPMarkingRuleV2(),
+			"opentelekomcloud_networking_router_v2":                   vpc.ResourceNetworkingRouterV2(),
+			"opentelekomcloud_networking_router_interface_v2":         vpc.ResourceNetworkingRouterInterfaceV2(),
+			"opentelekomcloud_networking_router_route_v2":             vpc.ResourceNetworkingRouterRouteV2(),
+			"opentelekomcloud_networking_secgroup_v2":                 vpc.ResourceNetworkingSecGroupV2(),
+			"opentelekomcloud_networking_secgroup_rule_v2":            vpc.ResourceNetworkingSecGroupRuleV2(),
+			"opentelekomcloud_networking_subnet_v2":                   vpc.ResourceNetworkingSubnetV2(),
+			"opentelekomcloud_networking_vip_v2":                      vpc.ResourceNetworkingVIPV2(),
+			"opentelekomcloud_networking_vip_associate_v2":            vpc.ResourceNetworkingVIPAssociateV2(),
+			"opentelekomcloud_obs_bucket":                             obs.ResourceObsBucket(),
+			"opentelekomcloud_obs_bucket_inventory":                   obs.ResourceObsBucketInventory(),
+			"opentelekomcloud_obs_bucket_notification":                obs.ResourceObsBucketNotification(),
+			"opentelekomcloud_obs_bucket_object":                      obs.ResourceObsBucketObject(),
+			"opentelekomcloud_obs_bucket_policy":                      obs.ResourceObsBucketPolicy(),
+			"opentelekomcloud_rds_instance_v1":                        rds.ResourceRdsInstance(),
+			"opentelekomcloud_rds_instance_v3":                        rds.ResourceRdsInstanceV3(),
+			"opentelekomcloud_rds_account_v3":                         rds.ResourceRdsAccountV3(),
+			"opentelekomcloud_rds_database_v3":                        rds.ResourceRdsDatabaseV3(),
+			"opentelekomcloud_rds_parametergroup_v3":                  rds.ResourceRdsConfigurationV3(),
+			"opentelekomcloud_rds_read_replica_v3":                    rds.ResourceRdsReadReplicaV3(),
+			"opentelekomcloud_rds_proxy":                              rds.ResourceRdsProxy(),
+			"opentelekomcloud_rds_sql_limit":                          rds.ResourceRdsSqlLimit(),
+			"opentelekomcloud_rts_software_deployment_v1":             rts.ResourceRtsSoftwareDeploymentV1(),
+			"opentelekomcloud_rts_software_config_v1":                 rts.ResourceSoftwareConfigV1(),
+			"opentelekomcloud_rts_stack_v1":                           rts.ResourceRTSStackV1(),
+			"opentelekomcloud_s3_bucket":                              s3.ResourceS3Bucket(),
+			"opentelekomcloud_s3_bucket_policy":                       s3.ResourceS3BucketPolicy(),
+			"opentelekomcloud_s3_bucket_object":                       s3.ResourceS3BucketObject(),
+			"opentelekomcloud_scm_certificate_v3":                     scm.ResourceScmCertificateV3(),
+			"opentelekomcloud_sfs_file_system_v2":                     sfs.ResourceSFSFileSystemV2(),
+			"opentelekomcloud_sfs_share_access_rules_v2":              sfs.ResourceSFSShareAccessRulesV2(),
+			"opentelekomcloud_sfs_share_replica":                      sfs.ResourceSFSShareReplica(),
+			"opentelekomcloud_sfs_turbo_share_v1":                     sfs.ResourceSFSTurboShareV1(),
+			"opentelekomcloud_smn_topic_v2":                           smn.ResourceTopic(),
+			"opentelekomcloud_smn_subscription_v2":                    smn.ResourceSubscription(),
+			"opentelekomcloud_smn_topic_subscriptions_v2":             smn.ResourceTopicSubscriptions(),
+			"opentelekomcloud_swr_domain_v2":                          swr.ResourceSwrDomainV2(),
+			"opentelekomcloud_swr_organization_permissions_v2":        swr.ResourceSwrOrganizationPermissionsV2(),
+			"opentelekomcloud_swr_organization_v2":                    swr.ResourceSwrOrganizationV2(),
+			"opentelekomcloud_swr_repository_v2":                      swr.ResourceSwrRepositoryV2(),
+			"opentelekomcloud_swr_trigger_v2":                         swr.ResourceSwrTriggerV2(),
+			"opentelekomcloud_tms_tag":                                tms.ResourceTmsTag(),
+			"opentelekomcloud_vpc_eip_v1":                             vpc.ResourceVpcEIPV1(),
+			"opentelekomcloud_vpc_v1":                                 vpc.ResourceVirtualPrivateCloudV1(),
+			"opentelekomcloud_vpc_peering_connection_v2":              vpc.ResourceVpcPeeringConnectionV2(),
+			"opentelekomcloud_vpc_peering_connection_accepter_v2":     vpc.ResourceVpcPeeringConnectionAccepterV2(),
+			"opentelekomcloud_vpc_route_v2":                           vpc.ResourceVPCRouteV2(),
+			"opentelekomcloud_vpc_subnet_v1":                          vpc.ResourceVpcSubnetV1(),
+			"opentelekomcloud_vpc_flow_log_v1":                        vpc.ResourceVpcFlowLogV1(),
+			"opentelekomcloud_vbs_backup_policy_v2":                   vbs.ResourceVBSBackupPolicyV2(),
+			"opentelekomcloud_vbs_backup_v2":                          vbs.ResourceVBSBackupV2(),
+			"opentelekomcloud_vbs_backup_share_v2":                    vbs.ResourceVBSBackupShareV2(),
+			"opentelekomcloud_sdrs_protected_instance_v1":             sdrs.ResourceSdrsProtectedInstanceV1(),
+			"opentelekomcloud_sdrs_protectiongroup_v1":                sdrs.ResourceSdrsProtectiongroupV1(),
+			"opentelekomcloud_service_ticket":                         osm.ResourceServiceTicket(),
+			"opentelekomcloud_vpnaas_ipsec_policy_v2":                 vpn.ResourceVpnIPSecPolicyV2(),
+			"opentelekomcloud_vpnaas_service_v2":                      vpn.ResourceVpnServiceV2(),
+			"opentelekomcloud_vpnaas_ike_policy_v2":                   vpn.ResourceVpnIKEPolicyV2(),
+			"opentelekomcloud_vpnaas_endpoint_group_v2":               vpn.ResourceVpnEndpointGroupV2(),
+			"opentelekomcloud_vpnaas_site_connection_v2":              vpn.ResourceVpnSiteConnectionV2(),
+			"opentelekomcloud_waf_certificate_v1":                     waf.ResourceWafCertificateV1(),
+			"opentelekomcloud_waf_domain_v1":                          waf.ResourceWafDomainV1(),
+			"opentelekomcloud_waf_policy_v1":                          waf.ResourceWafPolicyV1(),
+			"opentelekomcloud_waf_whiteblackip_rule_v1":               waf.ResourceWafWhiteBlackIpRuleV1(),
+			"opentelekomcloud_waf_datamasking_rule_v1":                waf.ResourceWafDataMaskingRuleV1(),
+			"opentelekomcloud_waf_falsealarmmasking_rule_v1":          waf.ResourceWafFalseAlarmMaskingRuleV1(),
+			"opentelekomcloud_waf_ccattackprotection_rule_v1":         waf.ResourceWafCcAttackProtectionRuleV1(),
+			"opentelekomcloud_waf_preciseprotection_rule_v1":          waf.ResourceWafPreciseProtectionRuleV1(),
+			"opentelekomcloud_waf_webtamperprotection_rule_v1":        waf.ResourceWafWebTamperProtectionRuleV1(),
 		},
 	}
 
+	for resourceType, r := range provider.ResourcesMap {
+		common.PreventReplacement(resourceType, r)
+		common.LimitParallelism(resourceType, r)
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return providerConfigure(ctx, d, provider)
 	}
@@ -433,32 +583,39 @@ func Provider() *schema.Provider {
 
 func providerConfigure(_ context.Context, d *schema.ResourceData, p *schema.Provider) (interface{}, diag.Diagnostics) {
 	config := cfg.Config{
-		AccessKey:        d.Get("access_key").(string),
-		SecretKey:        d.Get("secret_key").(string),
-		CACertFile:       d.Get("cacert_file").(string),
-		ClientCertFile:   d.Get("cert").(string),
-		ClientKeyFile:    d.Get("key").(string),
-		Cloud:            d.Get("cloud").(string),
-		DomainID:         d.Get("domain_id").(string),
-		DomainName:       d.Get("domain_name").(string),
-		EndpointType:     d.Get("endpoint_type").(string),
-		IdentityEndpoint: d.Get("auth_url").(string),
-		Insecure:         d.Get("insecure").(bool),
-		Password:         d.Get("password").(string),
-		Passcode:         d.Get("passcode").(string),
-		Region:           d.Get("region").(string),
-		Swauth:           d.Get("swauth").(bool),
-		Token:            d.Get("token").(string),
-		SecurityToken:    d.Get("security_token").(string),
-		TenantID:         d.Get("tenant_id").(string),
-		TenantName:       d.Get("tenant_name").(string),
-		Username:         d.Get("user_name").(string),
-		UserID:           d.Get("user_id").(string),
-		AgencyName:       d.Get("agency_name").(string),
-		AgencyDomainName: d.Get("agency_domain_name").(string),
-		DelegatedProject: d.Get("delegated_project").(string),
-		MaxRetries:       d.Get("max_retries").(int),
-		UserAgent:        p.UserAgent("terraform-provider-opentelekomcloud", version.ProviderVersion),
+		AccessKey:            d.Get("access_key").(string),
+		SecretKey:            d.Get("secret_key").(string),
+		CACertFile:           d.Get("cacert_file").(string),
+		ClientCertFile:       d.Get("cert").(string),
+		ClientKeyFile:        d.Get("key").(string),
+		Cloud:                d.Get("cloud").(string),
+		DomainID:             d.Get("domain_id").(string),
+		DomainName:           d.Get("domain_name").(string),
+		EndpointType:         d.Get("endpoint_type").(string),
+		IdentityEndpoint:     d.Get("auth_url").(string),
+		Insecure:             d.Get("insecure").(bool),
+		Password:             d.Get("password").(string),
+		Passcode:             d.Get("passcode").(string),
+		Region:               d.Get("region").(string),
+		Swauth:               d.Get("swauth").(bool),
+		Token:                d.Get("token").(string),
+		SecurityToken:        d.Get("security_token").(string),
+		TenantID:             d.Get("tenant_id").(string),
+		TenantName:           d.Get("tenant_name").(string),
+		Username:             d.Get("user_name").(string),
+		UserID:               d.Get("user_id").(string),
+		AgencyName:           d.Get("agency_name").(string),
+		AgencyDomainName:     d.Get("agency_domain_name").(string),
+		DelegatedProject:     d.Get("delegated_project").(string),
+		MaxRetries:           d.Get("max_retries").(int),
+		ServiceParallelism:   expandServiceParallelism(d.Get("service_parallelism").(map[string]interface{})),
+		PreventReplacement:   d.Get("prevent_replacement").(bool),
+		CacheDataSourceReads: d.Get("cache_data_source_reads").(bool),
+		UserAgent:            p.UserAgent("terraform-provider-opentelekomcloud", version.ProviderVersion),
+	}
+
+	for _, resourceType := range d.Get("protected_resource_types").(*schema.Set).List() {
+		config.ProtectedResourceTypes = append(config.ProtectedResourceTypes, resourceType.(string))
 	}
 
 	if err := config.LoadAndValidate(); err != nil {
@@ -467,3 +624,11 @@ func providerConfigure(_ context.Context, d *schema.ResourceData, p *schema.Prov
 
 	return &config, nil
 }
+
+func expandServiceParallelism(raw map[string]interface{}) map[string]int {
+	limits := make(map[string]int, len(raw))
+	for service, limit := range raw {
+		limits[service] = limit.(int)
+	}
+	return limits
+}
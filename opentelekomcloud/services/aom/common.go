@@ -0,0 +1,3 @@
+package aom
+
+const clientError = "error creating AOM client: %w"
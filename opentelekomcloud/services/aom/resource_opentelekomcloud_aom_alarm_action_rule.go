@@ -0,0 +1,200 @@
+package aom
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+var ruleNameRe = regexp.MustCompile(`^[A-Za-z0-9][\w-]{0,99}$`)
+
+type smnTopic struct {
+	TopicURN string `json:"topic_urn"`
+}
+
+// actionRule is an AOM alarm action rule, binding alarm notifications to SMN topics
+type actionRule struct {
+	RuleName             string     `json:"rule_name"`
+	ProjectID            string     `json:"project_id"`
+	UserName             string     `json:"user_name"`
+	Desc                 string     `json:"desc"`
+	Type                 string     `json:"type"`
+	NotificationTemplate string     `json:"notification_template"`
+	TimeZone             string     `json:"time_zone"`
+	SmnTopics            []smnTopic `json:"smn_topics"`
+	CreateTime           int64      `json:"create_time,omitempty"`
+	UpdateTime           int64      `json:"update_time,omitempty"`
+}
+
+func ResourceAOMAlarmActionRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAOMAlarmActionRuleCreate,
+		ReadContext:   resourceAOMAlarmActionRuleRead,
+		UpdateContext: resourceAOMAlarmActionRuleUpdate,
+		DeleteContext: resourceAOMAlarmActionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(ruleNameRe, "must contain only letters, digits, underscores and hyphens, up to 100 characters"),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"notification_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aom.built-in.template.en",
+			},
+			"time_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Europe/Berlin",
+			},
+			"smn_topics": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildActionRule(d *schema.ResourceData, projectID string) actionRule {
+	var topics []smnTopic
+	for _, urn := range d.Get("smn_topics").(*schema.Set).List() {
+		topics = append(topics, smnTopic{TopicURN: urn.(string)})
+	}
+	return actionRule{
+		RuleName:             d.Get("name").(string),
+		ProjectID:            projectID,
+		UserName:             d.Get("user_name").(string),
+		Desc:                 d.Get("description").(string),
+		Type:                 "1", // notification
+		NotificationTemplate: d.Get("notification_template").(string),
+		TimeZone:             d.Get("time_zone").(string),
+		SmnTopics:            topics,
+	}
+}
+
+func resourceAOMAlarmActionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	opts := buildActionRule(d, client.ProjectID)
+	_, err = client.Post(client.ServiceURL("alert", "action-rules"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating AOM alarm action rule: %w", err)
+	}
+	d.SetId(opts.RuleName)
+
+	return resourceAOMAlarmActionRuleRead(ctx, d, meta)
+}
+
+func resourceAOMAlarmActionRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("alert", "action-rules", d.Id()), &r.Body, nil)
+	rule := new(actionRule)
+	if err := r.ExtractInto(rule); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "AOM alarm action rule"))
+	}
+
+	var topics []string
+	for _, topic := range rule.SmnTopics {
+		topics = append(topics, topic.TopicURN)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", rule.RuleName),
+		d.Set("user_name", rule.UserName),
+		d.Set("description", rule.Desc),
+		d.Set("notification_template", rule.NotificationTemplate),
+		d.Set("time_zone", rule.TimeZone),
+		d.Set("smn_topics", topics),
+		d.Set("created_at", rule.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting AOM alarm action rule fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAOMAlarmActionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	_, err = client.Put(client.ServiceURL("alert", "action-rules"), buildActionRule(d, client.ProjectID), nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating AOM alarm action rule: %w", err)
+	}
+
+	return resourceAOMAlarmActionRuleRead(ctx, d, meta)
+}
+
+func resourceAOMAlarmActionRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	_, err = client.DeleteWithBody(client.ServiceURL("alert", "action-rules"), []string{d.Id()}, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting AOM alarm action rule"))
+	}
+
+	d.SetId("")
+	return nil
+}
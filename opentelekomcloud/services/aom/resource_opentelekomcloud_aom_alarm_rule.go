@@ -0,0 +1,550 @@
+package aom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const (
+	alarmRuleTypeMetric = "metric"
+	alarmRuleTypeEvent  = "event"
+)
+
+var alarmLevels = []string{"Critical", "Major", "Minor", "Info"}
+
+type alarmNotifications struct {
+	NotificationType       string `json:"notification_type"`
+	NotificationEnable     bool   `json:"notification_enable"`
+	BindNotificationRuleID string `json:"bind_notification_rule_id,omitempty"`
+	NotifyResolved         bool   `json:"notify_resolved"`
+	NotifyTriggered        bool   `json:"notify_triggered"`
+	NotifyFrequency        string `json:"notify_frequency,omitempty"`
+}
+
+type metricTriggerCondition struct {
+	MetricQueryMode string            `json:"metric_query_mode"`
+	MetricName      string            `json:"metric_name"`
+	PromQL          string            `json:"promql"`
+	TriggerType     string            `json:"trigger_type"`
+	TriggerInterval string            `json:"trigger_interval"`
+	TriggerTimes    string            `json:"trigger_times"`
+	Operator        string            `json:"operator"`
+	Thresholds      map[string]string `json:"thresholds"`
+}
+
+type metricAlarmSpec struct {
+	MonitorType        string `json:"monitor_type"`
+	RecoveryConditions struct {
+		RecoveryTimeframe int `json:"recovery_timeframe"`
+	} `json:"recovery_conditions"`
+	TriggerConditions []metricTriggerCondition `json:"trigger_conditions"`
+}
+
+type eventTriggerCondition struct {
+	EventName         string         `json:"event_name"`
+	TriggerType       string         `json:"trigger_type"`
+	AggregationWindow int            `json:"aggregation_window,omitempty"`
+	Operator          string         `json:"operator,omitempty"`
+	Thresholds        map[string]int `json:"thresholds,omitempty"`
+	Frequency         string         `json:"frequency,omitempty"`
+}
+
+type eventAlarmSpec struct {
+	AlarmSource       string                  `json:"alarm_source"`
+	EventSource       string                  `json:"event_source"`
+	TriggerConditions []eventTriggerCondition `json:"trigger_conditions"`
+}
+
+type alarmRule struct {
+	AlarmRuleName        string             `json:"alarm_rule_name"`
+	AlarmRuleDescription string             `json:"alarm_rule_description"`
+	AlarmRuleEnable      bool               `json:"alarm_rule_enable"`
+	AlarmRuleType        string             `json:"alarm_rule_type"`
+	AlarmRuleStatus      string             `json:"alarm_rule_status,omitempty"`
+	AlarmNotifications   alarmNotifications `json:"alarm_notifications"`
+	MetricAlarmSpec      *metricAlarmSpec   `json:"metric_alarm_spec,omitempty"`
+	EventAlarmSpec       *eventAlarmSpec    `json:"event_alarm_spec,omitempty"`
+	AlarmCreateTime      int64              `json:"alarm_create_time,omitempty"`
+}
+
+func ResourceAOMAlarmRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAOMAlarmRuleCreate,
+		ReadContext:   resourceAOMAlarmRuleRead,
+		UpdateContext: resourceAOMAlarmRuleUpdate,
+		DeleteContext: resourceAOMAlarmRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: validateAlarmRuleSpec,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(ruleNameRe, "must contain only letters, digits, underscores and hyphens, up to 100 characters"),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{alarmRuleTypeMetric, alarmRuleTypeEvent}, false),
+			},
+			"notification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "direct",
+							ValidateFunc: validation.StringInSlice([]string{
+								"direct", "alarm_policy",
+							}, false),
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"action_rule": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"notify_resolved": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"notify_triggered": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"frequency": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "0",
+							ValidateFunc: validation.StringInSlice([]string{
+								"-1", "0", "300", "600", "900", "1800", "3600", "10800", "21600", "43200", "86400",
+							}, false),
+						},
+					},
+				},
+			},
+			"metric_alarm_spec": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitor_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "all_metric",
+						},
+						"recovery_timeframe": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 30),
+						},
+						"trigger_condition": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"promql": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"trigger_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "FIXED_RATE",
+									},
+									"trigger_interval": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "1m",
+									},
+									"trigger_times": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  1,
+									},
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{">", "<", "=", ">=", "<="}, false),
+									},
+									"thresholds": {
+										Type:         schema.TypeMap,
+										Required:     true,
+										Elem:         &schema.Schema{Type: schema.TypeString},
+										ValidateFunc: validateAlarmLevels,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"event_alarm_spec": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_source": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "systemEvent",
+							ValidateFunc: validation.StringInSlice([]string{
+								"systemEvent", "customEvent",
+							}, false),
+						},
+						"event_source": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"trigger_condition": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"trigger_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "immediately",
+										ValidateFunc: validation.StringInSlice([]string{
+											"immediately", "accumulative",
+										}, false),
+									},
+									"aggregation_window": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"thresholds": {
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Elem:         &schema.Schema{Type: schema.TypeInt},
+										ValidateFunc: validateAlarmLevels,
+									},
+									"frequency": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateAlarmLevels(v interface{}, k string) (ws []string, errors []error) {
+	for level := range v.(map[string]interface{}) {
+		if !common.StrSliceContains(alarmLevels, level) {
+			errors = append(errors, fmt.Errorf("%s alarm level must be one of %v, got %s", k, alarmLevels, level))
+		}
+	}
+	return
+}
+
+func validateAlarmRuleSpec(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	metricSpecs := len(d.Get("metric_alarm_spec").([]interface{}))
+	eventSpecs := len(d.Get("event_alarm_spec").([]interface{}))
+	switch d.Get("type").(string) {
+	case alarmRuleTypeMetric:
+		if metricSpecs == 0 || eventSpecs != 0 {
+			return fmt.Errorf("`metric` alarm rule requires `metric_alarm_spec` only")
+		}
+	case alarmRuleTypeEvent:
+		if eventSpecs == 0 || metricSpecs != 0 {
+			return fmt.Errorf("`event` alarm rule requires `event_alarm_spec` only")
+		}
+	}
+	if d.Get("notification.0.type").(string) == "direct" && d.Get("notification.0.action_rule").(string) == "" {
+		return fmt.Errorf("`notification.0.action_rule` is required for `direct` notifications")
+	}
+	return nil
+}
+
+func buildAlarmRule(d *schema.ResourceData) alarmRule {
+	notification := d.Get("notification.0").(map[string]interface{})
+	rule := alarmRule{
+		AlarmRuleName:        d.Get("name").(string),
+		AlarmRuleDescription: d.Get("description").(string),
+		AlarmRuleEnable:      d.Get("enabled").(bool),
+		AlarmRuleType:        d.Get("type").(string),
+		AlarmNotifications: alarmNotifications{
+			NotificationType:       notification["type"].(string),
+			NotificationEnable:     notification["enabled"].(bool),
+			BindNotificationRuleID: notification["action_rule"].(string),
+			NotifyResolved:         notification["notify_resolved"].(bool),
+			NotifyTriggered:        notification["notify_triggered"].(bool),
+			NotifyFrequency:        notification["frequency"].(string),
+		},
+	}
+
+	if specs := d.Get("metric_alarm_spec").([]interface{}); len(specs) != 0 {
+		spec := specs[0].(map[string]interface{})
+		rule.MetricAlarmSpec = &metricAlarmSpec{
+			MonitorType: spec["monitor_type"].(string),
+		}
+		rule.MetricAlarmSpec.RecoveryConditions.RecoveryTimeframe = spec["recovery_timeframe"].(int)
+		for _, raw := range spec["trigger_condition"].([]interface{}) {
+			condition := raw.(map[string]interface{})
+			thresholds := make(map[string]string)
+			for level, value := range condition["thresholds"].(map[string]interface{}) {
+				thresholds[level] = value.(string)
+			}
+			rule.MetricAlarmSpec.TriggerConditions = append(rule.MetricAlarmSpec.TriggerConditions, metricTriggerCondition{
+				MetricQueryMode: "PROM",
+				MetricName:      condition["metric_name"].(string),
+				PromQL:          condition["promql"].(string),
+				TriggerType:     condition["trigger_type"].(string),
+				TriggerInterval: condition["trigger_interval"].(string),
+				TriggerTimes:    fmt.Sprint(condition["trigger_times"].(int)),
+				Operator:        condition["operator"].(string),
+				Thresholds:      thresholds,
+			})
+		}
+	}
+
+	if specs := d.Get("event_alarm_spec").([]interface{}); len(specs) != 0 {
+		spec := specs[0].(map[string]interface{})
+		rule.EventAlarmSpec = &eventAlarmSpec{
+			AlarmSource: spec["alarm_source"].(string),
+			EventSource: spec["event_source"].(string),
+		}
+		for _, raw := range spec["trigger_condition"].([]interface{}) {
+			condition := raw.(map[string]interface{})
+			var thresholds map[string]int
+			for level, value := range condition["thresholds"].(map[string]interface{}) {
+				if thresholds == nil {
+					thresholds = make(map[string]int)
+				}
+				thresholds[level] = value.(int)
+			}
+			rule.EventAlarmSpec.TriggerConditions = append(rule.EventAlarmSpec.TriggerConditions, eventTriggerCondition{
+				EventName:         condition["event_name"].(string),
+				TriggerType:       condition["trigger_type"].(string),
+				AggregationWindow: condition["aggregation_window"].(int),
+				Operator:          condition["operator"].(string),
+				Thresholds:        thresholds,
+				Frequency:         condition["frequency"].(string),
+			})
+		}
+	}
+
+	return rule
+}
+
+// alarm rules are created and updated by the same call, the operation is selected by `action_id`
+func saveAlarmRule(client *golangsdk.ServiceClient, rule alarmRule, action string) error {
+	url := client.ServiceURL("alarm-rules") + "?action_id=" + action
+	_, err := client.Post(url, rule, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func resourceAOMAlarmRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV4Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	rule := buildAlarmRule(d)
+	if err := saveAlarmRule(client, rule, "add-alarm-action"); err != nil {
+		return fmterr.Errorf("error creating AOM alarm rule: %w", err)
+	}
+	d.SetId(rule.AlarmRuleName)
+
+	return resourceAOMAlarmRuleRead(ctx, d, meta)
+}
+
+func getAlarmRule(client *golangsdk.ServiceClient, name string) (*alarmRule, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("alarm-rules")+"?name="+name, &r.Body, nil)
+	var rules []alarmRule
+	if err := r.ExtractIntoSlicePtr(&rules, "alarm_rules"); err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.AlarmRuleName == name {
+			return &rule, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceAOMAlarmRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV4Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	rule, err := getAlarmRule(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "AOM alarm rule"))
+	}
+
+	notification := []map[string]interface{}{{
+		"type":             rule.AlarmNotifications.NotificationType,
+		"enabled":          rule.AlarmNotifications.NotificationEnable,
+		"action_rule":      rule.AlarmNotifications.BindNotificationRuleID,
+		"notify_resolved":  rule.AlarmNotifications.NotifyResolved,
+		"notify_triggered": rule.AlarmNotifications.NotifyTriggered,
+		"frequency":        rule.AlarmNotifications.NotifyFrequency,
+	}}
+
+	var metricSpecs []map[string]interface{}
+	if spec := rule.MetricAlarmSpec; spec != nil {
+		var conditions []map[string]interface{}
+		for _, condition := range spec.TriggerConditions {
+			var triggerTimes int
+			_, _ = fmt.Sscan(condition.TriggerTimes, &triggerTimes)
+			conditions = append(conditions, map[string]interface{}{
+				"metric_name":      condition.MetricName,
+				"promql":           condition.PromQL,
+				"trigger_type":     condition.TriggerType,
+				"trigger_interval": condition.TriggerInterval,
+				"trigger_times":    triggerTimes,
+				"operator":         condition.Operator,
+				"thresholds":       condition.Thresholds,
+			})
+		}
+		metricSpecs = append(metricSpecs, map[string]interface{}{
+			"monitor_type":       spec.MonitorType,
+			"recovery_timeframe": spec.RecoveryConditions.RecoveryTimeframe,
+			"trigger_condition":  conditions,
+		})
+	}
+
+	var eventSpecs []map[string]interface{}
+	if spec := rule.EventAlarmSpec; spec != nil {
+		var conditions []map[string]interface{}
+		for _, condition := range spec.TriggerConditions {
+			conditions = append(conditions, map[string]interface{}{
+				"event_name":         condition.EventName,
+				"trigger_type":       condition.TriggerType,
+				"aggregation_window": condition.AggregationWindow,
+				"operator":           condition.Operator,
+				"thresholds":         condition.Thresholds,
+				"frequency":          condition.Frequency,
+			})
+		}
+		eventSpecs = append(eventSpecs, map[string]interface{}{
+			"alarm_source":      spec.AlarmSource,
+			"event_source":      spec.EventSource,
+			"trigger_condition": conditions,
+		})
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", rule.AlarmRuleName),
+		d.Set("description", rule.AlarmRuleDescription),
+		d.Set("enabled", rule.AlarmRuleEnable),
+		d.Set("type", rule.AlarmRuleType),
+		d.Set("notification", notification),
+		d.Set("metric_alarm_spec", metricSpecs),
+		d.Set("event_alarm_spec", eventSpecs),
+		d.Set("status", rule.AlarmRuleStatus),
+		d.Set("created_at", rule.AlarmCreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting AOM alarm rule fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAOMAlarmRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV4Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	if err := saveAlarmRule(client, buildAlarmRule(d), "update-alarm-action"); err != nil {
+		return fmterr.Errorf("error updating AOM alarm rule: %w", err)
+	}
+
+	return resourceAOMAlarmRuleRead(ctx, d, meta)
+}
+
+func resourceAOMAlarmRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV4Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	body := map[string][]string{"alarm_rules": {d.Id()}}
+	_, err = client.DeleteWithBody(client.ServiceURL("alarm-rules"), body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting AOM alarm rule"))
+	}
+
+	d.SetId("")
+	return nil
+}
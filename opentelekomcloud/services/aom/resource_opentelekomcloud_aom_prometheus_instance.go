@@ -0,0 +1,238 @@
+package aom
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type prometheusInstance struct {
+	PromID              string `json:"prom_id"`
+	PromName            string `json:"prom_name"`
+	PromType            string `json:"prom_type"`
+	PromVersion         string `json:"prom_version"`
+	PromStatus          string `json:"prom_status"`
+	PromCreateTimestamp int64  `json:"prom_create_timestamp"`
+	EnterpriseProjectID string `json:"enterprise_project_id"`
+	PromSpecConfig      struct {
+		RemoteWriteURL      string `json:"remote_write_url"`
+		RemoteReadURL       string `json:"remote_read_url"`
+		PromHTTPAPIEndpoint string `json:"prom_http_api_endpoint"`
+	} `json:"prom_spec_config"`
+}
+
+type accessCode struct {
+	AccessCodeID string `json:"access_code_id"`
+	AccessCode   string `json:"access_code"`
+	Status       string `json:"status"`
+}
+
+func ResourceAOMPrometheusInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAOMPrometheusInstanceCreate,
+		ReadContext:   resourceAOMPrometheusInstanceRead,
+		DeleteContext: resourceAOMPrometheusInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(ruleNameRe, "must contain only letters, digits, underscores and hyphens, up to 100 characters"),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"default", "ECS", "VPC", "CCE", "REMOTE_WRITE", "KUBERNETES", "CLOUD_SERVICE", "ACROSS_ACCOUNT",
+				}, false),
+			},
+			"prometheus_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remote_write_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_read_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prometheus_http_api_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_write_username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_write_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func prometheusURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("aom", "prometheus")
+}
+
+func resourceAOMPrometheusInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	opts := map[string]interface{}{
+		"prom_name": d.Get("name").(string),
+		"prom_type": d.Get("type").(string),
+	}
+	if v, ok := d.GetOk("prometheus_version"); ok {
+		opts["prom_version"] = v.(string)
+	}
+	if v, ok := d.GetOk("enterprise_project_id"); ok {
+		opts["enterprise_project_id"] = v.(string)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(prometheusURL(client), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	var instances []prometheusInstance
+	if err := r.ExtractIntoSlicePtr(&instances, "prometheus"); err != nil {
+		return fmterr.Errorf("error creating AOM Prometheus instance: %w", err)
+	}
+	if len(instances) == 0 {
+		return fmterr.Errorf("error creating AOM Prometheus instance: empty response")
+	}
+	d.SetId(instances[0].PromID)
+
+	return resourceAOMPrometheusInstanceRead(ctx, d, meta)
+}
+
+func getPrometheusInstance(client *golangsdk.ServiceClient, id string) (*prometheusInstance, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(prometheusURL(client)+"?prom_id="+id, &r.Body, nil)
+	var instances []prometheusInstance
+	if err := r.ExtractIntoSlicePtr(&instances, "prometheus"); err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if instance.PromID == id {
+			return &instance, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+// remoteWriteAccessCode returns the first enabled access code of the project,
+// which is used as a password for the remote write together with the project ID
+func remoteWriteAccessCode(client *golangsdk.ServiceClient) (string, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("access-code"), &r.Body, nil)
+	var codes []accessCode
+	if err := r.ExtractIntoSlicePtr(&codes, "access_codes"); err != nil {
+		return "", err
+	}
+	for _, code := range codes {
+		if code.Status == "" || code.Status == "enable" {
+			return code.AccessCode, nil
+		}
+	}
+	return "", nil
+}
+
+func resourceAOMPrometheusInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	instance, err := getPrometheusInstance(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "AOM Prometheus instance"))
+	}
+
+	password, err := remoteWriteAccessCode(client)
+	if err != nil {
+		log.Printf("[WARN] Unable to read AOM access codes: %s", err)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", instance.PromName),
+		d.Set("type", instance.PromType),
+		d.Set("prometheus_version", instance.PromVersion),
+		d.Set("enterprise_project_id", instance.EnterpriseProjectID),
+		d.Set("status", instance.PromStatus),
+		d.Set("created_at", instance.PromCreateTimestamp),
+		d.Set("remote_write_url", instance.PromSpecConfig.RemoteWriteURL),
+		d.Set("remote_read_url", instance.PromSpecConfig.RemoteReadURL),
+		d.Set("prometheus_http_api_endpoint", instance.PromSpecConfig.PromHTTPAPIEndpoint),
+		d.Set("remote_write_username", client.ProjectID),
+		d.Set("remote_write_password", password),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting AOM Prometheus instance fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAOMPrometheusInstanceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	_, err = client.Delete(prometheusURL(client)+"?prom_id="+d.Id(), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting AOM Prometheus instance"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,294 @@
+package aom
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type muteCondition struct {
+	Key     string   `json:"key"`
+	Operate string   `json:"operate"`
+	Value   []string `json:"value,omitempty"`
+}
+
+type muteConfig struct {
+	Type     string `json:"type"`
+	StartsAt int64  `json:"starts_at"`
+	EndsAt   int64  `json:"ends_at"`
+	Scope    []int  `json:"scope,omitempty"`
+}
+
+// muteRule is an AOM alarm silence policy, the API calls it a mute rule
+type muteRule struct {
+	Name       string            `json:"name"`
+	Desc       string            `json:"desc"`
+	Timezone   string            `json:"timezone"`
+	Match      [][]muteCondition `json:"match"`
+	MuteConfig muteConfig        `json:"mute_config"`
+	CreateTime int64             `json:"create_time,omitempty"`
+}
+
+func ResourceAOMSilencePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAOMSilencePolicyCreate,
+		ReadContext:   resourceAOMSilencePolicyRead,
+		UpdateContext: resourceAOMSilencePolicyUpdate,
+		DeleteContext: resourceAOMSilencePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(ruleNameRe, "must contain only letters, digits, underscores and hyphens, up to 100 characters"),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"time_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"match": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"operate": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"EQUALS", "REGEX", "EXIST",
+										}, false),
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"silence_time": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"FIXED", "DAILY", "WEEKLY", "MONTHLY",
+							}, false),
+						},
+						"starts_at": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"ends_at": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"scope": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildMuteRule(d *schema.ResourceData) muteRule {
+	var match [][]muteCondition
+	for _, rawGroup := range d.Get("match").([]interface{}) {
+		var group []muteCondition
+		for _, rawCondition := range rawGroup.(map[string]interface{})["condition"].([]interface{}) {
+			condition := rawCondition.(map[string]interface{})
+			group = append(group, muteCondition{
+				Key:     condition["key"].(string),
+				Operate: condition["operate"].(string),
+				Value:   common.ExpandToStringSlice(condition["values"].([]interface{})),
+			})
+		}
+		match = append(match, group)
+	}
+
+	silence := d.Get("silence_time.0").(map[string]interface{})
+	var scope []int
+	for _, day := range silence["scope"].([]interface{}) {
+		scope = append(scope, day.(int))
+	}
+
+	return muteRule{
+		Name:     d.Get("name").(string),
+		Desc:     d.Get("description").(string),
+		Timezone: d.Get("time_zone").(string),
+		Match:    match,
+		MuteConfig: muteConfig{
+			Type:     silence["type"].(string),
+			StartsAt: int64(silence["starts_at"].(int)),
+			EndsAt:   int64(silence["ends_at"].(int)),
+			Scope:    scope,
+		},
+	}
+}
+
+func resourceAOMSilencePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	opts := buildMuteRule(d)
+	_, err = client.Post(client.ServiceURL("alert", "mute-rules"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating AOM silence policy: %w", err)
+	}
+	d.SetId(opts.Name)
+
+	return resourceAOMSilencePolicyRead(ctx, d, meta)
+}
+
+// getMuteRule looks up the rule in the list, as there is no API to get a single rule
+func getMuteRule(client *golangsdk.ServiceClient, name string) (*muteRule, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("alert", "mute-rules"), &r.Body, nil)
+	var rules []muteRule
+	if err := r.ExtractInto(&rules); err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.Name == name {
+			return &rule, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceAOMSilencePolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	rule, err := getMuteRule(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "AOM silence policy"))
+	}
+
+	var match []map[string]interface{}
+	for _, group := range rule.Match {
+		var conditions []map[string]interface{}
+		for _, condition := range group {
+			conditions = append(conditions, map[string]interface{}{
+				"key":     condition.Key,
+				"operate": condition.Operate,
+				"values":  condition.Value,
+			})
+		}
+		match = append(match, map[string]interface{}{"condition": conditions})
+	}
+	silence := []map[string]interface{}{{
+		"type":      rule.MuteConfig.Type,
+		"starts_at": rule.MuteConfig.StartsAt,
+		"ends_at":   rule.MuteConfig.EndsAt,
+		"scope":     rule.MuteConfig.Scope,
+	}}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", rule.Name),
+		d.Set("description", rule.Desc),
+		d.Set("time_zone", rule.Timezone),
+		d.Set("match", match),
+		d.Set("silence_time", silence),
+		d.Set("created_at", rule.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting AOM silence policy fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAOMSilencePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	_, err = client.Put(client.ServiceURL("alert", "mute-rules"), buildMuteRule(d), nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating AOM silence policy: %w", err)
+	}
+
+	return resourceAOMSilencePolicyRead(ctx, d, meta)
+}
+
+func resourceAOMSilencePolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AomV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	body := []map[string]string{{"name": d.Id()}}
+	_, err = client.DeleteWithBody(client.ServiceURL("alert", "mute-rules"), body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting AOM silence policy"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,188 @@
+package apigw
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const (
+	apigwClientError    = "error creating OpenTelekomCloud APIGW client: %w"
+	environmentPageSize = 100
+)
+
+var environmentNameRe = regexp.MustCompile(`^[A-Za-z][\w]*$`)
+
+// environment is not supported by the SDK, so API Gateway API is used directly
+type environment struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Remark     string `json:"remark"`
+	CreateTime string `json:"create_time"`
+}
+
+type environmentList struct {
+	Total int           `json:"total"`
+	Envs  []environment `json:"envs"`
+}
+
+func ResourceAPIGWEnvironmentV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPIGWEnvironmentV1Create,
+		ReadContext:   resourceAPIGWEnvironmentV1Read,
+		UpdateContext: resourceAPIGWEnvironmentV1Update,
+		DeleteContext: resourceAPIGWEnvironmentV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(environmentNameRe, "must start with a letter and contain only letters, digits and underscores"),
+				),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getEnvironment looks up the environment in the list, as there is no API to get a single environment
+func getEnvironment(client *golangsdk.ServiceClient, id string) (*environment, error) {
+	page := 1
+	for {
+		var r golangsdk.Result
+		url := fmt.Sprintf("%s?page_size=%d&page_no=%d", client.ServiceURL("apigw", "envs"), environmentPageSize, page)
+		_, r.Err = client.Get(url, &r.Body, nil)
+		var envs environmentList
+		if err := r.ExtractInto(&envs); err != nil {
+			return nil, err
+		}
+		for _, env := range envs.Envs {
+			if env.ID == id {
+				return &env, nil
+			}
+		}
+		if len(envs.Envs) == 0 || page*environmentPageSize >= envs.Total {
+			return nil, golangsdk.ErrDefault404{}
+		}
+		page++
+	}
+}
+
+func resourceAPIGWEnvironmentV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApigwV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(apigwClientError, err)
+	}
+
+	opts := map[string]interface{}{
+		"name":   d.Get("name").(string),
+		"remark": d.Get("description").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("apigw", "envs"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	env := new(environment)
+	if err := r.ExtractInto(env); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud APIGW environment: %w", err)
+	}
+	d.SetId(env.ID)
+
+	return resourceAPIGWEnvironmentV1Read(ctx, d, meta)
+}
+
+func resourceAPIGWEnvironmentV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApigwV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(apigwClientError, err)
+	}
+
+	env, err := getEnvironment(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "APIGW environment"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", env.Name),
+		d.Set("description", env.Remark),
+		d.Set("created_at", env.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting APIGW environment fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAPIGWEnvironmentV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApigwV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(apigwClientError, err)
+	}
+
+	opts := map[string]interface{}{
+		"name":   d.Get("name").(string),
+		"remark": d.Get("description").(string),
+	}
+	_, err = client.Put(client.ServiceURL("apigw", "envs", d.Id()), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating OpenTelekomCloud APIGW environment: %w", err)
+	}
+
+	return resourceAPIGWEnvironmentV1Read(ctx, d, meta)
+}
+
+func resourceAPIGWEnvironmentV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApigwV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(apigwClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("apigw", "envs", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting APIGW environment"))
+	}
+
+	d.SetId("")
+	return nil
+}
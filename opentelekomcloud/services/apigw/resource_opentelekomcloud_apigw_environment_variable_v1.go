@@ -0,0 +1,145 @@
+package apigw
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+var variableNameRe = regexp.MustCompile(`^[A-Za-z][\w-]*$`)
+
+type environmentVariable struct {
+	ID            string `json:"id"`
+	GroupID       string `json:"group_id"`
+	EnvID         string `json:"env_id"`
+	VariableName  string `json:"variable_name"`
+	VariableValue string `json:"variable_value"`
+}
+
+func ResourceAPIGWEnvironmentVariableV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPIGWEnvironmentVariableV1Create,
+		ReadContext:   resourceAPIGWEnvironmentVariableV1Read,
+		DeleteContext: resourceAPIGWEnvironmentVariableV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 32),
+					validation.StringMatch(variableNameRe, "must start with a letter and contain only letters, digits, hyphens and underscores"),
+				),
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceAPIGWEnvironmentVariableV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApigwV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(apigwClientError, err)
+	}
+
+	opts := map[string]interface{}{
+		"group_id":       d.Get("group_id").(string),
+		"env_id":         d.Get("environment_id").(string),
+		"variable_name":  d.Get("name").(string),
+		"variable_value": d.Get("value").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("apigw", "env-variables"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	variable := new(environmentVariable)
+	if err := r.ExtractInto(variable); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud APIGW environment variable: %w", err)
+	}
+	d.SetId(variable.ID)
+
+	return resourceAPIGWEnvironmentVariableV1Read(ctx, d, meta)
+}
+
+func resourceAPIGWEnvironmentVariableV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApigwV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(apigwClientError, err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("apigw", "env-variables", d.Id()), &r.Body, nil)
+	variable := new(environmentVariable)
+	if err := r.ExtractInto(variable); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "APIGW environment variable"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("group_id", variable.GroupID),
+		d.Set("environment_id", variable.EnvID),
+		d.Set("name", variable.VariableName),
+		d.Set("value", variable.VariableValue),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting APIGW environment variable fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAPIGWEnvironmentVariableV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApigwV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(apigwClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("apigw", "env-variables", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting APIGW environment variable"))
+	}
+
+	d.SetId("")
+	return nil
+}
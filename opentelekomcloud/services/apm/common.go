@@ -0,0 +1,7 @@
+package apm
+
+import "regexp"
+
+const clientError = "error creating APM client: %w"
+
+var nameRe = regexp.MustCompile(`^[A-Za-z][\w-]{0,63}$`)
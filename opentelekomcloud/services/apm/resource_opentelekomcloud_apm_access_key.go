@@ -0,0 +1,179 @@
+package apm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type accessKey struct {
+	AK     string `json:"ak"`
+	SK     string `json:"sk"`
+	Descp  string `json:"descp"`
+	Status string `json:"status"`
+}
+
+func ResourceAPMAccessKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPMAccessKeyCreate,
+		ReadContext:   resourceAPMAccessKeyRead,
+		DeleteContext: resourceAPMAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"access_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAPMAccessKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	before, err := listAccessKeys(client)
+	if err != nil {
+		return fmterr.Errorf("error listing APM access keys: %w", err)
+	}
+
+	opts := map[string]string{"descp": d.Get("description").(string)}
+	_, err = client.Post(client.ServiceURL("apm2", "access-keys"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating APM access key: %w", err)
+	}
+
+	// the key isn't returned on creation, so it is found as the one missing in the previous list
+	after, err := listAccessKeys(client)
+	if err != nil {
+		return fmterr.Errorf("error listing APM access keys: %w", err)
+	}
+	existing := make(map[string]bool, len(before))
+	for _, key := range before {
+		existing[key.AK] = true
+	}
+	for _, key := range after {
+		if !existing[key.AK] {
+			d.SetId(key.AK)
+			break
+		}
+	}
+	if d.Id() == "" {
+		return fmterr.Errorf("error creating APM access key: new key not found")
+	}
+
+	return resourceAPMAccessKeyRead(ctx, d, meta)
+}
+
+func listAccessKeys(client *golangsdk.ServiceClient) ([]accessKey, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("apm2", "access-keys"), &r.Body, nil)
+	var keys []accessKey
+	if err := r.ExtractIntoSlicePtr(&keys, "access_ak_sk_models"); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func resourceAPMAccessKeyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	keys, err := listAccessKeys(client)
+	if err != nil {
+		return fmterr.Errorf("error listing APM access keys: %w", err)
+	}
+	var key *accessKey
+	for i := range keys {
+		if keys[i].AK == d.Id() {
+			key = &keys[i]
+			break
+		}
+	}
+	if key == nil {
+		return diag.FromErr(common.CheckDeleted(d, golangsdk.ErrDefault404{}, "APM access key"))
+	}
+
+	endpoint, err := url.Parse(client.Endpoint)
+	if err != nil {
+		return fmterr.Errorf("error parsing APM endpoint: %w", err)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("description", key.Descp),
+		d.Set("access_key", key.AK),
+		d.Set("secret_key", key.SK),
+		d.Set("status", key.Status),
+		d.Set("master_address", fmt.Sprintf("%s://%s", endpoint.Scheme, endpoint.Host)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting APM access key fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAPMAccessKeyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("apm2", "access-keys", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting APM access key"))
+	}
+
+	d.SetId("")
+	return nil
+}
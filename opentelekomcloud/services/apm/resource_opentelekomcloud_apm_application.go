@@ -0,0 +1,170 @@
+package apm
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type application struct {
+	ID          int64  `json:"id,omitempty"`
+	AppName     string `json:"app_name"`
+	DisplayName string `json:"display_name,omitempty"`
+	Descp       string `json:"descp"`
+	EpsID       string `json:"eps_id,omitempty"`
+	CreateTime  string `json:"create_time,omitempty"`
+}
+
+func ResourceAPMApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPMApplicationCreate,
+		ReadContext:   resourceAPMApplicationRead,
+		UpdateContext: resourceAPMApplicationUpdate,
+		DeleteContext: resourceAPMApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(nameRe, "must start with a letter and contain only letters, digits, underscores and hyphens, up to 64 characters"),
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationsURL(client *golangsdk.ServiceClient, action string, parts ...string) string {
+	return client.ServiceURL(append([]string{"apm2", "openapi", "cmdb", "apps", action}, parts...)...)
+}
+
+func resourceAPMApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	opts := application{
+		AppName:     d.Get("name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Descp:       d.Get("description").(string),
+		EpsID:       d.Get("enterprise_project_id").(string),
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(applicationsURL(client, "create-app"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	created := new(application)
+	if err := r.ExtractInto(created); err != nil {
+		return fmterr.Errorf("error creating APM application: %w", err)
+	}
+	d.SetId(strconv.FormatInt(created.ID, 10))
+
+	return resourceAPMApplicationRead(ctx, d, meta)
+}
+
+func resourceAPMApplicationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(applicationsURL(client, "get-app", d.Id()), &r.Body, nil)
+	app := new(application)
+	if err := r.ExtractInto(app); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "APM application"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", app.AppName),
+		d.Set("display_name", app.DisplayName),
+		d.Set("description", app.Descp),
+		d.Set("enterprise_project_id", app.EpsID),
+		d.Set("created_at", app.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting APM application fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAPMApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	opts := map[string]interface{}{
+		"display_name": d.Get("display_name").(string),
+		"descp":        d.Get("description").(string),
+	}
+	_, err = client.Put(applicationsURL(client, "update-app", d.Id()), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating APM application: %w", err)
+	}
+
+	return resourceAPMApplicationRead(ctx, d, meta)
+}
+
+func resourceAPMApplicationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	_, err = client.Delete(applicationsURL(client, "delete-app", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting APM application"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,207 @@
+package apm
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type environment struct {
+	ID         int64  `json:"id,omitempty"`
+	AppID      int64  `json:"app_id"`
+	EnvName    string `json:"env_name"`
+	EnvType    string `json:"env_type"`
+	Descp      string `json:"descp"`
+	Region     string `json:"region"`
+	CreateTime string `json:"create_time,omitempty"`
+}
+
+func ResourceAPMEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPMEnvironmentCreate,
+		ReadContext:   resourceAPMEnvironmentRead,
+		UpdateContext: resourceAPMEnvironmentUpdate,
+		DeleteContext: resourceAPMEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(nameRe, "must start with a letter and contain only letters, digits, underscores and hyphens, up to 64 characters"),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "DEV",
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DEV", "TEST", "PRE", "PROD",
+				}, false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"application_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_config": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func environmentsURL(client *golangsdk.ServiceClient, action string, parts ...string) string {
+	return client.ServiceURL(append([]string{"apm2", "openapi", "cmdb", "envs", action}, parts...)...)
+}
+
+func resourceAPMEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := config.ApmV1Client(region)
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	appID, err := strconv.ParseInt(d.Get("application_id").(string), 10, 64)
+	if err != nil {
+		return fmterr.Errorf("invalid APM application ID: %w", err)
+	}
+	opts := environment{
+		AppID:   appID,
+		EnvName: d.Get("name").(string),
+		EnvType: d.Get("type").(string),
+		Descp:   d.Get("description").(string),
+		Region:  region,
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(environmentsURL(client, "create-env"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	created := new(environment)
+	if err := r.ExtractInto(created); err != nil {
+		return fmterr.Errorf("error creating APM environment: %w", err)
+	}
+	d.SetId(strconv.FormatInt(created.ID, 10))
+
+	return resourceAPMEnvironmentRead(ctx, d, meta)
+}
+
+func resourceAPMEnvironmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(environmentsURL(client, "get-env", d.Id()), &r.Body, nil)
+	env := new(environment)
+	if err := r.ExtractInto(env); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "APM environment"))
+	}
+
+	appID := strconv.FormatInt(env.AppID, 10)
+	var appResult golangsdk.Result
+	_, appResult.Err = client.Get(applicationsURL(client, "get-app", appID), &appResult.Body, nil)
+	app := new(application)
+	if err := appResult.ExtractInto(app); err != nil {
+		return fmterr.Errorf("error reading APM application of the environment: %w", err)
+	}
+
+	// the same values are expected by the agents in the startup parameters or in `apm_agent.properties`
+	agentConfig := map[string]string{
+		"apm_application": app.AppName,
+		"apm_env":         env.EnvName,
+		"apm_env_type":    env.EnvType,
+		"apm_region":      env.Region,
+		"apm_project_id":  client.ProjectID,
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("application_id", appID),
+		d.Set("application_name", app.AppName),
+		d.Set("name", env.EnvName),
+		d.Set("type", env.EnvType),
+		d.Set("description", env.Descp),
+		d.Set("agent_config", agentConfig),
+		d.Set("created_at", env.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting APM environment fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAPMEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	opts := map[string]interface{}{
+		"descp": d.Get("description").(string),
+	}
+	_, err = client.Put(environmentsURL(client, "update-env", d.Id()), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating APM environment: %w", err)
+	}
+
+	return resourceAPMEnvironmentRead(ctx, d, meta)
+}
+
+func resourceAPMEnvironmentDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ApmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	_, err = client.Delete(environmentsURL(client, "delete-env", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting APM environment"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,142 @@
+package as
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/instances"
+)
+
+func instanceRefreshSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min_healthy_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      90,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"pause_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 3600),
+				},
+			},
+		},
+	}
+}
+
+// setGroupCapacity changes only the expected and maximum number of instances,
+// unlike `groups.Update` sending all the group parameters
+func setGroupCapacity(client *golangsdk.ServiceClient, groupID string, desire, max int) error {
+	opts := map[string]interface{}{
+		"desire_instance_number": desire,
+		"max_instance_number":    max,
+	}
+	_, err := client.Put(client.ServiceURL("scaling_group", groupID), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func waitForGroupInstances(ctx context.Context, client *golangsdk.ServiceClient, groupID string, number int, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING", "REMOVING"},
+		Target:  []string{"INSERVICE"},
+		Refresh: refreshInstancesLifeStates(client, groupID, number, true),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// refreshGroupInstances replaces instances launched with an outdated configuration in batches.
+// Every batch of new instances is launched and becomes in service before the old instances are
+// removed, so the group never has less than `desire_instance_number` instances in service.
+// The size of the batch is the part of the group allowed to exceed `min_healthy_percentage`.
+func refreshGroupInstances(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	refresh := d.Get("instance_refresh.0").(map[string]interface{})
+	minHealthy := refresh["min_healthy_percentage"].(int)
+	pause := time.Duration(refresh["pause_time"].(int)) * time.Second
+
+	configID := d.Get("scaling_configuration_id").(string)
+	desire := d.Get("desire_instance_number").(int)
+	max := d.Get("max_instance_number").(int)
+
+	instanceList, err := getInstancesInGroup(client, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+	var outdated []string
+	for _, instance := range instanceList {
+		if instance.ID != "" && instance.ConfigurationID != configID {
+			outdated = append(outdated, instance.ID)
+		}
+	}
+	if len(outdated) == 0 {
+		return nil
+	}
+
+	batchSize := desire * (100 - minHealthy) / 100
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	surgeMax := max
+	if desire+batchSize > surgeMax {
+		surgeMax = desire + batchSize
+	}
+
+	log.Printf("[DEBUG] Refreshing %d instances of ASGroup %q in batches of %d", len(outdated), d.Id(), batchSize)
+	for start := 0; start < len(outdated); start += batchSize {
+		end := start + batchSize
+		if end > len(outdated) {
+			end = len(outdated)
+		}
+		batch := outdated[start:end]
+
+		if err := setGroupCapacity(client, d.Id(), desire+len(batch), surgeMax); err != nil {
+			return fmt.Errorf("error launching new instances: %s", err)
+		}
+		if err := waitForGroupInstances(ctx, client, d.Id(), desire+len(batch), timeout); err != nil {
+			return fmt.Errorf("error waiting for new instances to become inservice: %s", err)
+		}
+
+		if err := instances.BatchDelete(client, d.Id(), batch, "yes").ExtractErr(); err != nil {
+			return fmt.Errorf("error removing outdated instances %v: %s", batch, err)
+		}
+		// the removal decreases the expected number by itself, this just makes sure no replacement is launched
+		if err := setGroupCapacity(client, d.Id(), desire, surgeMax); err != nil {
+			return fmt.Errorf("error restoring expected number of instances: %s", err)
+		}
+		if err := waitForGroupInstances(ctx, client, d.Id(), desire, timeout); err != nil {
+			return fmt.Errorf("error waiting for outdated instances to be removed: %s", err)
+		}
+
+		if pause > 0 && end < len(outdated) {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(pause):
+			}
+		}
+	}
+
+	if surgeMax != max {
+		if err := setGroupCapacity(client, d.Id(), desire, max); err != nil {
+			return fmt.Errorf("error restoring maximum number of instances: %s", err)
+		}
+	}
+	return nil
+}
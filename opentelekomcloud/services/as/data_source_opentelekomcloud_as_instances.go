@@ -0,0 +1,139 @@
+package as
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceASInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceASInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"lifecycle_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"INSERVICE", "PENDING", "REMOVING", "PENDING_WAIT", "REMOVING_WAIT",
+				}, false),
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"INITIALIZING", "NORMAL", "ERROR",
+				}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scaling_configuration_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scaling_configuration_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceASInstancesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	listOpts := instances.ListOpts{
+		LifeCycleStatus: d.Get("lifecycle_state").(string),
+		HealthStatus:    d.Get("health_status").(string),
+	}
+	instanceList, err := getInstancesInGroup(client, groupID, listOpts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, instance := range instanceList {
+		// instances being launched have no ID yet
+		if instance.ID == "" {
+			continue
+		}
+		ids = append(ids, instance.ID)
+		result = append(result, map[string]interface{}{
+			"id":                         instance.ID,
+			"name":                       instance.Name,
+			"lifecycle_state":            instance.LifeCycleStatus,
+			"health_status":              instance.HealthStatus,
+			"scaling_configuration_id":   instance.ConfigurationID,
+			"scaling_configuration_name": instance.ConfigurationName,
+			"created_at":                 instance.CreateTime,
+		})
+	}
+	log.Printf("[DEBUG] Found %d instances in ASGroup %q", len(ids), groupID)
+
+	d.SetId(hashcode.Strings(append([]string{groupID}, ids...)))
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("instances", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting AS instances fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+package as
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type bandwidthScheduledPolicy struct {
+	LaunchTime      string `json:"launch_time"`
+	RecurrenceType  string `json:"recurrence_type,omitempty"`
+	RecurrenceValue string `json:"recurrence_value,omitempty"`
+	StartTime       string `json:"start_time,omitempty"`
+	EndTime         string `json:"end_time,omitempty"`
+}
+
+type bandwidthPolicyAction struct {
+	Operation string `json:"operation,omitempty"`
+	Size      int    `json:"size,omitempty"`
+	Limits    int    `json:"limits,omitempty"`
+}
+
+type bandwidthPolicy struct {
+	ID              string                   `json:"scaling_policy_id"`
+	Name            string                   `json:"scaling_policy_name"`
+	ResourceID      string                   `json:"scaling_resource_id"`
+	ResourceType    string                   `json:"scaling_resource_type"`
+	Status          string                   `json:"policy_status"`
+	Type            string                   `json:"scaling_policy_type"`
+	AlarmID         string                   `json:"alarm_id"`
+	ScheduledPolicy bandwidthScheduledPolicy `json:"scheduled_policy"`
+	Action          bandwidthPolicyAction    `json:"scaling_policy_action"`
+	CoolDownTime    int                      `json:"cool_down_time"`
+	Description     string                   `json:"description"`
+}
+
+func ResourceASBandwidthPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceASBandwidthPolicyCreate,
+		ReadContext:   resourceASBandwidthPolicyRead,
+		UpdateContext: resourceASBandwidthPolicyUpdate,
+		DeleteContext: resourceASBandwidthPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_policy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceASPolicyValidateName,
+			},
+			"bandwidth_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scaling_policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceASPolicyValidatePolicyType,
+			},
+			"alarm_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"scheduled_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"recurrence_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: resourceASPolicyValidateRecurrenceType,
+						},
+						"recurrence_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"scaling_policy_action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "ADD",
+							ValidateFunc: validation.StringInSlice([]string{"ADD", "REDUCE", "SET"}, false),
+						},
+						"size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 300),
+						},
+						"limits": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 2000),
+						},
+					},
+				},
+			},
+			"cool_down_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(0, 86400),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildBandwidthPolicyOpts(d *schema.ResourceData) map[string]interface{} {
+	opts := map[string]interface{}{
+		"scaling_policy_name": d.Get("scaling_policy_name").(string),
+		"scaling_policy_type": d.Get("scaling_policy_type").(string),
+		"cool_down_time":      d.Get("cool_down_time").(int),
+		"description":         d.Get("description").(string),
+	}
+	if alarmID := d.Get("alarm_id").(string); alarmID != "" {
+		opts["alarm_id"] = alarmID
+	}
+	if v, ok := d.GetOk("scheduled_policy"); ok {
+		scheduled := v.([]interface{})[0].(map[string]interface{})
+		opts["scheduled_policy"] = bandwidthScheduledPolicy{
+			LaunchTime:      scheduled["launch_time"].(string),
+			RecurrenceType:  scheduled["recurrence_type"].(string),
+			RecurrenceValue: scheduled["recurrence_value"].(string),
+			StartTime:       scheduled["start_time"].(string),
+			EndTime:         scheduled["end_time"].(string),
+		}
+	}
+	action := d.Get("scaling_policy_action").([]interface{})[0].(map[string]interface{})
+	opts["scaling_policy_action"] = bandwidthPolicyAction{
+		Operation: action["operation"].(string),
+		Size:      action["size"].(int),
+		Limits:    action["limits"].(int),
+	}
+	return opts
+}
+
+func resourceASBandwidthPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud autoscaling v2 client: %s", err)
+	}
+	if err := validateParameters(d); err != nil {
+		return fmterr.Errorf("error creating AS bandwidth policy: %s", err)
+	}
+
+	opts := buildBandwidthPolicyOpts(d)
+	opts["scaling_resource_id"] = d.Get("bandwidth_id").(string)
+	opts["scaling_resource_type"] = "BANDWIDTH"
+	log.Printf("[DEBUG] Create AS bandwidth policy Options: %#v", opts)
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("scaling_policy"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	var created struct {
+		ID string `json:"scaling_policy_id"`
+	}
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error creating AS bandwidth policy: %s", err)
+	}
+	d.SetId(created.ID)
+
+	return resourceASBandwidthPolicyRead(ctx, d, meta)
+}
+
+func resourceASBandwidthPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud autoscaling v2 client: %s", err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("scaling_policy", d.Id()), &r.Body, nil)
+	policy := new(bandwidthPolicy)
+	if err := r.ExtractIntoStructPtr(policy, "scaling_policy"); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "AS bandwidth policy"))
+	}
+
+	var scheduledPolicy []map[string]interface{}
+	if policy.ScheduledPolicy.LaunchTime != "" {
+		scheduledPolicy = append(scheduledPolicy, map[string]interface{}{
+			"launch_time":      policy.ScheduledPolicy.LaunchTime,
+			"recurrence_type":  policy.ScheduledPolicy.RecurrenceType,
+			"recurrence_value": policy.ScheduledPolicy.RecurrenceValue,
+			"start_time":       policy.ScheduledPolicy.StartTime,
+			"end_time":         policy.ScheduledPolicy.EndTime,
+		})
+	}
+	action := []map[string]interface{}{
+		{
+			"operation": policy.Action.Operation,
+			"size":      policy.Action.Size,
+			"limits":    policy.Action.Limits,
+		},
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("scaling_policy_name", policy.Name),
+		d.Set("bandwidth_id", policy.ResourceID),
+		d.Set("scaling_policy_type", policy.Type),
+		d.Set("alarm_id", policy.AlarmID),
+		d.Set("scheduled_policy", scheduledPolicy),
+		d.Set("scaling_policy_action", action),
+		d.Set("cool_down_time", policy.CoolDownTime),
+		d.Set("description", policy.Description),
+		d.Set("status", policy.Status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting AS bandwidth policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceASBandwidthPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud autoscaling v2 client: %s", err)
+	}
+	if err := validateParameters(d); err != nil {
+		return fmterr.Errorf("error updating AS bandwidth policy: %s", err)
+	}
+
+	opts := buildBandwidthPolicyOpts(d)
+	log.Printf("[DEBUG] Update AS bandwidth policy Options: %#v", opts)
+	_, err = client.Put(client.ServiceURL("scaling_policy", d.Id()), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating AS bandwidth policy %q: %s", d.Id(), err)
+	}
+
+	return resourceASBandwidthPolicyRead(ctx, d, meta)
+}
+
+func resourceASBandwidthPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud autoscaling v2 client: %s", err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("scaling_policy", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting AS bandwidth policy"))
+	}
+
+	d.SetId("")
+	return nil
+}
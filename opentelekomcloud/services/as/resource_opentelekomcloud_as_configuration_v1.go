@@ -167,7 +167,7 @@ func ResourceASConfiguration() *schema.Resource {
 																Type:     schema.TypeString,
 																Required: true,
 																ValidateFunc: validation.StringInSlice([]string{
-																	"traffic",
+																	"traffic", "bandwidth",
 																}, false),
 															},
 														},
@@ -189,6 +189,10 @@ func ResourceASConfiguration() *schema.Resource {
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							Set:      schema.HashString,
 						},
+						"agency_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -196,6 +200,11 @@ func ResourceASConfiguration() *schema.Resource {
 	}
 }
 
+const (
+	agencyMetadataKey  = "agency_name"
+	diskKmsMetadataKey = "__system__cmkid"
+)
+
 func getDisk(diskMeta []interface{}) []configurations.DiskOpts {
 	var diskOptsList []configurations.DiskOpts
 
@@ -212,7 +221,7 @@ func getDisk(diskMeta []interface{}) []configurations.DiskOpts {
 		kmsID := disk["kms_id"].(string)
 		if kmsID != "" {
 			meta := make(map[string]string)
-			meta["__system__cmkid"] = kmsID
+			meta[diskKmsMetadataKey] = kmsID
 			meta["__system__encrypted"] = "1"
 			diskOpts.Metadata = meta
 		}
@@ -222,6 +231,20 @@ func getDisk(diskMeta []interface{}) []configurations.DiskOpts {
 	return diskOptsList
 }
 
+func flattenDisks(disks []configurations.Disk) []map[string]interface{} {
+	diskList := make([]map[string]interface{}, len(disks))
+	for i, disk := range disks {
+		kmsID, _ := disk.Metadata[diskKmsMetadataKey].(string)
+		diskList[i] = map[string]interface{}{
+			"size":        disk.Size,
+			"volume_type": disk.VolumeType,
+			"disk_type":   disk.DiskType,
+			"kms_id":      kmsID,
+		}
+	}
+	return diskList
+}
+
 func getPersonality(personalityMeta []interface{}) []configurations.PersonalityOpts {
 	var personalityOptsList []configurations.PersonalityOpts
 
@@ -255,6 +278,19 @@ func getPublicIps(publicIpMeta map[string]interface{}) *configurations.PublicIpO
 	return publicIpOpts
 }
 
+func flattenPublicIp(publicIp configurations.PublicIp) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"eip": []map[string]interface{}{{
+			"ip_type": publicIp.Eip.Type,
+			"bandwidth": []map[string]interface{}{{
+				"size":          publicIp.Eip.Bandwidth.Size,
+				"share_type":    publicIp.Eip.Bandwidth.ShareType,
+				"charging_mode": publicIp.Eip.Bandwidth.ChargingMode,
+			}},
+		}},
+	}}
+}
+
 func getSecurityGroups(d *schema.ResourceData) []configurations.SecurityGroupOpts {
 	rawSecGroups := d.Get("instance_config.0.security_groups").(*schema.Set).List()
 	secGroups := make([]configurations.SecurityGroupOpts, len(rawSecGroups))
@@ -271,6 +307,12 @@ func getInstanceConfig(d *schema.ResourceData) configurations.InstanceConfigOpts
 	disksData := configDataMap["disk"].([]interface{})
 	personalityData := configDataMap["personality"].([]interface{})
 
+	metadata := configDataMap["metadata"].(map[string]interface{})
+	// the agency is passed to the instances as system metadata
+	if agency := configDataMap["agency_name"].(string); agency != "" {
+		metadata[agencyMetadataKey] = agency
+	}
+
 	instanceConfigOpts := configurations.InstanceConfigOpts{
 		ID:             configDataMap["instance_id"].(string),
 		FlavorRef:      configDataMap["flavor"].(string),
@@ -279,7 +321,7 @@ func getInstanceConfig(d *schema.ResourceData) configurations.InstanceConfigOpts
 		SSHKey:         configDataMap["key_name"].(string),
 		Personality:    getPersonality(personalityData),
 		UserData:       []byte(configDataMap["user_data"].(string)),
-		Metadata:       configDataMap["metadata"].(map[string]interface{}),
+		Metadata:       metadata,
 		SecurityGroups: getSecurityGroups(d),
 	}
 
@@ -350,6 +392,15 @@ func resourceASConfigurationRead(_ context.Context, d *schema.ResourceData, meta
 		secGrpIDs = append(secGrpIDs, sg.ID)
 	}
 	instanceConfigInfo["security_groups"] = secGrpIDs
+	if agency, ok := asConfig.InstanceConfig.Metadata[agencyMetadataKey].(string); ok {
+		instanceConfigInfo["agency_name"] = agency
+	}
+	if asConfig.InstanceConfig.InstanceID == "" {
+		instanceConfigInfo["disk"] = flattenDisks(asConfig.InstanceConfig.Disk)
+	}
+	if asConfig.InstanceConfig.PublicIp.Eip.Type != "" {
+		instanceConfigInfo["public_ip"] = flattenPublicIp(asConfig.InstanceConfig.PublicIp)
+	}
 	instanceConfigList := []interface{}{instanceConfigInfo}
 
 	if err := d.Set("instance_config", instanceConfigList); err != nil {
@@ -422,6 +473,9 @@ func validateDiskSize(_ context.Context, d *schema.ResourceDiff, _ interface{})
 				mErr = multierror.Append(mErr, fmt.Errorf("for data disk size should be [10, 32768]"))
 			}
 		}
+		if diskType == "SYS" && disk["kms_id"].(string) != "" {
+			mErr = multierror.Append(mErr, fmt.Errorf("kms_id can be set for data disks only"))
+		}
 	}
 	if mErr.ErrorOrNil() != nil {
 		return mErr
@@ -31,6 +31,7 @@ func ResourceASGroup() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -79,8 +80,10 @@ func ResourceASGroup() *schema.Resource {
 				Description:  "The system supports the binding of up to three ELB listeners, the IDs of which are separated using a comma.",
 			},
 			"lbaas_listeners": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      6,
+				ConflictsWith: []string{"lb_listener_id"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"pool_id": {
@@ -88,13 +91,19 @@ func ResourceASGroup() *schema.Resource {
 							Required: true,
 						},
 						"protocol_port": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
 						},
 						"weight": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  1,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
 						},
 					},
 				},
@@ -196,7 +205,8 @@ func ResourceASGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": common.TagsSchema(),
+			"instance_refresh": instanceRefreshSchema(),
+			"tags":             common.TagsSchema(),
 		},
 	}
 }
@@ -245,10 +255,11 @@ func getAllSecurityGroups(d *schema.ResourceData) []groups.SecurityGroupOpts {
 	return Groups
 }
 
-func getAllLBaaSListeners(d *schema.ResourceData) []groups.LBaaSListenerOpts {
+func getAllLBaaSListeners(d *schema.ResourceData) ([]groups.LBaaSListenerOpts, error) {
 	var asListeners []groups.LBaaSListenerOpts
 
 	listeners := d.Get("lbaas_listeners").([]interface{})
+	registered := make(map[string]bool, len(listeners))
 	for _, v := range listeners {
 		listener := v.(map[string]interface{})
 		s := groups.LBaaSListenerOpts{
@@ -256,11 +267,34 @@ func getAllLBaaSListeners(d *schema.ResourceData) []groups.LBaaSListenerOpts {
 			ProtocolPort: listener["protocol_port"].(int),
 			Weight:       listener["weight"].(int),
 		}
+		key := fmt.Sprintf("%s:%d", s.PoolID, s.ProtocolPort)
+		if registered[key] {
+			return nil, fmt.Errorf("pool %s with protocol port %d is used in `lbaas_listeners` more than once", s.PoolID, s.ProtocolPort)
+		}
+		registered[key] = true
 		asListeners = append(asListeners, s)
 	}
 
 	log.Printf("[DEBUG] getAllLBaaSListeners: %#v", asListeners)
-	return asListeners
+	return asListeners, nil
+}
+
+// groupUpdateOpts allows to detach all the LBaaS pools of the group,
+// which is impossible with `groups.UpdateOpts` as empty `lbaas_listeners` are omitted.
+type groupUpdateOpts struct {
+	groups.UpdateOpts
+	ClearLBaaSListeners bool
+}
+
+func (opts groupUpdateOpts) ToGroupUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToGroupUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.ClearLBaaSListeners {
+		b["lbaas_listeners"] = []groups.LBaaSListenerOpts{}
+	}
+	return b, nil
 }
 
 func getInstancesInGroup(client *golangsdk.ServiceClient, groupID string, opts instances.ListOptsBuilder) ([]instances.Instance, error) {
@@ -357,7 +391,10 @@ func resourceASGroupCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	networks := getAllNetworks(d)
 	secGroups := getAllSecurityGroups(d)
-	asgLBaaSListeners := getAllLBaaSListeners(d)
+	asgLBaaSListeners, err := getAllLBaaSListeners(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	isDeletePublicIp := d.Get("delete_publicip").(bool)
 
 	log.Printf("[DEBUG] available_zones: %#v", d.Get("available_zones"))
@@ -466,18 +503,18 @@ func resourceASGroupRead(_ context.Context, d *schema.ResourceData, meta interfa
 			return diag.FromErr(err)
 		}
 	}
-	if len(asGroup.LBaaSListeners) >= 1 {
-		listeners := make([]map[string]interface{}, len(asGroup.LBaaSListeners))
-		for i, listener := range asGroup.LBaaSListeners {
-			listeners[i] = make(map[string]interface{})
-			listeners[i]["pool_id"] = listener.PoolID
-			listeners[i]["protocol_port"] = listener.ProtocolPort
-			listeners[i]["weight"] = listener.Weight
-		}
-		if err := d.Set("lbaas_listeners", listeners); err != nil {
-			return diag.FromErr(err)
+	listeners := make([]map[string]interface{}, len(asGroup.LBaaSListeners))
+	for i, listener := range asGroup.LBaaSListeners {
+		listeners[i] = map[string]interface{}{
+			"pool_id":       listener.PoolID,
+			"protocol_port": listener.ProtocolPort,
+			"weight":        listener.Weight,
+			"listener_id":   listener.ListenerID,
 		}
 	}
+	if err := d.Set("lbaas_listeners", listeners); err != nil {
+		return diag.FromErr(err)
+	}
 
 	var opts instances.ListOptsBuilder
 	instancesList, err := getInstancesInGroup(client, d.Id(), opts)
@@ -531,7 +568,10 @@ func resourceASGroupUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	secGroups := getAllSecurityGroups(d)
 	isDeletePublicIp := d.Get("delete_publicip").(bool)
 
-	asgLBaaSListeners := getAllLBaaSListeners(d)
+	asgLBaaSListeners, err := getAllLBaaSListeners(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	updateOpts := groups.UpdateOpts{
 		Name:                      d.Get("scaling_group_name").(string),
 		ConfigurationID:           d.Get("scaling_configuration_id").(string),
@@ -551,11 +591,20 @@ func resourceASGroupUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		Notifications:             getAllNotifications(d),
 		IsDeletePublicip:          &isDeletePublicIp,
 	}
-	asGroupID, err := groups.Update(client, d.Id(), updateOpts).Extract()
+	asGroupID, err := groups.Update(client, d.Id(), groupUpdateOpts{
+		UpdateOpts:          updateOpts,
+		ClearLBaaSListeners: d.HasChange("lbaas_listeners") && len(asgLBaaSListeners) == 0,
+	}).Extract()
 	if err != nil {
 		return fmterr.Errorf("error updating ASGroup %q: %s", asGroupID, err)
 	}
 
+	if d.HasChange("scaling_configuration_id") && len(d.Get("instance_refresh").([]interface{})) > 0 {
+		if err := refreshGroupInstances(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error refreshing instances of ASGroup %q: %s", d.Id(), err)
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
 		if err := common.UpdateResourceTags(client, d, "scaling_group_tag", d.Id()); err != nil {
@@ -0,0 +1,316 @@
+package as
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type plannedTaskPolicy struct {
+	LaunchTime      string `json:"launch_time"`
+	RecurrenceType  string `json:"recurrence_type,omitempty"`
+	RecurrenceValue string `json:"recurrence_value,omitempty"`
+	StartTime       string `json:"start_time,omitempty"`
+	EndTime         string `json:"end_time,omitempty"`
+}
+
+// plannedTaskCapacity uses pointers, as `0` is a valid capacity value
+type plannedTaskCapacity struct {
+	Desire *int `json:"desire,omitempty"`
+	Min    *int `json:"min,omitempty"`
+	Max    *int `json:"max,omitempty"`
+}
+
+type plannedTask struct {
+	ID              string              `json:"task_id,omitempty"`
+	Name            string              `json:"name"`
+	ScheduledPolicy plannedTaskPolicy   `json:"scheduled_policy"`
+	InstanceNumber  plannedTaskCapacity `json:"instance_number"`
+	CreatedAt       string              `json:"create_time,omitempty"`
+}
+
+func ResourceASPlannedTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceASPlannedTaskCreate,
+		ReadContext:   resourceASPlannedTaskRead,
+		UpdateContext: resourceASPlannedTaskUpdate,
+		DeleteContext: resourceASPlannedTaskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceASPlannedTaskImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceASPolicyValidateName,
+			},
+			"scheduled_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"recurrence_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: resourceASPolicyValidateRecurrenceType,
+						},
+						"recurrence_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"instance_number": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desire": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"min": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"max": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func plannedTasksURL(client *golangsdk.ServiceClient, groupID string, parts ...string) string {
+	return client.ServiceURL(append([]string{"scaling-groups", groupID, "scheduled-tasks"}, parts...)...)
+}
+
+// capacityValue converts the optional capacity, given as string to distinguish `0` from an unset value
+func capacityValue(raw interface{}) (*int, error) {
+	value := raw.(string)
+	if value == "" {
+		return nil, nil
+	}
+	var number int
+	if _, err := fmt.Sscan(value, &number); err != nil || number < 0 {
+		return nil, fmt.Errorf("instance number must be a non-negative integer, got %q", value)
+	}
+	return &number, nil
+}
+
+func buildPlannedTask(d *schema.ResourceData) (*plannedTask, error) {
+	policy := d.Get("scheduled_policy.0").(map[string]interface{})
+	task := &plannedTask{
+		Name: d.Get("name").(string),
+		ScheduledPolicy: plannedTaskPolicy{
+			LaunchTime:      policy["launch_time"].(string),
+			RecurrenceType:  policy["recurrence_type"].(string),
+			RecurrenceValue: policy["recurrence_value"].(string),
+			StartTime:       policy["start_time"].(string),
+			EndTime:         policy["end_time"].(string),
+		},
+	}
+	if task.ScheduledPolicy.RecurrenceType != "" && task.ScheduledPolicy.EndTime == "" {
+		return nil, fmt.Errorf("end_time should be set together with recurrence_type")
+	}
+
+	capacity := d.Get("instance_number.0").(map[string]interface{})
+	var err error
+	if task.InstanceNumber.Desire, err = capacityValue(capacity["desire"]); err != nil {
+		return nil, err
+	}
+	if task.InstanceNumber.Min, err = capacityValue(capacity["min"]); err != nil {
+		return nil, err
+	}
+	if task.InstanceNumber.Max, err = capacityValue(capacity["max"]); err != nil {
+		return nil, err
+	}
+	if task.InstanceNumber.Desire == nil && task.InstanceNumber.Min == nil && task.InstanceNumber.Max == nil {
+		return nil, fmt.Errorf("at least one of desire, min and max should be set in instance_number")
+	}
+	return task, nil
+}
+
+func resourceASPlannedTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+
+	task, err := buildPlannedTask(d)
+	if err != nil {
+		return fmterr.Errorf("error creating AS planned task: %s", err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(plannedTasksURL(client, d.Get("scaling_group_id").(string)), task, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	created := new(plannedTask)
+	if err := r.ExtractInto(created); err != nil {
+		return fmterr.Errorf("error creating AS planned task: %s", err)
+	}
+	d.SetId(created.ID)
+
+	return resourceASPlannedTaskRead(ctx, d, meta)
+}
+
+// getPlannedTask looks up the task in the group task list, as there is no API to get a single task
+func getPlannedTask(client *golangsdk.ServiceClient, groupID, id string) (*plannedTask, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(plannedTasksURL(client, groupID), &r.Body, nil)
+	var tasks []plannedTask
+	if err := r.ExtractIntoSlicePtr(&tasks, "scheduled_tasks"); err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if task.ID == id {
+			return &task, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func flattenCapacity(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(*value)
+}
+
+func resourceASPlannedTaskRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+
+	task, err := getPlannedTask(client, d.Get("scaling_group_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "AS planned task"))
+	}
+
+	policy := []map[string]interface{}{{
+		"launch_time":      task.ScheduledPolicy.LaunchTime,
+		"recurrence_type":  task.ScheduledPolicy.RecurrenceType,
+		"recurrence_value": task.ScheduledPolicy.RecurrenceValue,
+		"start_time":       task.ScheduledPolicy.StartTime,
+		"end_time":         task.ScheduledPolicy.EndTime,
+	}}
+	capacity := []map[string]interface{}{{
+		"desire": flattenCapacity(task.InstanceNumber.Desire),
+		"min":    flattenCapacity(task.InstanceNumber.Min),
+		"max":    flattenCapacity(task.InstanceNumber.Max),
+	}}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", task.Name),
+		d.Set("scheduled_policy", policy),
+		d.Set("instance_number", capacity),
+		d.Set("created_at", task.CreatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting AS planned task fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceASPlannedTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+
+	task, err := buildPlannedTask(d)
+	if err != nil {
+		return fmterr.Errorf("error updating AS planned task: %s", err)
+	}
+
+	_, err = client.Put(plannedTasksURL(client, d.Get("scaling_group_id").(string), d.Id()), task, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating AS planned task: %s", err)
+	}
+
+	return resourceASPlannedTaskRead(ctx, d, meta)
+}
+
+func resourceASPlannedTaskDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+
+	_, err = client.Delete(plannedTasksURL(client, d.Get("scaling_group_id").(string), d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting AS planned task"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceASPlannedTaskImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for AS planned task. Format must be <scaling group id>/<task id>")
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("scaling_group_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
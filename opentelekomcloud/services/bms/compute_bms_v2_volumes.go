@@ -0,0 +1,121 @@
+package bms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/volumeattach"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func resourceBmsVolumeAttachedHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(m["volume_id"].(string))
+}
+
+func getBmsVolumeAttachments(client *golangsdk.ServiceClient, serverID string) ([]volumeattach.VolumeAttachment, error) {
+	pages, err := volumeattach.List(client, serverID).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return volumeattach.ExtractVolumeAttachments(pages)
+}
+
+// flattenBmsVolumesAttached returns attachments of the volumes managed by `volume_attached`,
+// volumes attached on creation with `block_device` or by other resources are skipped
+func flattenBmsVolumesAttached(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]map[string]interface{}, error) {
+	attachments, err := getBmsVolumeAttachments(client, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error listing volume attachments of bms server %s: %s", d.Id(), err)
+	}
+
+	managed := make(map[string]bool)
+	for _, v := range d.Get("volume_attached").(*schema.Set).List() {
+		managed[v.(map[string]interface{})["volume_id"].(string)] = true
+	}
+
+	var volumes []map[string]interface{}
+	for _, attachment := range attachments {
+		if !managed[attachment.VolumeID] {
+			continue
+		}
+		volumes = append(volumes, map[string]interface{}{
+			"volume_id": attachment.VolumeID,
+			"device":    attachment.Device,
+		})
+	}
+	return volumes, nil
+}
+
+func attachBmsVolumes(ctx context.Context, client *golangsdk.ServiceClient, serverID string, volumes []interface{}, timeout time.Duration) error {
+	for _, v := range volumes {
+		volumeID := v.(map[string]interface{})["volume_id"].(string)
+		log.Printf("[DEBUG] Attaching volume %s to bms server %s", volumeID, serverID)
+		attachment, err := volumeattach.Create(client, serverID, volumeattach.CreateOpts{
+			VolumeID: volumeID,
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("error attaching volume %s to bms server %s: %s", volumeID, serverID, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"DETACHED"},
+			Target:     []string{"ATTACHED"},
+			Refresh:    bmsVolumeAttachmentRefreshFunc(client, serverID, attachment.ID),
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for volume %s to be attached: %s", volumeID, err)
+		}
+	}
+	return nil
+}
+
+func detachBmsVolumes(ctx context.Context, client *golangsdk.ServiceClient, serverID string, volumes []interface{}, timeout time.Duration) error {
+	for _, v := range volumes {
+		volumeID := v.(map[string]interface{})["volume_id"].(string)
+		log.Printf("[DEBUG] Detaching volume %s from bms server %s", volumeID, serverID)
+		// attachment ID is the same as volume ID
+		err := volumeattach.Delete(client, serverID, volumeID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error detaching volume %s from bms server %s: %s", volumeID, serverID, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"ATTACHED"},
+			Target:     []string{"DETACHED"},
+			Refresh:    bmsVolumeAttachmentRefreshFunc(client, serverID, volumeID),
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for volume %s to be detached: %s", volumeID, err)
+		}
+	}
+	return nil
+}
+
+func bmsVolumeAttachmentRefreshFunc(client *golangsdk.ServiceClient, serverID, attachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachment, err := volumeattach.Get(client, serverID, attachmentID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return attachmentID, "DETACHED", nil
+			}
+			return nil, "", err
+		}
+		return attachment, "ATTACHED", nil
+	}
+}
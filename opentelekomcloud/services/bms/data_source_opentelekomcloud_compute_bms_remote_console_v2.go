@@ -0,0 +1,102 @@
+package bms
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// remoteConsoleTypes maps remote console protocols to the supported console types
+var remoteConsoleTypes = map[string]string{
+	"serial": "serial",
+	"vnc":    "novnc",
+}
+
+// DataSourceBMSRemoteConsoleV2 returns the remote login URL of the bare metal server
+func DataSourceBMSRemoteConsoleV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBMSRemoteConsoleV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "serial",
+				ValidateFunc: validation.StringInSlice([]string{"serial", "vnc"}, false),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+type remoteConsole struct {
+	Protocol string `json:"protocol"`
+	Type     string `json:"type"`
+	URL      string `json:"url"`
+}
+
+func dataSourceBMSRemoteConsoleV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	computeClient, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	serverID := d.Get("server_id").(string)
+	protocol := d.Get("protocol").(string)
+	consoleOpts := map[string]interface{}{
+		"remote_console": map[string]interface{}{
+			"protocol": protocol,
+			"type":     remoteConsoleTypes[protocol],
+		},
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = computeClient.Post(computeClient.ServiceURL("servers", serverID, "remote-consoles"), consoleOpts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+		// the remote consoles API requires microversion 2.6 or newer
+		MoreHeaders: map[string]string{"X-OpenStack-Nova-API-Version": "2.26"},
+	})
+	var console remoteConsole
+	if err := r.ExtractIntoStructPtr(&console, "remote_console"); err != nil {
+		return fmterr.Errorf("error getting remote console of bms server %s: %s", serverID, err)
+	}
+	log.Printf("[DEBUG] Retrieved %s remote console of bms server %s", console.Type, serverID)
+
+	d.SetId(serverID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("type", console.Type),
+		d.Set("url", console.URL),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting remote console fields: %s", err)
+	}
+
+	return nil
+}
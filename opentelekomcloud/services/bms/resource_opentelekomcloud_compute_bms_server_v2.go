@@ -192,6 +192,23 @@ func ResourceComputeBMSInstanceV2() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: common.ValidateTags,
 			},
+			"volume_attached": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"volume_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"device": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Set: resourceBmsVolumeAttachedHash,
+			},
 			"stop_before_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -405,10 +422,15 @@ func resourceComputeBMSInstanceV2Create(ctx context.Context, d *schema.ResourceD
 		log.Printf("[DEBUG] Setting tags: %v", tagmap)
 		err = ecs.SetTagForInstance(d, meta, server.ID, tagmap)
 		if err != nil {
-			log.Printf("[WARN] Error setting tags of bms server:%s, err=%s", server.ID, err)
+			return fmterr.Errorf("error setting tags of bms server %s: %s", server.ID, err)
 		}
 	}
 
+	volumes := d.Get("volume_attached").(*schema.Set).List()
+	if err := attachBmsVolumes(ctx, computeClient, d.Id(), volumes, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceComputeBMSInstanceV2Read(ctx, d, meta)
 }
 
@@ -490,6 +512,30 @@ func resourceComputeBMSInstanceV2Read(_ context.Context, d *schema.ResourceData,
 	d.Set("user_id", server.UserID)
 	d.Set("region", config.GetRegion(d))
 
+	volumes, err := flattenBmsVolumesAttached(computeClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("volume_attached", volumes); err != nil {
+		return fmterr.Errorf("error setting volume_attached: %s", err)
+	}
+
+	computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud compute v1 client: %s", err)
+	}
+	resourceTags, err := ecstags.Get(computeV1Client, d.Id()).Extract()
+	if err != nil {
+		return fmterr.Errorf("error fetching OpenTelekomCloud bms server tags: %s", err)
+	}
+	tagmap := make(map[string]string)
+	for _, tag := range resourceTags.Tags {
+		tagmap[tag.Key] = tag.Value
+	}
+	if err := d.Set("tags", tagmap); err != nil {
+		return fmterr.Errorf("error saving tags for OpenTelekomCloud bms server (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -678,6 +724,21 @@ func resourceComputeBMSInstanceV2Update(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChange("volume_attached") {
+		oldVolumesRaw, newVolumesRaw := d.GetChange("volume_attached")
+		oldVolumes := oldVolumesRaw.(*schema.Set)
+		newVolumes := newVolumesRaw.(*schema.Set)
+
+		volumesToDetach := oldVolumes.Difference(newVolumes).List()
+		if err := detachBmsVolumes(ctx, computeClient, d.Id(), volumesToDetach, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+		volumesToAttach := newVolumes.Difference(oldVolumes).List()
+		if err := attachBmsVolumes(ctx, computeClient, d.Id(), volumesToAttach, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("flavor_id") || d.HasChange("flavor_name") {
 		var newFlavorId string
 		var err error
@@ -70,8 +70,10 @@ func ResourceCBRPolicyV3() *schema.Resource {
 							ValidateFunc: validation.IntBetween(-1, 99999),
 						},
 						"timezone": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateTimezone,
+							DiffSuppressFunc: suppressEquivalentTimezone,
 						},
 						"week_backups": {
 							Type:         schema.TypeInt,
@@ -85,6 +87,18 @@ func ResourceCBRPolicyV3() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.IntBetween(0, 100),
 						},
+						"destination_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination_project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enable_acceleration": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -99,8 +113,11 @@ func ResourceCBRPolicyV3() *schema.Resource {
 			"trigger_pattern": {
 				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 24,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateFunc:     validateTriggerPattern,
+					DiffSuppressFunc: suppressEquivalentTriggerPattern,
 				},
 			},
 		},
@@ -111,6 +128,8 @@ func resourceCBRPolicyV3OpDefinition(d *schema.ResourceData) *policies.PolicyODC
 	opDefinitionRaw := d.Get("operation_definition").([]interface{})
 	if len(opDefinitionRaw) == 1 {
 		opDefinition := opDefinitionRaw[0].(map[string]interface{})
+		// timezone is validated already
+		timezone, _ := normalizeTimezone(opDefinition["timezone"].(string))
 		return &policies.PolicyODCreate{
 			DailyBackups:          opDefinition["day_backups"].(int),
 			WeekBackups:           opDefinition["week_backups"].(int),
@@ -118,7 +137,7 @@ func resourceCBRPolicyV3OpDefinition(d *schema.ResourceData) *policies.PolicyODC
 			MonthBackups:          opDefinition["month_backups"].(int),
 			MaxBackups:            opDefinition["max_backups"].(int),
 			RetentionDurationDays: opDefinition["retention_duration_days"].(int),
-			Timezone:              opDefinition["timezone"].(string),
+			Timezone:              timezone,
 		}
 	}
 	return &policies.PolicyODCreate{
@@ -126,11 +145,53 @@ func resourceCBRPolicyV3OpDefinition(d *schema.ResourceData) *policies.PolicyODC
 	}
 }
 
+// policyReplicationDefinition contains the replication parameters of the operation definition,
+// missing in policies.PolicyODCreate
+type policyReplicationDefinition struct {
+	DestinationRegion    string `json:"destination_region,omitempty"`
+	DestinationProjectID string `json:"destination_project_id,omitempty"`
+	EnableAcceleration   bool   `json:"enable_acceleration,omitempty"`
+}
+
+func resourceCBRPolicyV3ReplicationDefinition(d *schema.ResourceData) *policyReplicationDefinition {
+	opDefinitionRaw := d.Get("operation_definition").([]interface{})
+	if len(opDefinitionRaw) != 1 || opDefinitionRaw[0] == nil {
+		return nil
+	}
+	opDefinition := opDefinitionRaw[0].(map[string]interface{})
+	if opDefinition["destination_region"].(string) == "" {
+		return nil
+	}
+	return &policyReplicationDefinition{
+		DestinationRegion:    opDefinition["destination_region"].(string),
+		DestinationProjectID: opDefinition["destination_project_id"].(string),
+		EnableAcceleration:   opDefinition["enable_acceleration"].(bool),
+	}
+}
+
+// addReplicationDefinition sets the replication parameters to the `policy.operation_definition` of the request body
+func addReplicationDefinition(body map[string]interface{}, replication *policyReplicationDefinition) {
+	if replication == nil {
+		return
+	}
+	policy := body["policy"].(map[string]interface{})
+	opDefinition, ok := policy["operation_definition"].(map[string]interface{})
+	if !ok {
+		opDefinition = make(map[string]interface{})
+		policy["operation_definition"] = opDefinition
+	}
+	opDefinition["destination_region"] = replication.DestinationRegion
+	opDefinition["destination_project_id"] = replication.DestinationProjectID
+	if replication.EnableAcceleration {
+		opDefinition["enable_acceleration"] = true
+	}
+}
+
 func resourceCBRPolicyV3TriggerPattern(d *schema.ResourceData) []string {
 	triggerPatternRaw := d.Get("trigger_pattern").([]interface{})
 	patterns := make([]string, 0)
 	for _, v := range triggerPatternRaw {
-		patterns = append(patterns, v.(string))
+		patterns = append(patterns, normalizeTriggerPattern(v.(string)))
 	}
 	return patterns
 }
@@ -156,8 +217,22 @@ func resourceCBRPolicyV3Create(ctx context.Context, d *schema.ResourceData, meta
 		},
 	}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	cbrPolicy, err := policies.Create(client, createOpts).Extract()
+	if createOpts.OperationType == "replication" && resourceCBRPolicyV3ReplicationDefinition(d) == nil {
+		return fmterr.Errorf("operation_definition.0.destination_region is required for replication policies")
+	}
+
+	body, err := createOpts.ToPolicyCreateMap()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	addReplicationDefinition(body, resourceCBRPolicyV3ReplicationDefinition(d))
+
+	log.Printf("[DEBUG] Create Options: %#v", body)
+	var r policies.CreateResult
+	_, r.Err = client.Post(client.ServiceURL("policies"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	cbrPolicy, err := r.Extract()
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud CBRv3 policy: %s", err)
 	}
@@ -175,7 +250,8 @@ func resourceCBRPolicyV3Read(_ context.Context, d *schema.ResourceData, meta int
 		return fmterr.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
 	}
 
-	cbrPolicy, err := policies.Get(client, d.Id()).Extract()
+	r := policies.Get(client, d.Id())
+	cbrPolicy, err := r.Extract()
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			log.Printf("[WARN] Removing CBR policy %s as it's already gone", d.Id())
@@ -207,6 +283,15 @@ func resourceCBRPolicyV3Read(_ context.Context, d *schema.ResourceData, meta int
 	opDefinition["timezone"] = cbrPolicyOD.Timezone
 	opDefinition["week_backups"] = cbrPolicyOD.WeekBackups
 	opDefinition["year_backups"] = cbrPolicyOD.YearBackups
+	var replication struct {
+		OperationDefinition policyReplicationDefinition `json:"operation_definition"`
+	}
+	if err := r.ExtractIntoStructPtr(&replication, "policy"); err != nil {
+		return fmterr.Errorf("error extracting replication parameters: %s", err)
+	}
+	opDefinition["destination_region"] = replication.OperationDefinition.DestinationRegion
+	opDefinition["destination_project_id"] = replication.OperationDefinition.DestinationProjectID
+	opDefinition["enable_acceleration"] = replication.OperationDefinition.EnableAcceleration
 	opDefinitionList = append(opDefinitionList, opDefinition)
 	if err := d.Set("operation_definition", opDefinitionList); err != nil {
 		return fmterr.Errorf("error setting operetion_definition: %s", err)
@@ -248,7 +333,19 @@ func resourceCBRPolicyV3Update(ctx context.Context, d *schema.ResourceData, meta
 		updateOpts.OperationDefinition = opDefinition
 	}
 
-	_, err = policies.Update(client, d.Id(), updateOpts).Extract()
+	body, err := updateOpts.ToPolicyUpdateMap()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("operation_definition") {
+		addReplicationDefinition(body, resourceCBRPolicyV3ReplicationDefinition(d))
+	}
+
+	var r policies.UpdateResult
+	_, r.Err = client.Put(client.ServiceURL("policies", d.Id()), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, err = r.Extract()
 	if err != nil {
 		return fmterr.Errorf("error updating OpenTelekomCloud CBRv3 policy: %s", err)
 	}
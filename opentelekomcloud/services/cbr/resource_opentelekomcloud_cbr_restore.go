@@ -0,0 +1,218 @@
+package cbr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/tasks"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceCBRRestore restores the backup once on creation, the resource has no remote object
+func ResourceCBRRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCBRRestoreCreate,
+		ReadContext:   resourceCBRRestoreRead,
+		DeleteContext: resourceCBRRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"server_id", "volume_id"},
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"mapping": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"server_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"volume_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"power_on": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ended_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getCBRBackupStatus(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("backups", id), &r.Body, nil)
+		var backup struct {
+			Status string `json:"status"`
+		}
+		if err := r.ExtractIntoStructPtr(&backup, "backup"); err != nil {
+			return nil, "", err
+		}
+		return backup, backup.Status, nil
+	}
+}
+
+// findRestoreTask returns the latest restore operation log of the backup
+func findRestoreTask(client *golangsdk.ServiceClient, backupID, targetID string) (*tasks.OperationLog, error) {
+	pages, err := tasks.List(client, tasks.ListOpts{
+		OperationType: "restore",
+		ResourceId:    targetID,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	logs, err := tasks.ExtractTasks(pages)
+	if err != nil {
+		return nil, err
+	}
+	var latest *tasks.OperationLog
+	for i, task := range logs {
+		if task.ExtraInfo.Restore.BackupID != backupID {
+			continue
+		}
+		if latest == nil || task.StartedAt > latest.StartedAt {
+			latest = &logs[i]
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("restore operation of backup %s is not found", backupID)
+	}
+	return latest, nil
+}
+
+func resourceCBRRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+	}
+
+	backupID := d.Get("backup_id").(string)
+	restore := map[string]interface{}{}
+	targetID := d.Get("volume_id").(string)
+	if serverID := d.Get("server_id").(string); serverID != "" {
+		targetID = serverID
+		restore["server_id"] = serverID
+		restore["power_on"] = d.Get("power_on").(bool)
+		var mappings []map[string]interface{}
+		for _, v := range d.Get("mapping").([]interface{}) {
+			mapping := v.(map[string]interface{})
+			mappings = append(mappings, map[string]interface{}{
+				"backup_id": mapping["backup_id"].(string),
+				"volume_id": mapping["volume_id"].(string),
+			})
+		}
+		if mappings != nil {
+			restore["mappings"] = mappings
+		}
+	} else {
+		restore["volume_id"] = targetID
+	}
+
+	log.Printf("[DEBUG] Restoring CBR backup %s: %#v", backupID, restore)
+	_, err = client.Post(client.ServiceURL("backups", backupID, "restore"), map[string]interface{}{"restore": restore}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return fmterr.Errorf("error restoring CBR backup %s: %s", backupID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"waiting_restore", "restoring"},
+		Target:     []string{"available"},
+		Refresh:    getCBRBackupStatus(client, backupID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for CBR backup %s to be restored: %s", backupID, err)
+	}
+
+	task, err := findRestoreTask(client, backupID, targetID)
+	if err != nil {
+		return fmterr.Errorf("error retrieving result of CBR backup %s restore: %s", backupID, err)
+	}
+	if task.Status != "success" {
+		return fmterr.Errorf("error restoring CBR backup %s: %s (%s)", backupID, task.ErrorInfo.Message, task.Status)
+	}
+	d.SetId(task.ID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("status", task.Status),
+		d.Set("started_at", task.StartedAt),
+		d.Set("ended_at", task.EndedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CBR restore fields: %s", err)
+	}
+
+	return resourceCBRRestoreRead(ctx, d, meta)
+}
+
+// resourceCBRRestoreRead keeps the result of the restore in the state, the operation is done only once
+func resourceCBRRestoreRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceCBRRestoreDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing CBR restore %s from the state, the restored resources are not changed", d.Id())
+	d.SetId("")
+	return nil
+}
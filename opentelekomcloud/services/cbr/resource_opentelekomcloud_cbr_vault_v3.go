@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -208,6 +209,21 @@ func ResourceCBRVaultV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"replication_policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"destination_vault_id"},
+			},
+			"destination_vault_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"replication_policy_id"},
+			},
+			"migrate_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tags": common.TagsSchema(),
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
@@ -295,7 +311,14 @@ func resourceCBRVaultV3Read(_ context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	replicationPolicyID, destinationVaultID, err := getVaultReplicationPolicy(client, d.Id())
+	if err != nil {
+		return fmterr.Errorf("error getting replication policy of vault: %s", err)
+	}
+
 	mErr := multierror.Append(
+		d.Set("replication_policy_id", replicationPolicyID),
+		d.Set("destination_vault_id", destinationVaultID),
 		d.Set("description", vault.Description),
 		d.Set("name", vault.Name),
 		d.Set("project_id", vault.ProjectID),
@@ -325,10 +348,30 @@ func resourceCBRVaultV3Create(ctx context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
 	}
 
-	resources, err := cbrVaultResourcesCreate(d)
+	allResources, err := cbrVaultResourcesCreate(d)
 	if err != nil {
 		return fmterr.Errorf("error constructing resources list: %s", err)
 	}
+	// with migrate_resources, resources bound to other vaults are migrated after the creation
+	resources := allResources
+	var boundResources []vaults.ResourceCreate
+	if d.Get("migrate_resources").(bool) {
+		resources = nil
+		for _, res := range allResources {
+			sourceID, err := findResourceVault(client, res.ID)
+			if err != nil {
+				return fmterr.Errorf("error searching vault of resource %s: %s", res.ID, err)
+			}
+			if sourceID != "" {
+				boundResources = append(boundResources, res)
+				continue
+			}
+			resources = append(resources, res)
+		}
+	}
+	if resources == nil {
+		resources = []vaults.ResourceCreate{}
+	}
 
 	opts := vaults.CreateOpts{
 		BackupPolicyID:      d.Get("backup_policy_id").(string),
@@ -349,6 +392,12 @@ func resourceCBRVaultV3Create(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.SetId(vault.ID)
 
+	if boundResources != nil {
+		if _, err := migrateResources(client, d.Id(), boundResources); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if policy := d.Get("backup_policy_id").(string); policy != "" {
 		_, err := vaults.BindPolicy(client, d.Id(), vaults.BindPolicyOpts{PolicyID: policy}).Extract()
 		if err != nil {
@@ -356,6 +405,12 @@ func resourceCBRVaultV3Create(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if policy := d.Get("replication_policy_id").(string); policy != "" {
+		if err := bindReplicationPolicy(client, d.Id(), policy, d.Get("destination_vault_id").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCBRVaultV3Read(ctx, d, meta)
 }
 
@@ -527,13 +582,80 @@ func vaultRemovedResources(d *schema.ResourceData) (ids []string) {
 	return
 }
 
+// findResourceVault returns the ID of the vault the resource is bound to or empty string
+func findResourceVault(client *golangsdk.ServiceClient, resourceID string) (string, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("vaults")+"?resource_ids="+resourceID, &r.Body, nil)
+	var found []vaults.Vault
+	if err := r.ExtractIntoSlicePtr(&found, "vaults"); err != nil {
+		return "", err
+	}
+	for _, vault := range found {
+		for _, res := range vault.Resources {
+			if res.ID == resourceID {
+				return vault.ID, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// migrateResources moves the resources bound to other vaults together with their backups
+func migrateResources(client *golangsdk.ServiceClient, vaultID string, resources []vaults.ResourceCreate) ([]vaults.ResourceCreate, error) {
+	var notBound []vaults.ResourceCreate
+	sources := make(map[string][]string)
+	for _, res := range resources {
+		sourceID, err := findResourceVault(client, res.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error searching vault of resource %s: %s", res.ID, err)
+		}
+		if sourceID == "" || sourceID == vaultID {
+			notBound = append(notBound, res)
+			continue
+		}
+		sources[sourceID] = append(sources[sourceID], res.ID)
+	}
+
+	for sourceID, ids := range sources {
+		opts := map[string]interface{}{
+			"destination_vault_id": vaultID,
+			"resource_ids":         ids,
+		}
+		log.Printf("[DEBUG] Migrating resources %v from vault %s to vault %s", ids, sourceID, vaultID)
+		_, err := client.Post(client.ServiceURL("vaults", sourceID, "migrateresources"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error migrating resources from vault %s: %s", sourceID, err)
+		}
+	}
+	return notBound, nil
+}
+
 func updateResources(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
 	if removedIDs := vaultRemovedResources(d); removedIDs != nil {
-		_, err := vaults.DissociateResources(client, d.Id(), vaults.DissociateResourcesOpts{
-			ResourceIDs: removedIDs,
-		}).Extract()
+		vault, err := vaults.Get(client, d.Id()).Extract()
 		if err != nil {
-			return fmt.Errorf("error unbinding resources: %s", err)
+			return fmt.Errorf("error getting vault details: %s", err)
+		}
+		// resources already migrated to another vault are not unbound
+		current := common.NewStringSearcher()
+		for _, res := range vault.Resources {
+			current.AddToIndex(res.ID)
+		}
+		var boundIDs []string
+		for _, id := range removedIDs {
+			if current.Contains(id) {
+				boundIDs = append(boundIDs, id)
+			}
+		}
+		if boundIDs != nil {
+			_, err = vaults.DissociateResources(client, d.Id(), vaults.DissociateResourcesOpts{
+				ResourceIDs: boundIDs,
+			}).Extract()
+			if err != nil {
+				return fmt.Errorf("error unbinding resources: %s", err)
+			}
 		}
 	}
 
@@ -541,6 +663,12 @@ func updateResources(d *schema.ResourceData, client *golangsdk.ServiceClient) er
 	if err != nil {
 		return err
 	}
+	if d.Get("migrate_resources").(bool) {
+		addedResources, err = migrateResources(client, d.Id(), addedResources)
+		if err != nil {
+			return err
+		}
+	}
 	if addedResources != nil {
 		_, err := vaults.AssociateResources(client, d.Id(), vaults.AssociateResourcesOpts{
 			Resources: addedResources,
@@ -574,6 +702,61 @@ func updatePolicy(d *schema.ResourceData, client *golangsdk.ServiceClient) error
 	return nil
 }
 
+func bindReplicationPolicy(client *golangsdk.ServiceClient, vaultID, policyID, destinationVaultID string) error {
+	opts := map[string]interface{}{
+		"policy_id":            policyID,
+		"destination_vault_id": destinationVaultID,
+	}
+	_, err := client.Post(client.ServiceURL("vaults", vaultID, "associatepolicy"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmt.Errorf("error binding replication policy to vault: %s", err)
+	}
+	return nil
+}
+
+// getVaultReplicationPolicy returns the IDs of the replication policy bound to the vault
+// and of its destination vault, or empty strings
+func getVaultReplicationPolicy(client *golangsdk.ServiceClient, vaultID string) (string, string, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("policies")+"?operation_type=replication&vault_id="+vaultID, &r.Body, nil)
+	var policies []struct {
+		ID               string `json:"id"`
+		AssociatedVaults []struct {
+			VaultID            string `json:"vault_id"`
+			DestinationVaultID string `json:"destination_vault_id"`
+		} `json:"associated_vaults"`
+	}
+	if err := r.ExtractIntoSlicePtr(&policies, "policies"); err != nil {
+		return "", "", err
+	}
+	for _, policy := range policies {
+		for _, vault := range policy.AssociatedVaults {
+			if vault.VaultID == vaultID {
+				return policy.ID, vault.DestinationVaultID, nil
+			}
+		}
+	}
+	return "", "", nil
+}
+
+func updateReplicationPolicy(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oldP, _ := d.GetChange("replication_policy_id")
+	if oldP != "" {
+		_, err := vaults.UnbindPolicy(client, d.Id(), vaults.BindPolicyOpts{
+			PolicyID: oldP.(string),
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("error unbinding replication policy from vault: %s", err)
+		}
+	}
+	if newP := d.Get("replication_policy_id").(string); newP != "" {
+		return bindReplicationPolicy(client, d.Id(), newP, d.Get("destination_vault_id").(string))
+	}
+	return nil
+}
+
 func resourceCBRVaultV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.CbrV3Client(config.GetRegion(d))
@@ -633,6 +816,12 @@ func resourceCBRVaultV3Update(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChanges("replication_policy_id", "destination_vault_id") {
+		if err := updateReplicationPolicy(d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCBRVaultV3Read(ctx, d, meta)
 }
 
@@ -0,0 +1,182 @@
+package cbr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+var (
+	// order of the rule parts in the normalized pattern
+	triggerPatternKeys = []string{"FREQ", "INTERVAL", "BYDAY", "BYHOUR", "BYMINUTE"}
+	triggerPatternDays = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+	timezoneRegex = regexp.MustCompile(`^UTC([+-])(\d{1,2})(?::?(\d{2}))?$`)
+)
+
+// parseTriggerPattern parses iCalendar (RFC 2445) rule supported by CBR,
+// e.g. `FREQ=WEEKLY;BYDAY=MO,TU;BYHOUR=14;BYMINUTE=00`
+func parseTriggerPattern(pattern string) (map[string]string, error) {
+	parts := make(map[string]string)
+	for _, part := range strings.Split(strings.TrimSpace(pattern), ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid rule part %q, `KEY=VALUE` is expected", part)
+		}
+		key := strings.ToUpper(strings.TrimSpace(kv[0]))
+		if !common.StrSliceContains(triggerPatternKeys, key) {
+			return nil, fmt.Errorf("unsupported rule part %q, supported parts are: %s", key, strings.Join(triggerPatternKeys, ", "))
+		}
+		if _, ok := parts[key]; ok {
+			return nil, fmt.Errorf("duplicated rule part %q", key)
+		}
+		parts[key] = strings.ToUpper(strings.TrimSpace(kv[1]))
+	}
+
+	switch parts["FREQ"] {
+	case "DAILY", "WEEKLY":
+	case "":
+		return nil, fmt.Errorf("`FREQ` is required")
+	default:
+		return nil, fmt.Errorf("`FREQ` can be only `DAILY` or `WEEKLY`, got %q", parts["FREQ"])
+	}
+
+	if days, ok := parts["BYDAY"]; ok {
+		for _, day := range strings.Split(days, ",") {
+			if !common.StrSliceContains(triggerPatternDays, day) {
+				return nil, fmt.Errorf("invalid `BYDAY` value %q, expected one of %s", day, strings.Join(triggerPatternDays, ", "))
+			}
+		}
+	} else if parts["FREQ"] == "WEEKLY" {
+		return nil, fmt.Errorf("`BYDAY` is required for `WEEKLY` frequency")
+	}
+
+	if interval, ok := parts["INTERVAL"]; ok {
+		maxInterval := 31
+		if parts["FREQ"] == "WEEKLY" {
+			maxInterval = 5
+		}
+		if err := validateNumbers(interval, "INTERVAL", 1, maxInterval, false); err != nil {
+			return nil, err
+		}
+	}
+
+	hours, ok := parts["BYHOUR"]
+	if !ok {
+		return nil, fmt.Errorf("`BYHOUR` is required")
+	}
+	if err := validateNumbers(hours, "BYHOUR", 0, 23, true); err != nil {
+		return nil, err
+	}
+	minutes, ok := parts["BYMINUTE"]
+	if !ok {
+		return nil, fmt.Errorf("`BYMINUTE` is required")
+	}
+	if err := validateNumbers(minutes, "BYMINUTE", 0, 59, true); err != nil {
+		return nil, err
+	}
+
+	return parts, nil
+}
+
+func validateNumbers(value, key string, min, max int, list bool) error {
+	values := []string{value}
+	if list {
+		values = strings.Split(value, ",")
+	}
+	for _, v := range values {
+		number, err := strconv.Atoi(v)
+		if err != nil || number < min || number > max {
+			return fmt.Errorf("invalid `%s` value %q, expected number from %d to %d", key, v, min, max)
+		}
+	}
+	return nil
+}
+
+// normalizeTriggerPattern returns pattern with parts in the fixed order and two-digit hours and minutes
+func normalizeTriggerPattern(pattern string) string {
+	parts, err := parseTriggerPattern(pattern)
+	if err != nil {
+		return pattern
+	}
+	var result []string
+	for _, key := range triggerPatternKeys {
+		value, ok := parts[key]
+		if !ok {
+			continue
+		}
+		if key == "BYHOUR" || key == "BYMINUTE" {
+			numbers := strings.Split(value, ",")
+			for i, n := range numbers {
+				number, _ := strconv.Atoi(n)
+				numbers[i] = fmt.Sprintf("%02d", number)
+			}
+			value = strings.Join(numbers, ",")
+		}
+		result = append(result, fmt.Sprintf("%s=%s", key, value))
+	}
+	return strings.Join(result, ";")
+}
+
+func validateTriggerPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseTriggerPattern(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid schedule %q: %s", k, v, err))
+	}
+	return
+}
+
+func suppressEquivalentTriggerPattern(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeTriggerPattern(old) == normalizeTriggerPattern(new)
+}
+
+// normalizeTimezone converts timezone to the `UTC+HH:MM` format used by CBR,
+// `UTC`, `UTC+3` and `UTC+0300` are accepted as well
+func normalizeTimezone(timezone string) (string, error) {
+	timezone = strings.ToUpper(strings.TrimSpace(timezone))
+	if timezone == "UTC" {
+		return "UTC+00:00", nil
+	}
+	matches := timezoneRegex.FindStringSubmatch(timezone)
+	if matches == nil {
+		return "", fmt.Errorf("timezone %q doesn't match `UTC+HH:MM` format", timezone)
+	}
+	hours, _ := strconv.Atoi(matches[2])
+	minutes := 0
+	if matches[3] != "" {
+		minutes, _ = strconv.Atoi(matches[3])
+	}
+	if hours > 14 || minutes > 59 || (hours == 14 && minutes != 0) {
+		return "", fmt.Errorf("timezone %q is out of range `UTC-12:00` .. `UTC+14:00`", timezone)
+	}
+	if matches[1] == "-" && (hours > 12 || (hours == 12 && minutes != 0)) {
+		return "", fmt.Errorf("timezone %q is out of range `UTC-12:00` .. `UTC+14:00`", timezone)
+	}
+	return fmt.Sprintf("UTC%s%02d:%02d", matches[1], hours, minutes), nil
+}
+
+func validateTimezone(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := normalizeTimezone(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %s", k, err))
+	}
+	return
+}
+
+func suppressEquivalentTimezone(_, old, new string, _ *schema.ResourceData) bool {
+	oldTZ, err := normalizeTimezone(old)
+	if err != nil {
+		return false
+	}
+	newTZ, err := normalizeTimezone(new)
+	if err != nil {
+		return false
+	}
+	return oldTZ == newTZ
+}
@@ -0,0 +1,212 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodepools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodes"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+// nodePoolIDAnnotation is set by CCE on every node created in a node pool
+const nodePoolIDAnnotation = "kubernetes.io/node-pool.id"
+
+// rebuildKeys are the arguments applied by reinstalling the node OS when `rebuild_trigger` is set.
+// Node pools update these arguments in the pool template in place, existing nodes are reinstalled
+// only when `rebuild_trigger` is set.
+var rebuildKeys = []string{"os", "preinstall", "postinstall"}
+
+func rebuildTriggerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+}
+
+// validateRebuildTrigger replaces the node on `os` and install script changes unless `rebuild_trigger` is set,
+// in which case the changes are applied in place by reinstalling the node
+func validateRebuildTrigger(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || d.Get("rebuild_trigger").(string) != "" {
+		return nil
+	}
+	for _, key := range rebuildKeys {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func needsRebuild(d *schema.ResourceData) bool {
+	return d.Get("rebuild_trigger").(string) != "" &&
+		d.HasChanges(append([]string{"rebuild_trigger"}, rebuildKeys...)...)
+}
+
+// installScripts returns base64 encoded pre- and post-install scripts
+func installScripts(d *schema.ResourceData) (preInstall, postInstall string) {
+	if v, ok := d.GetOk("preinstall"); ok {
+		preInstall = common.InstallScriptEncode(v.(string))
+	}
+	if v, ok := d.GetOk("postinstall"); ok {
+		postInstall = common.InstallScriptEncode(v.(string))
+	}
+	return
+}
+
+// setNestedValue sets the value in the request body, creating missing nested objects
+func setNestedValue(body map[string]interface{}, value interface{}, path ...string) {
+	current := body
+	for _, key := range path[:len(path)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			current[key] = next
+		}
+		current = next
+	}
+	current[path[len(path)-1]] = value
+}
+
+// nodeCreateOpts adds the fields missing in the SDK to the node create request
+type nodeCreateOpts struct {
+	nodes.CreateOpts
+	AgencyName string
+	Extra      nodeSpecExtra
+}
+
+func (opts nodeCreateOpts) ToNodeCreateMap() (map[string]interface{}, error) {
+	body, err := opts.CreateOpts.ToNodeCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.AgencyName != "" {
+		setNestedValue(body, opts.AgencyName, "spec", "extendParam", "agency_name")
+	}
+	setNodeSpecExtra(body, opts.Extra, "spec")
+	return body, nil
+}
+
+// nodePoolCreateOpts adds the fields missing in the SDK to the node pool create request
+type nodePoolCreateOpts struct {
+	nodepools.CreateOpts
+	AgencyName string
+	Extra      nodeSpecExtra
+}
+
+func (opts nodePoolCreateOpts) ToNodePoolCreateMap() (map[string]interface{}, error) {
+	body, err := opts.CreateOpts.ToNodePoolCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.AgencyName != "" {
+		setNestedValue(body, opts.AgencyName, "spec", "nodeTemplate", "extendParam", "agency_name")
+	}
+	setNodeSpecExtra(body, opts.Extra, "spec", "nodeTemplate")
+	return body, nil
+}
+
+// nodePoolUpdateOpts adds the OS and install scripts to the node pool template update
+type nodePoolUpdateOpts struct {
+	nodepools.UpdateOpts
+	Os          string
+	PreInstall  string
+	PostInstall string
+}
+
+func (opts nodePoolUpdateOpts) ToNodePoolUpdateMap() (map[string]interface{}, error) {
+	body, err := opts.UpdateOpts.ToNodePoolUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Os != "" {
+		setNestedValue(body, opts.Os, "spec", "nodeTemplate", "os")
+	}
+	setNestedValue(body, opts.PreInstall, "spec", "nodeTemplate", "extendParam", "alpha.cce/preInstall")
+	setNestedValue(body, opts.PostInstall, "spec", "nodeTemplate", "extendParam", "alpha.cce/postInstall")
+	return body, nil
+}
+
+type resetLifecycle struct {
+	PreInstall  string `json:"preInstall,omitempty"`
+	PostInstall string `json:"postInstall,omitempty"`
+}
+
+type resetNodeSpec struct {
+	Os        string          `json:"os"`
+	Login     nodes.LoginSpec `json:"login"`
+	Lifecycle resetLifecycle  `json:"lifecycle"`
+}
+
+type resetNode struct {
+	NodeID string        `json:"nodeID"`
+	Spec   resetNodeSpec `json:"spec"`
+}
+
+type resetNodesOpts struct {
+	ApiVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	NodeList   []resetNode `json:"nodeList"`
+}
+
+// resetNodes reinstalls the OS of the nodes and waits for them to become active again
+func resetNodes(ctx context.Context, client *golangsdk.ServiceClient, clusterID string, nodeIDs []string, spec resetNodeSpec, timeout time.Duration) error {
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+	opts := resetNodesOpts{
+		ApiVersion: "v3",
+		Kind:       "List",
+	}
+	for _, id := range nodeIDs {
+		opts.NodeList = append(opts.NodeList, resetNode{NodeID: id, Spec: spec})
+	}
+
+	log.Printf("[DEBUG] Reinstalling CCE nodes %v with OS %s", nodeIDs, spec.Os)
+	_, err := client.Post(client.ServiceURL("clusters", clusterID, "nodes", "reset"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmt.Errorf("error reinstalling CCE nodes: %w", err)
+	}
+
+	for _, id := range nodeIDs {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"Installing", "Upgrading", "Build"},
+			Target:     []string{"Active"},
+			Refresh:    waitForCceNodeActive(client, clusterID, id),
+			Timeout:    timeout,
+			Delay:      60 * time.Second,
+			MinTimeout: 10 * time.Second,
+			// the node stays `Active` for a moment after the request
+			ContinuousTargetOccurence: 3,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for CCE node %s to be reinstalled: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// nodePoolNodeIDs returns IDs of the nodes belonging to the node pool
+func nodePoolNodeIDs(client *golangsdk.ServiceClient, clusterID, poolID string) ([]string, error) {
+	allNodes, err := nodes.List(client, clusterID, nodes.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing CCE nodes: %w", err)
+	}
+	var ids []string
+	for _, node := range allNodes {
+		if node.Metadata.Annotations[nodePoolIDAnnotation] == poolID {
+			ids = append(ids, node.Metadata.Id)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,328 @@
+package cce
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type runtimeSpec struct {
+	Name string `json:"name"`
+}
+
+type storageMatchLabels struct {
+	Size              string `json:"size,omitempty"`
+	VolumeType        string `json:"volumeType,omitempty"`
+	MetadataEncrypted string `json:"metadataEncrypted,omitempty"`
+	MetadataCmkid     string `json:"metadataCmkid,omitempty"`
+	Count             string `json:"count,omitempty"`
+}
+
+type storageSelector struct {
+	Name        string             `json:"name"`
+	StorageType string             `json:"storageType"`
+	MatchLabels storageMatchLabels `json:"matchLabels"`
+}
+
+type lvmConfig struct {
+	LvType string `json:"lvType"`
+	Path   string `json:"path,omitempty"`
+}
+
+type virtualSpace struct {
+	Name          string     `json:"name"`
+	Size          string     `json:"size"`
+	LvmConfig     *lvmConfig `json:"lvmConfig,omitempty"`
+	RuntimeConfig *lvmConfig `json:"runtimeConfig,omitempty"`
+}
+
+type storageGroup struct {
+	Name          string         `json:"name"`
+	CceManaged    bool           `json:"cceManaged,omitempty"`
+	SelectorNames []string       `json:"selectorNames"`
+	VirtualSpaces []virtualSpace `json:"virtualSpaces"`
+}
+
+// storageSpec maps data disks, including local disks of disk-intensive flavors, to the node storage
+type storageSpec struct {
+	StorageSelectors []storageSelector `json:"storageSelectors"`
+	StorageGroups    []storageGroup    `json:"storageGroups"`
+}
+
+// nodeSpecExtra contains node spec fields missing in the SDK
+type nodeSpecExtra struct {
+	Runtime *runtimeSpec `json:"runtime,omitempty"`
+	Storage *storageSpec `json:"storage,omitempty"`
+}
+
+func runtimeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{"docker", "containerd"}, false),
+	}
+}
+
+func storageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"selectors": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      "evs",
+								ValidateFunc: validation.StringInSlice([]string{"evs", "local"}, false),
+							},
+							"match_label_size": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"match_label_volume_type": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"match_label_metadata_encrypted": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"match_label_metadata_cmkid": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"match_label_count": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"groups": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"cce_managed": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"selector_names": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"virtual_spaces": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice([]string{"kubernetes", "runtime", "user"}, false),
+										},
+										"size": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										// `striped` spreads the volume over all disks of the group, like RAID 0
+										"lvm_lv_type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice([]string{"linear", "striped"}, false),
+										},
+										"lvm_path": {
+											Type:     schema.TypeString,
+											Optional: true,
+											ForceNew: true,
+										},
+										"runtime_lv_type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice([]string{"linear", "striped"}, false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRuntime(d *schema.ResourceData) *runtimeSpec {
+	name := d.Get("runtime").(string)
+	if name == "" {
+		return nil
+	}
+	return &runtimeSpec{Name: name}
+}
+
+func expandStorage(d *schema.ResourceData) *storageSpec {
+	storageRaw := d.Get("storage").([]interface{})
+	if len(storageRaw) == 0 {
+		return nil
+	}
+	storage := storageRaw[0].(map[string]interface{})
+
+	spec := new(storageSpec)
+	for _, raw := range storage["selectors"].([]interface{}) {
+		selector := raw.(map[string]interface{})
+		spec.StorageSelectors = append(spec.StorageSelectors, storageSelector{
+			Name:        selector["name"].(string),
+			StorageType: selector["type"].(string),
+			MatchLabels: storageMatchLabels{
+				Size:              selector["match_label_size"].(string),
+				VolumeType:        selector["match_label_volume_type"].(string),
+				MetadataEncrypted: selector["match_label_metadata_encrypted"].(string),
+				MetadataCmkid:     selector["match_label_metadata_cmkid"].(string),
+				Count:             selector["match_label_count"].(string),
+			},
+		})
+	}
+	for _, raw := range storage["groups"].([]interface{}) {
+		group := raw.(map[string]interface{})
+		var spaces []virtualSpace
+		for _, rawSpace := range group["virtual_spaces"].([]interface{}) {
+			space := rawSpace.(map[string]interface{})
+			vs := virtualSpace{
+				Name: space["name"].(string),
+				Size: space["size"].(string),
+			}
+			if lvType := space["lvm_lv_type"].(string); lvType != "" {
+				vs.LvmConfig = &lvmConfig{LvType: lvType, Path: space["lvm_path"].(string)}
+			}
+			if lvType := space["runtime_lv_type"].(string); lvType != "" {
+				vs.RuntimeConfig = &lvmConfig{LvType: lvType}
+			}
+			spaces = append(spaces, vs)
+		}
+		var selectorNames []string
+		for _, name := range group["selector_names"].([]interface{}) {
+			selectorNames = append(selectorNames, name.(string))
+		}
+		spec.StorageGroups = append(spec.StorageGroups, storageGroup{
+			Name:          group["name"].(string),
+			CceManaged:    group["cce_managed"].(bool),
+			SelectorNames: selectorNames,
+			VirtualSpaces: spaces,
+		})
+	}
+	return spec
+}
+
+func flattenStorage(spec *storageSpec) []map[string]interface{} {
+	if spec == nil {
+		return nil
+	}
+	var selectors []map[string]interface{}
+	for _, selector := range spec.StorageSelectors {
+		selectors = append(selectors, map[string]interface{}{
+			"name":                           selector.Name,
+			"type":                           selector.StorageType,
+			"match_label_size":               selector.MatchLabels.Size,
+			"match_label_volume_type":        selector.MatchLabels.VolumeType,
+			"match_label_metadata_encrypted": selector.MatchLabels.MetadataEncrypted,
+			"match_label_metadata_cmkid":     selector.MatchLabels.MetadataCmkid,
+			"match_label_count":              selector.MatchLabels.Count,
+		})
+	}
+	var groups []map[string]interface{}
+	for _, group := range spec.StorageGroups {
+		var spaces []map[string]interface{}
+		for _, space := range group.VirtualSpaces {
+			vs := map[string]interface{}{
+				"name": space.Name,
+				"size": space.Size,
+			}
+			if space.LvmConfig != nil {
+				vs["lvm_lv_type"] = space.LvmConfig.LvType
+				vs["lvm_path"] = space.LvmConfig.Path
+			}
+			if space.RuntimeConfig != nil {
+				vs["runtime_lv_type"] = space.RuntimeConfig.LvType
+			}
+			spaces = append(spaces, vs)
+		}
+		groups = append(groups, map[string]interface{}{
+			"name":           group.Name,
+			"cce_managed":    group.CceManaged,
+			"selector_names": group.SelectorNames,
+			"virtual_spaces": spaces,
+		})
+	}
+	return []map[string]interface{}{{
+		"selectors": selectors,
+		"groups":    groups,
+	}}
+}
+
+// setNodeSpecExtra adds the fields missing in the SDK to the node spec of the request body
+func setNodeSpecExtra(body map[string]interface{}, extra nodeSpecExtra, specPath ...string) {
+	if extra.Runtime != nil {
+		setNestedValue(body, extra.Runtime, append(specPath, "runtime")...)
+	}
+	if extra.Storage != nil {
+		setNestedValue(body, extra.Storage, append(specPath, "storage")...)
+	}
+}
+
+// extractNodeSpecExtra reads the fields missing in the SDK from the node or node pool response
+func extractNodeSpecExtra(r golangsdk.Result, nodePool bool) (*nodeSpecExtra, error) {
+	if nodePool {
+		var pool struct {
+			Spec struct {
+				NodeTemplate nodeSpecExtra `json:"nodeTemplate"`
+			} `json:"spec"`
+		}
+		if err := r.ExtractInto(&pool); err != nil {
+			return nil, err
+		}
+		return &pool.Spec.NodeTemplate, nil
+	}
+	var node struct {
+		Spec nodeSpecExtra `json:"spec"`
+	}
+	if err := r.ExtractInto(&node); err != nil {
+		return nil, err
+	}
+	return &node.Spec, nil
+}
@@ -232,6 +232,7 @@ func ResourceCCEClusterV3() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -261,6 +262,9 @@ func resourceClusterExtendParamV3(d *schema.ResourceData) map[string]string {
 	if eip, ok := d.GetOk("eip"); ok {
 		m["clusterExternalIP"] = eip.(string)
 	}
+	if epsID, ok := d.GetOk("enterprise_project_id"); ok {
+		m["enterpriseProjectId"] = epsID.(string)
+	}
 	return m
 }
 
@@ -333,7 +337,7 @@ func resourceCCEClusterV3Create(ctx context.Context, d *schema.ResourceData, met
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"Creating"},
 		Target:     []string{"Available"},
-		Refresh:    waitForCCEClusterActive(cceClient, create.Metadata.Id),
+		Refresh:    common.LogStateTransitions("CCE cluster "+create.Metadata.Id, waitForCCEClusterActive(cceClient, create.Metadata.Id)),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -454,6 +458,14 @@ func resourceCCEClusterV3Read(_ context.Context, d *schema.ResourceData, meta in
 		return fmterr.Errorf("error setting installed addons: %w", err)
 	}
 
+	if epsID, ok := cluster.Spec.ExtendParam["enterpriseProjectId"]; ok {
+		if err := d.Set("enterprise_project_id", epsID); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if err := common.ReadEnterpriseProject(config, d, "cce", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -510,6 +522,10 @@ func resourceCCEClusterV3Update(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "cce", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceCCEClusterV3Read(ctx, d, meta)
 }
 
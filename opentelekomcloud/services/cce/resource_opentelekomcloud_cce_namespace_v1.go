@@ -0,0 +1,285 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/addons"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCCENamespaceV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCCENamespaceV1Create,
+		ReadContext:   resourceCCENamespaceV1Read,
+		UpdateContext: resourceCCENamespaceV1Update,
+		DeleteContext: resourceCCENamespaceV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCCENamespaceV1Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`),
+					"name must be a valid DNS-1123 label",
+				),
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"phase": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type k8sMetadata struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace,omitempty"`
+	UID               string            `json:"uid,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	Annotations       map[string]string `json:"annotations,omitempty"`
+	CreationTimestamp string            `json:"creationTimestamp,omitempty"`
+}
+
+type k8sNamespace struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Metadata   k8sMetadata `json:"metadata"`
+	Status     struct {
+		Phase string `json:"phase,omitempty"`
+	} `json:"status"`
+}
+
+func getCCENamespace(client *golangsdk.ServiceClient, clusterID, name string) (*k8sNamespace, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(addons.CCEServiceURL(client, clusterID, "namespaces", name), &r.Body, nil)
+	var namespace k8sNamespace
+	if err := r.ExtractInto(&namespace); err != nil {
+		return nil, err
+	}
+	return &namespace, nil
+}
+
+func resourceCCENamespaceV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CceV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	namespace := k8sNamespace{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+		Metadata: k8sMetadata{
+			Name:        d.Get("name").(string),
+			Labels:      expandStringMap(d.Get("labels").(map[string]interface{})),
+			Annotations: expandStringMap(d.Get("annotations").(map[string]interface{})),
+		},
+	}
+	log.Printf("[DEBUG] Creating CCE namespace: %#v", namespace)
+
+	_, err = client.Post(addons.CCEServiceURL(client, clusterID, "namespaces"), namespace, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating CCE namespace: %w", logHttpError(err))
+	}
+	d.SetId(common.BuildComponentID(clusterID, namespace.Metadata.Name))
+
+	return resourceCCENamespaceV1Read(ctx, d, meta)
+}
+
+func resourceCCENamespaceV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CceV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	namespace, err := getCCENamespace(client, d.Get("cluster_id").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CCE namespace"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("labels", managedMetadata(d, "labels", namespace.Metadata.Labels)),
+		d.Set("annotations", managedMetadata(d, "annotations", namespace.Metadata.Annotations)),
+		d.Set("phase", namespace.Status.Phase),
+		d.Set("creation_timestamp", namespace.Metadata.CreationTimestamp),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CCE namespace fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCCENamespaceV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CceV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	metadata := make(map[string]interface{})
+	for _, key := range []string{"labels", "annotations"} {
+		if d.HasChange(key) {
+			metadata[key] = metadataPatch(d, key)
+		}
+	}
+	patch := map[string]interface{}{"metadata": metadata}
+	log.Printf("[DEBUG] Updating CCE namespace %s: %#v", d.Id(), patch)
+
+	url := addons.CCEServiceURL(client, d.Get("cluster_id").(string), "namespaces", d.Get("name").(string))
+	_, err = client.Patch(url, patch, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: map[string]string{"Content-Type": "application/merge-patch+json"},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating CCE namespace: %w", logHttpError(err))
+	}
+
+	return resourceCCENamespaceV1Read(ctx, d, meta)
+}
+
+func resourceCCENamespaceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CceV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	_, err = client.Delete(addons.CCEServiceURL(client, clusterID, "namespaces", name), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CCE namespace"))
+	}
+
+	// namespace stays in `Terminating` phase until all its content is removed
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Active", "Terminating"},
+		Target:  []string{"Deleted"},
+		Refresh: func() (interface{}, string, error) {
+			namespace, err := getCCENamespace(client, clusterID, name)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return name, "Deleted", nil
+				}
+				return nil, "", err
+			}
+			return namespace, namespace.Status.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for CCE namespace %s to be deleted: %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCCENamespaceV1Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for CCE namespace, must be <cluster_id>/<name>")
+	}
+	mErr := multierror.Append(
+		d.Set("cluster_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandStringMap(raw map[string]interface{}) map[string]string {
+	if len(raw) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(raw))
+	for k, v := range raw {
+		result[k] = v.(string)
+	}
+	return result
+}
+
+// managedMetadata returns only the keys set by the resource, so the labels and annotations
+// added by Kubernetes itself, e.g. `kubernetes.io/metadata.name`, don't cause the diff
+func managedMetadata(d *schema.ResourceData, key string, actual map[string]string) map[string]string {
+	managed := d.Get(key).(map[string]interface{})
+	result := make(map[string]string)
+	for k, v := range actual {
+		if _, ok := managed[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// metadataPatch returns the merge patch of the labels or annotations, removed keys are set to null
+func metadataPatch(d *schema.ResourceData, key string) map[string]interface{} {
+	oldRaw, newRaw := d.GetChange(key)
+	patch := make(map[string]interface{})
+	for k := range oldRaw.(map[string]interface{}) {
+		patch[k] = nil
+	}
+	for k, v := range newRaw.(map[string]interface{}) {
+		patch[k] = v
+	}
+	return patch
+}
@@ -123,6 +123,11 @@ func ResourceCCENodePoolV3() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"kms_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
 						"extend_param": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -195,15 +200,21 @@ func ResourceCCENodePoolV3() *schema.Resource {
 			"preinstall": {
 				Type:      schema.TypeString,
 				Optional:  true,
-				ForceNew:  true,
 				StateFunc: common.GetHashOrEmpty,
 			},
 			"postinstall": {
 				Type:      schema.TypeString,
 				Optional:  true,
-				ForceNew:  true,
 				StateFunc: common.GetHashOrEmpty,
 			},
+			"agency_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rebuild_trigger": rebuildTriggerSchema(),
+			"runtime":         runtimeSchema(),
+			"storage":         storageSchema(),
 			"scale_enable": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -242,20 +253,7 @@ func resourceCCENodePoolUserTags(d *schema.ResourceData) []tags.ResourceTag {
 	return common.ExpandResourceTags(tagRaw)
 }
 
-func resourceCCENodePoolV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*cfg.Config)
-	nodePoolClient, err := config.CceV3Client(config.GetRegion(d))
-	if err != nil {
-		return fmterr.Errorf(cceClientError, err)
-	}
-
-	var base64PreInstall, base64PostInstall string
-	if v, ok := d.GetOk("preinstall"); ok {
-		base64PreInstall = common.InstallScriptEncode(v.(string))
-	}
-	if v, ok := d.GetOk("postinstall"); ok {
-		base64PostInstall = common.InstallScriptEncode(v.(string))
-	}
+func resourceCCENodePoolLogin(d *schema.ResourceData) nodes.LoginSpec {
 	var loginSpec nodes.LoginSpec
 	if common.HasFilledOpt(d, "key_pair") {
 		loginSpec = nodes.LoginSpec{SshKey: d.Get("key_pair").(string)}
@@ -268,8 +266,27 @@ func resourceCCENodePoolV3Create(ctx context.Context, d *schema.ResourceData, me
 			},
 		}
 	}
+	return loginSpec
+}
 
-	createOpts := nodepools.CreateOpts{
+func resourceCCENodePoolV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	nodePoolClient, err := config.CceV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	base64PreInstall, base64PostInstall := installScripts(d)
+	loginSpec := resourceCCENodePoolLogin(d)
+
+	createOpts := nodePoolCreateOpts{
+		AgencyName: d.Get("agency_name").(string),
+		Extra: nodeSpecExtra{
+			Runtime: expandRuntime(d),
+			Storage: expandStorage(d),
+		},
+	}
+	createOpts.CreateOpts = nodepools.CreateOpts{
 		Kind:       "NodePool",
 		ApiVersion: "v3",
 		Metadata: nodepools.CreateMetaData{
@@ -365,7 +382,8 @@ func resourceCCENodePoolV3Read(_ context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf(cceClientError, err)
 	}
 	clusterId := d.Get("cluster_id").(string)
-	s, err := nodepools.Get(nodePoolClient, clusterId, d.Id()).Extract()
+	getResult := nodepools.Get(nodePoolClient, clusterId, d.Id())
+	s, err := getResult.Extract()
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
@@ -374,6 +392,10 @@ func resourceCCENodePoolV3Read(_ context.Context, d *schema.ResourceData, meta i
 
 		return fmterr.Errorf("error retrieving Open Telekom Cloud CCE Node Pool: %w", err)
 	}
+	extra, err := extractNodeSpecExtra(getResult.Result, true)
+	if err != nil {
+		return fmterr.Errorf("error retrieving Open Telekom Cloud CCE Node Pool: %w", err)
+	}
 
 	me := multierror.Append(
 		d.Set("name", s.Metadata.Name),
@@ -383,8 +405,13 @@ func resourceCCENodePoolV3Read(_ context.Context, d *schema.ResourceData, meta i
 		d.Set("key_pair", s.Spec.NodeTemplate.Login.SshKey),
 		d.Set("initial_node_count", s.Spec.InitialNodeCount),
 		d.Set("scale_enable", s.Spec.Autoscaling.Enable),
+		d.Set("storage", flattenStorage(extra.Storage)),
 	)
 
+	if extra.Runtime != nil {
+		me = multierror.Append(me, d.Set("runtime", extra.Runtime.Name))
+	}
+
 	if s.Spec.Autoscaling.Enable {
 		me = multierror.Append(me,
 			d.Set("min_node_count", s.Spec.Autoscaling.MinNodeCount),
@@ -416,6 +443,9 @@ func resourceCCENodePoolV3Read(_ context.Context, d *schema.ResourceData, meta i
 			"volumetype":   pairObject.VolumeType,
 			"extend_param": pairObject.ExtendParam,
 		}
+		if pairObject.Metadata != nil {
+			volume["kms_id"] = pairObject.Metadata["__system__cmkid"]
+		}
 		volumes = append(volumes, volume)
 	}
 	if err := d.Set("data_volumes", volumes); err != nil {
@@ -446,7 +476,13 @@ func resourceCCENodePoolV3Update(ctx context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return fmterr.Errorf(cceClientError, err)
 	}
-	updateOpts := nodepools.UpdateOpts{
+	base64PreInstall, base64PostInstall := installScripts(d)
+	updateOpts := nodePoolUpdateOpts{
+		Os:          d.Get("os").(string),
+		PreInstall:  base64PreInstall,
+		PostInstall: base64PostInstall,
+	}
+	updateOpts.UpdateOpts = nodepools.UpdateOpts{
 		Kind:       "NodePool",
 		ApiVersion: "v3",
 		Metadata: nodepools.UpdateMetaData{
@@ -485,6 +521,25 @@ func resourceCCENodePoolV3Update(ctx context.Context, d *schema.ResourceData, me
 		return fmterr.Errorf("error waiting for Open Telekom Cloud CCE Node Pool to update: %w", err)
 	}
 
+	// the updated template is used only for new nodes, existing nodes have to be reinstalled
+	if needsRebuild(d) {
+		nodeIDs, err := nodePoolNodeIDs(nodePoolClient, clusterId, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		spec := resetNodeSpec{
+			Os:    d.Get("os").(string),
+			Login: resourceCCENodePoolLogin(d),
+			Lifecycle: resetLifecycle{
+				PreInstall:  base64PreInstall,
+				PostInstall: base64PostInstall,
+			},
+		}
+		if err := resetNodes(ctx, nodePoolClient, clusterId, nodeIDs, spec, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCCENodePoolV3Read(ctx, d, meta)
 }
 
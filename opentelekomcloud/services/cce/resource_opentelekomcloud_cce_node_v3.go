@@ -38,9 +38,12 @@ func ResourceCCENodeV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: validateRebuildTrigger,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -89,7 +92,6 @@ func ResourceCCENodeV3() *schema.Resource {
 			"os": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"EulerOS 2.5", "CentOS 7.7",
@@ -226,7 +228,6 @@ func ResourceCCENodeV3() *schema.Resource {
 			"preinstall": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				StateFunc: func(v interface{}) string {
 					switch v.(type) {
 					case string:
@@ -239,7 +240,6 @@ func ResourceCCENodeV3() *schema.Resource {
 			"postinstall": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				StateFunc: func(v interface{}) string {
 					switch v.(type) {
 					case string:
@@ -249,6 +249,14 @@ func ResourceCCENodeV3() *schema.Resource {
 					}
 				},
 			},
+			"agency_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rebuild_trigger": rebuildTriggerSchema(),
+			"runtime":         runtimeSchema(),
+			"storage":         storageSchema(),
 			"tags": {
 				Type:          schema.TypeMap,
 				ConflictsWith: []string{"labels"},
@@ -375,13 +383,7 @@ func resourceCCENodeV3Create(ctx context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf("error creating OpenTelekomCloud CCE Node client: %s", err)
 	}
 
-	var base64PreInstall, base64PostInstall string
-	if v, ok := d.GetOk("preinstall"); ok {
-		base64PreInstall = common.InstallScriptEncode(v.(string))
-	}
-	if v, ok := d.GetOk("postinstall"); ok {
-		base64PostInstall = common.InstallScriptEncode(v.(string))
-	}
+	base64PreInstall, base64PostInstall := installScripts(d)
 
 	// eip_count and bandwidth_size parameters must be set simultaneously
 	bandwidthSize := d.Get("bandwidth_size").(int)
@@ -391,7 +393,7 @@ func resourceCCENodeV3Create(ctx context.Context, d *schema.ResourceData, meta i
 		checkCCENodeV3PublicIpParams(d)
 	}
 
-	createOpts := nodes.CreateOpts{
+	baseOpts := nodes.CreateOpts{
 		Kind:       "Node",
 		ApiVersion: "v3",
 		Metadata: nodes.CreateMetaData{
@@ -436,7 +438,15 @@ func resourceCCENodeV3Create(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if ip := d.Get("private_ip").(string); ip != "" {
-		createOpts.Spec.NodeNicSpec.PrimaryNic.FixedIPs = []string{ip}
+		baseOpts.Spec.NodeNicSpec.PrimaryNic.FixedIPs = []string{ip}
+	}
+	createOpts := nodeCreateOpts{
+		CreateOpts: baseOpts,
+		AgencyName: d.Get("agency_name").(string),
+		Extra: nodeSpecExtra{
+			Runtime: expandRuntime(d),
+			Storage: expandStorage(d),
+		},
 	}
 
 	clusterId := d.Get("cluster_id").(string)
@@ -486,7 +496,7 @@ func resourceCCENodeV3Create(ctx context.Context, d *schema.ResourceData, meta i
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"Build", "Installing"},
 		Target:     []string{"Active"},
-		Refresh:    waitForCceNodeActive(nodeClient, clusterId, nodeId),
+		Refresh:    common.LogStateTransitions("CCE node "+nodeId, waitForCceNodeActive(nodeClient, clusterId, nodeId)),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -507,7 +517,8 @@ func resourceCCENodeV3Read(_ context.Context, d *schema.ResourceData, meta inter
 		return fmterr.Errorf("error creating OpenTelekomCloud CCE Node client: %s", err)
 	}
 	clusterId := d.Get("cluster_id").(string)
-	node, err := nodes.Get(nodeClient, clusterId, d.Id()).Extract()
+	getResult := nodes.Get(nodeClient, clusterId, d.Id())
+	node, err := getResult.Extract()
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
@@ -515,6 +526,10 @@ func resourceCCENodeV3Read(_ context.Context, d *schema.ResourceData, meta inter
 		}
 		return fmterr.Errorf("error retrieving OpenTelekomCloud Node: %s", err)
 	}
+	extra, err := extractNodeSpecExtra(getResult.Result, false)
+	if err != nil {
+		return fmterr.Errorf("error retrieving OpenTelekomCloud Node: %s", err)
+	}
 
 	me := &multierror.Error{}
 	me = multierror.Append(me,
@@ -526,7 +541,11 @@ func resourceCCENodeV3Read(_ context.Context, d *schema.ResourceData, meta inter
 		d.Set("billing_mode", node.Spec.BillingMode),
 		d.Set("key_pair", node.Spec.Login.SshKey),
 		d.Set("k8s_tags", node.Spec.K8sTags),
+		d.Set("storage", flattenStorage(extra.Storage)),
 	)
+	if extra.Runtime != nil {
+		me = multierror.Append(me, d.Set("runtime", extra.Runtime.Name))
+	}
 	if err := me.ErrorOrNil(); err != nil {
 		return fmterr.Errorf("[DEBUG] Error saving main conf to state for OpenTelekomCloud Node (%s): %s", d.Id(), err)
 	}
@@ -680,6 +699,22 @@ func resourceCCENodeV3Update(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if needsRebuild(d) {
+		preInstall, postInstall := installScripts(d)
+		spec := resetNodeSpec{
+			Os:    d.Get("os").(string),
+			Login: nodes.LoginSpec{SshKey: d.Get("key_pair").(string)},
+			Lifecycle: resetLifecycle{
+				PreInstall:  preInstall,
+				PostInstall: postInstall,
+			},
+		}
+		clusterId := d.Get("cluster_id").(string)
+		if err := resetNodes(ctx, nodeClient, clusterId, []string{d.Id()}, spec, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCCENodeV3Read(ctx, d, meta)
 }
 
@@ -0,0 +1,193 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/addons"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCCEResourceQuotaV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCCEResourceQuotaV1Create,
+		ReadContext:   resourceCCEResourceQuotaV1Read,
+		UpdateContext: resourceCCEResourceQuotaV1Update,
+		DeleteContext: resourceCCEResourceQuotaV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCCEResourceQuotaV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"hard": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"used": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+type k8sResourceQuota struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Metadata   k8sMetadata `json:"metadata"`
+	Spec       struct {
+		Hard map[string]string `json:"hard"`
+	} `json:"spec"`
+	Status struct {
+		Hard map[string]string `json:"hard,omitempty"`
+		Used map[string]string `json:"used,omitempty"`
+	} `json:"status"`
+}
+
+func resourceQuotaURL(client *golangsdk.ServiceClient, d *schema.ResourceData, parts ...string) string {
+	parts = append([]string{"namespaces", d.Get("namespace").(string), "resourcequotas"}, parts...)
+	return addons.CCEServiceURL(client, d.Get("cluster_id").(string), parts...)
+}
+
+func buildResourceQuota(d *schema.ResourceData) k8sResourceQuota {
+	quota := k8sResourceQuota{
+		APIVersion: "v1",
+		Kind:       "ResourceQuota",
+		Metadata: k8sMetadata{
+			Name:      d.Get("name").(string),
+			Namespace: d.Get("namespace").(string),
+		},
+	}
+	quota.Spec.Hard = expandStringMap(d.Get("hard").(map[string]interface{}))
+	return quota
+}
+
+func resourceCCEResourceQuotaV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CceV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	quota := buildResourceQuota(d)
+	log.Printf("[DEBUG] Creating CCE resource quota: %#v", quota)
+	_, err = client.Post(resourceQuotaURL(client, d), quota, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating CCE resource quota: %w", logHttpError(err))
+	}
+	d.SetId(common.BuildComponentID(d.Get("cluster_id").(string), quota.Metadata.Namespace, quota.Metadata.Name))
+
+	return resourceCCEResourceQuotaV1Read(ctx, d, meta)
+}
+
+func resourceCCEResourceQuotaV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CceV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(resourceQuotaURL(client, d, d.Get("name").(string)), &r.Body, nil)
+	var quota k8sResourceQuota
+	if err := r.ExtractInto(&quota); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CCE resource quota"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("hard", quota.Spec.Hard),
+		d.Set("used", quota.Status.Used),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CCE resource quota fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCCEResourceQuotaV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CceV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	quota := buildResourceQuota(d)
+	_, err = client.Put(resourceQuotaURL(client, d, quota.Metadata.Name), quota, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating CCE resource quota: %w", logHttpError(err))
+	}
+
+	return resourceCCEResourceQuotaV1Read(ctx, d, meta)
+}
+
+func resourceCCEResourceQuotaV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CceV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cceClientError, err)
+	}
+
+	_, err = client.Delete(resourceQuotaURL(client, d, d.Get("name").(string)), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CCE resource quota"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCCEResourceQuotaV1Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid format specified for CCE resource quota, must be <cluster_id>/<namespace>/<name>")
+	}
+	mErr := multierror.Append(
+		d.Set("cluster_id", parts[0]),
+		d.Set("namespace", parts[1]),
+		d.Set("name", parts[2]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
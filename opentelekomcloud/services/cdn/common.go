@@ -0,0 +1,130 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	cdnClientError = "error creating OpenTelekomCloud CDNv1 client: %w"
+)
+
+// cdnSource is an origin server of the acceleration domain
+type cdnSource struct {
+	DomainID      string `json:"domain_id,omitempty"`
+	IPOrDomain    string `json:"ip_or_domain"`
+	OriginType    string `json:"origin_type"`
+	ActiveStandby int    `json:"active_standby"`
+}
+
+type cdnDomain struct {
+	ID           string      `json:"id"`
+	DomainName   string      `json:"domain_name"`
+	BusinessType string      `json:"business_type"`
+	ServiceArea  string      `json:"service_area"`
+	DomainStatus string      `json:"domain_status"`
+	Cname        string      `json:"cname"`
+	HttpsStatus  int         `json:"https_status"`
+	Sources      []cdnSource `json:"sources"`
+}
+
+func getCdnDomain(client *golangsdk.ServiceClient, id string) (*cdnDomain, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("cdn", "domains", id, "detail"), &r.Body, nil)
+	var domain cdnDomain
+	if err := r.ExtractIntoStructPtr(&domain, "domain"); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
+// waitForCdnDomainStatus waits until the domain configuration is applied
+func waitForCdnDomainStatus(ctx context.Context, client *golangsdk.ServiceClient, id string, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"configuring", "checking", "deleting"},
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			domain, err := getCdnDomain(client, id)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return &cdnDomain{}, "deleted", nil
+				}
+				return nil, "", err
+			}
+			return domain, domain.DomainStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for CDN domain %s to become %v: %w", id, target, err)
+	}
+	return nil
+}
+
+// mapKeyByValue returns the key of the Terraform to API enum mapping by the API value
+func mapKeyByValue(mapping map[string]int, value int) string {
+	for k, v := range mapping {
+		if v == value {
+			return k
+		}
+	}
+	return ""
+}
+
+func mapKeys(mapping map[string]int) []string {
+	keys := make([]string, 0, len(mapping))
+	for k := range mapping {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// cdnTask is a history task of the content refreshing or preheating
+type cdnTask struct {
+	ID         string `json:"id"`
+	TaskType   string `json:"task_type"`
+	Status     string `json:"status"`
+	Processing int    `json:"processing"`
+	Succeed    int    `json:"succeed"`
+	Failed     int    `json:"failed"`
+	Total      int    `json:"total"`
+}
+
+func getCdnTask(client *golangsdk.ServiceClient, id string) (*cdnTask, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("cdn", "historytasks", id, "detail"), &r.Body, nil)
+	var task cdnTask
+	if err := r.ExtractInto(&task); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// waitForCdnTask waits until all URLs of the task are processed
+func waitForCdnTask(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) (*cdnTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"task_inprocess"},
+		Target:  []string{"task_done"},
+		Refresh: func() (interface{}, string, error) {
+			task, err := getCdnTask(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			return task, task.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	task, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for CDN task %s to complete: %w", id, err)
+	}
+	return task.(*cdnTask), nil
+}
@@ -0,0 +1,254 @@
+package cdn
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// accessListTypes are the types of referer and IP ACL, `0` disables the list
+var accessListTypes = map[string]int{
+	"blacklist": 1,
+	"whitelist": 2,
+}
+
+// ResourceCdnAccessControlV1 manages referer and IP access lists of the CDN domain
+func ResourceCdnAccessControlV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCdnAccessControlV1Put,
+		ReadContext:   resourceCdnAccessControlV1Read,
+		UpdateContext: resourceCdnAccessControlV1Put,
+		DeleteContext: resourceCdnAccessControlV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCdnDomainSettingsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"referer": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"referer", "ip_acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mapKeys(accessListTypes), false),
+						},
+						"list": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"include_empty": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ip_acl": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mapKeys(accessListTypes), false),
+						},
+						"ip_list": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 150,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type cdnReferer struct {
+	RefererType  int    `json:"referer_type"`
+	RefererList  string `json:"referer_list"`
+	IncludeEmpty bool   `json:"include_empty"`
+}
+
+type cdnIPACL struct {
+	Type   int      `json:"type"`
+	IPList []string `json:"ip_list"`
+}
+
+func putCdnReferer(client *golangsdk.ServiceClient, domainID string, referer cdnReferer) error {
+	body := map[string]interface{}{
+		"referer": referer,
+	}
+	_, err := client.Put(client.ServiceURL("cdn", "domains", domainID, "referer"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func putCdnIPACL(client *golangsdk.ServiceClient, domainID string, acl cdnIPACL) error {
+	body := map[string]interface{}{
+		"ip_acl": acl,
+	}
+	_, err := client.Put(client.ServiceURL("cdn", "domains", domainID, "ip-acl"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func resourceCdnAccessControlV1Put(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+	domainID := d.Get("domain_id").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	if d.HasChange("referer") {
+		referer := cdnReferer{}
+		if refererRaw := d.Get("referer").([]interface{}); len(refererRaw) > 0 {
+			raw := refererRaw[0].(map[string]interface{})
+			referer = cdnReferer{
+				RefererType:  accessListTypes[raw["type"].(string)],
+				RefererList:  strings.Join(common.ExpandToStringSlice(raw["list"].([]interface{})), ";"),
+				IncludeEmpty: raw["include_empty"].(bool),
+			}
+		}
+		if err := putCdnReferer(client, domainID, referer); err != nil {
+			return fmterr.Errorf("error setting referer list of CDN domain: %w", err)
+		}
+		if err := waitForCdnDomainStatus(ctx, client, domainID, []string{"online", "offline"}, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("ip_acl") {
+		acl := cdnIPACL{}
+		if aclRaw := d.Get("ip_acl").([]interface{}); len(aclRaw) > 0 {
+			raw := aclRaw[0].(map[string]interface{})
+			acl = cdnIPACL{
+				Type:   accessListTypes[raw["type"].(string)],
+				IPList: common.ExpandToStringSlice(raw["ip_list"].(*schema.Set).List()),
+			}
+		}
+		if err := putCdnIPACL(client, domainID, acl); err != nil {
+			return fmterr.Errorf("error setting IP ACL of CDN domain: %w", err)
+		}
+		if err := waitForCdnDomainStatus(ctx, client, domainID, []string{"online", "offline"}, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(domainID)
+
+	return resourceCdnAccessControlV1Read(ctx, d, meta)
+}
+
+func resourceCdnAccessControlV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	refererResult := golangsdk.Result{}
+	_, refererResult.Err = client.Get(client.ServiceURL("cdn", "domains", d.Id(), "referer"), &refererResult.Body, nil)
+	var referer cdnReferer
+	if err := refererResult.ExtractIntoStructPtr(&referer, "referer"); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN access control"))
+	}
+
+	aclResult := golangsdk.Result{}
+	_, aclResult.Err = client.Get(client.ServiceURL("cdn", "domains", d.Id(), "ip-acl"), &aclResult.Body, nil)
+	var acl cdnIPACL
+	if err := aclResult.ExtractIntoStructPtr(&acl, "ip_acl"); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN access control"))
+	}
+
+	var refererList []map[string]interface{}
+	if referer.RefererType != 0 {
+		refererList = append(refererList, map[string]interface{}{
+			"type":          mapKeyByValue(accessListTypes, referer.RefererType),
+			"list":          strings.Split(referer.RefererList, ";"),
+			"include_empty": referer.IncludeEmpty,
+		})
+	}
+	var aclList []map[string]interface{}
+	if acl.Type != 0 {
+		aclList = append(aclList, map[string]interface{}{
+			"type":    mapKeyByValue(accessListTypes, acl.Type),
+			"ip_list": acl.IPList,
+		})
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("domain_id", d.Id()),
+		d.Set("referer", refererList),
+		d.Set("ip_acl", aclList),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CDN access control fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCdnAccessControlV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	if err := putCdnReferer(client, d.Id(), cdnReferer{}); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN access control"))
+	}
+	if err := putCdnIPACL(client, d.Id(), cdnIPACL{}); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN access control"))
+	}
+	if err := waitForCdnDomainStatus(ctx, client, d.Id(), []string{"online", "offline"}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
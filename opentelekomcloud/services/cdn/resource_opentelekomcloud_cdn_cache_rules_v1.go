@@ -0,0 +1,231 @@
+package cdn
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+var (
+	cacheRuleTypes = map[string]int{
+		"all":            0,
+		"file_extension": 1,
+		"catalog":        2,
+		"full_path":      3,
+		"home_page":      4,
+	}
+	cacheTTLUnits = map[string]int{
+		"s": 1,
+		"m": 2,
+		"h": 3,
+		"d": 4,
+	}
+)
+
+// ResourceCdnCacheRulesV1 manages the whole cache configuration of the CDN domain
+func ResourceCdnCacheRulesV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCdnCacheRulesV1Put,
+		ReadContext:   resourceCdnCacheRulesV1Read,
+		UpdateContext: resourceCdnCacheRulesV1Put,
+		DeleteContext: resourceCdnCacheRulesV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCdnDomainSettingsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ignore_url_parameter": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mapKeys(cacheRuleTypes), false),
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 365*24*60*60),
+						},
+						"ttl_unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "s",
+							ValidateFunc: validation.StringInSlice(mapKeys(cacheTTLUnits), false),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type cdnCacheRule struct {
+	RuleType int    `json:"rule_type"`
+	Content  string `json:"content"`
+	TTL      int    `json:"ttl"`
+	TTLType  int    `json:"ttl_type"`
+	Priority int    `json:"priority"`
+}
+
+type cdnCacheConfig struct {
+	IgnoreURLParameter bool           `json:"ignore_url_parameter"`
+	Rules              []cdnCacheRule `json:"rules"`
+}
+
+func putCdnCacheConfig(ctx context.Context, client *golangsdk.ServiceClient, domainID string, cacheConfig cdnCacheConfig, timeout time.Duration) error {
+	body := map[string]interface{}{
+		"cache_config": cacheConfig,
+	}
+	log.Printf("[DEBUG] Setting cache configuration of CDN domain %s: %#v", domainID, body)
+	_, err := client.Put(client.ServiceURL("cdn", "domains", domainID, "cache"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return err
+	}
+	return waitForCdnDomainStatus(ctx, client, domainID, []string{"online", "offline"}, timeout)
+}
+
+func resourceCdnCacheRulesV1Put(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	cacheConfig := cdnCacheConfig{
+		IgnoreURLParameter: d.Get("ignore_url_parameter").(bool),
+	}
+	for _, v := range d.Get("rule").([]interface{}) {
+		raw := v.(map[string]interface{})
+		cacheConfig.Rules = append(cacheConfig.Rules, cdnCacheRule{
+			RuleType: cacheRuleTypes[raw["type"].(string)],
+			Content:  raw["content"].(string),
+			TTL:      raw["ttl"].(int),
+			TTLType:  cacheTTLUnits[raw["ttl_unit"].(string)],
+			Priority: raw["priority"].(int),
+		})
+	}
+
+	domainID := d.Get("domain_id").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+	if err := putCdnCacheConfig(ctx, client, domainID, cacheConfig, timeout); err != nil {
+		return fmterr.Errorf("error setting cache rules of CDN domain: %w", err)
+	}
+	d.SetId(domainID)
+
+	return resourceCdnCacheRulesV1Read(ctx, d, meta)
+}
+
+func resourceCdnCacheRulesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("cdn", "domains", d.Id(), "cache"), &r.Body, nil)
+	var cacheConfig cdnCacheConfig
+	if err := r.ExtractIntoStructPtr(&cacheConfig, "cache_config"); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN cache rules"))
+	}
+
+	var rules []map[string]interface{}
+	for _, rule := range cacheConfig.Rules {
+		rules = append(rules, map[string]interface{}{
+			"type":     mapKeyByValue(cacheRuleTypes, rule.RuleType),
+			"content":  rule.Content,
+			"ttl":      rule.TTL,
+			"ttl_unit": mapKeyByValue(cacheTTLUnits, rule.TTLType),
+			"priority": rule.Priority,
+		})
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("domain_id", d.Id()),
+		d.Set("ignore_url_parameter", cacheConfig.IgnoreURLParameter),
+		d.Set("rule", rules),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CDN cache rules fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCdnCacheRulesV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	// the default configuration caches all files for 30 days
+	defaultConfig := cdnCacheConfig{
+		Rules: []cdnCacheRule{
+			{RuleType: cacheRuleTypes["all"], TTL: 30, TTLType: cacheTTLUnits["d"], Priority: 1},
+		},
+	}
+	if err := putCdnCacheConfig(ctx, client, d.Id(), defaultConfig, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN cache rules"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCdnDomainSettingsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("domain_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,366 @@
+package cdn
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+var certificateSources = map[string]int{
+	"own": 0,
+	"scm": 2,
+}
+
+func ResourceCdnDomainV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCdnDomainV1Create,
+		ReadContext:   resourceCdnDomainV1Read,
+		UpdateContext: resourceCdnDomainV1Update,
+		DeleteContext: resourceCdnDomainV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"web", "download", "video", "wholeSite",
+				}, false),
+			},
+			"service_area": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"mainland_china", "outside_mainland_china", "global",
+				}, false),
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"origin": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"origin_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ipaddr", "domain", "obs_bucket",
+							}, false),
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"https": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_source": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "own",
+							ValidateFunc: validation.StringInSlice(mapKeys(certificateSources), false),
+						},
+						"scm_certificate_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"certificate_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"certificate_body": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"private_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"http2": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"force_redirect_https": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandCdnSources(d *schema.ResourceData) []cdnSource {
+	var sources []cdnSource
+	for _, v := range d.Get("sources").([]interface{}) {
+		raw := v.(map[string]interface{})
+		activeStandby := 0
+		if raw["active"].(bool) {
+			activeStandby = 1
+		}
+		sources = append(sources, cdnSource{
+			IPOrDomain:    raw["origin"].(string),
+			OriginType:    raw["origin_type"].(string),
+			ActiveStandby: activeStandby,
+		})
+	}
+	return sources
+}
+
+func flattenCdnSources(sources []cdnSource) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, source := range sources {
+		result = append(result, map[string]interface{}{
+			"origin":      source.IPOrDomain,
+			"origin_type": source.OriginType,
+			"active":      source.ActiveStandby == 1,
+		})
+	}
+	return result
+}
+
+func boolToInt(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func expandCdnHttps(d *schema.ResourceData) map[string]interface{} {
+	httpsRaw := d.Get("https").([]interface{})
+	if len(httpsRaw) == 0 {
+		return map[string]interface{}{
+			"https_status": 0,
+		}
+	}
+	raw := httpsRaw[0].(map[string]interface{})
+	https := map[string]interface{}{
+		"https_status":         2,
+		"cert_name":            raw["certificate_name"],
+		"certificate_source":   certificateSources[raw["certificate_source"].(string)],
+		"http2":                boolToInt(raw["http2"].(bool)),
+		"force_redirect_https": boolToInt(raw["force_redirect_https"].(bool)),
+	}
+	if raw["certificate_source"].(string) == "scm" {
+		https["scm_certificate_id"] = raw["scm_certificate_id"]
+	} else {
+		https["certificate"] = raw["certificate_body"]
+		https["private_key"] = raw["private_key"]
+	}
+	return https
+}
+
+func updateCdnHttps(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	body := map[string]interface{}{
+		"https": expandCdnHttps(d),
+	}
+	_, err := client.Put(client.ServiceURL("cdn", "domains", d.Id(), "https-info"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func resourceCdnDomainV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	domain := map[string]interface{}{
+		"domain_name":   d.Get("name"),
+		"business_type": d.Get("type"),
+		"sources":       expandCdnSources(d),
+	}
+	if area := d.Get("service_area").(string); area != "" {
+		domain["service_area"] = area
+	}
+	body := map[string]interface{}{
+		"domain": domain,
+	}
+	log.Printf("[DEBUG] Creating CDN domain: %#v", body)
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("cdn", "domains"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	var created cdnDomain
+	if err := r.ExtractIntoStructPtr(&created, "domain"); err != nil {
+		return fmterr.Errorf("error creating CDN domain: %w", err)
+	}
+	d.SetId(created.ID)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForCdnDomainStatus(ctx, client, d.Id(), []string{"online"}, timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, ok := d.GetOk("https"); ok {
+		if err := updateCdnHttps(client, d); err != nil {
+			return fmterr.Errorf("error setting HTTPS of CDN domain: %w", err)
+		}
+		if err := waitForCdnDomainStatus(ctx, client, d.Id(), []string{"online"}, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCdnDomainV1Read(ctx, d, meta)
+}
+
+func resourceCdnDomainV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	domain, err := getCdnDomain(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN domain"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", domain.DomainName),
+		d.Set("type", domain.BusinessType),
+		d.Set("service_area", domain.ServiceArea),
+		d.Set("sources", flattenCdnSources(domain.Sources)),
+		d.Set("cname", domain.Cname),
+		d.Set("domain_status", domain.DomainStatus),
+	)
+	// certificate and private key are not returned by the API
+	if domain.HttpsStatus == 0 {
+		mErr = multierror.Append(mErr, d.Set("https", nil))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CDN domain fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCdnDomainV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("sources") {
+		body := map[string]interface{}{
+			"origin": map[string]interface{}{
+				"sources": expandCdnSources(d),
+			},
+		}
+		_, err = client.Put(client.ServiceURL("cdn", "domains", d.Id(), "sources"), body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating sources of CDN domain: %w", err)
+		}
+		if err := waitForCdnDomainStatus(ctx, client, d.Id(), []string{"online"}, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("https") {
+		if err := updateCdnHttps(client, d); err != nil {
+			return fmterr.Errorf("error updating HTTPS of CDN domain: %w", err)
+		}
+		if err := waitForCdnDomainStatus(ctx, client, d.Id(), []string{"online"}, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCdnDomainV1Read(ctx, d, meta)
+}
+
+func resourceCdnDomainV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	// only disabled domain can be deleted
+	if d.Get("domain_status").(string) != "offline" {
+		_, err = client.Put(client.ServiceURL("cdn", "domains", d.Id(), "disable"), nil, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return diag.FromErr(common.CheckDeleted(d, err, "CDN domain"))
+		}
+		if err := waitForCdnDomainStatus(ctx, client, d.Id(), []string{"offline"}, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err = client.Delete(client.ServiceURL("cdn", "domains", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN domain"))
+	}
+	if err := waitForCdnDomainStatus(ctx, client, d.Id(), []string{"deleted"}, timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,62 @@
+package cdn
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceCdnPreheatTaskV1 is an action resource caching the content on the CDN nodes
+// in advance, the task is executed on creation only
+func ResourceCdnPreheatTaskV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCdnPreheatTaskV1Create,
+		ReadContext:   resourceCdnTaskV1Read,
+		DeleteContext: resourceCdnTaskV1Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: cdnTaskSchema(),
+	}
+}
+
+func resourceCdnPreheatTaskV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	body := map[string]interface{}{
+		"preheating_task": map[string]interface{}{
+			"urls": common.ExpandToStringSlice(d.Get("urls").([]interface{})),
+		},
+	}
+	log.Printf("[DEBUG] Creating CDN preheat task: %#v", body)
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("cdn", "content", "preheating-tasks"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	var task cdnTask
+	if err := r.ExtractIntoStructPtr(&task, "preheating_task"); err != nil {
+		return fmterr.Errorf("error creating CDN preheat task: %w", err)
+	}
+	d.SetId(task.ID)
+
+	if _, err := waitForCdnTask(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCdnTaskV1Read(ctx, d, meta)
+}
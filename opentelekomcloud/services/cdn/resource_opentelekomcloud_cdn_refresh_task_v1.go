@@ -0,0 +1,146 @@
+package cdn
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceCdnRefreshTaskV1 is an action resource purging the cached content,
+// the task is executed on creation only
+func ResourceCdnRefreshTaskV1() *schema.Resource {
+	taskSchema := cdnTaskSchema()
+	taskSchema["type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "file",
+		ValidateFunc: validation.StringInSlice([]string{"file", "directory"}, false),
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceCdnRefreshTaskV1Create,
+		ReadContext:   resourceCdnTaskV1Read,
+		DeleteContext: resourceCdnTaskV1Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: taskSchema,
+	}
+}
+
+func resourceCdnRefreshTaskV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	body := map[string]interface{}{
+		"refresh_task": map[string]interface{}{
+			"type": d.Get("type"),
+			"urls": common.ExpandToStringSlice(d.Get("urls").([]interface{})),
+		},
+	}
+	log.Printf("[DEBUG] Creating CDN refresh task: %#v", body)
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("cdn", "content", "refresh-tasks"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	var task cdnTask
+	if err := r.ExtractIntoStructPtr(&task, "refresh_task"); err != nil {
+		return fmterr.Errorf("error creating CDN refresh task: %w", err)
+	}
+	d.SetId(task.ID)
+
+	if _, err := waitForCdnTask(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCdnTaskV1Read(ctx, d, meta)
+}
+
+func cdnTaskSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"urls": {
+			Type:     schema.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			MaxItems: 1000,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"processing": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"succeed": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"failed": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"total": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func resourceCdnTaskV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CdnV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cdnClientError, err)
+	}
+
+	task, err := getCdnTask(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CDN task"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("status", task.Status),
+		d.Set("processing", task.Processing),
+		d.Set("succeed", task.Succeed),
+		d.Set("failed", task.Failed),
+		d.Set("total", task.Total),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CDN task fields: %w", err)
+	}
+
+	return nil
+}
+
+// resourceCdnTaskV1Delete only removes the task from the state, executed tasks can't be reverted
+func resourceCdnTaskV1Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
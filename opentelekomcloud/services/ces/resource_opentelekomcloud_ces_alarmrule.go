@@ -20,6 +20,11 @@ import (
 
 const nameCESAR = "CES-AlarmRule"
 
+const (
+	alarmTypeEventSys    = "EVENT.SYS"
+	alarmTypeEventCustom = "EVENT.CUSTOM"
+)
+
 func ResourceAlarmRule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAlarmRuleCreate,
@@ -61,6 +66,16 @@ func ResourceAlarmRule() *schema.Resource {
 				},
 			},
 
+			"alarm_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					alarmTypeEventSys, alarmTypeEventCustom,
+				}, false),
+			},
+
 			"alarm_level": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -96,7 +111,7 @@ func ResourceAlarmRule() *schema.Resource {
 
 						"dimensions": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 3,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -145,6 +160,7 @@ func ResourceAlarmRule() *schema.Resource {
 							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 								value := v.(int)
 								switch value {
+								case 0:
 								case 1:
 								case 300:
 								case 1200:
@@ -152,7 +168,7 @@ func ResourceAlarmRule() *schema.Resource {
 								case 14400:
 								case 86400:
 								default:
-									errors = append(errors, fmt.Errorf("%s can be 1, 300, 1200, 3600, 14400, 86400", k))
+									errors = append(errors, fmt.Errorf("%s can be 0 (event alarms only), 1, 300, 1200, 3600, 14400, 86400", k))
 								}
 								return
 							},
@@ -226,98 +242,11 @@ func ResourceAlarmRule() *schema.Resource {
 				},
 			},
 
-			"alarm_actions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-								value := v.(string)
-								switch value {
-								case "notification":
-								case "autoscaling":
-								default:
-									errors = append(errors, fmt.Errorf("%s can be notification or autoscaling", k))
-								}
-								return
-							},
-						},
-
-						"notification_list": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 5,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
-				},
-			},
-
-			"insufficientdata_actions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-								value := v.(string)
-								switch value {
-								case "notification":
-								case "autoscaling":
-								default:
-									errors = append(errors, fmt.Errorf("%s can be notification or autoscaling", k))
-								}
-								return
-							},
-						},
-
-						"notification_list": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 5,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
-				},
-			},
+			"alarm_actions": alarmActionSchema(),
 
-			"ok_actions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-								value := v.(string)
-								switch value {
-								case "notification":
-								case "autoscaling":
-								default:
-									errors = append(errors, fmt.Errorf("%s can be notification or autoscaling", k))
-								}
-								return
-							},
-						},
+			"insufficientdata_actions": alarmActionSchema(),
 
-						"notification_list": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 5,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
-				},
-			},
+			"ok_actions": alarmActionSchema(),
 
 			"alarm_enabled": {
 				Type:     schema.TypeBool,
@@ -347,6 +276,75 @@ func ResourceAlarmRule() *schema.Resource {
 	}
 }
 
+// alarmActionSchema returns the schema of the alarm action, which can notify SMN topics,
+// trigger AS policies or invoke FunctionGraph functions
+func alarmActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"notification", "autoscaling", "function",
+					}, false),
+				},
+
+				"notification_list": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 5,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// validateAlarmActions checks that notification targets match the action type:
+// `autoscaling` actions are referenced by AS policies and must not have targets,
+// `notification` and `function` actions require SMN topic or function URNs
+func validateAlarmActions(d *schema.ResourceData) error {
+	for _, name := range []string{"alarm_actions", "insufficientdata_actions", "ok_actions"} {
+		for i, v := range d.Get(name).([]interface{}) {
+			action := v.(map[string]interface{})
+			targets := len(action["notification_list"].([]interface{}))
+			switch action["type"].(string) {
+			case "autoscaling":
+				if targets != 0 {
+					return fmt.Errorf("%s.%d.notification_list must be empty for `autoscaling` action", name, i)
+				}
+			default:
+				if targets == 0 {
+					return fmt.Errorf("%s.%d.notification_list is required for `%s` action", name, i, action["type"])
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateAlarmCondition checks that the rule is either an event alarm with immediate (0) period
+// or a metric alarm with dimensions set
+func validateAlarmCondition(d *schema.ResourceData) error {
+	alarmType := d.Get("alarm_type").(string)
+	isEvent := alarmType == alarmTypeEventSys || alarmType == alarmTypeEventCustom
+	period := d.Get("condition.0.period").(int)
+	dimensions := d.Get("metric.0.dimensions").([]interface{})
+	switch {
+	case isEvent && period != 0:
+		return fmt.Errorf("condition.0.period must be 0 for `%s` alarms", alarmType)
+	case !isEvent && period == 0:
+		return fmt.Errorf("condition.0.period can be 0 only for event alarms")
+	case !isEvent && len(dimensions) == 0:
+		return fmt.Errorf("metric.0.dimensions are required for metric alarms")
+	}
+	return nil
+}
+
 func getMetricOpts(d *schema.ResourceData) (alarmrule.MetricOpts, error) {
 	mos, ok := d.Get("metric").([]interface{})
 	if !ok {
@@ -400,6 +398,13 @@ func resourceAlarmRuleCreate(ctx context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf("error creating Cloud Eye Service client: %s", err)
 	}
 
+	if err := validateAlarmActions(d); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := validateAlarmCondition(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	metric, err := getMetricOpts(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -409,6 +414,7 @@ func resourceAlarmRuleCreate(ctx context.Context, d *schema.ResourceData, meta i
 	createOpts := alarmrule.CreateOpts{
 		AlarmName:        d.Get("alarm_name").(string),
 		AlarmDescription: d.Get("alarm_description").(string),
+		AlarmType:        d.Get("alarm_type").(string),
 		AlarmLevel:       d.Get("alarm_level").(int),
 		Metric:           metric,
 		Condition: alarmrule.ConditionOpts{
@@ -457,6 +463,7 @@ func resourceAlarmRuleRead(_ context.Context, d *schema.ResourceData, meta inter
 	}
 	d.Set("alarm_name", m["alarm_name"])
 	d.Set("alarm_description", m["alarm_description"])
+	d.Set("alarm_type", m["alarm_type"])
 	d.Set("alarm_level", m["alarm_level"])
 	d.Set("metric", m["metric"])
 	d.Set("condition", m["condition"])
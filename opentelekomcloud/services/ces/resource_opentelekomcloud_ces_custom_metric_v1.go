@@ -0,0 +1,202 @@
+package ces
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const nameCESCM = "CES-CustomMetric"
+
+var (
+	// custom namespaces have `service.item` format, `SYS` prefix is reserved for cloud services
+	customNamespaceRe = regexp.MustCompile(`^[A-Za-z][\w]*\.[A-Za-z][\w]*$`)
+	metricNameRe      = regexp.MustCompile(`^[A-Za-z][\w]{0,63}$`)
+)
+
+type metricDimension struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type metricData struct {
+	Metric struct {
+		Namespace  string            `json:"namespace"`
+		MetricName string            `json:"metric_name"`
+		Dimensions []metricDimension `json:"dimensions"`
+	} `json:"metric"`
+	TTL         int     `json:"ttl"`
+	CollectTime int64   `json:"collect_time"`
+	Value       float64 `json:"value"`
+	Unit        string  `json:"unit,omitempty"`
+	Type        string  `json:"type,omitempty"`
+}
+
+func ResourceCustomMetricV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomMetricV1Create,
+		ReadContext:   resourceCustomMetricV1Read,
+		UpdateContext: resourceCustomMetricV1Create,
+		DeleteContext: resourceCustomMetricV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 32),
+					validation.StringMatch(customNamespaceRe, "namespace must be in `service.item` format"),
+					func(v interface{}, k string) (ws []string, errors []error) {
+						if strings.HasPrefix(strings.ToUpper(v.(string)), "SYS.") {
+							errors = append(errors, fmt.Errorf("%s can't use the reserved `SYS` service", k))
+						}
+						return
+					},
+				),
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(metricNameRe, "metric name must start with a letter and contain letters, digits and underscores"),
+			},
+			"dimensions": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"value": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"int", "float"}, false),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      172800,
+				ValidateFunc: validation.IntBetween(1, 604800),
+			},
+			"collect_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func customMetricID(namespace, metricName string, dimensions []metricDimension) string {
+	parts := []string{namespace, metricName}
+	for _, dim := range dimensions {
+		parts = append(parts, fmt.Sprintf("%s=%s", dim.Name, dim.Value))
+	}
+	return strings.Join(parts, "/")
+}
+
+// resourceCustomMetricV1Create reports a data point of the metric, which declares the metric
+// and its namespace until the data point expires
+func resourceCustomMetricV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	data := metricData{
+		TTL:         d.Get("ttl").(int),
+		CollectTime: time.Now().UnixNano() / int64(time.Millisecond),
+		Value:       d.Get("value").(float64),
+		Unit:        d.Get("unit").(string),
+		Type:        d.Get("type").(string),
+	}
+	data.Metric.Namespace = d.Get("namespace").(string)
+	data.Metric.MetricName = d.Get("metric_name").(string)
+	for _, raw := range d.Get("dimensions").([]interface{}) {
+		dim := raw.(map[string]interface{})
+		data.Metric.Dimensions = append(data.Metric.Dimensions, metricDimension{
+			Name:  dim["name"].(string),
+			Value: dim["value"].(string),
+		})
+	}
+
+	_, err = client.Post(client.ServiceURL("metric-data"), []metricData{data}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error reporting %s data: %s", nameCESCM, err)
+	}
+	log.Printf("[DEBUG] Reported %s %s.%s data: %v", nameCESCM, data.Metric.Namespace, data.Metric.MetricName, data.Value)
+
+	d.SetId(customMetricID(data.Metric.Namespace, data.Metric.MetricName, data.Metric.Dimensions))
+	if err := d.Set("collect_time", data.CollectTime); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCustomMetricV1Read(ctx, d, meta)
+}
+
+// resourceCustomMetricV1Read removes the metric from the state once the reported data point expires,
+// so it will be reported again on the next apply
+func resourceCustomMetricV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+
+	expiresAt := time.Unix(0, int64(d.Get("collect_time").(int))*int64(time.Millisecond)).
+		Add(time.Duration(d.Get("ttl").(int)) * time.Second)
+	if time.Now().After(expiresAt) {
+		log.Printf("[WARN] %s %s data expired at %s", nameCESCM, d.Id(), expiresAt)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("region", config.GetRegion(d)); err != nil {
+		return fmterr.Errorf("error setting %s fields: %s", nameCESCM, err)
+	}
+	return nil
+}
+
+func resourceCustomMetricV1Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] %s data can't be deleted, %s will disappear after the TTL expires", nameCESCM, d.Id())
+	d.SetId("")
+	return nil
+}
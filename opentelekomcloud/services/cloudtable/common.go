@@ -0,0 +1,86 @@
+package cloudtable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	cloudTableClientError = "error creating OpenTelekomCloud CloudTable client: %w"
+)
+
+// cluster statuses are returned as numeric codes
+const (
+	clusterStatusCreating     = "100"
+	clusterStatusRunning      = "200"
+	clusterStatusAbnormal     = "300"
+	clusterStatusCreateFailed = "303"
+	clusterStatusDeleted      = "400"
+)
+
+type cloudTableDatastore struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+type cloudTableCluster struct {
+	ID                string              `json:"clusterId"`
+	Name              string              `json:"clusterName"`
+	Status            string              `json:"status"`
+	Datastore         cloudTableDatastore `json:"datastore"`
+	VpcID             string              `json:"vpcId"`
+	SubnetID          string              `json:"subNetId"`
+	SecurityGroupID   string              `json:"securityGroupId"`
+	AvailabilityZone  string              `json:"azCode"`
+	StorageType       string              `json:"storageType"`
+	StorageSize       int                 `json:"storageQuota"`
+	ComputeUnits      int                 `json:"cuNum"`
+	TSDUnits          int                 `json:"tsdNum"`
+	OpenTSDBEnabled   bool                `json:"openTSDB"`
+	AuthMode          bool                `json:"auth_mode"`
+	ZookeeperLink     string              `json:"zkLink"`
+	HBasePublicLink   string              `json:"hbase_public_endpoint"`
+	OpenTSDBLink      string              `json:"tsdLink"`
+	OpenTSDBPublicURL string              `json:"tsdPublicEndpoint"`
+	Created           string              `json:"created"`
+}
+
+func getCloudTableCluster(client *golangsdk.ServiceClient, id string) (*cloudTableCluster, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("clusters", id), &r.Body, nil)
+	cluster := new(cloudTableCluster)
+	if err := r.ExtractInto(cluster); err != nil {
+		return nil, err
+	}
+	if cluster.Status == clusterStatusDeleted {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return cluster, nil
+}
+
+func waitForCloudTableCluster(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{clusterStatusCreating},
+		Target:  []string{clusterStatusRunning},
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := getCloudTableCluster(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			switch cluster.Status {
+			case clusterStatusAbnormal, clusterStatusCreateFailed:
+				return cluster, cluster.Status, fmt.Errorf("cluster is in status %s", cluster.Status)
+			}
+			return cluster, cluster.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      60 * time.Second,
+		MinTimeout: 20 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
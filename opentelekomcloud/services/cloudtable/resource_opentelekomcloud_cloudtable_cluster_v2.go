@@ -0,0 +1,281 @@
+package cloudtable
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCloudTableClusterV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudTableClusterV2Create,
+		ReadContext:   resourceCloudTableClusterV2Read,
+		DeleteContext: resourceCloudTableClusterV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"hbase_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "1.0.6",
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"compute_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(2, 10),
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ULTRAHIGH",
+				ValidateFunc: validation.StringInSlice([]string{"COMMON", "ULTRAHIGH"}, false),
+			},
+			"storage_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(400),
+			},
+			"iam_auth_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"opentsdb_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"opentsdb_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(2, 10),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hbase_access_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hbase_public_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"opentsdb_access_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"opentsdb_public_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudTableClusterV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CloudTableV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cloudTableClientError, err)
+	}
+
+	openTSDB := d.Get("opentsdb_enabled").(bool)
+	instance := map[string]interface{}{
+		"availability_zone": d.Get("availability_zone").(string),
+		"cmp_count":         d.Get("compute_units").(int),
+		"nics": []map[string]interface{}{
+			{
+				"net_id":            d.Get("subnet_id").(string),
+				"security_group_id": d.Get("security_group_id").(string),
+			},
+		},
+	}
+	if openTSDB {
+		units := d.Get("opentsdb_units").(int)
+		if units == 0 {
+			return fmterr.Errorf("opentsdb_units must be set when OpenTSDB is enabled")
+		}
+		instance["tsd_count"] = units
+	}
+	opts := map[string]interface{}{
+		"cluster": map[string]interface{}{
+			"cluster_name": d.Get("name").(string),
+			"datastore": map[string]interface{}{
+				"type":    "hbase",
+				"version": d.Get("hbase_version").(string),
+			},
+			"vpc_id":          d.Get("vpc_id").(string),
+			"storage_type":    d.Get("storage_type").(string),
+			"storage_size":    d.Get("storage_size").(int),
+			"auth_mode":       d.Get("iam_auth_enabled").(bool),
+			"enable_openTSDB": openTSDB,
+			"instance":        instance,
+		},
+	}
+	log.Printf("[DEBUG] Creating CloudTable cluster %s", d.Get("name"))
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("clusters"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var created struct {
+		ID string `json:"cluster_id"`
+	}
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error creating CloudTable cluster: %w", err)
+	}
+	d.SetId(created.ID)
+
+	if err := waitForCloudTableCluster(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for CloudTable cluster %s to become running: %w", d.Id(), err)
+	}
+
+	return resourceCloudTableClusterV2Read(ctx, d, meta)
+}
+
+func resourceCloudTableClusterV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CloudTableV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cloudTableClientError, err)
+	}
+
+	cluster, err := getCloudTableCluster(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CloudTable cluster"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", cluster.Name),
+		d.Set("hbase_version", cluster.Datastore.Version),
+		d.Set("availability_zone", cluster.AvailabilityZone),
+		d.Set("vpc_id", cluster.VpcID),
+		d.Set("subnet_id", cluster.SubnetID),
+		d.Set("security_group_id", cluster.SecurityGroupID),
+		d.Set("compute_units", cluster.ComputeUnits),
+		d.Set("storage_type", cluster.StorageType),
+		d.Set("storage_size", cluster.StorageSize),
+		d.Set("iam_auth_enabled", cluster.AuthMode),
+		d.Set("opentsdb_enabled", cluster.OpenTSDBEnabled),
+		d.Set("status", cluster.Status),
+		d.Set("hbase_access_address", cluster.ZookeeperLink),
+		d.Set("hbase_public_address", cluster.HBasePublicLink),
+		d.Set("opentsdb_access_address", cluster.OpenTSDBLink),
+		d.Set("opentsdb_public_address", cluster.OpenTSDBPublicURL),
+		d.Set("created_at", cluster.Created),
+	)
+	if cluster.OpenTSDBEnabled {
+		mErr = multierror.Append(mErr, d.Set("opentsdb_units", cluster.TSDUnits))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CloudTable cluster fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCloudTableClusterV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CloudTableV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(cloudTableClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("clusters", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting CloudTable cluster"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		// the status isn't changed until the cluster is gone
+		Pending: []string{clusterStatusRunning, clusterStatusAbnormal, clusterStatusCreateFailed},
+		Target:  []string{clusterStatusDeleted},
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := getCloudTableCluster(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return d.Id(), clusterStatusDeleted, nil
+				}
+				return nil, "", err
+			}
+			return cluster, cluster.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for CloudTable cluster %s to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
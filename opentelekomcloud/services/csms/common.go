@@ -0,0 +1,111 @@
+package csms
+
+import (
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	csmsClientError = "error creating OpenTelekomCloud CSMSv1 client: %w"
+
+	currentStage = "SYSCURRENT"
+)
+
+type csmsSecret struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	State              string   `json:"state"`
+	KmsKeyID           string   `json:"kms_key_id"`
+	Description        string   `json:"description"`
+	CreateTime         int64    `json:"create_time"`
+	UpdateTime         int64    `json:"update_time"`
+	EventSubscriptions []string `json:"event_subscriptions"`
+}
+
+type csmsVersionMetadata struct {
+	ID            string   `json:"id"`
+	CreateTime    int64    `json:"create_time"`
+	KmsKeyID      string   `json:"kms_key_id"`
+	SecretName    string   `json:"secret_name"`
+	VersionStages []string `json:"version_stages"`
+}
+
+type csmsVersion struct {
+	VersionMetadata csmsVersionMetadata `json:"version_metadata"`
+	SecretString    string              `json:"secret_string"`
+	SecretBinary    string              `json:"secret_binary"`
+}
+
+func getSecret(client *golangsdk.ServiceClient, name string) (*csmsSecret, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("secrets", name), &r.Body, nil)
+	var secret csmsSecret
+	if err := r.ExtractIntoStructPtr(&secret, "secret"); err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
+// createSecretVersion stores a new value of the secret,
+// CSMS moves `SYSCURRENT` stage to the new version automatically
+func createSecretVersion(client *golangsdk.ServiceClient, name string, body map[string]interface{}) (*csmsVersionMetadata, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("secrets", name, "versions"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var version csmsVersion
+	if err := r.ExtractIntoStructPtr(&version, "version"); err != nil {
+		return nil, err
+	}
+	return &version.VersionMetadata, nil
+}
+
+// getSecretVersion returns the version with its value, `versionID` can be `latest`
+func getSecretVersion(client *golangsdk.ServiceClient, name, versionID string) (*csmsVersion, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("secrets", name, "versions", versionID), &r.Body, nil)
+	var version csmsVersion
+	if err := r.ExtractIntoStructPtr(&version, "version"); err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+// getStageVersionID returns ID of the version the stage is attached to
+func getStageVersionID(client *golangsdk.ServiceClient, name, stage string) (string, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("secrets", name, "stages", stage), &r.Body, nil)
+	var result struct {
+		VersionID string `json:"version_id"`
+	}
+	if err := r.ExtractIntoStructPtr(&result, "stage"); err != nil {
+		return "", err
+	}
+	return result.VersionID, nil
+}
+
+func setStage(client *golangsdk.ServiceClient, name, stage, versionID string) error {
+	body := map[string]interface{}{
+		"version_id": versionID,
+	}
+	_, err := client.Put(client.ServiceURL("secrets", name, "stages", stage), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteStage(client *golangsdk.ServiceClient, name, stage string) error {
+	_, err := client.Delete(client.ServiceURL("secrets", name, "stages", stage), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// formatTimestamp converts milliseconds timestamp returned by CSMS to RFC3339 string
+func formatTimestamp(ms int64) string {
+	if ms == 0 {
+		return ""
+	}
+	return time.Unix(ms/1000, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,100 @@
+package csms
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceCsmsSecretVersionV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCsmsSecretVersionV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"secret_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_stage"},
+			},
+			"version_stage": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secret_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_stages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCsmsSecretVersionV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	secretName := d.Get("secret_name").(string)
+	versionID := d.Get("version_id").(string)
+	if versionID == "" {
+		stage := d.Get("version_stage").(string)
+		if stage == "" {
+			stage = currentStage
+		}
+		versionID, err = getStageVersionID(client, secretName, stage)
+		if err != nil {
+			return fmterr.Errorf("error retrieving stage %s of CSMS secret %s: %w", stage, secretName, err)
+		}
+	}
+
+	version, err := getSecretVersion(client, secretName, versionID)
+	if err != nil {
+		return fmterr.Errorf("error retrieving CSMS secret version %s/%s: %w", secretName, versionID, err)
+	}
+	d.SetId(common.BuildComponentID(secretName, version.VersionMetadata.ID))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("version_id", version.VersionMetadata.ID),
+		d.Set("secret_string", version.SecretString),
+		d.Set("version_stages", version.VersionMetadata.VersionStages),
+		d.Set("kms_key_id", version.VersionMetadata.KmsKeyID),
+		d.Set("created_at", formatTimestamp(version.VersionMetadata.CreateTime)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CSMS secret version fields: %w", err)
+	}
+
+	return nil
+}
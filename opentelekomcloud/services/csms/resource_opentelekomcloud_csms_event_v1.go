@@ -0,0 +1,196 @@
+package csms
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCsmsEventV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCsmsEventV1Create,
+		ReadContext:   resourceCsmsEventV1Read,
+		UpdateContext: resourceCsmsEventV1Update,
+		DeleteContext: resourceCsmsEventV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"event_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SECRET_VERSION_CREATED", "SECRET_VERSION_EXPIRED", "SECRET_ROTATED", "SECRET_DELETED",
+					}, false),
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"smn_topic_urn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"smn_topic_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"event_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type csmsEventNotification struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	TargetName string `json:"target_name"`
+}
+
+type csmsEvent struct {
+	Name         string                `json:"name"`
+	EventID      string                `json:"event_id"`
+	EventTypes   []string              `json:"event_types"`
+	State        string                `json:"state"`
+	Notification csmsEventNotification `json:"notification"`
+	CreateTime   int64                 `json:"create_time"`
+}
+
+func csmsEventState(enabled bool) string {
+	if enabled {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
+
+func csmsEventBody(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"event_types": common.ExpandToStringSlice(d.Get("event_types").(*schema.Set).List()),
+		"state":       csmsEventState(d.Get("enabled").(bool)),
+		"notification": csmsEventNotification{
+			TargetType: "SMN",
+			TargetID:   d.Get("smn_topic_urn").(string),
+			TargetName: d.Get("smn_topic_name").(string),
+		},
+	}
+}
+
+func resourceCsmsEventV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	body := csmsEventBody(d)
+	body["name"] = d.Get("name")
+	log.Printf("[DEBUG] Creating CSMS event: %#v", body)
+
+	_, err = client.Post(client.ServiceURL("csms", "events"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating CSMS event: %w", err)
+	}
+	d.SetId(d.Get("name").(string))
+
+	return resourceCsmsEventV1Read(ctx, d, meta)
+}
+
+func resourceCsmsEventV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("csms", "events", d.Id()), &r.Body, nil)
+	var event csmsEvent
+	if err := r.ExtractIntoStructPtr(&event, "event"); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CSMS event"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", event.Name),
+		d.Set("event_types", event.EventTypes),
+		d.Set("enabled", event.State == "ENABLED"),
+		d.Set("smn_topic_urn", event.Notification.TargetID),
+		d.Set("smn_topic_name", event.Notification.TargetName),
+		d.Set("event_id", event.EventID),
+		d.Set("created_at", formatTimestamp(event.CreateTime)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CSMS event fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCsmsEventV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	_, err = client.Put(client.ServiceURL("csms", "events", d.Id()), csmsEventBody(d), nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating CSMS event %s: %w", d.Id(), err)
+	}
+
+	return resourceCsmsEventV1Read(ctx, d, meta)
+}
+
+func resourceCsmsEventV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("csms", "events", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CSMS event"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,230 @@
+package csms
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCsmsSecretV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCsmsSecretV1Create,
+		ReadContext:   resourceCsmsSecretV1Read,
+		UpdateContext: resourceCsmsSecretV1Update,
+		DeleteContext: resourceCsmsSecretV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[\w.-]+$`), "only letters, digits, '_', '-' and '.' are allowed"),
+				),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"secret_string": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"event_subscriptions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": common.TagsSchema(),
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCsmsSecretV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	name := d.Get("name").(string)
+	body := map[string]interface{}{
+		"name":          name,
+		"description":   d.Get("description"),
+		"secret_string": d.Get("secret_string"),
+	}
+	if keyID := d.Get("kms_key_id").(string); keyID != "" {
+		body["kms_key_id"] = keyID
+	}
+	if events := common.ExpandToStringSlice(d.Get("event_subscriptions").([]interface{})); len(events) > 0 {
+		body["event_subscriptions"] = events
+	}
+	log.Printf("[DEBUG] Creating CSMS secret: %s", name)
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("secrets"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var secret csmsSecret
+	if err := r.ExtractIntoStructPtr(&secret, "secret"); err != nil {
+		return fmterr.Errorf("error creating CSMS secret: %w", err)
+	}
+	d.SetId(secret.Name)
+
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		tagList := common.ExpandResourceTags(tagRaw)
+		if err := tags.Create(client, "csms", secret.ID, tagList).ExtractErr(); err != nil {
+			return fmterr.Errorf("error setting tags of CSMS secret %s: %w", secret.ID, err)
+		}
+	}
+
+	return resourceCsmsSecretV1Read(ctx, d, meta)
+}
+
+func resourceCsmsSecretV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	secret, err := getSecret(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CSMS secret"))
+	}
+
+	latestID, err := getStageVersionID(client, secret.Name, currentStage)
+	if err != nil {
+		return fmterr.Errorf("error retrieving current version of CSMS secret %s: %w", secret.Name, err)
+	}
+
+	resourceTags, err := tags.Get(client, "csms", secret.ID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error fetching tags of CSMS secret %s: %w", secret.ID, err)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", secret.Name),
+		d.Set("kms_key_id", secret.KmsKeyID),
+		d.Set("description", secret.Description),
+		d.Set("event_subscriptions", secret.EventSubscriptions),
+		d.Set("tags", common.TagsToMap(resourceTags)),
+		d.Set("secret_id", secret.ID),
+		d.Set("status", secret.State),
+		d.Set("latest_version", latestID),
+		d.Set("created_at", formatTimestamp(secret.CreateTime)),
+		d.Set("updated_at", formatTimestamp(secret.UpdateTime)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CSMS secret fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCsmsSecretV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	if d.HasChanges("kms_key_id", "description", "event_subscriptions") {
+		body := map[string]interface{}{
+			"kms_key_id":          d.Get("kms_key_id"),
+			"description":         d.Get("description"),
+			"event_subscriptions": common.ExpandToStringSlice(d.Get("event_subscriptions").([]interface{})),
+		}
+		_, err = client.Put(client.ServiceURL("secrets", d.Id()), body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating CSMS secret %s: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("secret_string") {
+		body := map[string]interface{}{
+			"secret_string": d.Get("secret_string"),
+		}
+		if _, err := createSecretVersion(client, d.Id(), body); err != nil {
+			return fmterr.Errorf("error creating new version of CSMS secret %s: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := common.UpdateResourceTags(client, d, "csms", d.Get("secret_id").(string)); err != nil {
+			return fmterr.Errorf("error updating tags of CSMS secret %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCsmsSecretV1Read(ctx, d, meta)
+}
+
+func resourceCsmsSecretV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("secrets", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CSMS secret"))
+	}
+
+	d.SetId("")
+	return nil
+}
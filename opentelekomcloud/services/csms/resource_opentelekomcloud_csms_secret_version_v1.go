@@ -0,0 +1,186 @@
+package csms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCsmsSecretVersionV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCsmsSecretVersionV1Create,
+		ReadContext:   resourceCsmsSecretVersionV1Read,
+		UpdateContext: resourceCsmsSecretVersionV1Update,
+		DeleteContext: resourceCsmsSecretVersionV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCsmsSecretVersionV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"secret_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_string": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"version_stages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCsmsSecretVersionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	secretName := d.Get("secret_name").(string)
+	body := map[string]interface{}{
+		"secret_string": d.Get("secret_string"),
+	}
+	if stages := common.ExpandToStringSlice(d.Get("version_stages").(*schema.Set).List()); len(stages) > 0 {
+		body["version_stages"] = stages
+	}
+
+	version, err := createSecretVersion(client, secretName, body)
+	if err != nil {
+		return fmterr.Errorf("error creating version of CSMS secret %s: %w", secretName, err)
+	}
+	d.SetId(common.BuildComponentID(secretName, version.ID))
+
+	return resourceCsmsSecretVersionV1Read(ctx, d, meta)
+}
+
+func resourceCsmsSecretVersionV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	version, err := getSecretVersion(client, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "CSMS secret version"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("secret_name", parts[0]),
+		d.Set("secret_string", version.SecretString),
+		d.Set("version_stages", version.VersionMetadata.VersionStages),
+		d.Set("version_id", version.VersionMetadata.ID),
+		d.Set("kms_key_id", version.VersionMetadata.KmsKeyID),
+		d.Set("created_at", formatTimestamp(version.VersionMetadata.CreateTime)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CSMS secret version fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCsmsSecretVersionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	if d.HasChange("version_stages") {
+		secretName := d.Get("secret_name").(string)
+		versionID := d.Get("version_id").(string)
+
+		oldRaw, newRaw := d.GetChange("version_stages")
+		oldStages := oldRaw.(*schema.Set)
+		newStages := newRaw.(*schema.Set)
+
+		// stages are moved to this version, they are detached from other versions automatically
+		for _, stage := range newStages.Difference(oldStages).List() {
+			if err := setStage(client, secretName, stage.(string), versionID); err != nil {
+				return fmterr.Errorf("error attaching stage %s to CSMS secret version %s: %w", stage, d.Id(), err)
+			}
+		}
+		for _, stage := range oldStages.Difference(newStages).List() {
+			if err := deleteStage(client, secretName, stage.(string)); err != nil {
+				return fmterr.Errorf("error removing stage %s of CSMS secret version %s: %w", stage, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceCsmsSecretVersionV1Read(ctx, d, meta)
+}
+
+// resourceCsmsSecretVersionV1Delete detaches custom stages from the version,
+// the version itself can't be deleted and is removed together with the secret
+func resourceCsmsSecretVersionV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CsmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(csmsClientError, err)
+	}
+
+	secretName := d.Get("secret_name").(string)
+	for _, stage := range d.Get("version_stages").(*schema.Set).List() {
+		name := stage.(string)
+		if strings.HasPrefix(name, "SYS") {
+			continue
+		}
+		if err := deleteStage(client, secretName, name); err != nil {
+			return diag.FromErr(common.CheckDeleted(d, err, "CSMS secret version"))
+		}
+	}
+
+	log.Printf("[DEBUG] CSMS secret version %s can't be deleted, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceCsmsSecretVersionV1Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for CSMS secret version, must be <secret_name>/<version_id>")
+	}
+	if err := d.Set("secret_name", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
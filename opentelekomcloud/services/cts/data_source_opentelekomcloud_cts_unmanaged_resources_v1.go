@@ -0,0 +1,320 @@
+package cts
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/blockstorage/v2/volumes"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/vpcs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/groups"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+type liveResource struct {
+	ID   string
+	Name string
+	Type string
+}
+
+type resourceLister func(config *cfg.Config, region string) ([]liveResource, error)
+
+// resourceListers contains listers of the live resources of supported types
+var resourceListers = map[string]resourceLister{
+	"ecs":            listLiveServers,
+	"evs":            listLiveVolumes,
+	"vpc":            listLiveVpcs,
+	"subnet":         listLiveSubnets,
+	"eip":            listLiveEips,
+	"security_group": listLiveSecGroups,
+}
+
+func DataSourceCTSUnmanagedResourcesV1() *schema.Resource {
+	supportedTypes := make([]string, 0, len(resourceListers))
+	for resourceType := range resourceListers {
+		supportedTypes = append(supportedTypes, resourceType)
+	}
+	sort.Strings(supportedTypes)
+
+	return &schema.Resource{
+		ReadContext: dataSourceCTSUnmanagedResourcesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(supportedTypes, false),
+				},
+			},
+			"managed_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"with_traces": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_operation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_operator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_operation_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCTSUnmanagedResourcesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+
+	managed := make(map[string]bool)
+	for _, id := range d.Get("managed_ids").(*schema.Set).List() {
+		managed[id.(string)] = true
+	}
+
+	resourceTypes := common.ExpandToStringSlice(d.Get("resource_types").(*schema.Set).List())
+	sort.Strings(resourceTypes)
+
+	var unmanaged []liveResource
+	for _, resourceType := range resourceTypes {
+		live, err := resourceListers[resourceType](config, region)
+		if err != nil {
+			return fmterr.Errorf("error listing %s resources: %w", resourceType, err)
+		}
+		for _, res := range live {
+			if !managed[res.ID] {
+				unmanaged = append(unmanaged, res)
+			}
+		}
+	}
+	log.Printf("[DEBUG] Found %d unmanaged resources", len(unmanaged))
+
+	var ctsClient *golangsdk.ServiceClient
+	if d.Get("with_traces").(bool) {
+		client, err := config.CtsV1Client(config.GetProjectName(d))
+		if err != nil {
+			return fmterr.Errorf(clientError, err)
+		}
+		ctsClient = client
+	}
+
+	ids := make([]string, len(unmanaged))
+	resources := make([]map[string]interface{}, len(unmanaged))
+	for i, res := range unmanaged {
+		ids[i] = res.ID
+		resources[i] = map[string]interface{}{
+			"id":   res.ID,
+			"name": res.Name,
+			"type": res.Type,
+		}
+		if ctsClient == nil {
+			continue
+		}
+		trace, err := lastResourceTrace(ctsClient, res.ID)
+		if err != nil {
+			return fmterr.Errorf("error retrieving traces of %s: %w", res.ID, err)
+		}
+		if trace != nil {
+			resources[i]["last_operation"] = trace.TraceName
+			resources[i]["last_operator"] = trace.User.Name
+			resources[i]["last_operation_time"] = trace.Time
+		}
+	}
+
+	d.SetId(hashcode.Strings(append(resourceTypes, ids...)))
+
+	mErr := multierror.Append(
+		d.Set("ids", ids),
+		d.Set("resources", resources),
+		d.Set("region", region),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting unmanaged resources fields: %w", err)
+	}
+
+	return nil
+}
+
+type resourceTrace struct {
+	TraceName string `json:"trace_name"`
+	Time      int    `json:"time"`
+	User      struct {
+		Name string `json:"name"`
+	} `json:"user"`
+}
+
+// lastResourceTrace returns the latest trace of the resource recorded by the system tracker
+func lastResourceTrace(client *golangsdk.ServiceClient, resourceID string) (*resourceTrace, error) {
+	url := client.ServiceURL("system", "trace") + fmt.Sprintf("?resource_id=%s&limit=1", resourceID)
+	var r golangsdk.Result
+	_, r.Err = client.Get(url, &r.Body, nil)
+	var traces []resourceTrace
+	if err := r.ExtractIntoSlicePtr(&traces, "traces"); err != nil {
+		return nil, err
+	}
+	if len(traces) == 0 {
+		return nil, nil
+	}
+	return &traces[0], nil
+}
+
+func listLiveServers(config *cfg.Config, region string) ([]liveResource, error) {
+	client, err := config.ComputeV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+	pages, err := servers.List(client, servers.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	list, err := servers.ExtractServers(pages)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]liveResource, len(list))
+	for i, v := range list {
+		result[i] = liveResource{ID: v.ID, Name: v.Name, Type: "ecs"}
+	}
+	return result, nil
+}
+
+func listLiveVolumes(config *cfg.Config, region string) ([]liveResource, error) {
+	client, err := config.BlockStorageV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud BlockStorageV2 client: %w", err)
+	}
+	pages, err := volumes.List(client, volumes.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	list, err := volumes.ExtractVolumes(pages)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]liveResource, len(list))
+	for i, v := range list {
+		result[i] = liveResource{ID: v.ID, Name: v.Name, Type: "evs"}
+	}
+	return result, nil
+}
+
+func listLiveVpcs(config *cfg.Config, region string) ([]liveResource, error) {
+	client, err := config.NetworkingV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+	list, err := vpcs.List(client, vpcs.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]liveResource, len(list))
+	for i, v := range list {
+		result[i] = liveResource{ID: v.ID, Name: v.Name, Type: "vpc"}
+	}
+	return result, nil
+}
+
+func listLiveSubnets(config *cfg.Config, region string) ([]liveResource, error) {
+	client, err := config.NetworkingV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+	list, err := subnets.List(client, subnets.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]liveResource, len(list))
+	for i, v := range list {
+		result[i] = liveResource{ID: v.ID, Name: v.Name, Type: "subnet"}
+	}
+	return result, nil
+}
+
+func listLiveEips(config *cfg.Config, region string) ([]liveResource, error) {
+	client, err := config.NetworkingV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+	list, err := eips.List(client, eips.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]liveResource, len(list))
+	for i, v := range list {
+		result[i] = liveResource{ID: v.ID, Name: v.PublicAddress, Type: "eip"}
+	}
+	return result, nil
+}
+
+func listLiveSecGroups(config *cfg.Config, region string) ([]liveResource, error) {
+	client, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+	pages, err := groups.List(client, groups.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	list, err := groups.ExtractGroups(pages)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]liveResource, len(list))
+	for i, v := range list {
+		result[i] = liveResource{ID: v.ID, Name: v.Name, Type: "security_group"}
+	}
+	return result, nil
+}
@@ -0,0 +1,356 @@
+package cts
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const (
+	notificationOperationCustomized = "customized"
+	notificationOperationComplete   = "complete"
+)
+
+var notificationNameRe = regexp.MustCompile(`^[\w]{1,64}$`)
+
+type notificationOperation struct {
+	ServiceType  string   `json:"service_type"`
+	ResourceType string   `json:"resource_type"`
+	TraceNames   []string `json:"trace_names"`
+}
+
+type notificationUsers struct {
+	UserGroup string   `json:"user_group"`
+	UserList  []string `json:"user_list"`
+}
+
+type notificationOpts struct {
+	NotificationID   string                  `json:"notification_id,omitempty"`
+	NotificationName string                  `json:"notification_name"`
+	OperationType    string                  `json:"operation_type"`
+	Operations       []notificationOperation `json:"operations,omitempty"`
+	NotifyUserList   []notificationUsers     `json:"notify_user_list,omitempty"`
+	Status           string                  `json:"status,omitempty"`
+	TopicID          string                  `json:"topic_id,omitempty"`
+}
+
+type notification struct {
+	NotificationID   string                  `json:"notification_id"`
+	NotificationName string                  `json:"notification_name"`
+	NotificationType string                  `json:"notification_type"`
+	OperationType    string                  `json:"operation_type"`
+	Operations       []notificationOperation `json:"operations"`
+	NotifyUserList   []notificationUsers     `json:"notify_user_list"`
+	Status           string                  `json:"status"`
+	TopicID          string                  `json:"topic_id"`
+	ProjectID        string                  `json:"project_id"`
+	CreateTime       int64                   `json:"create_time"`
+}
+
+func ResourceCTSEventNotificationV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCTSEventNotificationV3Create,
+		ReadContext:   resourceCTSEventNotificationV3Read,
+		UpdateContext: resourceCTSEventNotificationV3Update,
+		DeleteContext: resourceCTSEventNotificationV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: validateEventNotificationOperations,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"notification_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(notificationNameRe,
+					"only letters, digits and underscores are allowed, up to 64 characters"),
+			},
+			"operation_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					notificationOperationCustomized, notificationOperationComplete,
+				}, false),
+			},
+			"operations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"trace_names": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"notify_user_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_group": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"user_list": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"topic_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateEventNotificationOperations(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	operations := d.Get("operations").([]interface{})
+	switch d.Get("operation_type").(string) {
+	case notificationOperationCustomized:
+		if len(operations) == 0 {
+			return fmt.Errorf("`operations` are required for the `%s` operation type", notificationOperationCustomized)
+		}
+	case notificationOperationComplete:
+		if len(operations) != 0 {
+			return fmt.Errorf("`operations` can't be set for the `%s` operation type", notificationOperationComplete)
+		}
+	}
+	return nil
+}
+
+func notificationsURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("notifications")
+}
+
+func buildNotificationOpts(d *schema.ResourceData) notificationOpts {
+	status := "disabled"
+	if d.Get("enabled").(bool) {
+		status = "enabled"
+	}
+
+	var operations []notificationOperation
+	for _, raw := range d.Get("operations").([]interface{}) {
+		operation := raw.(map[string]interface{})
+		operations = append(operations, notificationOperation{
+			ServiceType:  operation["service_type"].(string),
+			ResourceType: operation["resource_type"].(string),
+			TraceNames:   common.ExpandToStringSlice(operation["trace_names"].([]interface{})),
+		})
+	}
+
+	var users []notificationUsers
+	for _, raw := range d.Get("notify_user_list").([]interface{}) {
+		group := raw.(map[string]interface{})
+		users = append(users, notificationUsers{
+			UserGroup: group["user_group"].(string),
+			UserList:  common.ExpandToStringSlice(group["user_list"].([]interface{})),
+		})
+	}
+
+	return notificationOpts{
+		NotificationName: d.Get("notification_name").(string),
+		OperationType:    d.Get("operation_type").(string),
+		Operations:       operations,
+		NotifyUserList:   users,
+		Status:           status,
+		TopicID:          d.Get("topic_id").(string),
+	}
+}
+
+func resourceCTSEventNotificationV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CtsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	opts := buildNotificationOpts(d)
+	// status can't be set on creation, notifications are always created enabled
+	status := opts.Status
+	opts.Status = ""
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(notificationsURL(client), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	created := new(notification)
+	if err := r.ExtractInto(created); err != nil {
+		return fmterr.Errorf("error creating CTS event notification: %w", err)
+	}
+	d.SetId(created.NotificationID)
+
+	if created.Status != status {
+		if err := updateEventNotification(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCTSEventNotificationV3Read(ctx, d, meta)
+}
+
+func getEventNotification(client *golangsdk.ServiceClient, id string) (*notification, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("notifications", "smn"), &r.Body, nil)
+	var notifications []notification
+	if err := r.ExtractIntoSlicePtr(&notifications, "notifications"); err != nil {
+		return nil, err
+	}
+	for _, n := range notifications {
+		if n.NotificationID == id {
+			return &n, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceCTSEventNotificationV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CtsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	n, err := getEventNotification(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error reading CTS event notification"))
+	}
+
+	var operations []map[string]interface{}
+	for _, operation := range n.Operations {
+		operations = append(operations, map[string]interface{}{
+			"service_type":  operation.ServiceType,
+			"resource_type": operation.ResourceType,
+			"trace_names":   operation.TraceNames,
+		})
+	}
+	var users []map[string]interface{}
+	for _, group := range n.NotifyUserList {
+		users = append(users, map[string]interface{}{
+			"user_group": group.UserGroup,
+			"user_list":  group.UserList,
+		})
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("notification_name", n.NotificationName),
+		d.Set("operation_type", n.OperationType),
+		d.Set("operations", operations),
+		d.Set("notify_user_list", users),
+		d.Set("topic_id", n.TopicID),
+		d.Set("enabled", n.Status == "enabled"),
+		d.Set("status", n.Status),
+		d.Set("notification_type", n.NotificationType),
+		d.Set("project_id", n.ProjectID),
+		d.Set("created_at", n.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting CTS event notification fields: %w", err)
+	}
+
+	return nil
+}
+
+func updateEventNotification(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	opts := buildNotificationOpts(d)
+	opts.NotificationID = d.Id()
+	_, err := client.Put(notificationsURL(client), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmt.Errorf("error updating CTS event notification: %w", err)
+	}
+	return nil
+}
+
+func resourceCTSEventNotificationV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CtsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	if err := updateEventNotification(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCTSEventNotificationV3Read(ctx, d, meta)
+}
+
+func resourceCTSEventNotificationV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.CtsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(clientError, err)
+	}
+
+	url := notificationsURL(client) + "?notification_id=" + d.Id()
+	_, err = client.Delete(url, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting CTS event notification"))
+	}
+	log.Printf("[DEBUG] Successfully deleted CTS event notification %s", d.Id())
+
+	d.SetId("")
+	return nil
+}
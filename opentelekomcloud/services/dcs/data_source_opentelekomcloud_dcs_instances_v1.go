@@ -0,0 +1,183 @@
+package dcs
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v1/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceDcsInstancesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDcsInstancesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resource_spec_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDcsInstancesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating dcs instance client: %s", err)
+	}
+
+	listOpts := instances.ListDcsInstanceOpts{
+		Name:  d.Get("name").(string),
+		VpcId: d.Get("vpc_id").(string),
+	}
+	pages, err := instances.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing DCS instances: %w", err)
+	}
+	allInstances, err := instances.ExtractDcsInstances(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting DCS instances: %w", err)
+	}
+
+	engine := d.Get("engine").(string)
+	version := d.Get("engine_version").(string)
+	status := d.Get("status").(string)
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, instance := range allInstances.Instances {
+		if engine != "" && instance.Engine != engine {
+			continue
+		}
+		if version != "" && instance.EngineVersion != version {
+			continue
+		}
+		if status != "" && instance.Status != status {
+			continue
+		}
+		ids = append(ids, instance.InstanceID)
+		result = append(result, map[string]interface{}{
+			"id":                 instance.InstanceID,
+			"name":               instance.Name,
+			"engine":             instance.Engine,
+			"engine_version":     instance.EngineVersion,
+			"status":             instance.Status,
+			"capacity":           instance.Capacity,
+			"resource_spec_code": instance.ResourceSpecCode,
+			"ip":                 instance.IP,
+			"port":               instance.Port,
+			"vpc_id":             instance.VPCID,
+			"subnet_id":          instance.SubnetID,
+			"security_group_id":  instance.SecurityGroupID,
+			"available_zones":    instance.AvailableZones,
+		})
+	}
+	log.Printf("[DEBUG] Found %d DCS instances matching the filters", len(ids))
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("instances", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DCS instances fields: %w", err)
+	}
+
+	return nil
+}
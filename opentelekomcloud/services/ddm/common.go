@@ -0,0 +1,70 @@
+package ddm
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	ddmClientError = "error creating OpenTelekomCloud DDM client: %w"
+)
+
+// transitional statuses of the instances and schemas
+var ddmPendingStatuses = []string{"CREATING", "RESIZING", "GROWING", "REDUCING", "RESTARTING", "SET_CONFIGURATION", "BACKING_UP"}
+
+type ddmNode struct {
+	ID     string `json:"node_id"`
+	Status string `json:"status"`
+	IP     string `json:"ip"`
+	Port   string `json:"port"`
+}
+
+type ddmInstance struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	Status          string    `json:"status"`
+	VpcID           string    `json:"vpc_id"`
+	SubnetID        string    `json:"subnet_id"`
+	SecurityGroupID string    `json:"security_group_id"`
+	NodeCount       int       `json:"node_count"`
+	Nodes           []ddmNode `json:"nodes"`
+	AccessIP        string    `json:"access_ip"`
+	AccessPort      string    `json:"access_port"`
+	EngineVersion   string    `json:"engine_version"`
+	Created         string    `json:"created"`
+}
+
+func getDdmInstance(client *golangsdk.ServiceClient, id string) (*ddmInstance, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("instances", id), &r.Body, nil)
+	instance := new(ddmInstance)
+	if err := r.ExtractInto(instance); err != nil {
+		return nil, err
+	}
+	if instance.Status == "DELETED" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return instance, nil
+}
+
+func waitForDdmInstance(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: ddmPendingStatuses,
+		Target:  []string{"RUNNING"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := getDdmInstance(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			return instance, instance.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,271 @@
+package ddm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ddmAccountPageLimit is the maximum page size of the account list
+const ddmAccountPageLimit = 128
+
+type ddmAccountSchema struct {
+	Name string `json:"name"`
+}
+
+type ddmAccount struct {
+	Name          string             `json:"name"`
+	Status        string             `json:"status"`
+	BaseAuthority []string           `json:"base_authority"`
+	Description   string             `json:"description"`
+	Databases     []ddmAccountSchema `json:"databases"`
+}
+
+func ResourceDdmAccountV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdmAccountV1Create,
+		ReadContext:   resourceDdmAccountV1Read,
+		UpdateContext: resourceDdmAccountV1Update,
+		DeleteContext: resourceDdmAccountV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 32),
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"CREATE", "DROP", "ALTER", "INDEX", "INSERT", "DELETE", "UPDATE", "SELECT",
+					}, false),
+				},
+			},
+			"schemas": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func listDdmAccounts(client *golangsdk.ServiceClient, instanceID string) ([]ddmAccount, error) {
+	var accounts []ddmAccount
+	for offset := 0; ; offset += ddmAccountPageLimit {
+		query := url.Values{
+			"offset": []string{strconv.Itoa(offset)},
+			"limit":  []string{strconv.Itoa(ddmAccountPageLimit)},
+		}
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "users")+"?"+query.Encode(), &r.Body, nil)
+		var pageAccounts []ddmAccount
+		if err := r.ExtractIntoSlicePtr(&pageAccounts, "users"); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, pageAccounts...)
+		if len(pageAccounts) < ddmAccountPageLimit {
+			return accounts, nil
+		}
+	}
+}
+
+func expandDdmAccountSchemas(d *schema.ResourceData) []ddmAccountSchema {
+	names := common.ExpandToStringSlice(d.Get("schemas").(*schema.Set).List())
+	schemas := make([]ddmAccountSchema, len(names))
+	for i, name := range names {
+		schemas[i] = ddmAccountSchema{Name: name}
+	}
+	return schemas
+}
+
+func resourceDdmAccountV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	opts := map[string]interface{}{
+		"users": []map[string]interface{}{
+			{
+				"name":           name,
+				"password":       d.Get("password").(string),
+				"base_authority": common.ExpandToStringSlice(d.Get("permissions").(*schema.Set).List()),
+				"description":    d.Get("description").(string),
+				"databases":      expandDdmAccountSchemas(d),
+			},
+		},
+	}
+	log.Printf("[DEBUG] Creating DDM account %s in instance %s", name, instanceID)
+
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "users"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DDM account: %w", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, name))
+
+	return resourceDdmAccountV1Read(ctx, d, meta)
+}
+
+func resourceDdmAccountV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instanceID, name, err := parseDdmObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accounts, err := listDdmAccounts(client, instanceID)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DDM account"))
+	}
+	var found *ddmAccount
+	for i := range accounts {
+		if accounts[i].Name == name {
+			found = &accounts[i]
+			break
+		}
+	}
+	if found == nil {
+		return diag.FromErr(common.CheckDeleted(d, golangsdk.ErrDefault404{}, "DDM account"))
+	}
+
+	schemas := make([]string, len(found.Databases))
+	for i, database := range found.Databases {
+		schemas[i] = database.Name
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instanceID),
+		d.Set("name", found.Name),
+		d.Set("permissions", found.BaseAuthority),
+		d.Set("schemas", schemas),
+		d.Set("description", found.Description),
+		d.Set("status", found.Status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DDM account fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDdmAccountV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instanceID, name, err := parseDdmObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("permissions", "schemas", "description") {
+		opts := map[string]interface{}{
+			"user": map[string]interface{}{
+				"base_authority": common.ExpandToStringSlice(d.Get("permissions").(*schema.Set).List()),
+				"description":    d.Get("description").(string),
+				"databases":      expandDdmAccountSchemas(d),
+			},
+		}
+		_, err := client.Put(client.ServiceURL("instances", instanceID, "users", name), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating DDM account %s: %w", name, err)
+		}
+	}
+
+	if d.HasChange("password") {
+		opts := map[string]interface{}{"password": d.Get("password").(string)}
+		_, err := client.Put(client.ServiceURL("instances", instanceID, "users", name, "password"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error changing password of DDM account %s: %w", name, err)
+		}
+	}
+
+	return resourceDdmAccountV1Read(ctx, d, meta)
+}
+
+func resourceDdmAccountV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instanceID, name, err := parseDdmObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("instances", instanceID, "users", name), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting DDM account"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,340 @@
+package ddm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDdmInstanceV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdmInstanceV1Create,
+		ReadContext:   resourceDdmInstanceV1Read,
+		UpdateContext: resourceDdmInstanceV1Update,
+		DeleteContext: resourceDdmInstanceV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			"engine_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"param_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"admin_user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"admin_password"},
+			},
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"admin_user"},
+			},
+			"delete_rds_data": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDdmInstanceV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instance := map[string]interface{}{
+		"name":              d.Get("name").(string),
+		"flavor_id":         d.Get("flavor_id").(string),
+		"node_num":          d.Get("node_num").(int),
+		"engine_id":         d.Get("engine_id").(string),
+		"available_zones":   common.ExpandToStringSlice(d.Get("availability_zones").([]interface{})),
+		"vpc_id":            d.Get("vpc_id").(string),
+		"subnet_id":         d.Get("subnet_id").(string),
+		"security_group_id": d.Get("security_group_id").(string),
+	}
+	if v, ok := d.GetOk("param_group_id"); ok {
+		instance["param_group_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("admin_user"); ok {
+		instance["admin_user_name"] = v.(string)
+		instance["admin_user_password"] = d.Get("admin_password").(string)
+	}
+	log.Printf("[DEBUG] Creating DDM instance %s", instance["name"])
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("instances"), map[string]interface{}{"instance": instance}, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error creating DDM instance: %w", err)
+	}
+	d.SetId(created.ID)
+
+	if err := waitForDdmInstance(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for DDM instance %s to become running: %w", d.Id(), err)
+	}
+
+	return resourceDdmInstanceV1Read(ctx, d, meta)
+}
+
+func resourceDdmInstanceV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instance, err := getDdmInstance(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DDM instance"))
+	}
+
+	nodes := make([]map[string]interface{}, len(instance.Nodes))
+	for i, node := range instance.Nodes {
+		nodes[i] = map[string]interface{}{
+			"id":     node.ID,
+			"status": node.Status,
+			"ip":     node.IP,
+			"port":   node.Port,
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", instance.Name),
+		d.Set("node_num", instance.NodeCount),
+		d.Set("vpc_id", instance.VpcID),
+		d.Set("subnet_id", instance.SubnetID),
+		d.Set("security_group_id", instance.SecurityGroupID),
+		d.Set("status", instance.Status),
+		d.Set("access_ip", instance.AccessIP),
+		d.Set("access_port", instance.AccessPort),
+		d.Set("engine_version", instance.EngineVersion),
+		d.Set("nodes", nodes),
+		d.Set("created_at", instance.Created),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DDM instance fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDdmInstanceV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("name") {
+		opts := map[string]interface{}{"name": d.Get("name").(string)}
+		_, err := client.Put(client.ServiceURL("instances", d.Id(), "modify-name"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error renaming DDM instance: %w", err)
+		}
+	}
+
+	if d.HasChange("security_group_id") {
+		opts := map[string]interface{}{"security_group_id": d.Get("security_group_id").(string)}
+		_, err := client.Put(client.ServiceURL("instances", d.Id(), "modify-security-group"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error changing security group of DDM instance: %w", err)
+		}
+	}
+
+	if d.HasChange("node_num") {
+		oldRaw, newRaw := d.GetChange("node_num")
+		oldNum, newNum := oldRaw.(int), newRaw.(int)
+
+		var err error
+		if newNum > oldNum {
+			opts := map[string]interface{}{
+				"flavor_id":   d.Get("flavor_id").(string),
+				"node_number": newNum - oldNum,
+			}
+			_, err = client.Post(client.ServiceURL("instances", d.Id(), "action", "enlarge"), opts, nil, &golangsdk.RequestOpts{
+				OkCodes: []int{200, 202},
+			})
+		} else {
+			opts := map[string]interface{}{"number_of_nodes": oldNum - newNum}
+			_, err = client.Post(client.ServiceURL("instances", d.Id(), "action", "reduce"), opts, nil, &golangsdk.RequestOpts{
+				OkCodes: []int{200, 202},
+			})
+		}
+		if err != nil {
+			return fmterr.Errorf("error changing node number of DDM instance from %d to %d: %w", oldNum, newNum, err)
+		}
+		if err := waitForDdmInstance(ctx, client, d.Id(), timeout); err != nil {
+			return fmterr.Errorf("error waiting for DDM instance %s to become running: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDdmInstanceV1Read(ctx, d, meta)
+}
+
+func resourceDdmInstanceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	url := client.ServiceURL("instances", d.Id()) + fmt.Sprintf("?delete_rds_data=%t", d.Get("delete_rds_data").(bool))
+	_, err = client.Delete(url, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting DDM instance"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: append([]string{"RUNNING", "DELETING"}, ddmPendingStatuses...),
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := getDdmInstance(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return d.Id(), "DELETED", nil
+				}
+				return nil, "", err
+			}
+			return instance, instance.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DDM instance %s to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,315 @@
+package ddm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type ddmSchemaDataNode struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type ddmSchema struct {
+	Name        string              `json:"name"`
+	Status      string              `json:"status"`
+	ShardMode   string              `json:"shard_mode"`
+	ShardNumber int                 `json:"shard_number"`
+	DataNodes   []ddmSchemaDataNode `json:"used_rds"`
+	Created     string              `json:"created"`
+}
+
+func ResourceDdmSchemaV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdmSchemaV1Create,
+		ReadContext:   resourceDdmSchemaV1Read,
+		UpdateContext: resourceDdmSchemaV1Update,
+		DeleteContext: resourceDdmSchemaV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 48),
+			},
+			"shard_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"cluster", "single"}, false),
+			},
+			"shard_number": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4096),
+			},
+			"data_nodes": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"admin_user": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"admin_password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"delete_rds_data": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseDdmObjectID parses the ID of the schema or account in the format `instance_id/name`
+func parseDdmObjectID(id string) (instanceID, name string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, expected `instance_id/name`, got %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func getDdmSchema(client *golangsdk.ServiceClient, instanceID, name string) (*ddmSchema, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "databases", name), &r.Body, nil)
+	ddmSchema := new(ddmSchema)
+	if err := r.ExtractIntoStructPtr(ddmSchema, "database"); err != nil {
+		return nil, err
+	}
+	return ddmSchema, nil
+}
+
+func resourceDdmSchemaV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	dataNodesRaw := d.Get("data_nodes").([]interface{})
+	dataNodes := make([]map[string]interface{}, len(dataNodesRaw))
+	for i, v := range dataNodesRaw {
+		node := v.(map[string]interface{})
+		dataNodes[i] = map[string]interface{}{
+			"id":            node["id"].(string),
+			"adminUser":     node["admin_user"].(string),
+			"adminPassword": node["admin_password"].(string),
+		}
+	}
+	opts := map[string]interface{}{
+		"databases": []map[string]interface{}{
+			{
+				"name":         name,
+				"shard_mode":   d.Get("shard_mode").(string),
+				"shard_number": d.Get("shard_number").(int),
+				"used_rds":     dataNodes,
+			},
+		},
+	}
+	log.Printf("[DEBUG] Creating DDM schema %s in instance %s", name, instanceID)
+
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "databases"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DDM schema: %w", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, name))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: ddmPendingStatuses,
+		Target:  []string{"RUNNING"},
+		Refresh: func() (interface{}, string, error) {
+			ddmSchema, err := getDdmSchema(client, instanceID, name)
+			if err != nil {
+				return nil, "", err
+			}
+			return ddmSchema, ddmSchema.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DDM schema %s to become running: %w", name, err)
+	}
+
+	return resourceDdmSchemaV1Read(ctx, d, meta)
+}
+
+func resourceDdmSchemaV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instanceID, name, err := parseDdmObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ddmSchema, err := getDdmSchema(client, instanceID, name)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DDM schema"))
+	}
+
+	// credentials of the data nodes aren't returned by the API
+	credentials := make(map[string]map[string]interface{})
+	for _, v := range d.Get("data_nodes").([]interface{}) {
+		node := v.(map[string]interface{})
+		credentials[node["id"].(string)] = node
+	}
+	dataNodes := make([]map[string]interface{}, len(ddmSchema.DataNodes))
+	for i, node := range ddmSchema.DataNodes {
+		dataNodes[i] = map[string]interface{}{
+			"id":     node.ID,
+			"name":   node.Name,
+			"status": node.Status,
+		}
+		if known, ok := credentials[node.ID]; ok {
+			dataNodes[i]["admin_user"] = known["admin_user"]
+			dataNodes[i]["admin_password"] = known["admin_password"]
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instanceID),
+		d.Set("name", ddmSchema.Name),
+		d.Set("shard_mode", ddmSchema.ShardMode),
+		d.Set("shard_number", ddmSchema.ShardNumber),
+		d.Set("data_nodes", dataNodes),
+		d.Set("status", ddmSchema.Status),
+		d.Set("created_at", ddmSchema.Created),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DDM schema fields: %w", err)
+	}
+
+	return nil
+}
+
+// resourceDdmSchemaV1Update only stores `delete_rds_data`, all the other arguments force a new schema
+func resourceDdmSchemaV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDdmSchemaV1Read(ctx, d, meta)
+}
+
+func resourceDdmSchemaV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DdmV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ddmClientError, err)
+	}
+
+	instanceID, name, err := parseDdmObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := client.ServiceURL("instances", instanceID, "databases", name) +
+		fmt.Sprintf("?delete_rds_data=%t", d.Get("delete_rds_data").(bool))
+	_, err = client.Delete(url, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting DDM schema"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"RUNNING", "DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			ddmSchema, err := getDdmSchema(client, instanceID, name)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return name, "DELETED", nil
+				}
+				return nil, "", err
+			}
+			return ddmSchema, ddmSchema.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DDM schema %s to be deleted: %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,90 @@
+package deh
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// DataSourceDEHHostTypesV1 lists Dedicated Host types available in the availability zone
+func DataSourceDEHHostTypesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDEHHostTypesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type dehHostType struct {
+	HostType     string `json:"host_type"`
+	HostTypeName string `json:"host_type_name"`
+}
+
+func dataSourceDEHHostTypesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	dehClient, err := config.DehV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DeH client: %s", err)
+	}
+
+	az := d.Get("availability_zone").(string)
+	r := golangsdk.Result{}
+	_, r.Err = dehClient.Get(dehClient.ServiceURL("availability-zone", az, "dedicated-host-types"), &r.Body, nil)
+	var hostTypes []dehHostType
+	if err := r.ExtractIntoSlicePtr(&hostTypes, "dedicated_host_types"); err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud Dedicated Host types: %s", err)
+	}
+	log.Printf("[DEBUG] Retrieved Dedicated Host types in %s: %#v", az, hostTypes)
+
+	var hostTypeList []map[string]interface{}
+	for _, hostType := range hostTypes {
+		hostTypeList = append(hostTypeList, map[string]interface{}{
+			"host_type":      hostType.HostType,
+			"host_type_name": hostType.HostTypeName,
+		})
+	}
+
+	d.SetId(az)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("host_types", hostTypeList),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Dedicated Host types fields: %s", err)
+	}
+
+	return nil
+}
@@ -8,13 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/deh/v1/hosts"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
 
+const dehTagsResourceType = "dedicated-host-tags"
+
 func ResourceDeHHostV1() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDeHHostV1Create,
@@ -42,9 +47,24 @@ func ResourceDeHHostV1() *schema.Resource {
 				Required: true,
 			},
 			"auto_placement": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
 			},
+			"quantity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"host_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": common.TagsSchema(),
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -139,7 +159,7 @@ func resourceDeHHostV1Create(ctx context.Context, d *schema.ResourceData, meta i
 		HostType:      d.Get("host_type").(string),
 		AutoPlacement: d.Get("auto_placement").(string),
 		Az:            d.Get("availability_zone").(string),
-		Quantity:      1,
+		Quantity:      d.Get("quantity").(int),
 	}
 
 	allocate, err := hosts.Allocate(dehClient, allocateOpts).ExtractHost()
@@ -147,21 +167,35 @@ func resourceDeHHostV1Create(ctx context.Context, d *schema.ResourceData, meta i
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomcomCloud Dedicated Host: %s", err)
 	}
+	// the first allocated host represents the whole group of hosts
 	d.SetId(allocate.AllocatedHostIds[0])
+	if err := d.Set("host_ids", allocate.AllocatedHostIds); err != nil {
+		return diag.FromErr(err)
+	}
 
-	log.Printf("[DEBUG] Waiting for OpenTelekomcomCloud Dedicated Host (%s) to become available", allocate.AllocatedHostIds[0])
+	tagRaw := d.Get("tags").(map[string]interface{})
+	for _, hostID := range allocate.AllocatedHostIds {
+		log.Printf("[DEBUG] Waiting for OpenTelekomcomCloud Dedicated Host (%s) to become available", hostID)
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"creating"},
+			Target:     []string{"available", "fault"},
+			Refresh:    waitForDeHActive(dehClient, hostID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, Stateerr := stateConf.WaitForStateContext(ctx)
+		if Stateerr != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud Dedicated Host : %s", Stateerr)
+		}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"creating"},
-		Target:     []string{"available", "fault"},
-		Refresh:    waitForDeHActive(dehClient, allocate.AllocatedHostIds[0]),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-	_, Stateerr := stateConf.WaitForStateContext(ctx)
-	if Stateerr != nil {
-		return fmterr.Errorf("error creating OpenTelekomCloud Dedicated Host : %s", Stateerr)
+		if len(tagRaw) > 0 {
+			tagList := common.ExpandResourceTags(tagRaw)
+			if err := tags.Create(dehClient, dehTagsResourceType, hostID, tagList).ExtractErr(); err != nil {
+				return fmterr.Errorf("error setting tags of OpenTelekomCloud Dedicated Host: %s", err)
+			}
+		}
 	}
 
 	return resourceDeHHostV1Read(ctx, d, meta)
@@ -203,6 +237,20 @@ func resourceDeHHostV1Read(_ context.Context, d *schema.ResourceData, meta inter
 	d.Set("memory", n.HostProperties.Memory)
 	d.Set("available_instance_capacities", getInstanceProperties(n))
 
+	// hosts allocated before `quantity` support
+	if len(d.Get("host_ids").([]interface{})) == 0 {
+		d.Set("host_ids", []string{d.Id()})
+		d.Set("quantity", 1)
+	}
+
+	resourceTags, err := tags.Get(dehClient, dehTagsResourceType, d.Id()).Extract()
+	if err != nil {
+		return fmterr.Errorf("error fetching OpenTelekomCloud Dedicated Host tags: %s", err)
+	}
+	if err := d.Set("tags", common.TagsToMap(resourceTags)); err != nil {
+		return fmterr.Errorf("error saving tags of OpenTelekomCloud Dedicated Host: %s", err)
+	}
+
 	return nil
 }
 
@@ -221,9 +269,16 @@ func resourceDeHHostV1Update(ctx context.Context, d *schema.ResourceData, meta i
 		updateOpts.AutoPlacement = d.Get("auto_placement").(string)
 	}
 
-	_, err = hosts.Update(dehClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmterr.Errorf("error updating OpenTelekomCloud Dedicated Host: %s", err)
+	for _, hostID := range dehHostIDs(d) {
+		if d.HasChanges("name", "auto_placement") {
+			_, err = hosts.Update(dehClient, hostID, updateOpts).Extract()
+			if err != nil {
+				return fmterr.Errorf("error updating OpenTelekomCloud Dedicated Host: %s", err)
+			}
+		}
+		if err := common.UpdateResourceTags(dehClient, d, dehTagsResourceType, hostID); err != nil {
+			return fmterr.Errorf("error updating tags of OpenTelekomCloud Dedicated Host: %s", err)
+		}
 	}
 	return resourceDeHHostV1Read(ctx, d, meta)
 }
@@ -235,22 +290,27 @@ func resourceDeHHostV1Delete(ctx context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf("error creating OpenTelekomCloud DeH client: %s", err)
 	}
 
-	result := hosts.Delete(dehClient, d.Id())
-	if result.Err != nil {
-		return fmterr.Errorf("error deleting OpenTelekomCloud Dedicated Host: %s", err)
-	}
+	for _, hostID := range dehHostIDs(d) {
+		result := hosts.Delete(dehClient, hostID)
+		if result.Err != nil {
+			if _, ok := result.Err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmterr.Errorf("error deleting OpenTelekomCloud Dedicated Host: %s", result.Err)
+		}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"available", "released", "fault", "ERROR"},
-		Target:     []string{"deleted"},
-		Refresh:    waitForDeHDelete(dehClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return fmterr.Errorf("error deleting OpenTelekomCloud Dedicated Host : %s", err)
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"available", "released", "fault", "ERROR"},
+			Target:     []string{"deleted"},
+			Refresh:    waitForDeHDelete(dehClient, hostID),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmterr.Errorf("error deleting OpenTelekomCloud Dedicated Host : %s", err)
+		}
 	}
 	d.SetId("")
 	return nil
@@ -301,3 +361,12 @@ func getInstanceProperties(n *hosts.Host) []map[string]interface{} {
 	}
 	return v
 }
+
+// dehHostIDs returns IDs of all hosts allocated by the resource
+func dehHostIDs(d *schema.ResourceData) []string {
+	hostIDs := common.ExpandToStringSlice(d.Get("host_ids").([]interface{}))
+	if len(hostIDs) == 0 {
+		hostIDs = []string{d.Id()}
+	}
+	return hostIDs
+}
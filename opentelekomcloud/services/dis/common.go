@@ -0,0 +1,5 @@
+package dis
+
+const (
+	disClientError = "error creating OpenTelekomCloud DISv2 client: %w"
+)
@@ -0,0 +1,201 @@
+package dis
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceDisAppV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDisAppV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stream_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"checkpoint_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "LAST_READ",
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"commit_checkpoint_stream_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sequence_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"earliest_offset": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"latest_offset": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"lag": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total_lag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type disApp struct {
+	AppName                     string   `json:"app_name"`
+	AppID                       string   `json:"app_id"`
+	CreateTime                  int      `json:"create_time"`
+	CommitCheckpointStreamNames []string `json:"commit_checkpoint_stream_names"`
+}
+
+type disPartitionState struct {
+	PartitionID    string `json:"partition_id"`
+	SequenceNumber string `json:"sequence_number"`
+	LatestOffset   int    `json:"latest_offset"`
+	EarliestOffset int    `json:"earliest_offset"`
+	Status         string `json:"status"`
+}
+
+func listDisPartitionStates(client *golangsdk.ServiceClient, app, stream, checkpointType string) ([]disPartitionState, error) {
+	const limit = 100
+
+	var states []disPartitionState
+	startPartition := ""
+	for {
+		url := client.ServiceURL("apps", app, "streams", stream) +
+			"?limit=" + strconv.Itoa(limit) + "&checkpoint_type=" + checkpointType
+		if startPartition != "" {
+			url += "&start_partition_id=" + startPartition
+		}
+
+		r := golangsdk.Result{}
+		_, r.Err = client.Get(url, &r.Body, nil)
+		var page struct {
+			HasMore bool                `json:"has_more"`
+			States  []disPartitionState `json:"partition_consuming_states"`
+		}
+		if err := r.ExtractInto(&page); err != nil {
+			return nil, err
+		}
+		states = append(states, page.States...)
+		if !page.HasMore || len(page.States) == 0 {
+			return states, nil
+		}
+		startPartition = page.States[len(page.States)-1].PartitionID
+	}
+}
+
+// partitionLag returns the number of records not yet consumed by the app.
+// Sequence number `-1` means nothing was committed yet.
+func partitionLag(state disPartitionState) int {
+	committed, err := strconv.Atoi(state.SequenceNumber)
+	if err != nil || committed < 0 {
+		return state.LatestOffset - state.EarliestOffset
+	}
+	if lag := state.LatestOffset - committed; lag > 0 {
+		return lag
+	}
+	return 0
+}
+
+func dataSourceDisAppV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	name := d.Get("name").(string)
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("apps", name), &r.Body, nil)
+	var app disApp
+	if err := r.ExtractInto(&app); err != nil {
+		return fmterr.Errorf("error retrieving DIS app %s: %w", name, err)
+	}
+	log.Printf("[DEBUG] Retrieved DIS app %s: %#v", name, app)
+	d.SetId(app.AppID)
+
+	var partitions []map[string]interface{}
+	totalLag := 0
+	if stream := d.Get("stream_name").(string); stream != "" {
+		states, err := listDisPartitionStates(client, name, stream, d.Get("checkpoint_type").(string))
+		if err != nil {
+			return fmterr.Errorf("error retrieving DIS app %s consuming state of stream %s: %w", name, stream, err)
+		}
+		for _, state := range states {
+			lag := partitionLag(state)
+			totalLag += lag
+			partitions = append(partitions, map[string]interface{}{
+				"partition_id":    state.PartitionID,
+				"status":          state.Status,
+				"sequence_number": state.SequenceNumber,
+				"earliest_offset": state.EarliestOffset,
+				"latest_offset":   state.LatestOffset,
+				"lag":             lag,
+			})
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("app_id", app.AppID),
+		d.Set("created", app.CreateTime),
+		d.Set("commit_checkpoint_stream_names", app.CommitCheckpointStreamNames),
+		d.Set("partitions", partitions),
+		d.Set("total_lag", totalLag),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DIS app fields: %w", err)
+	}
+
+	return nil
+}
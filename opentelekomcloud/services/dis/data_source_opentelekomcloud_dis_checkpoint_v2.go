@@ -0,0 +1,94 @@
+package dis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceDisCheckpointV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDisCheckpointV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"app_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"partition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"checkpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "LAST_READ",
+				ValidateFunc: validation.StringInSlice([]string{"LAST_READ"}, false),
+			},
+			"sequence_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDisCheckpointV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	app := d.Get("app_name").(string)
+	stream := d.Get("stream_name").(string)
+	partition := d.Get("partition_id").(string)
+	checkpointType := d.Get("checkpoint_type").(string)
+
+	url := fmt.Sprintf("%s?app_name=%s&stream_name=%s&partition_id=%s&checkpoint_type=%s",
+		client.ServiceURL("checkpoints"), app, stream, partition, checkpointType)
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	var checkpoint struct {
+		SequenceNumber string `json:"sequence_number"`
+		Metadata       string `json:"metadata"`
+	}
+	if err := r.ExtractInto(&checkpoint); err != nil {
+		return fmterr.Errorf("error retrieving DIS checkpoint: %w", err)
+	}
+
+	d.SetId(common.BuildComponentID(app, stream, partition))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("sequence_number", checkpoint.SequenceNumber),
+		d.Set("metadata", checkpoint.Metadata),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DIS checkpoint fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,395 @@
+package dis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+var disDestinations = []string{"obs_destination", "dws_destination", "mrs_destination"}
+
+func ResourceDisDumpTaskV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDisDumpTaskV2Create,
+		ReadContext:   resourceDisDumpTaskV2Read,
+		DeleteContext: resourceDisDumpTaskV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDisDumpTaskV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"task_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agency_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deliver_time_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(30, 900),
+			},
+			"consumer_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "LATEST",
+				ValidateFunc: validation.StringInSlice([]string{
+					"LATEST", "TRIM_HORIZON",
+				}, false),
+			},
+			"obs_bucket_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"obs_destination": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: disDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"yyyy", "yyyy/MM", "yyyy/MM/dd", "yyyy/MM/dd/HH", "yyyy/MM/dd/HH/mm",
+							}, false),
+						},
+						"destination_file_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "text",
+							ValidateFunc: validation.StringInSlice([]string{
+								"text", "parquet", "carbon",
+							}, false),
+						},
+						"record_delimiter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"dws_destination": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: disDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"cluster_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"schema": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"delimiter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "|",
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"user_password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"kms_user_key_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"kms_user_key_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"retry_duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      1800,
+							ValidateFunc: validation.IntBetween(0, 7200),
+						},
+					},
+				},
+			},
+			"mrs_destination": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: disDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"cluster_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"hdfs_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"hdfs_prefix_folder": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"retry_duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      1800,
+							ValidateFunc: validation.IntBetween(0, 7200),
+						},
+					},
+				},
+			},
+			"destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type disDumpTask struct {
+	TaskName        string `json:"task_name"`
+	DestinationType string `json:"destination_type"`
+	State           string `json:"state"`
+	CreateTime      int    `json:"create_time"`
+}
+
+// commonDumpDescriptor returns fields shared by all the destination descriptors
+func commonDumpDescriptor(d *schema.ResourceData) map[string]interface{} {
+	descriptor := map[string]interface{}{
+		"task_name":             d.Get("task_name"),
+		"agency_name":           d.Get("agency_name"),
+		"deliver_time_interval": d.Get("deliver_time_interval"),
+		"consumer_strategy":     d.Get("consumer_strategy"),
+		"obs_bucket_path":       d.Get("obs_bucket_path"),
+	}
+	if prefix := d.Get("file_prefix").(string); prefix != "" {
+		descriptor["file_prefix"] = prefix
+	}
+	return descriptor
+}
+
+func buildDisDumpTaskBody(d *schema.ResourceData) map[string]interface{} {
+	descriptor := commonDumpDescriptor(d)
+
+	if v, ok := d.GetOk("obs_destination"); ok {
+		obs := v.([]interface{})[0].(map[string]interface{})
+		descriptor["destination_file_type"] = obs["destination_file_type"]
+		if format := obs["partition_format"].(string); format != "" {
+			descriptor["partition_format"] = format
+		}
+		if delimiter := obs["record_delimiter"].(string); delimiter != "" {
+			descriptor["record_delimiter"] = delimiter
+		}
+		return map[string]interface{}{
+			"destination_type":           "OBS",
+			"obs_destination_descriptor": descriptor,
+		}
+	}
+
+	if v, ok := d.GetOk("dws_destination"); ok {
+		dws := v.([]interface{})[0].(map[string]interface{})
+		descriptor["dws_cluster_name"] = dws["cluster_name"]
+		descriptor["dws_cluster_id"] = dws["cluster_id"]
+		descriptor["dws_database_name"] = dws["database_name"]
+		descriptor["dws_schema"] = dws["schema"]
+		descriptor["dws_table_name"] = dws["table_name"]
+		descriptor["dws_delimiter"] = dws["delimiter"]
+		descriptor["user_name"] = dws["user_name"]
+		descriptor["user_password"] = dws["user_password"]
+		descriptor["kms_user_key_name"] = dws["kms_user_key_name"]
+		descriptor["kms_user_key_id"] = dws["kms_user_key_id"]
+		descriptor["retry_duration"] = dws["retry_duration"]
+		return map[string]interface{}{
+			"destination_type":           "DWS",
+			"dws_destination_descriptor": descriptor,
+		}
+	}
+
+	mrs := d.Get("mrs_destination").([]interface{})[0].(map[string]interface{})
+	descriptor["mrs_cluster_name"] = mrs["cluster_name"]
+	descriptor["mrs_cluster_id"] = mrs["cluster_id"]
+	descriptor["mrs_hdfs_path"] = mrs["hdfs_path"]
+	descriptor["retry_duration"] = mrs["retry_duration"]
+	if folder := mrs["hdfs_prefix_folder"].(string); folder != "" {
+		descriptor["hdfs_prefix_folder"] = folder
+	}
+	return map[string]interface{}{
+		"destination_type":           "MRS",
+		"mrs_destination_descriptor": descriptor,
+	}
+}
+
+func resourceDisDumpTaskV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	taskName := d.Get("task_name").(string)
+	body := buildDisDumpTaskBody(d)
+	log.Printf("[DEBUG] Creating DIS dump task %s for stream %s", taskName, streamName)
+
+	_, err = client.Post(client.ServiceURL("streams", streamName, "transfer-tasks"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DIS dump task: %w", err)
+	}
+	d.SetId(common.BuildComponentID(streamName, taskName))
+
+	return resourceDisDumpTaskV2Read(ctx, d, meta)
+}
+
+func resourceDisDumpTaskV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	taskName := d.Get("task_name").(string)
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("streams", streamName, "transfer-tasks", taskName), &r.Body, nil)
+	var task disDumpTask
+	if err := r.ExtractInto(&task); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DIS dump task"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("destination_type", task.DestinationType),
+		d.Set("state", task.State),
+		d.Set("created", task.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DIS dump task fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDisDumpTaskV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	taskName := d.Get("task_name").(string)
+	_, err = client.Delete(client.ServiceURL("streams", streamName, "transfer-tasks", taskName), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DIS dump task"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDisDumpTaskV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for DIS dump task, must be <stream_name>/<task_name>")
+	}
+	mErr := multierror.Append(
+		d.Set("stream_name", parts[0]),
+		d.Set("task_name", parts[1]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,334 @@
+package dis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDisStreamV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDisStreamV2Create,
+		ReadContext:   resourceDisStreamV2Read,
+		UpdateContext: resourceDisStreamV2Update,
+		DeleteContext: resourceDisStreamV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: validateDisAutoScale,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"partition_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"stream_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "COMMON",
+				ValidateFunc: validation.StringInSlice([]string{
+					"COMMON", "ADVANCED",
+				}, false),
+			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "BLOB",
+				ValidateFunc: validation.StringInSlice([]string{
+					"BLOB", "JSON", "CSV",
+				}, false),
+			},
+			"retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      24,
+				ValidateFunc: validation.IntBetween(24, 168),
+			},
+			"auto_scale_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"auto_scale_min_partition_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"auto_scale_max_partition_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"compression_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"snappy", "gzip", "zip",
+				}, false),
+			},
+			"tags": common.TagsSchema(),
+			"stream_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"readable_partition_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"writable_partition_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type disStreamCreateOpts struct {
+	StreamName                 string             `json:"stream_name"`
+	PartitionCount             int                `json:"partition_count"`
+	StreamType                 string             `json:"stream_type"`
+	DataType                   string             `json:"data_type"`
+	DataDuration               int                `json:"data_duration"`
+	AutoScaleEnabled           bool               `json:"auto_scale_enabled"`
+	AutoScaleMinPartitionCount int                `json:"auto_scale_min_partition_count,omitempty"`
+	AutoScaleMaxPartitionCount int                `json:"auto_scale_max_partition_count,omitempty"`
+	CompressionFormat          string             `json:"compression_format,omitempty"`
+	Tags                       []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+type disStream struct {
+	StreamID                   string             `json:"stream_id"`
+	StreamName                 string             `json:"stream_name"`
+	CreateTime                 int                `json:"create_time"`
+	RetentionPeriod            int                `json:"retention_period"`
+	Status                     string             `json:"status"`
+	StreamType                 string             `json:"stream_type"`
+	DataType                   string             `json:"data_type"`
+	WritablePartitionCount     int                `json:"writable_partition_count"`
+	ReadablePartitionCount     int                `json:"readable_partition_count"`
+	AutoScaleEnabled           bool               `json:"auto_scale_enabled"`
+	AutoScaleMinPartitionCount int                `json:"auto_scale_min_partition_count"`
+	AutoScaleMaxPartitionCount int                `json:"auto_scale_max_partition_count"`
+	CompressionFormat          string             `json:"compression_format"`
+	Tags                       []tags.ResourceTag `json:"tags"`
+}
+
+func validateDisAutoScale(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.Get("auto_scale_enabled").(bool) {
+		return nil
+	}
+	minCount := d.Get("auto_scale_min_partition_count").(int)
+	maxCount := d.Get("auto_scale_max_partition_count").(int)
+	if minCount < 1 || maxCount < minCount {
+		return fmt.Errorf("`auto_scale_min_partition_count` and `auto_scale_max_partition_count` are required " +
+			"when `auto_scale_enabled` is set and max count can't be less than min count")
+	}
+	return nil
+}
+
+func getDisStream(client *golangsdk.ServiceClient, name string) (*disStream, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("streams", name), &r.Body, nil)
+	var stream disStream
+	if err := r.ExtractInto(&stream); err != nil {
+		return nil, err
+	}
+	return &stream, nil
+}
+
+func waitForDisStream(ctx context.Context, client *golangsdk.ServiceClient, name string, partitions int, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING", "PENDING"},
+		Target:  []string{"RUNNING"},
+		Refresh: func() (interface{}, string, error) {
+			stream, err := getDisStream(client, name)
+			if err != nil {
+				return nil, "", err
+			}
+			if stream.Status == "RUNNING" && partitions > 0 && stream.ReadablePartitionCount != partitions {
+				return stream, "PENDING", nil
+			}
+			return stream, stream.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDisStreamV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	name := d.Get("name").(string)
+	createOpts := disStreamCreateOpts{
+		StreamName:                 name,
+		PartitionCount:             d.Get("partition_count").(int),
+		StreamType:                 d.Get("stream_type").(string),
+		DataType:                   d.Get("data_type").(string),
+		DataDuration:               d.Get("retention_period").(int),
+		AutoScaleEnabled:           d.Get("auto_scale_enabled").(bool),
+		AutoScaleMinPartitionCount: d.Get("auto_scale_min_partition_count").(int),
+		AutoScaleMaxPartitionCount: d.Get("auto_scale_max_partition_count").(int),
+		CompressionFormat:          d.Get("compression_format").(string),
+		Tags:                       common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	log.Printf("[DEBUG] Creating DIS stream: %#v", createOpts)
+
+	_, err = client.Post(client.ServiceURL("streams"), createOpts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DIS stream: %w", err)
+	}
+	d.SetId(name)
+
+	if err := waitForDisStream(ctx, client, name, 0, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for DIS stream %s to become running: %w", name, err)
+	}
+
+	return resourceDisStreamV2Read(ctx, d, meta)
+}
+
+func resourceDisStreamV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	stream, err := getDisStream(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DIS stream"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", stream.StreamName),
+		d.Set("partition_count", stream.ReadablePartitionCount),
+		d.Set("stream_type", stream.StreamType),
+		d.Set("data_type", stream.DataType),
+		d.Set("retention_period", stream.RetentionPeriod),
+		d.Set("auto_scale_enabled", stream.AutoScaleEnabled),
+		d.Set("stream_id", stream.StreamID),
+		d.Set("status", stream.Status),
+		d.Set("created", stream.CreateTime),
+		d.Set("readable_partition_count", stream.ReadablePartitionCount),
+		d.Set("writable_partition_count", stream.WritablePartitionCount),
+		d.Set("tags", common.TagsToMap(stream.Tags)),
+	)
+	if stream.AutoScaleEnabled {
+		mErr = multierror.Append(mErr,
+			d.Set("auto_scale_min_partition_count", stream.AutoScaleMinPartitionCount),
+			d.Set("auto_scale_max_partition_count", stream.AutoScaleMaxPartitionCount),
+		)
+	}
+	if stream.CompressionFormat != "" {
+		mErr = multierror.Append(mErr, d.Set("compression_format", stream.CompressionFormat))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DIS stream fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDisStreamV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	if d.HasChange("partition_count") {
+		partitions := d.Get("partition_count").(int)
+		body := map[string]interface{}{
+			"stream_name":            d.Id(),
+			"target_partition_count": partitions,
+		}
+		_, err = client.Put(client.ServiceURL("streams", d.Id()), body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+		if err != nil {
+			return fmterr.Errorf("error changing partition count of DIS stream: %w", err)
+		}
+		if err := waitForDisStream(ctx, client, d.Id(), partitions, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for DIS stream %s to be scaled: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := common.UpdateResourceTags(client, d, "stream", d.Get("stream_id").(string)); err != nil {
+			return fmterr.Errorf("error updating tags of DIS stream %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDisStreamV2Read(ctx, d, meta)
+}
+
+func resourceDisStreamV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DisV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(disClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("streams", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DIS stream"))
+	}
+
+	d.SetId("")
+	return nil
+}
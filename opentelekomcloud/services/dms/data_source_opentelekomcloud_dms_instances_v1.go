@@ -0,0 +1,177 @@
+package dms
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v1/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceDmsInstancesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDmsInstancesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"specification": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_space": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"connect_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDmsInstancesV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud dms instance client: %s", err)
+	}
+
+	listOpts := instances.ListDmsInstanceOpts{
+		Name:   d.Get("name").(string),
+		Engine: d.Get("engine").(string),
+		Status: d.Get("status").(string),
+	}
+	pages, err := instances.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing DMS instances: %w", err)
+	}
+	allInstances, err := instances.ExtractDmsInstances(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting DMS instances: %w", err)
+	}
+
+	version := d.Get("engine_version").(string)
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, instance := range allInstances.Instances {
+		if version != "" && instance.EngineVersion != version {
+			continue
+		}
+		ids = append(ids, instance.InstanceID)
+		result = append(result, map[string]interface{}{
+			"id":                instance.InstanceID,
+			"name":              instance.Name,
+			"engine":            instance.Engine,
+			"engine_version":    instance.EngineVersion,
+			"status":            instance.Status,
+			"type":              instance.Type,
+			"specification":     instance.Specification,
+			"storage_space":     instance.StorageSpace,
+			"connect_address":   instance.ConnectAddress,
+			"port":              instance.Port,
+			"vpc_id":            instance.VPCID,
+			"subnet_id":         instance.SubnetID,
+			"security_group_id": instance.SecurityGroupID,
+			"available_zones":   instance.AvailableZones,
+		})
+	}
+	log.Printf("[DEBUG] Found %d DMS instances matching the filters", len(ids))
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("instances", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DMS instances fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDmsConsumerGroupV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsConsumerGroupV2Create,
+		ReadContext:   resourceDmsConsumerGroupV2Read,
+		DeleteContext: resourceDmsConsumerGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsConsumerGroupV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"coordinator_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type kafkaConsumerGroup struct {
+	GroupID       string `json:"group_id"`
+	State         string `json:"state"`
+	CoordinatorID int    `json:"coordinator_id"`
+	GroupDesc     string `json:"group_desc"`
+}
+
+func getKafkaConsumerGroup(client *golangsdk.ServiceClient, instanceID, name string) (*kafkaConsumerGroup, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "groups", name), &r.Body, nil)
+	var group kafkaConsumerGroup
+	if err := r.ExtractIntoStructPtr(&group, "group"); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func resourceDmsConsumerGroupV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	body := map[string]interface{}{
+		"group_name": name,
+		"group_desc": d.Get("description").(string),
+	}
+	_, err = client.Post(client.ServiceURL("kafka", "instances", instanceID, "group"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 204},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DMS consumer group %s: %w", name, err)
+	}
+	d.SetId(common.BuildComponentID(instanceID, name))
+
+	return resourceDmsConsumerGroupV2Read(ctx, d, meta)
+}
+
+func resourceDmsConsumerGroupV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	group, err := getKafkaConsumerGroup(client, d.Get("instance_id").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS consumer group"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("description", group.GroupDesc),
+		d.Set("state", group.State),
+		d.Set("coordinator_id", group.CoordinatorID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DMS consumer group fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDmsConsumerGroupV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	body := map[string]interface{}{
+		"group_ids": []string{d.Get("name").(string)},
+	}
+	_, err = client.Post(client.ServiceURL("instances", d.Get("instance_id").(string), "groups", "batch-delete"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS consumer group"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsConsumerGroupV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for DMS consumer group, must be <instance_id>/<group_name>")
+	}
+	mErr := multierror.Append(
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
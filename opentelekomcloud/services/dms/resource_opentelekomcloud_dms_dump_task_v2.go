@@ -0,0 +1,306 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDmsDumpTaskV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsDumpTaskV2Create,
+		ReadContext:   resourceDmsDumpTaskV2Read,
+		DeleteContext: resourceDmsDumpTaskV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsDumpTaskV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"task_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topics": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"obs_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"obs_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"access_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"secret_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"partition_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "yyyy/MM/dd/HH/mm",
+				ValidateFunc: validation.StringInSlice([]string{
+					"yyyy", "yyyy/MM", "yyyy/MM/dd", "yyyy/MM/dd/HH", "yyyy/MM/dd/HH/mm",
+				}, false),
+			},
+			"record_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "\n",
+				ValidateFunc: validation.StringInSlice([]string{
+					",", ";", "|", "\n", " ", "",
+				}, false),
+			},
+			"deliver_time_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(30, 900),
+			},
+			"consumer_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "latest",
+				ValidateFunc: validation.StringInSlice([]string{
+					"latest", "earliest",
+				}, false),
+			},
+			"destination_file_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "TEXT",
+				ValidateFunc: validation.StringInSlice([]string{
+					"TEXT",
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type obsDestinationDescriptor struct {
+	Topics              string `json:"topics"`
+	AccessKey           string `json:"access_key,omitempty"`
+	SecretKey           string `json:"secret_key,omitempty"`
+	ConsumerStrategy    string `json:"consumer_strategy"`
+	DestinationFileType string `json:"destination_file_type"`
+	DeliverTimeInterval int    `json:"deliver_time_interval"`
+	OBSBucketName       string `json:"obs_bucket_name"`
+	OBSPath             string `json:"obs_path,omitempty"`
+	PartitionFormat     string `json:"partition_format"`
+	RecordDelimiter     string `json:"record_delimiter"`
+}
+
+type dumpTask struct {
+	TaskID                   string                    `json:"task_id,omitempty"`
+	TaskName                 string                    `json:"task_name"`
+	SourceType               string                    `json:"source_type,omitempty"`
+	DestinationType          string                    `json:"destination_type"`
+	Status                   string                    `json:"status,omitempty"`
+	CreateTime               int                       `json:"create_time,omitempty"`
+	OBSDestinationDescriptor *obsDestinationDescriptor `json:"obs_destination_descriptor"`
+}
+
+func getConnectorID(client *golangsdk.ServiceClient, instanceID string) (string, error) {
+	instance, err := getInstanceV2(client, instanceID)
+	if err != nil {
+		return "", err
+	}
+	if !instance.ConnectorEnable || instance.ConnectorID == "" {
+		return "", fmt.Errorf("smart connect is not enabled for DMS instance %s", instanceID)
+	}
+	return instance.ConnectorID, nil
+}
+
+func resourceDmsDumpTaskV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	connectorID, err := getConnectorID(client, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	task := dumpTask{
+		TaskName:        d.Get("task_name").(string),
+		SourceType:      "BLOB",
+		DestinationType: "OBS_SINK",
+		OBSDestinationDescriptor: &obsDestinationDescriptor{
+			Topics:              strings.Join(common.ExpandToStringSlice(d.Get("topics").([]interface{})), ","),
+			AccessKey:           d.Get("access_key").(string),
+			SecretKey:           d.Get("secret_key").(string),
+			ConsumerStrategy:    d.Get("consumer_strategy").(string),
+			DestinationFileType: d.Get("destination_file_type").(string),
+			DeliverTimeInterval: d.Get("deliver_time_interval").(int),
+			OBSBucketName:       d.Get("obs_bucket_name").(string),
+			OBSPath:             d.Get("obs_path").(string),
+			PartitionFormat:     d.Get("partition_format").(string),
+			RecordDelimiter:     d.Get("record_delimiter").(string),
+		},
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("connectors", connectorID, "sink-tasks"), task, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var created struct {
+		TaskID string `json:"task_id"`
+	}
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error creating DMS dump task: %w", err)
+	}
+	log.Printf("[DEBUG] Created DMS dump task %s", created.TaskID)
+	d.SetId(common.BuildComponentID(instanceID, created.TaskID))
+
+	return resourceDmsDumpTaskV2Read(ctx, d, meta)
+}
+
+func resourceDmsDumpTaskV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID, taskID, err := parseDumpTaskID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	connectorID, err := getConnectorID(client, instanceID)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS instance"))
+	}
+
+	r := golangsdk.Result{}
+	url := client.ServiceURL("connectors", connectorID, "sink-tasks", taskID) + "?destination-descriptor=true"
+	_, r.Err = client.Get(url, &r.Body, nil)
+	var task dumpTask
+	if err := r.ExtractInto(&task); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS dump task"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instanceID),
+		d.Set("task_name", task.TaskName),
+		d.Set("status", task.Status),
+		d.Set("create_time", task.CreateTime),
+	)
+	if descriptor := task.OBSDestinationDescriptor; descriptor != nil {
+		mErr = multierror.Append(mErr,
+			d.Set("topics", strings.Split(descriptor.Topics, ",")),
+			d.Set("obs_bucket_name", descriptor.OBSBucketName),
+			d.Set("obs_path", descriptor.OBSPath),
+			d.Set("partition_format", descriptor.PartitionFormat),
+			d.Set("record_delimiter", descriptor.RecordDelimiter),
+			d.Set("deliver_time_interval", descriptor.DeliverTimeInterval),
+			d.Set("consumer_strategy", descriptor.ConsumerStrategy),
+			d.Set("destination_file_type", descriptor.DestinationFileType),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DMS dump task fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDmsDumpTaskV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID, taskID, err := parseDumpTaskID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	connectorID, err := getConnectorID(client, instanceID)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS instance"))
+	}
+
+	_, err = client.Delete(client.ServiceURL("connectors", connectorID, "sink-tasks", taskID), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS dump task"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseDumpTaskID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid format specified for DMS dump task, must be <instance_id>/<task_id>")
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceDmsDumpTaskV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, _, err := parseDumpTaskID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("instance_id", instanceID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
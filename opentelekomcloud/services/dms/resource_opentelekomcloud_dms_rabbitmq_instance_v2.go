@@ -0,0 +1,455 @@
+package dms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const dmsV2ClientError = "error creating OpenTelekomCloud DMSv2 client: %w"
+
+func ResourceDmsRabbitMQInstanceV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitMQInstanceV2Create,
+		ReadContext:   resourceDmsRabbitMQInstanceV2Read,
+		UpdateContext: resourceDmsRabbitMQInstanceV2Update,
+		DeleteContext: resourceDmsRabbitMQInstanceV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "3.7.17",
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_space": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_spec_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dms.physical.storage.normal",
+				ValidateFunc: validation.StringInSlice([]string{
+					"dms.physical.storage.normal", "dms.physical.storage.high", "dms.physical.storage.ultra",
+				}, false),
+			},
+			"access_user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"available_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"maintain_begin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"maintain_end": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ssl_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enable_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"public_ip_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"public_bandwidth": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"specification": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connect_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"management_connect_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_connect_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"used_storage_space": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type rabbitMQInstanceCreateOpts struct {
+	Name            string   `json:"name" required:"true"`
+	Description     string   `json:"description,omitempty"`
+	Engine          string   `json:"engine" required:"true"`
+	EngineVersion   string   `json:"engine_version" required:"true"`
+	StorageSpace    int      `json:"storage_space" required:"true"`
+	AccessUser      string   `json:"access_user" required:"true"`
+	Password        string   `json:"password" required:"true"`
+	VpcID           string   `json:"vpc_id" required:"true"`
+	SecurityGroupID string   `json:"security_group_id" required:"true"`
+	SubnetID        string   `json:"subnet_id" required:"true"`
+	AvailableZones  []string `json:"available_zones" required:"true"`
+	ProductID       string   `json:"product_id" required:"true"`
+	MaintainBegin   string   `json:"maintain_begin,omitempty"`
+	MaintainEnd     string   `json:"maintain_end,omitempty"`
+	SslEnable       bool     `json:"ssl_enable"`
+	EnablePublicIP  bool     `json:"enable_publicip"`
+	PublicBandwidth int      `json:"public_bandwidth,omitempty"`
+	PublicIpID      string   `json:"publicip_id,omitempty"`
+	StorageSpecCode string   `json:"storage_spec_code" required:"true"`
+}
+
+type instanceV2UpdateOpts struct {
+	Name            string  `json:"name,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	MaintainBegin   string  `json:"maintain_begin,omitempty"`
+	MaintainEnd     string  `json:"maintain_end,omitempty"`
+	SecurityGroupID string  `json:"security_group_id,omitempty"`
+}
+
+// instanceV2 is a DMS v2 instance, used for both RabbitMQ and Kafka engines.
+type instanceV2 struct {
+	InstanceID               string `json:"instance_id"`
+	Name                     string `json:"name"`
+	Engine                   string `json:"engine"`
+	EngineVersion            string `json:"engine_version"`
+	Description              string `json:"description"`
+	Specification            string `json:"specification"`
+	StorageSpace             int    `json:"storage_space"`
+	UsedStorageSpace         int    `json:"used_storage_space"`
+	StorageSpecCode          string `json:"storage_spec_code"`
+	ConnectAddress           string `json:"connect_address"`
+	Port                     int    `json:"port"`
+	Status                   string `json:"status"`
+	Type                     string `json:"type"`
+	ProductID                string `json:"product_id"`
+	VpcID                    string `json:"vpc_id"`
+	SubnetID                 string `json:"subnet_id"`
+	SecurityGroupID          string `json:"security_group_id"`
+	AccessUser               string `json:"access_user"`
+	MaintainBegin            string `json:"maintain_begin"`
+	MaintainEnd              string `json:"maintain_end"`
+	SslEnable                bool   `json:"ssl_enable"`
+	EnablePublicIP           bool   `json:"enable_publicip"`
+	PublicIpID               string `json:"publicip_id"`
+	PublicBandwidth          int    `json:"public_bandwidth"`
+	PublicConnectAddress     string `json:"public_connect_address"`
+	ManagementConnectAddress string `json:"management_connect_address"`
+	EnterpriseProjectID      string `json:"enterprise_project_id"`
+	ConnectorEnable          bool   `json:"connector_enable"`
+	ConnectorID              string `json:"connector_id"`
+
+	AvailableZones []string `json:"available_zones"`
+}
+
+func createInstanceV2(client *golangsdk.ServiceClient, opts interface{}) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("instances"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	var res struct {
+		InstanceID string `json:"instance_id"`
+	}
+	if err := r.ExtractInto(&res); err != nil {
+		return "", err
+	}
+	return res.InstanceID, nil
+}
+
+func getInstanceV2(client *golangsdk.ServiceClient, id string) (*instanceV2, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("instances", id), &r.Body, nil)
+	var instance instanceV2
+	if err := r.ExtractInto(&instance); err != nil {
+		return nil, err
+	}
+	return &instance, nil
+}
+
+func updateInstanceV2(client *golangsdk.ServiceClient, id string, opts instanceV2UpdateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(client.ServiceURL("instances", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func deleteInstanceV2(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("instances", id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func instanceV2StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getInstanceV2(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return instance, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return instance, instance.Status, nil
+	}
+}
+
+func waitForInstanceV2(ctx context.Context, client *golangsdk.ServiceClient, id string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    instanceV2StateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDmsRabbitMQInstanceV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	createOpts := rabbitMQInstanceCreateOpts{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		Engine:          "rabbitmq",
+		EngineVersion:   d.Get("engine_version").(string),
+		StorageSpace:    d.Get("storage_space").(int),
+		AccessUser:      d.Get("access_user").(string),
+		Password:        d.Get("password").(string),
+		VpcID:           d.Get("vpc_id").(string),
+		SecurityGroupID: d.Get("security_group_id").(string),
+		SubnetID:        d.Get("subnet_id").(string),
+		AvailableZones:  common.GetAllAvailableZones(d),
+		ProductID:       d.Get("product_id").(string),
+		MaintainBegin:   d.Get("maintain_begin").(string),
+		MaintainEnd:     d.Get("maintain_end").(string),
+		SslEnable:       d.Get("ssl_enable").(bool),
+		EnablePublicIP:  d.Get("enable_public_ip").(bool),
+		PublicBandwidth: d.Get("public_bandwidth").(int),
+		PublicIpID:      d.Get("public_ip_id").(string),
+		StorageSpecCode: d.Get("storage_spec_code").(string),
+	}
+	if createOpts.EnablePublicIP && createOpts.PublicIpID == "" {
+		return fmterr.Errorf("`public_ip_id` is required when `enable_public_ip` is set")
+	}
+
+	id, err := createInstanceV2(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating DMS RabbitMQ instance: %w", err)
+	}
+	log.Printf("[INFO] DMS RabbitMQ instance ID: %s", id)
+	d.SetId(id)
+
+	if err := waitForInstanceV2(ctx, client, id, []string{"CREATING"}, "RUNNING", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for DMS RabbitMQ instance (%s) to become ready: %w", id, err)
+	}
+
+	return resourceDmsRabbitMQInstanceV2Read(ctx, d, meta)
+}
+
+func resourceDmsRabbitMQInstanceV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instance, err := getInstanceV2(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS RabbitMQ instance"))
+	}
+	log.Printf("[DEBUG] DMS RabbitMQ instance %s: %+v", d.Id(), instance)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", instance.Name),
+		d.Set("description", instance.Description),
+		d.Set("engine_version", instance.EngineVersion),
+		d.Set("product_id", instance.ProductID),
+		d.Set("storage_space", instance.StorageSpace),
+		d.Set("storage_spec_code", instance.StorageSpecCode),
+		d.Set("access_user", instance.AccessUser),
+		d.Set("vpc_id", instance.VpcID),
+		d.Set("subnet_id", instance.SubnetID),
+		d.Set("security_group_id", instance.SecurityGroupID),
+		d.Set("maintain_begin", instance.MaintainBegin),
+		d.Set("maintain_end", instance.MaintainEnd),
+		d.Set("ssl_enable", instance.SslEnable),
+		d.Set("enable_public_ip", instance.EnablePublicIP),
+		d.Set("public_ip_id", instance.PublicIpID),
+		d.Set("type", instance.Type),
+		d.Set("specification", instance.Specification),
+		d.Set("status", instance.Status),
+		d.Set("connect_address", instance.ConnectAddress),
+		d.Set("port", instance.Port),
+		d.Set("management_connect_address", instance.ManagementConnectAddress),
+		d.Set("public_connect_address", instance.PublicConnectAddress),
+		d.Set("used_storage_space", instance.UsedStorageSpace),
+	)
+	if len(instance.AvailableZones) > 0 {
+		mErr = multierror.Append(mErr, d.Set("available_zones", instance.AvailableZones))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DMS RabbitMQ instance fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitMQInstanceV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	var updateOpts instanceV2UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("maintain_begin") || d.HasChange("maintain_end") {
+		updateOpts.MaintainBegin = d.Get("maintain_begin").(string)
+		updateOpts.MaintainEnd = d.Get("maintain_end").(string)
+	}
+	if d.HasChange("security_group_id") {
+		updateOpts.SecurityGroupID = d.Get("security_group_id").(string)
+	}
+
+	if err := updateInstanceV2(client, d.Id(), updateOpts); err != nil {
+		return fmterr.Errorf("error updating DMS RabbitMQ instance: %w", err)
+	}
+
+	return resourceDmsRabbitMQInstanceV2Read(ctx, d, meta)
+}
+
+func resourceDmsRabbitMQInstanceV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	if err := deleteInstanceV2(client, d.Id()); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS RabbitMQ instance"))
+	}
+
+	log.Printf("[DEBUG] Waiting for DMS RabbitMQ instance (%s) to be deleted", d.Id())
+	err = waitForInstanceV2(ctx, client, d.Id(), []string{"DELETING", "RUNNING"}, "DELETED", d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmterr.Errorf("error waiting for DMS RabbitMQ instance (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
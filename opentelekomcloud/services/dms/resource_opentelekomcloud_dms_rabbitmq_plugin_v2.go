@@ -0,0 +1,227 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDmsRabbitMQPluginV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitMQPluginV2Create,
+		ReadContext:   resourceDmsRabbitMQPluginV2Read,
+		DeleteContext: resourceDmsRabbitMQPluginV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitMQPluginV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"running": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type rabbitMQPlugin struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Enable  bool   `json:"enable"`
+	Running bool   `json:"running"`
+}
+
+func listRabbitMQPlugins(client *golangsdk.ServiceClient, instanceID string) ([]rabbitMQPlugin, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "rabbitmq", "plugins"), &r.Body, nil)
+	var plugins []rabbitMQPlugin
+	if err := r.ExtractIntoSlicePtr(&plugins, "plugins"); err != nil {
+		return nil, err
+	}
+	return plugins, nil
+}
+
+func switchRabbitMQPlugin(client *golangsdk.ServiceClient, instanceID, name string, enable bool) error {
+	body := map[string]interface{}{
+		"enable":  enable,
+		"plugins": name,
+	}
+	_, err := client.Put(client.ServiceURL("instances", instanceID, "rabbitmq", "plugins"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func rabbitMQPluginStateRefreshFunc(client *golangsdk.ServiceClient, instanceID, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		plugins, err := listRabbitMQPlugins(client, instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, plugin := range plugins {
+			if plugin.Name != name {
+				continue
+			}
+			if plugin.Enable && plugin.Running {
+				return plugin, "ENABLED", nil
+			}
+			if !plugin.Enable && !plugin.Running {
+				return plugin, "DISABLED", nil
+			}
+			return plugin, "PENDING", nil
+		}
+		return nil, "", fmt.Errorf("plugin %s is not available for the instance %s", name, instanceID)
+	}
+}
+
+func waitForRabbitMQPlugin(ctx context.Context, client *golangsdk.ServiceClient, instanceID, name, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "ENABLED", "DISABLED"},
+		Target:     []string{target},
+		Refresh:    rabbitMQPluginStateRefreshFunc(client, instanceID, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDmsRabbitMQPluginV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	// plugins can't be switched while the instance is changing
+	if err := waitForInstanceV2(ctx, client, instanceID, []string{"CREATING", "RESTARTING", "EXTENDING"}, "RUNNING", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for DMS RabbitMQ instance (%s) to become ready: %w", instanceID, err)
+	}
+
+	if err := switchRabbitMQPlugin(client, instanceID, name, true); err != nil {
+		return fmterr.Errorf("error enabling RabbitMQ plugin %s: %w", name, err)
+	}
+	d.SetId(common.BuildComponentID(instanceID, name))
+
+	if err := waitForRabbitMQPlugin(ctx, client, instanceID, name, "ENABLED", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for RabbitMQ plugin %s to be enabled: %w", name, err)
+	}
+
+	return resourceDmsRabbitMQPluginV2Read(ctx, d, meta)
+}
+
+func resourceDmsRabbitMQPluginV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	plugins, err := listRabbitMQPlugins(client, instanceID)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS RabbitMQ instance"))
+	}
+
+	for _, plugin := range plugins {
+		if plugin.Name != name {
+			continue
+		}
+		if !plugin.Enable {
+			log.Printf("[WARN] RabbitMQ plugin %s is disabled, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		mErr := multierror.Append(
+			d.Set("region", config.GetRegion(d)),
+			d.Set("version", plugin.Version),
+			d.Set("running", plugin.Running),
+		)
+		if err := mErr.ErrorOrNil(); err != nil {
+			return fmterr.Errorf("error setting RabbitMQ plugin fields: %w", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] RabbitMQ plugin %s not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsRabbitMQPluginV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	if err := switchRabbitMQPlugin(client, instanceID, name, false); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS RabbitMQ plugin"))
+	}
+
+	if err := waitForRabbitMQPlugin(ctx, client, instanceID, name, "DISABLED", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for RabbitMQ plugin %s to be disabled: %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsRabbitMQPluginV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for RabbitMQ plugin, must be <instance_id>/<plugin_name>")
+	}
+	mErr := multierror.Append(
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,179 @@
+package dms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDmsSmartConnectV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsSmartConnectV2Create,
+		ReadContext:   resourceDmsSmartConnectV2Read,
+		DeleteContext: resourceDmsSmartConnectV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsSmartConnectV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"specification": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(2),
+			},
+			"connector_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func connectorStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string, enabled bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getInstanceV2(client, instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+		if instance.ConnectorEnable == enabled && instance.Status == "RUNNING" {
+			return instance, "DONE", nil
+		}
+		return instance, "PENDING", nil
+	}
+}
+
+func waitForConnector(ctx context.Context, client *golangsdk.ServiceClient, instanceID string, enabled bool, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"DONE"},
+		Refresh:    connectorStateRefreshFunc(client, instanceID, enabled),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDmsSmartConnectV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	body := map[string]interface{}{}
+	if v, ok := d.GetOk("specification"); ok {
+		body["specification"] = v.(string)
+	}
+	if v, ok := d.GetOk("node_count"); ok {
+		body["node_cnt"] = v.(int)
+	}
+	log.Printf("[DEBUG] Enabling smart connect for DMS instance %s: %#v", instanceID, body)
+
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "connector"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error enabling smart connect for DMS instance %s: %w", instanceID, err)
+	}
+	d.SetId(instanceID)
+
+	if err := waitForConnector(ctx, client, instanceID, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for smart connect of DMS instance %s to be enabled: %w", instanceID, err)
+	}
+
+	return resourceDmsSmartConnectV2Read(ctx, d, meta)
+}
+
+func resourceDmsSmartConnectV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instance, err := getInstanceV2(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS instance"))
+	}
+	if !instance.ConnectorEnable {
+		log.Printf("[WARN] Smart connect is disabled for DMS instance %s, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instance.InstanceID),
+		d.Set("connector_id", instance.ConnectorID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DMS smart connect fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDmsSmartConnectV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	_, err = client.Put(client.ServiceURL("kafka", "instances", d.Id(), "delete-connector"), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS smart connect"))
+	}
+
+	if err := waitForConnector(ctx, client, d.Id(), false, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for smart connect of DMS instance %s to be disabled: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsSmartConnectV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("instance_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
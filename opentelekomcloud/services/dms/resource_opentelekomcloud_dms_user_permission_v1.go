@@ -0,0 +1,246 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDmsUserPermissionV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsUserPermissionV1Create,
+		ReadContext:   resourceDmsUserPermissionV1Read,
+		UpdateContext: resourceDmsUserPermissionV1Update,
+		DeleteContext: resourceDmsUserPermissionV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsUserPermissionV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policies": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"access_policy": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"all", "pub", "sub",
+							}, false),
+						},
+					},
+				},
+			},
+			"topic_type": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type topicPolicy struct {
+	Owner        bool   `json:"owner,omitempty"`
+	Username     string `json:"user_name"`
+	AccessPolicy string `json:"access_policy"`
+}
+
+type topicAccessPolicy struct {
+	Name      string        `json:"name"`
+	TopicType int           `json:"topic_type,omitempty"`
+	Policies  []topicPolicy `json:"policies"`
+}
+
+// topic access policies are managed with the DMS v1 (not v1.0) API
+func topicAccessPolicyURL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.Endpoint + "v1/" + client.ProjectID + "/" + strings.Join(parts, "/")
+}
+
+func getTopicAccessPolicy(client *golangsdk.ServiceClient, instanceID, topic string) (*topicAccessPolicy, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(topicAccessPolicyURL(client, "instances", instanceID, "topics", topic, "accesspolicy"), &r.Body, nil)
+	var policy topicAccessPolicy
+	if err := r.ExtractInto(&policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func setTopicAccessPolicy(client *golangsdk.ServiceClient, instanceID string, policy topicAccessPolicy) error {
+	body := map[string]interface{}{
+		"topics": []topicAccessPolicy{policy},
+	}
+	_, err := client.Post(topicAccessPolicyURL(client, "instances", instanceID, "topics", "accesspolicy"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func resourceDmsUserPermissionV1Set(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf(dmsV2ClientError, err)
+	}
+
+	policy := topicAccessPolicy{
+		Name: d.Get("topic_name").(string),
+	}
+	for _, v := range d.Get("policies").(*schema.Set).List() {
+		p := v.(map[string]interface{})
+		policy.Policies = append(policy.Policies, topicPolicy{
+			Username:     p["username"].(string),
+			AccessPolicy: p["access_policy"].(string),
+		})
+	}
+
+	// users removed from the configuration lose their access to the topic
+	if d.HasChange("policies") {
+		oldPolicies, _ := d.GetChange("policies")
+		for _, v := range oldPolicies.(*schema.Set).Difference(d.Get("policies").(*schema.Set)).List() {
+			username := v.(map[string]interface{})["username"].(string)
+			if !hasTopicPolicyForUser(policy.Policies, username) {
+				policy.Policies = append(policy.Policies, topicPolicy{
+					Username:     username,
+					AccessPolicy: "none",
+				})
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] DMS topic access policy: %#v", policy)
+	return setTopicAccessPolicy(client, d.Get("instance_id").(string), policy)
+}
+
+func hasTopicPolicyForUser(policies []topicPolicy, username string) bool {
+	for _, p := range policies {
+		if p.Username == username {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceDmsUserPermissionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceDmsUserPermissionV1Set(d, meta); err != nil {
+		return fmterr.Errorf("error setting DMS topic permissions: %w", err)
+	}
+	d.SetId(common.BuildComponentID(d.Get("instance_id").(string), d.Get("topic_name").(string)))
+
+	return resourceDmsUserPermissionV1Read(ctx, d, meta)
+}
+
+func resourceDmsUserPermissionV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	policy, err := getTopicAccessPolicy(client, d.Get("instance_id").(string), d.Get("topic_name").(string))
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS topic permissions"))
+	}
+
+	var policies []map[string]interface{}
+	for _, p := range policy.Policies {
+		// topic owner always has full access and is not managed by this resource
+		if p.Owner {
+			continue
+		}
+		policies = append(policies, map[string]interface{}{
+			"username":      p.Username,
+			"access_policy": p.AccessPolicy,
+		})
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("policies", policies),
+		d.Set("topic_type", policy.TopicType),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DMS topic permissions fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDmsUserPermissionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceDmsUserPermissionV1Set(d, meta); err != nil {
+		return fmterr.Errorf("error updating DMS topic permissions: %w", err)
+	}
+
+	return resourceDmsUserPermissionV1Read(ctx, d, meta)
+}
+
+func resourceDmsUserPermissionV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	policy := topicAccessPolicy{
+		Name: d.Get("topic_name").(string),
+	}
+	for _, v := range d.Get("policies").(*schema.Set).List() {
+		policy.Policies = append(policy.Policies, topicPolicy{
+			Username:     v.(map[string]interface{})["username"].(string),
+			AccessPolicy: "none",
+		})
+	}
+	if err := setTopicAccessPolicy(client, d.Get("instance_id").(string), policy); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS topic permissions"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsUserPermissionV1Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for DMS user permission, must be <instance_id>/<topic_name>")
+	}
+	mErr := multierror.Append(
+		d.Set("instance_id", parts[0]),
+		d.Set("topic_name", parts[1]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
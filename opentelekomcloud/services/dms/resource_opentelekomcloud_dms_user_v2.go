@@ -0,0 +1,202 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceDmsUserV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsUserV2Create,
+		ReadContext:   resourceDmsUserV2Read,
+		UpdateContext: resourceDmsUserV2Update,
+		DeleteContext: resourceDmsUserV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsUserV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_app": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type kafkaUser struct {
+	Username    string `json:"user_name"`
+	Role        string `json:"role"`
+	DefaultApp  bool   `json:"default_app"`
+	CreatedTime int    `json:"created_time"`
+}
+
+func listKafkaUsers(client *golangsdk.ServiceClient, instanceID string) ([]kafkaUser, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "users"), &r.Body, nil)
+	var users []kafkaUser
+	if err := r.ExtractIntoSlicePtr(&users, "users"); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func resourceDmsUserV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	username := d.Get("username").(string)
+	body := map[string]interface{}{
+		"user_name":   username,
+		"user_passwd": d.Get("password").(string),
+	}
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "users"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 204},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DMS user %s: %w", username, err)
+	}
+	d.SetId(common.BuildComponentID(instanceID, username))
+
+	return resourceDmsUserV2Read(ctx, d, meta)
+}
+
+func resourceDmsUserV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	users, err := listKafkaUsers(client, d.Get("instance_id").(string))
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS instance"))
+	}
+
+	username := d.Get("username").(string)
+	for _, user := range users {
+		if user.Username != username {
+			continue
+		}
+		mErr := multierror.Append(
+			d.Set("region", config.GetRegion(d)),
+			d.Set("role", user.Role),
+			d.Set("default_app", user.DefaultApp),
+			d.Set("created_at", user.CreatedTime),
+		)
+		if err := mErr.ErrorOrNil(); err != nil {
+			return fmterr.Errorf("error setting DMS user fields: %w", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] DMS user %s not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsUserV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	if d.HasChange("password") {
+		body := map[string]interface{}{
+			"new_password": d.Get("password").(string),
+		}
+		url := client.ServiceURL("instances", d.Get("instance_id").(string), "users", d.Get("username").(string))
+		_, err = client.Put(url, body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+		if err != nil {
+			return fmterr.Errorf("error resetting DMS user password: %w", err)
+		}
+	}
+
+	return resourceDmsUserV2Read(ctx, d, meta)
+}
+
+func resourceDmsUserV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dmsV2ClientError, err)
+	}
+
+	body := map[string]interface{}{
+		"action": "delete",
+		"users":  []string{d.Get("username").(string)},
+	}
+	_, err = client.Put(client.ServiceURL("instances", d.Get("instance_id").(string), "users"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DMS user"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsUserV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for DMS user, must be <instance_id>/<username>")
+	}
+	mErr := multierror.Append(
+		d.Set("instance_id", parts[0]),
+		d.Set("username", parts[1]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
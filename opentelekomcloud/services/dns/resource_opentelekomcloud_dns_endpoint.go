@@ -0,0 +1,378 @@
+package dns
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type endpointIPAddress struct {
+	ID       string `json:"id,omitempty"`
+	SubnetID string `json:"subnet_id"`
+	IP       string `json:"ip,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+type endpoint struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Direction         string `json:"direction"`
+	Status            string `json:"status"`
+	VpcID             string `json:"vpc_id"`
+	ResolverRuleCount int    `json:"resolver_rule_count"`
+	CreateTime        string `json:"create_time"`
+}
+
+func ResourceDNSEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSEndpointCreate,
+		ReadContext:   resourceDNSEndpointRead,
+		UpdateContext: resourceDNSEndpointUpdate,
+		DeleteContext: resourceDNSEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"inbound", "outbound"}, false),
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 6,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ip": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resolver_rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandEndpointIPAddresses(raw []interface{}) []endpointIPAddress {
+	addresses := make([]endpointIPAddress, len(raw))
+	for i, v := range raw {
+		address := v.(map[string]interface{})
+		addresses[i] = endpointIPAddress{
+			SubnetID: address["subnet_id"].(string),
+			IP:       address["ip"].(string),
+		}
+	}
+	return addresses
+}
+
+func getDNSEndpoint(client *golangsdk.ServiceClient, id string) (*endpoint, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("endpoints", id), &r.Body, nil)
+	ep := new(endpoint)
+	if err := r.ExtractIntoStructPtr(ep, "endpoint"); err != nil {
+		return nil, err
+	}
+	return ep, nil
+}
+
+func getDNSEndpointIPAddresses(client *golangsdk.ServiceClient, id string) ([]endpointIPAddress, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("endpoints", id, "ipaddresses"), &r.Body, nil)
+	var addresses []endpointIPAddress
+	if err := r.ExtractIntoSlicePtr(&addresses, "ipaddresses"); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+func waitForDNSEndpoint(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ep, err := getDNSEndpoint(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return ep, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] OpenTelekomCloud DNS endpoint (%s) current status: %s", id, ep.Status)
+		return ep, parseStatus(ep.Status), nil
+	}
+}
+
+func waitForDNSEndpointActive(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    waitForDNSEndpoint(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDNSEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV21Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	opts := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"direction":   d.Get("direction").(string),
+		"region":      config.GetRegion(d),
+		"ipaddresses": expandEndpointIPAddresses(d.Get("ip_addresses").([]interface{})),
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("endpoints"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	ep := new(endpoint)
+	if err := r.ExtractIntoStructPtr(ep, "endpoint"); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS endpoint: %s", err)
+	}
+	d.SetId(ep.ID)
+
+	if err := waitForDNSEndpointActive(ctx, client, ep.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for DNS endpoint (%s) to become ACTIVE: %s", ep.ID, err)
+	}
+
+	return resourceDNSEndpointRead(ctx, d, meta)
+}
+
+func resourceDNSEndpointRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV21Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	ep, err := getDNSEndpoint(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DNS endpoint"))
+	}
+
+	addresses, err := getDNSEndpointIPAddresses(client, d.Id())
+	if err != nil {
+		return fmterr.Errorf("error listing IP addresses of DNS endpoint (%s): %s", d.Id(), err)
+	}
+	ipAddresses := make([]map[string]interface{}, 0, len(addresses))
+	for _, address := range sortEndpointIPAddresses(d.Get("ip_addresses").([]interface{}), addresses) {
+		ipAddresses = append(ipAddresses, map[string]interface{}{
+			"subnet_id": address.SubnetID,
+			"ip":        address.IP,
+			"id":        address.ID,
+		})
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", ep.Name),
+		d.Set("direction", ep.Direction),
+		d.Set("ip_addresses", ipAddresses),
+		d.Set("vpc_id", ep.VpcID),
+		d.Set("status", ep.Status),
+		d.Set("resolver_rule_count", ep.ResolverRuleCount),
+		d.Set("created_at", ep.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DNS endpoint fields: %s", err)
+	}
+
+	return nil
+}
+
+// sortEndpointIPAddresses keeps the order of the addresses in the state, the API returns them in any order
+func sortEndpointIPAddresses(stateRaw []interface{}, addresses []endpointIPAddress) []endpointIPAddress {
+	sorted := make([]endpointIPAddress, 0, len(addresses))
+	used := make([]bool, len(addresses))
+	for _, v := range stateRaw {
+		known := v.(map[string]interface{})
+		for i, address := range addresses {
+			if !used[i] && address.SubnetID == known["subnet_id"] && (known["ip"] == "" || address.IP == known["ip"]) {
+				sorted = append(sorted, address)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, address := range addresses {
+		if !used[i] {
+			sorted = append(sorted, address)
+		}
+	}
+	return sorted
+}
+
+// diffEndpointIPAddresses matches the addresses by subnet and IP, an address without IP
+// in the configuration matches any address of the subnet
+func diffEndpointIPAddresses(oldRaw, newRaw []interface{}) (toAdd []endpointIPAddress, toRemove []string) {
+	remaining := make([]map[string]interface{}, 0, len(oldRaw))
+	for _, v := range oldRaw {
+		remaining = append(remaining, v.(map[string]interface{}))
+	}
+
+	for _, v := range newRaw {
+		address := v.(map[string]interface{})
+		found := -1
+		for i, old := range remaining {
+			if old["subnet_id"] == address["subnet_id"] && (address["ip"] == "" || old["ip"] == address["ip"]) {
+				found = i
+				break
+			}
+		}
+		if found >= 0 {
+			remaining = append(remaining[:found], remaining[found+1:]...)
+			continue
+		}
+		toAdd = append(toAdd, endpointIPAddress{
+			SubnetID: address["subnet_id"].(string),
+			IP:       address["ip"].(string),
+		})
+	}
+	for _, old := range remaining {
+		toRemove = append(toRemove, old["id"].(string))
+	}
+	return
+}
+
+func resourceDNSEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV21Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		opts := map[string]interface{}{"name": d.Get("name").(string)}
+		_, err := client.Put(client.ServiceURL("endpoints", d.Id()), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud DNS endpoint: %s", err)
+		}
+	}
+
+	if d.HasChange("ip_addresses") {
+		oldRaw, newRaw := d.GetChange("ip_addresses")
+		toAdd, toRemove := diffEndpointIPAddresses(oldRaw.([]interface{}), newRaw.([]interface{}))
+
+		// add first, as the endpoint can't have less than 2 addresses
+		for _, address := range toAdd {
+			opts := map[string]interface{}{"ipaddress": address}
+			_, err := client.Post(client.ServiceURL("endpoints", d.Id(), "ipaddresses"), opts, nil, &golangsdk.RequestOpts{
+				OkCodes: []int{200, 201, 202},
+			})
+			if err != nil {
+				return fmterr.Errorf("error adding IP address in subnet %s to DNS endpoint: %s", address.SubnetID, err)
+			}
+			if err := waitForDNSEndpointActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmterr.Errorf("error waiting for DNS endpoint (%s) to become ACTIVE: %s", d.Id(), err)
+			}
+		}
+		for _, id := range toRemove {
+			_, err := client.Delete(client.ServiceURL("endpoints", d.Id(), "ipaddresses", id), &golangsdk.RequestOpts{
+				OkCodes: []int{200, 202, 204},
+			})
+			if err != nil {
+				return fmterr.Errorf("error removing IP address %s from DNS endpoint: %s", id, err)
+			}
+			if err := waitForDNSEndpointActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmterr.Errorf("error waiting for DNS endpoint (%s) to become ACTIVE: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceDNSEndpointRead(ctx, d, meta)
+}
+
+func resourceDNSEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV21Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("endpoints", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud DNS endpoint"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"DELETED"},
+		Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:    waitForDNSEndpoint(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DNS endpoint (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
@@ -86,6 +87,17 @@ func ResourceDNSRecordSetV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"tags": common.TagsSchema(),
+			"line": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
 
 			"shared": {
 				Type:     schema.TypeBool,
@@ -102,16 +114,31 @@ func getRecordSetCreateOpts(d cfg.SchemaOrDiff) RecordSetCreateOpts {
 		records[i] = record.(string)
 	}
 
-	return RecordSetCreateOpts{
-		recordsets.CreateOpts{
+	createOpts := RecordSetCreateOpts{
+		CreateOpts: recordsets.CreateOpts{
 			Name:        d.Get("name").(string),
 			Description: d.Get("description").(string),
 			Records:     records,
 			TTL:         d.Get("ttl").(int),
 			Type:        d.Get("type").(string),
 		},
-		common.MapValueSpecs(d),
+		ValueSpecs: common.MapValueSpecs(d),
+	}
+	if line := d.Get("line").(string); line != "" {
+		createOpts.Line = line
+		if weight, ok := d.GetOk("weight"); ok {
+			createOpts.Weight = golangsdk.IntToPointer(weight.(int))
+		}
+	}
+	return createOpts
+}
+
+// dnsRecordSetClient returns DNS v2.1 client for the record sets using line-based resolution and v2 client otherwise
+func dnsRecordSetClient(d cfg.SchemaOrDiff, config *cfg.Config) (*golangsdk.ServiceClient, error) {
+	if d.Get("line").(string) != "" {
+		return config.DnsV21Client(config.GetRegion(d))
 	}
+	return config.DnsV2Client(config.GetRegion(d))
 }
 
 func resourceDNSRecordSetV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -120,6 +147,10 @@ func resourceDNSRecordSetV2Create(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
 	}
+	recordSetClient, err := dnsRecordSetClient(d, config)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
 
 	zoneID := d.Get("zone_id").(string)
 
@@ -132,9 +163,18 @@ func resourceDNSRecordSetV2Create(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	createOpts := getRecordSetCreateOpts(d)
+	if createOpts.Line != "" {
+		resourceType, err := getDNSRecordSetResourceType(dnsClient, zoneID)
+		if err != nil {
+			return fmterr.Errorf("error getting resource type of DNS record set: %s", err)
+		}
+		if resourceType != "DNS-public_recordset" {
+			return fmterr.Errorf("line-based resolution is supported for public zones only")
+		}
+	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	recordSet, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract()
+	recordSet, err := recordsets.Create(recordSetClient, zoneID, createOpts).Extract()
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud DNS record set: %s", err)
 	}
@@ -143,7 +183,7 @@ func resourceDNSRecordSetV2Create(ctx context.Context, d *schema.ResourceData, m
 	stateConf := &resource.StateChangeConf{
 		Target:     []string{"ACTIVE"},
 		Pending:    []string{"PENDING"},
-		Refresh:    waitForDNSRecordSet(dnsClient, zoneID, recordSet.ID),
+		Refresh:    waitForDNSRecordSet(recordSetClient, zoneID, recordSet.ID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -183,6 +223,10 @@ func resourceDNSRecordSetV2Read(_ context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
 	}
+	recordSetClient, err := dnsRecordSetClient(d, config)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
 
 	// Obtain relevant info from parsing the ID
 	zoneID, recordsetID, err := ParseDNSV2RecordSetID(d.Id())
@@ -190,7 +234,8 @@ func resourceDNSRecordSetV2Read(_ context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	n, err := recordsets.Get(dnsClient, zoneID, recordsetID).Extract()
+	result := recordsets.Get(recordSetClient, zoneID, recordsetID)
+	n, err := result.Extract()
 	if err != nil {
 		return diag.FromErr(common.CheckDeleted(d, err, "record_set"))
 	}
@@ -206,6 +251,16 @@ func resourceDNSRecordSetV2Read(_ context.Context, d *schema.ResourceData, meta
 		d.Set("region", config.GetRegion(d)),
 		d.Set("zone_id", zoneID),
 	)
+	if d.Get("line").(string) != "" {
+		line := new(recordSetLine)
+		if err := result.ExtractInto(line); err != nil {
+			return fmterr.Errorf("error extracting line of OpenTelekomCloud DNS record set: %s", err)
+		}
+		mErr = multierror.Append(mErr,
+			d.Set("line", line.Line),
+			d.Set("weight", line.Weight),
+		)
+	}
 	if err := mErr.ErrorOrNil(); err != nil {
 		return fmterr.Errorf(
 			"error saving records to state for OpenTelekomCloud DNS record set (%s): %s", d.Id(), err)
@@ -235,8 +290,15 @@ func resourceDNSRecordSetV2Update(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
 	}
+	recordSetClient, err := dnsRecordSetClient(d, config)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
 
-	var updateOpts recordsets.UpdateOpts
+	var updateOpts RecordSetUpdateOpts
+	if d.HasChange("weight") && d.Get("line").(string) != "" {
+		updateOpts.Weight = golangsdk.IntToPointer(d.Get("weight").(int))
+	}
 	if d.HasChange("ttl") {
 		updateOpts.TTL = d.Get("ttl").(int)
 	}
@@ -261,7 +323,7 @@ func resourceDNSRecordSetV2Update(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[DEBUG] Updating  record set %s with options: %#v", recordsetID, updateOpts)
 
-	_, err = recordsets.Update(dnsClient, zoneID, recordsetID, updateOpts).Extract()
+	_, err = recordsets.Update(recordSetClient, zoneID, recordsetID, updateOpts).Extract()
 	if err != nil {
 		return fmterr.Errorf("error updating OpenTelekomCloud DNS  record set: %s", err)
 	}
@@ -270,7 +332,7 @@ func resourceDNSRecordSetV2Update(ctx context.Context, d *schema.ResourceData, m
 	stateConf := &resource.StateChangeConf{
 		Target:     []string{"ACTIVE"},
 		Pending:    []string{"PENDING"},
-		Refresh:    waitForDNSRecordSet(dnsClient, zoneID, recordsetID),
+		Refresh:    waitForDNSRecordSet(recordSetClient, zoneID, recordsetID),
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -299,7 +361,7 @@ func resourceDNSRecordSetV2Update(ctx context.Context, d *schema.ResourceData, m
 
 func resourceDNSRecordSetV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
-	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	recordSetClient, err := dnsRecordSetClient(d, config)
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
 	}
@@ -317,7 +379,7 @@ func resourceDNSRecordSetV2Delete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	err = recordsets.Delete(dnsClient, zoneID, recordsetID).ExtractErr()
+	err = recordsets.Delete(recordSetClient, zoneID, recordsetID).ExtractErr()
 	if err != nil {
 		return fmterr.Errorf("error deleting OpenTelekomCloud DNS record set: %s", err)
 	}
@@ -326,7 +388,7 @@ func resourceDNSRecordSetV2Delete(ctx context.Context, d *schema.ResourceData, m
 	stateConf := &resource.StateChangeConf{
 		Target:     []string{"DELETED"},
 		Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
-		Refresh:    waitForDNSRecordSet(dnsClient, zoneID, recordsetID),
+		Refresh:    waitForDNSRecordSet(recordSetClient, zoneID, recordsetID),
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -443,6 +505,11 @@ func useSharedRecordSet(_ context.Context, d *schema.ResourceDiff, meta interfac
 		return
 	}
 
+	if d.Get("line").(string) != "" { // record sets of different lines can have the same name
+		_ = d.SetNew("shared", false)
+		return
+	}
+
 	id, err := getExistingRecordSetID(d, meta)
 	if id == "" {
 		_ = d.SetNew("shared", false)
@@ -0,0 +1,371 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type resolverRuleIP struct {
+	IP string `json:"ip"`
+}
+
+type resolverRuleRouter struct {
+	RouterID     string `json:"router_id"`
+	RouterRegion string `json:"router_region"`
+	Status       string `json:"status,omitempty"`
+}
+
+type resolverRule struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	DomainName  string               `json:"domain_name"`
+	EndpointID  string               `json:"endpoint_id"`
+	Status      string               `json:"status"`
+	RuleType    string               `json:"rule_type"`
+	IPAddresses []resolverRuleIP     `json:"ipaddresses"`
+	Routers     []resolverRuleRouter `json:"routers"`
+	CreateTime  string               `json:"create_time"`
+}
+
+func ResourceDNSResolverRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSResolverRuleCreate,
+		ReadContext:   resourceDNSResolverRuleRead,
+		UpdateContext: resourceDNSResolverRuleUpdate,
+		DeleteContext: resourceDNSResolverRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"domain_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: common.SuppressEqualZoneNames,
+			},
+			"endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 6,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"router": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"router_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"router_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandResolverRuleIPs(d *schema.ResourceData) []resolverRuleIP {
+	raw := d.Get("ip_addresses").([]interface{})
+	ips := make([]resolverRuleIP, len(raw))
+	for i, ip := range raw {
+		ips[i] = resolverRuleIP{IP: ip.(string)}
+	}
+	return ips
+}
+
+func expandResolverRuleRouters(raw *schema.Set, region string) map[string]resolverRuleRouter {
+	routers := make(map[string]resolverRuleRouter)
+	for _, v := range raw.List() {
+		router := v.(map[string]interface{})
+		routerRegion := router["router_region"].(string)
+		if routerRegion == "" {
+			routerRegion = region
+		}
+		routers[router["router_id"].(string)] = resolverRuleRouter{
+			RouterID:     router["router_id"].(string),
+			RouterRegion: routerRegion,
+		}
+	}
+	return routers
+}
+
+func getDNSResolverRule(client *golangsdk.ServiceClient, id string) (*resolverRule, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("resolverrules", id), &r.Body, nil)
+	rule := new(resolverRule)
+	if err := r.ExtractIntoStructPtr(rule, "resolver_rule"); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func waitForDNSResolverRule(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := getDNSResolverRule(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return rule, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		// the rule is pending while any of the routers is being associated or disassociated
+		for _, router := range rule.Routers {
+			if parseStatus(router.Status) == "PENDING" {
+				return rule, "PENDING", nil
+			}
+		}
+		log.Printf("[DEBUG] OpenTelekomCloud DNS resolver rule (%s) current status: %s", id, rule.Status)
+		return rule, parseStatus(rule.Status), nil
+	}
+}
+
+func waitForDNSResolverRuleActive(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    waitForDNSResolverRule(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// updateResolverRuleRouter associates or disassociates the VPC with the rule, `action` is
+// either `associaterouter` or `disassociaterouter`
+func updateResolverRuleRouter(ctx context.Context, client *golangsdk.ServiceClient, id, action string, router resolverRuleRouter, timeout time.Duration) error {
+	log.Printf("[DEBUG] Calling %s of DNS resolver rule (%s) with Router: %#v", action, id, router)
+	opts := map[string]interface{}{"router": router}
+	_, err := client.Post(client.ServiceURL("resolverrules", id, action), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return fmt.Errorf("error calling %s of DNS resolver rule (%s) with Router (%s): %s", action, id, router.RouterID, err)
+	}
+	if err := waitForDNSResolverRuleActive(ctx, client, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for DNS resolver rule (%s) to become ACTIVE: %s", id, err)
+	}
+	return nil
+}
+
+func resourceDNSResolverRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV21Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	opts := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"domain_name": d.Get("domain_name").(string),
+		"endpoint_id": d.Get("endpoint_id").(string),
+		"ipaddresses": expandResolverRuleIPs(d),
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("resolverrules"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	rule := new(resolverRule)
+	if err := r.ExtractIntoStructPtr(rule, "resolver_rule"); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS resolver rule: %s", err)
+	}
+	d.SetId(rule.ID)
+
+	if err := waitForDNSResolverRuleActive(ctx, client, rule.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for DNS resolver rule (%s) to become ACTIVE: %s", rule.ID, err)
+	}
+
+	for _, router := range expandResolverRuleRouters(d.Get("router").(*schema.Set), config.GetRegion(d)) {
+		if err := updateResolverRuleRouter(ctx, client, rule.ID, "associaterouter", router, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDNSResolverRuleRead(ctx, d, meta)
+}
+
+func resourceDNSResolverRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV21Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	rule, err := getDNSResolverRule(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DNS resolver rule"))
+	}
+
+	ips := make([]string, len(rule.IPAddresses))
+	for i, ip := range rule.IPAddresses {
+		ips[i] = ip.IP
+	}
+	routers := make([]map[string]interface{}, len(rule.Routers))
+	for i, router := range rule.Routers {
+		routers[i] = map[string]interface{}{
+			"router_id":     router.RouterID,
+			"router_region": router.RouterRegion,
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", rule.Name),
+		d.Set("domain_name", rule.DomainName),
+		d.Set("endpoint_id", rule.EndpointID),
+		d.Set("ip_addresses", ips),
+		d.Set("router", routers),
+		d.Set("status", rule.Status),
+		d.Set("rule_type", rule.RuleType),
+		d.Set("created_at", rule.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DNS resolver rule fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDNSResolverRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV21Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	if d.HasChanges("name", "ip_addresses") {
+		opts := map[string]interface{}{
+			"resolver_rule": map[string]interface{}{
+				"name":        d.Get("name").(string),
+				"ipaddresses": expandResolverRuleIPs(d),
+			},
+		}
+		_, err := client.Put(client.ServiceURL("resolverrules", d.Id()), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud DNS resolver rule: %s", err)
+		}
+		if err := waitForDNSResolverRuleActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for DNS resolver rule (%s) to become ACTIVE: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("router") {
+		oldRaw, newRaw := d.GetChange("router")
+		oldRouters := expandResolverRuleRouters(oldRaw.(*schema.Set), config.GetRegion(d))
+		newRouters := expandResolverRuleRouters(newRaw.(*schema.Set), config.GetRegion(d))
+		for id, router := range newRouters {
+			if _, ok := oldRouters[id]; !ok {
+				if err := updateResolverRuleRouter(ctx, client, d.Id(), "associaterouter", router, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		for id, router := range oldRouters {
+			if _, ok := newRouters[id]; !ok {
+				if err := updateResolverRuleRouter(ctx, client, d.Id(), "disassociaterouter", router, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+
+	return resourceDNSResolverRuleRead(ctx, d, meta)
+}
+
+func resourceDNSResolverRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DnsV21Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	// the rule can't be deleted while associated with VPCs
+	for _, router := range expandResolverRuleRouters(d.Get("router").(*schema.Set), config.GetRegion(d)) {
+		if err := updateResolverRuleRouter(ctx, client, d.Id(), "disassociaterouter", router, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err = client.Delete(client.ServiceURL("resolverrules", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud DNS resolver rule"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"DELETED"},
+		Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:    waitForDNSResolverRule(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DNS resolver rule (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
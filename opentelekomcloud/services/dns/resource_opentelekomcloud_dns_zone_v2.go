@@ -2,6 +2,7 @@ package dns
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -176,39 +177,11 @@ func resourceDNSZoneV2Create(ctx context.Context, d *schema.ResourceData, meta i
 			n.ID, err)
 	}
 
-	// router length >1 when creating private zone
+	// the first router is associated on creation, the others are associated separately
 	if zone_type == "private" {
-		// AssociateZone for the other routers
-		routerList := getDNSRouters(d)
-		if len(routerList) > 1 {
-			for i := range routerList {
-				// Skip the first router
-				if i > 0 {
-					log.Printf("[DEBUG] Creating AssociateZone Options: %#v", routerList[i])
-					_, err := zones.AssociateZone(dnsClient, n.ID, routerList[i]).Extract()
-					if err != nil {
-						return fmterr.Errorf("error AssociateZone: %s", err)
-					}
-
-					log.Printf("[DEBUG] Waiting for AssociateZone (%s) to Router (%s) become ACTIVE",
-						n.ID, routerList[i].RouterID)
-					stateRouterConf := &resource.StateChangeConf{
-						Target:     []string{"ACTIVE"},
-						Pending:    []string{"PENDING"},
-						Refresh:    waitForDNSZoneRouter(dnsClient, n.ID, routerList[i].RouterID),
-						Timeout:    d.Timeout(schema.TimeoutCreate),
-						Delay:      5 * time.Second,
-						MinTimeout: 3 * time.Second,
-					}
-
-					_, err = stateRouterConf.WaitForStateContext(ctx)
-					if err != nil {
-						return fmterr.Errorf("error waiting for AssociateZone (%s) to Router (%s) become ACTIVE: %s",
-							n.ID, routerList[i].RouterID, err)
-					}
-				} else {
-					log.Printf("[DEBUG] First Router Options: %#v", routerList[i])
-				}
+		for _, router := range getDNSRouters(d)[1:] {
+			if err := associateDNSRouter(ctx, dnsClient, n.ID, router, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -252,6 +225,20 @@ func resourceDNSZoneV2Read(_ context.Context, d *schema.ResourceData, meta inter
 	}
 	d.Set("region", config.GetRegion(d))
 
+	// all the associated routers are saved, so that routers associated outside of terraform are removed
+	if n.ZoneType == "private" {
+		routers := make([]map[string]interface{}, len(n.Routers))
+		for i, router := range n.Routers {
+			routers[i] = map[string]interface{}{
+				"router_id":     router.RouterID,
+				"router_region": router.RouterRegion,
+			}
+		}
+		if err := d.Set("router", routers); err != nil {
+			return fmterr.Errorf("error saving routers to state for OpenTelekomCloud DNS zone (%s): %s", d.Id(), err)
+		}
+	}
+
 	// save tags
 	resourceTags, err := tags.Get(dnsClient, serviceMap[n.ZoneType], d.Id()).Extract()
 	if err != nil {
@@ -312,67 +299,24 @@ func resourceDNSZoneV2Update(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmterr.Errorf("error waiting for DNS Zone (%s) to update: %s", d.Id(), err)
+	}
 
-	if d.HasChange("router") {
-		// when updating private zone
-		if zone_type == "private" {
-			associateList, disassociateList, err := resourceGetDNSRouters(dnsClient, d)
-			if err != nil {
-				return fmterr.Errorf("error getting OpenTelekomCloud DNS Zone Router: %s", err)
-			}
-			if len(associateList) > 0 {
-				// AssociateZone
-				for i := range associateList {
-					log.Printf("[DEBUG] Updating AssociateZone Options: %#v", associateList[i])
-					_, err := zones.AssociateZone(dnsClient, d.Id(), associateList[i]).Extract()
-					if err != nil {
-						return fmterr.Errorf("error AssociateZone: %s", err)
-					}
-
-					log.Printf("[DEBUG] Waiting for AssociateZone (%s) to Router (%s) become ACTIVE",
-						d.Id(), associateList[i].RouterID)
-					stateRouterConf := &resource.StateChangeConf{
-						Target:     []string{"ACTIVE"},
-						Pending:    []string{"PENDING"},
-						Refresh:    waitForDNSZoneRouter(dnsClient, d.Id(), associateList[i].RouterID),
-						Timeout:    d.Timeout(schema.TimeoutUpdate),
-						Delay:      5 * time.Second,
-						MinTimeout: 3 * time.Second,
-					}
-
-					_, err = stateRouterConf.WaitForStateContext(ctx)
-					if err != nil {
-						return fmterr.Errorf("error waiting for AssociateZone (%s) to Router (%s) become ACTIVE: %s",
-							d.Id(), associateList[i].RouterID, err)
-					}
-				}
+	if zone_type == "private" && d.HasChange("router") {
+		associateList, disassociateList, err := resourceGetDNSRouters(dnsClient, d)
+		if err != nil {
+			return fmterr.Errorf("error getting OpenTelekomCloud DNS Zone Router: %s", err)
+		}
+		// associate first, as the last router of the zone can't be disassociated
+		for _, router := range associateList {
+			if err := associateDNSRouter(ctx, dnsClient, d.Id(), router, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
 			}
-			if len(disassociateList) > 0 {
-				// DisassociateZone
-				for j := range disassociateList {
-					log.Printf("[DEBUG] Updating DisassociateZone Options: %#v", disassociateList[j])
-					_, err := zones.DisassociateZone(dnsClient, d.Id(), disassociateList[j]).Extract()
-					if err != nil {
-						return fmterr.Errorf("error DisassociateZone: %s", err)
-					}
-
-					log.Printf("[DEBUG] Waiting for DisassociateZone (%s) to Router (%s) become DELETED",
-						d.Id(), disassociateList[j].RouterID)
-					stateRouterConf := &resource.StateChangeConf{
-						Target:     []string{"DELETED"},
-						Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
-						Refresh:    waitForDNSZoneRouter(dnsClient, d.Id(), disassociateList[j].RouterID),
-						Timeout:    d.Timeout(schema.TimeoutUpdate),
-						Delay:      5 * time.Second,
-						MinTimeout: 3 * time.Second,
-					}
-
-					_, err = stateRouterConf.WaitForStateContext(ctx)
-					if err != nil {
-						return fmterr.Errorf("error waiting for DisassociateZone (%s) to Router (%s) become DELETED: %s",
-							d.Id(), disassociateList[j].RouterID, err)
-					}
-				}
+		}
+		for _, router := range disassociateList {
+			if err := disassociateDNSRouter(ctx, dnsClient, d.Id(), router, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -479,6 +423,46 @@ func waitForDNSZoneRouter(dnsClient *golangsdk.ServiceClient, zoneId string, rou
 	}
 }
 
+func associateDNSRouter(ctx context.Context, dnsClient *golangsdk.ServiceClient, zoneID string, router zones.RouterOpts, timeout time.Duration) error {
+	log.Printf("[DEBUG] Associating DNS Zone (%s) with Router: %#v", zoneID, router)
+	if _, err := zones.AssociateZone(dnsClient, zoneID, router).Extract(); err != nil {
+		return fmt.Errorf("error associating DNS Zone (%s) with Router (%s): %s", zoneID, router.RouterID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    waitForDNSZoneRouter(dnsClient, zoneID, router.RouterID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for AssociateZone (%s) to Router (%s) become ACTIVE: %s", zoneID, router.RouterID, err)
+	}
+	return nil
+}
+
+func disassociateDNSRouter(ctx context.Context, dnsClient *golangsdk.ServiceClient, zoneID string, router zones.RouterOpts, timeout time.Duration) error {
+	log.Printf("[DEBUG] Disassociating DNS Zone (%s) from Router: %#v", zoneID, router)
+	if _, err := zones.DisassociateZone(dnsClient, zoneID, router).Extract(); err != nil {
+		return fmt.Errorf("error disassociating DNS Zone (%s) from Router (%s): %s", zoneID, router.RouterID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"DELETED"},
+		Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:    waitForDNSZoneRouter(dnsClient, zoneID, router.RouterID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for DisassociateZone (%s) to Router (%s) become DELETED: %s", zoneID, router.RouterID, err)
+	}
+	return nil
+}
+
 func resourceGetDNSRouters(dnsClient *golangsdk.ServiceClient, d *schema.ResourceData) ([]zones.RouterOpts, []zones.RouterOpts, error) {
 	// get zone info from api
 	n, err := zones.Get(dnsClient, d.Id()).Extract()
@@ -12,6 +12,8 @@ import (
 // RecordSetCreateOpts represents the attributes used when creating a new DNS record set.
 type RecordSetCreateOpts struct {
 	recordsets.CreateOpts
+	Line       string            `json:"line,omitempty"`
+	Weight     *int              `json:"weight,omitempty"`
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
 
@@ -30,6 +32,31 @@ func (opts RecordSetCreateOpts) ToRecordSetCreateMap() (map[string]interface{},
 	return nil, fmt.Errorf("Expected map but got %T", b[""])
 }
 
+// RecordSetUpdateOpts represents the attributes used when updating a DNS record set using v2.1 API.
+type RecordSetUpdateOpts struct {
+	recordsets.UpdateOpts
+	Weight *int `json:"weight,omitempty"`
+}
+
+// ToRecordSetUpdateMap casts an UpdateOpts struct to a map.
+// It overrides recordsets.ToRecordSetUpdateMap to add the Weight field.
+func (opts RecordSetUpdateOpts) ToRecordSetUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToRecordSetUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Weight != nil {
+		b["weight"] = *opts.Weight
+	}
+	return b, nil
+}
+
+// recordSetLine contains line-based resolution attributes of the record set returned by v2.1 API
+type recordSetLine struct {
+	Line   string `json:"line"`
+	Weight *int   `json:"weight"`
+}
+
 // ZoneCreateOpts represents the attributes used when creating a new DNS zone.
 type ZoneCreateOpts struct {
 	zones.CreateOpts
@@ -0,0 +1,5 @@
+package dws
+
+const (
+	dwsClientError = "error creating OpenTelekomCloud DWS client: %w"
+)
@@ -0,0 +1,335 @@
+package dws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceDwsLogicalClusterV2 manages the logical cluster of the DWS cluster
+func ResourceDwsLogicalClusterV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsLogicalClusterV2Create,
+		ReadContext:   resourceDwsLogicalClusterV2Read,
+		UpdateContext: resourceDwsLogicalClusterV2Update,
+		DeleteContext: resourceDwsLogicalClusterV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDwsLogicalClusterV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_ring": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ring_host": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"back_ip": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"cpu_cores": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"memory": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+									"disk_size": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_logical_cluster": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type dwsRingHost struct {
+	HostName string  `json:"host_name"`
+	BackIP   string  `json:"back_ip"`
+	CPUCores int     `json:"cpu_cores"`
+	Memory   float64 `json:"memory"`
+	DiskSize float64 `json:"disk_size"`
+}
+
+type dwsClusterRing struct {
+	RingHosts []dwsRingHost `json:"ring_hosts"`
+}
+
+type dwsLogicalCluster struct {
+	ID                  string           `json:"logical_cluster_id"`
+	Name                string           `json:"logical_cluster_name"`
+	Status              string           `json:"status"`
+	FirstLogicalCluster bool             `json:"first_logical_cluster"`
+	ClusterRings        []dwsClusterRing `json:"cluster_rings"`
+}
+
+func expandDwsClusterRings(d *schema.ResourceData) []dwsClusterRing {
+	var rings []dwsClusterRing
+	for _, v := range d.Get("cluster_ring").([]interface{}) {
+		ringRaw := v.(map[string]interface{})
+		ring := dwsClusterRing{}
+		for _, h := range ringRaw["ring_host"].([]interface{}) {
+			host := h.(map[string]interface{})
+			ring.RingHosts = append(ring.RingHosts, dwsRingHost{
+				HostName: host["host_name"].(string),
+				BackIP:   host["back_ip"].(string),
+				CPUCores: host["cpu_cores"].(int),
+				Memory:   host["memory"].(float64),
+				DiskSize: host["disk_size"].(float64),
+			})
+		}
+		rings = append(rings, ring)
+	}
+	return rings
+}
+
+func flattenDwsClusterRings(rings []dwsClusterRing) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, ring := range rings {
+		var hosts []map[string]interface{}
+		for _, host := range ring.RingHosts {
+			hosts = append(hosts, map[string]interface{}{
+				"host_name": host.HostName,
+				"back_ip":   host.BackIP,
+				"cpu_cores": host.CPUCores,
+				"memory":    host.Memory,
+				"disk_size": host.DiskSize,
+			})
+		}
+		result = append(result, map[string]interface{}{
+			"ring_host": hosts,
+		})
+	}
+	return result
+}
+
+func getDwsLogicalCluster(client *golangsdk.ServiceClient, clusterID, id string) (*dwsLogicalCluster, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("clusters", clusterID, "logical-clusters"), &r.Body, nil)
+	var clusters []dwsLogicalCluster
+	if err := r.ExtractIntoSlicePtr(&clusters, "logical_clusters"); err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		if cluster.ID == id || cluster.Name == id {
+			return &cluster, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func waitForDwsLogicalCluster(ctx context.Context, client *golangsdk.ServiceClient, clusterID, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Creating", "Editing", "Restarting"},
+		Target:  []string{"Normal"},
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := getDwsLogicalCluster(client, clusterID, id)
+			if err != nil {
+				return nil, "", err
+			}
+			return cluster, cluster.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDwsLogicalClusterV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	body := map[string]interface{}{
+		"logical_cluster_name": name,
+		"cluster_rings":        expandDwsClusterRings(d),
+	}
+	log.Printf("[DEBUG] Creating logical cluster of DWS cluster %s: %#v", clusterID, body)
+	_, err = client.Post(client.ServiceURL("clusters", clusterID, "logical-clusters"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DWS logical cluster: %w", err)
+	}
+
+	// the logical cluster ID is not returned on creation
+	if err := waitForDwsLogicalCluster(ctx, client, clusterID, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for DWS logical cluster %s to become ready: %w", name, err)
+	}
+	cluster, err := getDwsLogicalCluster(client, clusterID, name)
+	if err != nil {
+		return fmterr.Errorf("error retrieving DWS logical cluster %s: %w", name, err)
+	}
+	d.SetId(cluster.ID)
+
+	return resourceDwsLogicalClusterV2Read(ctx, d, meta)
+}
+
+func resourceDwsLogicalClusterV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	cluster, err := getDwsLogicalCluster(client, d.Get("cluster_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DWS logical cluster"))
+	}
+	log.Printf("[DEBUG] Retrieved DWS logical cluster %s: %#v", d.Id(), cluster)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", cluster.Name),
+		d.Set("cluster_ring", flattenDwsClusterRings(cluster.ClusterRings)),
+		d.Set("status", cluster.Status),
+		d.Set("first_logical_cluster", cluster.FirstLogicalCluster),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DWS logical cluster fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDwsLogicalClusterV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if d.HasChange("cluster_ring") {
+		body := map[string]interface{}{
+			"cluster_rings": expandDwsClusterRings(d),
+		}
+		log.Printf("[DEBUG] Updating DWS logical cluster %s: %#v", d.Id(), body)
+		_, err = client.Put(client.ServiceURL("clusters", clusterID, "logical-clusters", d.Id()), body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating DWS logical cluster %s: %w", d.Id(), err)
+		}
+		if err := waitForDwsLogicalCluster(ctx, client, clusterID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for DWS logical cluster %s to be updated: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDwsLogicalClusterV2Read(ctx, d, meta)
+}
+
+func resourceDwsLogicalClusterV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	_, err = client.Delete(client.ServiceURL("clusters", clusterID, "logical-clusters", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DWS logical cluster"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting", "Normal"},
+		Target:  []string{"Deleted"},
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := getDwsLogicalCluster(client, clusterID, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return cluster, "Deleted", nil
+				}
+				return nil, "", err
+			}
+			return cluster, cluster.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for DWS logical cluster %s to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDwsLogicalClusterV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for DWS logical cluster, must be <cluster_id>/<logical_cluster_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("cluster_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("error setting cluster_id: %w", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
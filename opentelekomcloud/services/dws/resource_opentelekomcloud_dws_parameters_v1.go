@@ -0,0 +1,215 @@
+package dws
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceDwsParametersV1 manages the values of the parameter group assigned to the DWS cluster
+func ResourceDwsParametersV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsParametersV1Put,
+		ReadContext:   resourceDwsParametersV1Read,
+		UpdateContext: resourceDwsParametersV1Put,
+		DeleteContext: resourceDwsParametersV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"cn", "dn"}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"restart_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type dwsParameterValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type dwsParameter struct {
+	Name            string              `json:"name"`
+	Values          []dwsParameterValue `json:"values"`
+	RestartRequired bool                `json:"restart_required"`
+}
+
+// getDwsConfigurationID returns the ID of the parameter group assigned to the cluster
+func getDwsConfigurationID(client *golangsdk.ServiceClient, clusterID string) (string, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("clusters", clusterID, "configurations"), &r.Body, nil)
+	var configurations []struct {
+		ID string `json:"id"`
+	}
+	if err := r.ExtractIntoSlicePtr(&configurations, "configurations"); err != nil {
+		return "", err
+	}
+	if len(configurations) == 0 {
+		return "", golangsdk.ErrDefault404{}
+	}
+	return configurations[0].ID, nil
+}
+
+func listDwsParameters(client *golangsdk.ServiceClient, clusterID, configurationID string) ([]dwsParameter, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("clusters", clusterID, "configurations", configurationID), &r.Body, nil)
+	var parameters []dwsParameter
+	if err := r.ExtractIntoSlicePtr(&parameters, "configurations"); err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
+func resourceDwsParametersV1Put(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	configurationID, err := getDwsConfigurationID(client, clusterID)
+	if err != nil {
+		return fmterr.Errorf("error retrieving parameter group of DWS cluster %s: %w", clusterID, err)
+	}
+
+	if d.HasChange("parameter") {
+		oldRaw, _ := d.GetChange("parameter")
+		for _, v := range oldRaw.(*schema.Set).Difference(d.Get("parameter").(*schema.Set)).List() {
+			parameter := v.(map[string]interface{})
+			log.Printf("[WARN] Parameter %s (%s) of DWS cluster %s is no longer managed and keeps its value",
+				parameter["name"], parameter["type"], clusterID)
+		}
+	}
+
+	var parameters []map[string]interface{}
+	for _, v := range d.Get("parameter").(*schema.Set).List() {
+		parameter := v.(map[string]interface{})
+		parameters = append(parameters, map[string]interface{}{
+			"name":  parameter["name"].(string),
+			"type":  parameter["type"].(string),
+			"value": parameter["value"].(string),
+		})
+	}
+	log.Printf("[DEBUG] Setting parameters of DWS cluster %s: %#v", clusterID, parameters)
+	_, err = client.Put(client.ServiceURL("clusters", clusterID, "configurations", configurationID),
+		map[string]interface{}{"configurations": parameters}, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+	if err != nil {
+		return fmterr.Errorf("error setting parameters of DWS cluster %s: %w", clusterID, err)
+	}
+	d.SetId(clusterID)
+
+	return resourceDwsParametersV1Read(ctx, d, meta)
+}
+
+func resourceDwsParametersV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	configurationID, err := getDwsConfigurationID(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DWS parameter group"))
+	}
+	parameters, err := listDwsParameters(client, d.Id(), configurationID)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DWS parameter group"))
+	}
+
+	current := make(map[string]dwsParameter)
+	for _, parameter := range parameters {
+		current[parameter.Name] = parameter
+	}
+
+	// only the managed parameters are read, the group contains hundreds of them
+	var managed []map[string]interface{}
+	restartRequired := false
+	for _, v := range d.Get("parameter").(*schema.Set).List() {
+		parameter := v.(map[string]interface{})
+		name := parameter["name"].(string)
+		found, ok := current[name]
+		if !ok {
+			continue
+		}
+		for _, value := range found.Values {
+			if value.Type != parameter["type"].(string) {
+				continue
+			}
+			managed = append(managed, map[string]interface{}{
+				"name":  name,
+				"type":  value.Type,
+				"value": value.Value,
+			})
+			restartRequired = restartRequired || found.RestartRequired
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("cluster_id", d.Id()),
+		d.Set("parameter", managed),
+		d.Set("configuration_id", configurationID),
+		d.Set("restart_required", restartRequired),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DWS parameters fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDwsParametersV1Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// the API has no way to reset the parameters to the defaults
+	log.Printf("[WARN] Parameters of DWS cluster %s keep their values after removal from the state", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,205 @@
+package dws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceDwsSnapshotPolicyV1 manages the automated snapshot policy of the DWS cluster
+func ResourceDwsSnapshotPolicyV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsSnapshotPolicyV1Put,
+		ReadContext:   resourceDwsSnapshotPolicyV1Read,
+		UpdateContext: resourceDwsSnapshotPolicyV1Put,
+		DeleteContext: resourceDwsSnapshotPolicyV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDwsSnapshotPolicyV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keep_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 31),
+			},
+			"strategy": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "full",
+							ValidateFunc: validation.StringInSlice([]string{"full", "increment"}, false),
+						},
+						"schedule": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_fire_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type dwsBackupStrategy struct {
+	PolicyID       string `json:"policy_id,omitempty"`
+	PolicyName     string `json:"policy_name"`
+	PolicyType     string `json:"policy_type"`
+	BackupStrategy string `json:"backup_strategy"`
+	BackupLevel    string `json:"backup_level"`
+	NextFireTime   string `json:"next_fire_time,omitempty"`
+}
+
+type dwsSnapshotPolicy struct {
+	KeepDay          int                 `json:"keep_day"`
+	BackupStrategies []dwsBackupStrategy `json:"backup_strategies"`
+}
+
+func getDwsSnapshotPolicy(client *golangsdk.ServiceClient, clusterID string) (*dwsSnapshotPolicy, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("clusters", clusterID, "snapshot-policies"), &r.Body, nil)
+	var policy dwsSnapshotPolicy
+	if err := r.ExtractInto(&policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func resourceDwsSnapshotPolicyV1Put(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	policy := dwsSnapshotPolicy{
+		KeepDay: d.Get("keep_days").(int),
+	}
+	for _, v := range d.Get("strategy").([]interface{}) {
+		raw := v.(map[string]interface{})
+		policy.BackupStrategies = append(policy.BackupStrategies, dwsBackupStrategy{
+			PolicyID:       raw["id"].(string),
+			PolicyName:     raw["name"].(string),
+			PolicyType:     raw["type"].(string),
+			BackupStrategy: raw["schedule"].(string),
+			BackupLevel:    "cluster",
+		})
+	}
+
+	log.Printf("[DEBUG] Setting snapshot policy of DWS cluster %s: %#v", clusterID, policy)
+	_, err = client.Put(client.ServiceURL("clusters", clusterID, "snapshot-policies"), policy, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return fmterr.Errorf("error setting snapshot policy of DWS cluster %s: %w", clusterID, err)
+	}
+	d.SetId(clusterID)
+
+	return resourceDwsSnapshotPolicyV1Read(ctx, d, meta)
+}
+
+func resourceDwsSnapshotPolicyV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	policy, err := getDwsSnapshotPolicy(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DWS snapshot policy"))
+	}
+	log.Printf("[DEBUG] Retrieved snapshot policy of DWS cluster %s: %#v", d.Id(), policy)
+
+	var strategies []map[string]interface{}
+	for _, strategy := range policy.BackupStrategies {
+		strategies = append(strategies, map[string]interface{}{
+			"id":             strategy.PolicyID,
+			"name":           strategy.PolicyName,
+			"type":           strategy.PolicyType,
+			"schedule":       strategy.BackupStrategy,
+			"next_fire_time": strategy.NextFireTime,
+		})
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("cluster_id", d.Id()),
+		d.Set("keep_days", policy.KeepDay),
+		d.Set("strategy", strategies),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DWS snapshot policy fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDwsSnapshotPolicyV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	policy, err := getDwsSnapshotPolicy(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DWS snapshot policy"))
+	}
+	for _, strategy := range policy.BackupStrategies {
+		_, err := client.Delete(client.ServiceURL("clusters", d.Id(), "snapshot-policies", strategy.PolicyID), &golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+		if err != nil {
+			return fmterr.Errorf("error deleting snapshot strategy %s of DWS cluster %s: %w", strategy.PolicyName, d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDwsSnapshotPolicyV1Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("cluster_id", d.Id()); err != nil {
+		return nil, fmt.Errorf("error setting cluster_id: %w", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
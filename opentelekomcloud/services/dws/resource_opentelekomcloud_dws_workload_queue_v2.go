@@ -0,0 +1,212 @@
+package dws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceDwsWorkloadQueueV2 manages the workload queue (resource pool) of the DWS cluster
+func ResourceDwsWorkloadQueueV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsWorkloadQueueV2Create,
+		ReadContext:   resourceDwsWorkloadQueueV2Read,
+		DeleteContext: resourceDwsWorkloadQueueV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDwsWorkloadQueueV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 28),
+			},
+			"logical_cluster_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cpu_share": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 99),
+			},
+			"memory": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"tablespace": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"active_statements": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntBetween(-1, 2147483647),
+			},
+		},
+	}
+}
+
+type dwsWorkloadResource struct {
+	Name  string `json:"resource_name"`
+	Value int    `json:"resource_value"`
+}
+
+type dwsWorkloadQueue struct {
+	Name               string                `json:"workload_queue_name"`
+	LogicalClusterName string                `json:"logical_cluster_name,omitempty"`
+	Resources          []dwsWorkloadResource `json:"workload_resource_item_list"`
+}
+
+// dwsWorkloadResourceNames maps the resource names of the API to the resource arguments
+var dwsWorkloadResourceNames = map[string]string{
+	"cpu_share":        "cpu_share",
+	"memory":           "memory",
+	"tablespace":       "tablespace",
+	"activestatements": "active_statements",
+}
+
+func getDwsWorkloadQueue(client *golangsdk.ServiceClient, clusterID, name string) (*dwsWorkloadQueue, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("clusters", clusterID, "workload", "queues"), &r.Body, nil)
+	var queues []dwsWorkloadQueue
+	if err := r.ExtractIntoSlicePtr(&queues, "workload_queue_list"); err != nil {
+		return nil, err
+	}
+	for _, queue := range queues {
+		if queue.Name == name {
+			return &queue, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceDwsWorkloadQueueV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	queue := dwsWorkloadQueue{
+		Name:               d.Get("name").(string),
+		LogicalClusterName: d.Get("logical_cluster_name").(string),
+	}
+	for apiName, argument := range dwsWorkloadResourceNames {
+		queue.Resources = append(queue.Resources, dwsWorkloadResource{
+			Name:  apiName,
+			Value: d.Get(argument).(int),
+		})
+	}
+	log.Printf("[DEBUG] Creating workload queue of DWS cluster %s: %#v", clusterID, queue)
+	_, err = client.Post(client.ServiceURL("clusters", clusterID, "workload", "queues"), map[string]interface{}{"workload_queue": queue}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating DWS workload queue: %w", err)
+	}
+	d.SetId(queue.Name)
+
+	return resourceDwsWorkloadQueueV2Read(ctx, d, meta)
+}
+
+func resourceDwsWorkloadQueueV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	queue, err := getDwsWorkloadQueue(client, d.Get("cluster_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DWS workload queue"))
+	}
+	log.Printf("[DEBUG] Retrieved DWS workload queue %s: %#v", d.Id(), queue)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", queue.Name),
+		d.Set("logical_cluster_name", queue.LogicalClusterName),
+	)
+	for _, res := range queue.Resources {
+		if argument, ok := dwsWorkloadResourceNames[res.Name]; ok {
+			mErr = multierror.Append(mErr, d.Set(argument, res.Value))
+		}
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting DWS workload queue fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDwsWorkloadQueueV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.DwsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(dwsClientError, err)
+	}
+
+	query := url.Values{"workload_queue_name": []string{d.Id()}}
+	if v := d.Get("logical_cluster_name").(string); v != "" {
+		query.Set("logical_cluster_name", v)
+	}
+	deleteURL := client.ServiceURL("clusters", d.Get("cluster_id").(string), "workload", "queues") + "?" + query.Encode()
+	_, err = client.Delete(deleteURL, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "DWS workload queue"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDwsWorkloadQueueV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for DWS workload queue, must be <cluster_id>/<queue_name>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("cluster_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("error setting cluster_id: %w", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
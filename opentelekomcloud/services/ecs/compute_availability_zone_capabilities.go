@@ -0,0 +1,146 @@
+package ecs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/datastores"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/flavors"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// Volume type extra specs listing AZs where the volume type is available or sold out
+const (
+	specVolumeTypeAZs        = "RESKEY:availability_zones"
+	specVolumeTypeSoldOutAZs = "os-vendor-extended:sold_out_availability_zones"
+)
+
+// rdsEngines are the RDS engines checked for availability
+var rdsEngines = []string{"MySQL", "PostgreSQL", "SQLServer"}
+
+type zoneCapabilities struct {
+	FlavorGenerations []string
+	VolumeTypes       []string
+	RdsEngines        []string
+}
+
+// sortedKeys returns the sorted keys of the set
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// splitSpecList splits comma-separated spec value
+func splitSpecList(value string) map[string]bool {
+	result := make(map[string]bool)
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result[v] = true
+		}
+	}
+	return result
+}
+
+// getZoneCapabilities returns the ECS flavor generations, EVS volume types and RDS engines available in every zone
+func getZoneCapabilities(config *cfg.Config, region string, zones []string) (map[string]*zoneCapabilities, error) {
+	result := make(map[string]*zoneCapabilities, len(zones))
+
+	computeClient, err := config.ComputeV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+	}
+	for _, zone := range zones {
+		zoneFlavors, err := listEcsFlavors(config.CachedClient(computeClient), zone)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving ECS flavors of %s: %w", zone, err)
+		}
+		generations := make(map[string]bool)
+		for _, flavor := range zoneFlavors {
+			if isFlavorAvailable(flavor.OsExtraSpecs, zone) {
+				generations[flavorGeneration(flavor.Name)] = true
+			}
+		}
+		result[zone] = &zoneCapabilities{FlavorGenerations: sortedKeys(generations)}
+	}
+
+	blockStorageClient, err := config.BlockStorageV3Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud block storage client: %w", err)
+	}
+	pages, err := volumetypes.List(config.CachedClient(blockStorageClient), nil).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing EVS volume types: %w", err)
+	}
+	types, err := volumetypes.ExtractVolumeTypes(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting EVS volume types: %w", err)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	for _, volumeType := range types {
+		availableAZs := splitSpecList(volumeType.ExtraSpecs[specVolumeTypeAZs])
+		soldOutAZs := splitSpecList(volumeType.ExtraSpecs[specVolumeTypeSoldOutAZs])
+		for _, zone := range zones {
+			if availableAZs[zone] && !soldOutAZs[zone] {
+				result[zone].VolumeTypes = append(result[zone].VolumeTypes, volumeType.Name)
+			}
+		}
+	}
+
+	rdsClient, err := config.RdsV3Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %w", err)
+	}
+	for _, engine := range rdsEngines {
+		pages, err := datastores.List(rdsClient, engine).AllPages()
+		if err != nil {
+			return nil, fmt.Errorf("error listing RDSv3 versions of %s: %w", engine, err)
+		}
+		stores, err := datastores.ExtractDataStores(pages)
+		if err != nil {
+			return nil, fmt.Errorf("error extracting RDSv3 versions of %s: %w", engine, err)
+		}
+		if len(stores.DataStores) == 0 {
+			continue
+		}
+		opts := flavors.DbFlavorsOpts{Versionname: stores.DataStores[0].Name}
+		pages, err = flavors.List(rdsClient, opts, engine).AllPages()
+		if err != nil {
+			return nil, fmt.Errorf("error listing RDSv3 flavors of %s: %w", engine, err)
+		}
+		engineFlavors, err := flavors.ExtractDbFlavors(pages)
+		if err != nil {
+			return nil, fmt.Errorf("error extracting RDSv3 flavors of %s: %w", engine, err)
+		}
+		for _, zone := range zones {
+			for _, flavor := range engineFlavors.Flavorslist {
+				if flavor.Azstatus[zone] == "normal" {
+					result[zone].RdsEngines = append(result[zone].RdsEngines, engine)
+					break
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// hasAll checks that all required values are present in the list
+func hasAll(values []string, required []string) bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	for _, r := range required {
+		if !set[r] {
+			return false
+		}
+	}
+	return true
+}
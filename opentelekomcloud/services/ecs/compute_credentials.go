@@ -0,0 +1,146 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// resetEcsPassword resets the password of the administrator (`root` or `Administrator`) of the instance.
+// The password reset plug-in has to be installed in the image of the instance.
+// The password can't be reset to an empty value, so removing it from the configuration is a no-op.
+func resetEcsPassword(config *cfg.Config, d *schema.ResourceData) error {
+	client, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf(errCreateClient, err)
+	}
+
+	password := d.Get("password").(string)
+	if password == "" {
+		log.Printf("[DEBUG] Password of CloudServer %s is removed from the configuration, skipping reset", d.Id())
+		return nil
+	}
+
+	body := map[string]interface{}{
+		"reset-password": map[string]string{
+			"new_password": password,
+		},
+	}
+	log.Printf("[DEBUG] Resetting password of CloudServer %s", d.Id())
+	_, err = client.Put(client.ServiceURL("cloudservers", d.Id(), "os-reset-password"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return fmt.Errorf("error resetting password of CloudServer %s: %w", d.Id(), err)
+	}
+	return nil
+}
+
+// replaceEcsKeypair binds the new SSH key pair to the instance using Key Pair Service.
+// KPS can replace the key pair bound to the instance only while the instance is stopped,
+// so the running instance is stopped before the replacement and started again after it.
+func replaceEcsKeypair(ctx context.Context, config *cfg.Config, d *schema.ResourceData) error {
+	oldKeyName, _ := d.GetChange("key_name")
+	if oldKeyName.(string) == "" {
+		return changeEcsKeypair(ctx, config, d)
+	}
+
+	client, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+	computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf(errCreateClient, err)
+	}
+	server, err := servers.Get(client, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("error fetching CloudServer %s: %w", d.Id(), err)
+	}
+	if server.Status != "ACTIVE" {
+		return changeEcsKeypair(ctx, config, d)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	log.Printf("[DEBUG] Stopping CloudServer %s to replace the key pair", d.Id())
+	if err := stopInstance(ctx, client, computeV1Client, d.Id(), 0, timeout); err != nil {
+		return fmt.Errorf("error stopping CloudServer %s to replace the key pair: %w", d.Id(), err)
+	}
+	changeErr := changeEcsKeypair(ctx, config, d)
+
+	log.Printf("[DEBUG] Starting CloudServer %s after replacing the key pair", d.Id())
+	if err := startInstance(ctx, client, d.Id(), timeout); err != nil {
+		return multierror.Append(changeErr, fmt.Errorf("error starting CloudServer %s after replacing the key pair: %w", d.Id(), err))
+	}
+	return changeErr
+}
+
+func changeEcsKeypair(ctx context.Context, config *cfg.Config, d *schema.ResourceData) error {
+	client, err := config.KpsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KPS client: %w", err)
+	}
+
+	keyName := d.Get("key_name").(string)
+	body := map[string]interface{}{
+		"server": map[string]interface{}{
+			"id": d.Id(),
+		},
+	}
+	url := client.ServiceURL("keypairs", "disassociate")
+	if keyName != "" {
+		body["keypair_name"] = keyName
+		url = client.ServiceURL("keypairs", "associate")
+	}
+
+	log.Printf("[DEBUG] Changing key pair of CloudServer %s: %#v", d.Id(), body)
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(url, body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	var task struct {
+		TaskID string `json:"task_id"`
+	}
+	if err := r.ExtractInto(&task); err != nil {
+		return fmt.Errorf("error changing key pair of CloudServer %s: %w", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"READY_RESET", "RUNNING"},
+		Target:     []string{"SUCCESS"},
+		Refresh:    kpsTaskRefreshFunc(client, task.TaskID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for key pair of CloudServer %s to be changed: %w", d.Id(), err)
+	}
+	return nil
+}
+
+func kpsTaskRefreshFunc(client *golangsdk.ServiceClient, taskID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := golangsdk.Result{}
+		_, r.Err = client.Get(client.ServiceURL("tasks", taskID), &r.Body, nil)
+		var task struct {
+			TaskStatus string `json:"task_status"`
+		}
+		if err := r.ExtractInto(&task); err != nil {
+			return nil, "", err
+		}
+		if task.TaskStatus == "FAILED" {
+			return task, task.TaskStatus, fmt.Errorf("KPS task %s failed", taskID)
+		}
+		return task, task.TaskStatus, nil
+	}
+}
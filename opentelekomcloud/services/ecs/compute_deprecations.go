@@ -0,0 +1,42 @@
+package ecs
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/imageservice/v2/images"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// instanceDeprecationWarnings returns warnings for the deprecated flavor or image used by the instance.
+// Failures of the checks are only logged, as they shouldn't break reading the instance.
+func instanceDeprecationWarnings(config *cfg.Config, region, availabilityZone, flavorID, imageID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if flavorID != "" {
+		specs, err := getFlavorSpecs(config, region, availabilityZone, flavorID)
+		if err != nil {
+			log.Printf("[WARN] Unable to check deprecation of the flavor %s: %s", flavorID, err)
+		} else {
+			diags = append(diags, common.FlavorDeprecationWarning(flavorID, specs, availabilityZone)...)
+		}
+	}
+
+	if imageID != "" {
+		client, err := config.ImageV2Client(region)
+		if err != nil {
+			log.Printf("[WARN] Unable to check deprecation of the image %s: %s", imageID, err)
+			return diags
+		}
+		image, err := images.Get(client, imageID).Extract()
+		if err != nil {
+			log.Printf("[WARN] Unable to check deprecation of the image %s: %s", imageID, err)
+			return diags
+		}
+		diags = append(diags, common.ImageDeprecationWarning(image.Name, string(image.Status), image.Properties)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,130 @@
+package ecs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// Flavor extra specs used to describe virtualization and GPU capabilities
+const (
+	specHypervisorType     = "capabilities:hypervisor_type"
+	specVirtualizationEnv  = "ecs:virtualization_env_types"
+	specPerformanceType    = "ecs:performancetype"
+	specInstanceArch       = "ecs:instance_architecture"
+	specGpuEnabled         = "pci_passthrough:enable_gpu"
+	specGpuAlias           = "pci_passthrough:alias"
+	specGpuSpecs           = "pci_passthrough:gpu_specs"
+	specGpuVirtualizedType = "ecs:gpu_virtualization_type"
+)
+
+type ecsFlavor struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Vcpus        string            `json:"vcpus"`
+	RAM          int               `json:"ram"`
+	Disk         string            `json:"disk"`
+	OsExtraSpecs map[string]string `json:"os_extra_specs"`
+}
+
+// listEcsFlavors returns ECS flavors with extra specs, which are not returned by `compute/v2/flavors`
+func listEcsFlavors(client *golangsdk.ServiceClient, availabilityZone string) ([]ecsFlavor, error) {
+	url := client.ServiceURL("cloudservers", "flavors")
+	if availabilityZone != "" {
+		url += "?availability_zone=" + availabilityZone
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	var flavors []ecsFlavor
+	if err := r.ExtractIntoSlicePtr(&flavors, "flavors"); err != nil {
+		return nil, err
+	}
+	return flavors, nil
+}
+
+// getFlavorSpecs returns extra specs of the ECS flavor
+func getFlavorSpecs(config *cfg.Config, region, availabilityZone, flavorID string) (map[string]string, error) {
+	client, err := config.ComputeV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+	}
+	flavors, err := listEcsFlavors(client, availabilityZone)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving ECS flavors: %w", err)
+	}
+	for _, flavor := range flavors {
+		if flavor.ID == flavorID {
+			return flavor.OsExtraSpecs, nil
+		}
+	}
+	return nil, fmt.Errorf("ECS flavor %s is not found in %s", flavorID, availabilityZone)
+}
+
+// flavorCapabilitiesSchema returns computed attributes describing flavor capabilities
+func flavorCapabilitiesSchema() map[string]*schema.Schema {
+	computedString := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+	return map[string]*schema.Schema{
+		"hypervisor_type":         computedString(),
+		"virtualization_type":     computedString(),
+		"cpu_architecture":        computedString(),
+		"gpu_model":               computedString(),
+		"gpu_specs":               computedString(),
+		"gpu_virtualization_type": computedString(),
+		"gpu_passthrough": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"gpu_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+// setFlavorCapabilities sets attributes of `flavorCapabilitiesSchema` from the flavor extra specs
+func setFlavorCapabilities(d *schema.ResourceData, specs map[string]string) error {
+	gpuModel, gpuCount := parseGpuAlias(specs[specGpuAlias])
+	values := map[string]interface{}{
+		"hypervisor_type":         specs[specHypervisorType],
+		"virtualization_type":     specs[specVirtualizationEnv],
+		"cpu_architecture":        specs[specInstanceArch],
+		"gpu_model":               gpuModel,
+		"gpu_specs":               specs[specGpuSpecs],
+		"gpu_virtualization_type": specs[specGpuVirtualizedType],
+		"gpu_passthrough":         specs[specGpuEnabled] == "true",
+		"gpu_count":               gpuCount,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseGpuAlias parses PCI passthrough alias in format `<model>:<count>`, e.g. `nvidia-v100:1`
+func parseGpuAlias(alias string) (string, int) {
+	if alias == "" {
+		return "", 0
+	}
+	parts := strings.SplitN(alias, ":", 2)
+	if len(parts) != 2 {
+		return parts[0], 0
+	}
+	count, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return parts[0], 0
+	}
+	return parts[0], count
+}
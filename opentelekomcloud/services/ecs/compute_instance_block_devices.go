@@ -0,0 +1,125 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/blockstorage/v2/volumes"
+	evstags "github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v2/tags"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/evs"
+)
+
+// preparedVolumes contains IDs of the volumes created by prepareBlockDevices
+type preparedVolumes []string
+
+// prepareBlockDevices creates volumes for block devices using `kms_key_id` or `tags`,
+// so they are attached to the instance as existing volumes
+func prepareBlockDevices(ctx context.Context, d *schema.ResourceData, config *cfg.Config, bds []interface{}) ([]interface{}, preparedVolumes, error) {
+	result := make([]interface{}, len(bds))
+	var client *golangsdk.ServiceClient
+	var created preparedVolumes
+
+	for i, bd := range bds {
+		bdM := make(map[string]interface{})
+		for k, v := range bd.(map[string]interface{}) {
+			bdM[k] = v
+		}
+		result[i] = bdM
+
+		kmsKeyID := bdM["kms_key_id"].(string)
+		volumeTags := bdM["tags"].(map[string]interface{})
+		if kmsKeyID == "" && len(volumeTags) == 0 {
+			continue
+		}
+
+		sourceType := bdM["source_type"].(string)
+		if bdM["destination_type"].(string) != "volume" || (sourceType != "blank" && sourceType != "image") {
+			return nil, created, fmt.Errorf("`kms_key_id` and `tags` can be used only for `blank` or `image` " +
+				"block devices with `volume` destination type")
+		}
+
+		if client == nil {
+			c, err := config.BlockStorageV2Client(config.GetRegion(d))
+			if err != nil {
+				return nil, created, fmt.Errorf("error creating OpenTelekomCloud block storage client: %w", err)
+			}
+			client = c
+		}
+
+		metadata := make(map[string]string)
+		if kmsKeyID != "" {
+			metadata["__system__encrypted"] = "1"
+			metadata["__system__cmkid"] = kmsKeyID
+		}
+		createOpts := volumes.CreateOpts{
+			AvailabilityZone: d.Get("availability_zone").(string),
+			Name:             fmt.Sprintf("%s-volume-%d", d.Get("name").(string), i),
+			Size:             bdM["volume_size"].(int),
+			VolumeType:       bdM["volume_type"].(string),
+			Metadata:         metadata,
+		}
+		if sourceType == "image" {
+			createOpts.ImageID = bdM["uuid"].(string)
+		}
+
+		log.Printf("[DEBUG] Creating volume for block device %d: %#v", i, createOpts)
+		v, err := volumes.Create(client, createOpts).Extract()
+		if err != nil {
+			return nil, created, fmt.Errorf("error creating volume for block device %d: %w", i, err)
+		}
+		created = append(created, v.ID)
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"downloading", "creating"},
+			Target:     []string{"available"},
+			Refresh:    evs.VolumeV2StateRefreshFunc(client, v.ID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return nil, created, fmt.Errorf("error waiting for volume (%s) to become ready: %w", v.ID, err)
+		}
+
+		if len(volumeTags) > 0 {
+			tagMap := make(map[string]string, len(volumeTags))
+			for key, val := range volumeTags {
+				tagMap[key] = val.(string)
+			}
+			if _, err := evstags.Create(client, "volumes", v.ID, evstags.CreateOpts{Tags: tagMap}).Extract(); err != nil {
+				return nil, created, fmt.Errorf("error creating tags for volume (%s): %w", v.ID, err)
+			}
+		}
+
+		bdM["source_type"] = "volume"
+		bdM["uuid"] = v.ID
+		bdM["volume_size"] = 0
+		bdM["volume_type"] = ""
+	}
+
+	return result, created, nil
+}
+
+// cleanup removes prepared volumes if instance creation failed
+func (p preparedVolumes) cleanup(d *schema.ResourceData, config *cfg.Config) {
+	if len(p) == 0 {
+		return
+	}
+	client, err := config.BlockStorageV2Client(config.GetRegion(d))
+	if err != nil {
+		log.Printf("[WARN] Error creating OpenTelekomCloud block storage client: %s", err)
+		return
+	}
+	for _, id := range p {
+		if err := volumes.Delete(client, id, volumes.DeleteOpts{}).ExtractErr(); err != nil {
+			log.Printf("[WARN] Error deleting prepared volume %s: %s", id, err)
+		}
+	}
+}
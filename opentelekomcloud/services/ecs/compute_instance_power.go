@@ -0,0 +1,96 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/startstop"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+)
+
+func waitForInstanceStatus(ctx context.Context, client *golangsdk.ServiceClient, id, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Refresh:    ServerV2StateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// forceStopInstance cuts the power of the instance, which is possible only using ECS API
+func forceStopInstance(computeV1Client *golangsdk.ServiceClient, id string) error {
+	body := map[string]interface{}{
+		"os-stop": map[string]interface{}{
+			"type":    "HARD",
+			"servers": []map[string]string{{"id": id}},
+		},
+	}
+	_, err := computeV1Client.Post(computeV1Client.ServiceURL("cloudservers", "action"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// stopInstance shuts the instance down gracefully. If the instance is still running after
+// `shutdownTimeout`, it is stopped forcibly. Zero `shutdownTimeout` means no forced stop.
+func stopInstance(ctx context.Context, client, computeV1Client *golangsdk.ServiceClient, id string, shutdownTimeout, timeout time.Duration) error {
+	if err := startstop.Stop(client, id).ExtractErr(); err != nil {
+		return fmt.Errorf("error stopping instance: %w", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to stop", id)
+	if shutdownTimeout == 0 || shutdownTimeout >= timeout {
+		return waitForInstanceStatus(ctx, client, id, "SHUTOFF", timeout)
+	}
+
+	err := waitForInstanceStatus(ctx, client, id, "SHUTOFF", shutdownTimeout)
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*resource.TimeoutError); !ok {
+		return err
+	}
+
+	log.Printf("[WARN] Instance (%s) didn't shut down in %s, stopping it forcibly", id, shutdownTimeout)
+	if err := forceStopInstance(computeV1Client, id); err != nil {
+		return fmt.Errorf("error stopping instance forcibly: %w", err)
+	}
+	return waitForInstanceStatus(ctx, client, id, "SHUTOFF", timeout-shutdownTimeout)
+}
+
+func startInstance(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	if err := startstop.Start(client, id).ExtractErr(); err != nil {
+		return fmt.Errorf("error starting instance: %w", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to start", id)
+	return waitForInstanceStatus(ctx, client, id, "ACTIVE", timeout)
+}
+
+func rebootInstance(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	opts := servers.RebootOpts{Type: servers.SoftReboot}
+	if err := servers.Reboot(client, id, opts).ExtractErr(); err != nil {
+		return fmt.Errorf("error rebooting instance: %w", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to reboot", id)
+	// the status is changed to REBOOT asynchronously
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"REBOOT", "HARD_REBOOT"},
+		Refresh:    ServerV2StateRefreshFunc(client, id),
+		Timeout:    time.Minute,
+		MinTimeout: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		log.Printf("[DEBUG] Reboot of instance (%s) was not observed: %s", id, err)
+	}
+	return waitForInstanceStatus(ctx, client, id, "ACTIVE", timeout)
+}
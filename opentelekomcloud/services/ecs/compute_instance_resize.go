@@ -0,0 +1,89 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+)
+
+const (
+	// resizePolicyLive resizes the instance in its current state, running instances are
+	// restarted by the platform
+	resizePolicyLive = "live"
+	// resizePolicyStopStart stops the running instance before the resize and starts it after
+	resizePolicyStopStart = "stop_start"
+)
+
+func resizePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  resizePolicyLive,
+		ValidateFunc: validation.StringInSlice([]string{
+			resizePolicyLive, resizePolicyStopStart,
+		}, false),
+	}
+}
+
+type resizeOpts struct {
+	FlavorID string
+	Policy   string
+	// ShutdownTimeout is passed to stopInstance when the instance is stopped for the resize
+	ShutdownTimeout time.Duration
+	Timeout         time.Duration
+}
+
+// resizeInstance changes the flavor of the instance, confirms the resize and waits for the
+// instance to return to the status it had before. With `stop_start` policy a running
+// instance is stopped before the resize and started again after it is confirmed.
+func resizeInstance(ctx context.Context, client, computeV1Client *golangsdk.ServiceClient, id string, opts resizeOpts) error {
+	server, err := servers.Get(client, id).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving instance: %w", err)
+	}
+
+	targetStatus := server.Status
+	restart := false
+	if opts.Policy == resizePolicyStopStart && server.Status == "ACTIVE" {
+		log.Printf("[DEBUG] Stopping instance (%s) before resize", id)
+		if err := stopInstance(ctx, client, computeV1Client, id, opts.ShutdownTimeout, opts.Timeout); err != nil {
+			return fmt.Errorf("error stopping instance before resize: %w", err)
+		}
+		targetStatus = "SHUTOFF"
+		restart = true
+	}
+
+	resizeOpts := &servers.ResizeOpts{
+		FlavorRef: opts.FlavorID,
+	}
+	log.Printf("[DEBUG] Resize configuration: %#v", resizeOpts)
+	if err := servers.Resize(client, id, resizeOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("error resizing instance: %w", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to finish resizing", id)
+	if err := waitForInstanceStatus(ctx, client, id, "VERIFY_RESIZE", opts.Timeout); err != nil {
+		return fmt.Errorf("error waiting for instance to resize: %w", err)
+	}
+
+	log.Printf("[DEBUG] Confirming resize of instance (%s)", id)
+	if err := servers.ConfirmResize(client, id).ExtractErr(); err != nil {
+		return fmt.Errorf("error confirming resize: %w", err)
+	}
+	if err := waitForInstanceStatus(ctx, client, id, targetStatus, opts.Timeout); err != nil {
+		return fmt.Errorf("error waiting for instance to confirm resize: %w", err)
+	}
+
+	if restart {
+		if err := startInstance(ctx, client, id, opts.Timeout); err != nil {
+			return fmt.Errorf("error starting instance after resize: %w", err)
+		}
+	}
+	return nil
+}
@@ -508,3 +508,94 @@ func GetInstanceAccessAddresses(_ *schema.ResourceData, networks []map[string]in
 
 	return hostv4, hostv6
 }
+
+// getInstanceNICPorts returns IDs of the instance ports mapped by the NIC MAC address.
+func getInstanceNICPorts(client *golangsdk.ServiceClient, serverID string) (map[string]string, error) {
+	serverNICs, err := servers.GetNICs(client, serverID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving NICs of OpenTelekomCloud server (%s): %w", serverID, err)
+	}
+	nicPorts := make(map[string]string, len(serverNICs))
+	for _, nic := range serverNICs {
+		nicPorts[nic.MACAddress] = nic.PortID
+	}
+	return nicPorts, nil
+}
+
+// flattenInstanceNetworkSecurityGroups adds IDs of the security groups bound
+// to the port of every NIC to the flattened networks. The port is the source
+// of truth here, so out-of-band changes are reported as a drift.
+func flattenInstanceNetworkSecurityGroups(d *schema.ResourceData, meta interface{}, networkList []map[string]interface{}) error {
+	config := meta.(*cfg.Config)
+	computeClient, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	nicPorts, err := getInstanceNICPorts(computeClient, d.Id())
+	if err != nil {
+		return err
+	}
+	for _, network := range networkList {
+		portID, ok := nicPorts[network["mac"].(string)]
+		if !ok {
+			continue
+		}
+		port, err := ports.Get(networkingClient, portID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving port (%s) of OpenTelekomCloud server (%s): %w", portID, d.Id(), err)
+		}
+		network["security_groups"] = port.SecurityGroups
+	}
+	return nil
+}
+
+// updateInstanceNetworkSecurityGroups replaces security groups bound to the
+// NIC ports with the ones set in the `network` blocks, `macs` are MAC addresses
+// of the NICs in the order of the blocks. Networks without `security_groups`
+// configured are left untouched.
+func updateInstanceNetworkSecurityGroups(d *schema.ResourceData, meta interface{}, macs []string, onlyChanged bool) error {
+	config := meta.(*cfg.Config)
+	computeClient, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	var nicPorts map[string]string
+	for i, mac := range macs {
+		key := fmt.Sprintf("network.%d.security_groups", i)
+		if onlyChanged && !d.HasChange(key) {
+			continue
+		}
+		securityGroups := common.ExpandToStringSlice(d.Get(key).(*schema.Set).List())
+		if len(securityGroups) == 0 {
+			continue
+		}
+
+		if nicPorts == nil {
+			nicPorts, err = getInstanceNICPorts(computeClient, d.Id())
+			if err != nil {
+				return err
+			}
+		}
+		portID, ok := nicPorts[mac]
+		if !ok {
+			return fmt.Errorf("unable to find port of the OpenTelekomCloud server (%s) NIC #%d (%s)", d.Id(), i, mac)
+		}
+
+		log.Printf("[DEBUG] Setting security groups of port (%s): %v", portID, securityGroups)
+		updateOpts := ports.UpdateOpts{SecurityGroups: &securityGroups}
+		if _, err := ports.Update(networkingClient, portID, updateOpts).Extract(); err != nil {
+			return fmt.Errorf("error updating security groups of port (%s): %w", portID, err)
+		}
+	}
+	return nil
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"sort"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -38,6 +40,57 @@ func DataSourceComputeAvailabilityZonesV2() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"available", "unavailable"}, true),
 			},
+
+			"with_capabilities": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"flavor_generations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"volume_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"rds_engines": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor_generations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"volume_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"rds_engines": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -50,7 +103,7 @@ func dataSourceComputeAvailabilityZonesV2Read(_ context.Context, d *schema.Resou
 		return fmterr.Errorf("error creating OpenStack compute client: %s", err)
 	}
 
-	allPages, err := availabilityzones.List(computeClient).AllPages()
+	allPages, err := availabilityzones.List(config.CachedClient(computeClient)).AllPages()
 	if err != nil {
 		return fmterr.Errorf("error retrieving openstack_compute_availability_zones_v2: %s", err)
 	}
@@ -70,9 +123,42 @@ func dataSourceComputeAvailabilityZonesV2Read(_ context.Context, d *schema.Resou
 	// sort.Strings sorts in place, returns nothing
 	sort.Strings(zones)
 
+	flavorGenerations := common.ExpandToStringSlice(d.Get("flavor_generations").([]interface{}))
+	volumeTypes := common.ExpandToStringSlice(d.Get("volume_types").([]interface{}))
+	engines := common.ExpandToStringSlice(d.Get("rds_engines").([]interface{}))
+
+	var zonesInfo []map[string]interface{}
+	if d.Get("with_capabilities").(bool) || len(flavorGenerations)+len(volumeTypes)+len(engines) > 0 {
+		capabilities, err := getZoneCapabilities(config, region, zones)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		var filtered []string
+		for _, zone := range zones {
+			c := capabilities[zone]
+			if !hasAll(c.FlavorGenerations, flavorGenerations) || !hasAll(c.VolumeTypes, volumeTypes) || !hasAll(c.RdsEngines, engines) {
+				continue
+			}
+			filtered = append(filtered, zone)
+			zonesInfo = append(zonesInfo, map[string]interface{}{
+				"name":               zone,
+				"flavor_generations": c.FlavorGenerations,
+				"volume_types":       c.VolumeTypes,
+				"rds_engines":        c.RdsEngines,
+			})
+		}
+		zones = filtered
+	}
+
 	d.SetId(hashcode.Strings(zones))
-	d.Set("names", zones)
-	d.Set("region", region)
+	mErr := multierror.Append(
+		d.Set("names", zones),
+		d.Set("zones", zonesInfo),
+		d.Set("region", region),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting availability zones fields: %w", err)
+	}
 
 	return nil
 }
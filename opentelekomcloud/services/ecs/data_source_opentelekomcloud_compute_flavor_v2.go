@@ -0,0 +1,128 @@
+package ecs
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceComputeFlavorV2() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"availability_zone": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"vcpus": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"ram": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"performance_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"extra_specs": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	for k, v := range flavorCapabilitiesSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceComputeFlavorV2Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceComputeFlavorV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+	}
+
+	flavors, err := listEcsFlavors(config.CachedClient(client), d.Get("availability_zone").(string))
+	if err != nil {
+		return fmterr.Errorf("error retrieving ECS flavors: %w", err)
+	}
+
+	name := d.Get("name").(string)
+	vcpus := d.Get("vcpus").(int)
+	ram := d.Get("ram").(int)
+	performanceType := d.Get("performance_type").(string)
+
+	var found []ecsFlavor
+	for _, flavor := range flavors {
+		if name != "" && flavor.Name != name {
+			continue
+		}
+		if vcpus != 0 && flavor.Vcpus != strconv.Itoa(vcpus) {
+			continue
+		}
+		if ram != 0 && flavor.RAM != ram {
+			continue
+		}
+		if performanceType != "" && flavor.OsExtraSpecs[specPerformanceType] != performanceType {
+			continue
+		}
+		found = append(found, flavor)
+	}
+
+	if len(found) < 1 {
+		return fmterr.Errorf("your query returned no results, please change your search criteria and try again")
+	}
+	if len(found) > 1 {
+		return fmterr.Errorf("your query returned %d results, please use more specific search criteria", len(found))
+	}
+	flavor := found[0]
+
+	log.Printf("[DEBUG] Retrieved ECS flavor %s: %#v", flavor.ID, flavor)
+	d.SetId(flavor.ID)
+
+	flavorVcpus, err := strconv.Atoi(flavor.Vcpus)
+	if err != nil {
+		return fmterr.Errorf("error parsing vCPUs of the flavor %s: %w", flavor.ID, err)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", flavor.Name),
+		d.Set("vcpus", flavorVcpus),
+		d.Set("ram", flavor.RAM),
+		d.Set("performance_type", flavor.OsExtraSpecs[specPerformanceType]),
+		d.Set("extra_specs", flavor.OsExtraSpecs),
+		setFlavorCapabilities(d, flavor.OsExtraSpecs),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting ECS flavor fields: %w", err)
+	}
+
+	return common.FlavorDeprecationWarning(flavor.Name, flavor.OsExtraSpecs, d.Get("availability_zone").(string))
+}
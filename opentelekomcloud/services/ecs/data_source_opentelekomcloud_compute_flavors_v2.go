@@ -0,0 +1,243 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+const (
+	specStatus   = "cond:operation:status"
+	specAzStatus = "cond:operation:az"
+)
+
+// unavailableFlavorStatuses are the sale statuses of the flavors which can't be used for new instances
+var unavailableFlavorStatuses = []string{"abandon", "sellout", "obt"}
+
+func DataSourceComputeFlavorsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeFlavorsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vcpus": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"min_vcpus", "max_vcpus"},
+			},
+			"min_vcpus": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"max_vcpus": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"ram": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"min_ram", "max_ram"},
+			},
+			"min_ram": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"max_ram": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"generation": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"performance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sort_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "size",
+				ValidateFunc: validation.StringInSlice([]string{"size", "name"}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ram": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"generation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"performance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flavorGeneration returns the generation of the flavor, e.g. `s3` for `s3.medium.1`
+func flavorGeneration(name string) string {
+	return strings.SplitN(name, ".", 2)[0]
+}
+
+// isFlavorAvailable checks the flavor sale status in general and in the given AZ
+func isFlavorAvailable(specs map[string]string, availabilityZone string) bool {
+	for _, status := range unavailableFlavorStatuses {
+		if specs[specStatus] == status {
+			return false
+		}
+		if availabilityZone == "" {
+			continue
+		}
+		for _, azStatus := range strings.Split(specs[specAzStatus], ",") {
+			if strings.TrimSpace(azStatus) == fmt.Sprintf("%s(%s)", availabilityZone, status) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+type sizedFlavor struct {
+	ecsFlavor
+	vcpus int
+}
+
+func dataSourceComputeFlavorsV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+	}
+
+	availabilityZone := d.Get("availability_zone").(string)
+	flavors, err := listEcsFlavors(config.CachedClient(client), availabilityZone)
+	if err != nil {
+		return fmterr.Errorf("error retrieving ECS flavors: %w", err)
+	}
+
+	vcpus := d.Get("vcpus").(int)
+	minVcpus := d.Get("min_vcpus").(int)
+	maxVcpus := d.Get("max_vcpus").(int)
+	ram := d.Get("ram").(int)
+	minRAM := d.Get("min_ram").(int)
+	maxRAM := d.Get("max_ram").(int)
+	generation := d.Get("generation").(string)
+	performanceType := d.Get("performance_type").(string)
+
+	var found []sizedFlavor
+	for _, flavor := range flavors {
+		flavorVcpus, err := strconv.Atoi(flavor.Vcpus)
+		if err != nil {
+			return fmterr.Errorf("error parsing vCPUs of the flavor %s: %w", flavor.ID, err)
+		}
+		switch {
+		case vcpus != 0 && flavorVcpus != vcpus,
+			minVcpus != 0 && flavorVcpus < minVcpus,
+			maxVcpus != 0 && flavorVcpus > maxVcpus,
+			ram != 0 && flavor.RAM != ram,
+			minRAM != 0 && flavor.RAM < minRAM,
+			maxRAM != 0 && flavor.RAM > maxRAM,
+			generation != "" && flavorGeneration(flavor.Name) != generation,
+			performanceType != "" && flavor.OsExtraSpecs[specPerformanceType] != performanceType,
+			!isFlavorAvailable(flavor.OsExtraSpecs, availabilityZone):
+			continue
+		}
+		found = append(found, sizedFlavor{ecsFlavor: flavor, vcpus: flavorVcpus})
+	}
+
+	if len(found) < 1 {
+		return fmterr.Errorf("your query returned no results, please change your search criteria and try again")
+	}
+
+	sortBySize := d.Get("sort_by").(string) == "size"
+	sort.SliceStable(found, func(i, j int) bool {
+		if sortBySize && found[i].vcpus != found[j].vcpus {
+			return found[i].vcpus < found[j].vcpus
+		}
+		if sortBySize && found[i].RAM != found[j].RAM {
+			return found[i].RAM < found[j].RAM
+		}
+		return found[i].Name < found[j].Name
+	})
+
+	var ids, names []string
+	var result []map[string]interface{}
+	for _, flavor := range found {
+		ids = append(ids, flavor.ID)
+		names = append(names, flavor.Name)
+		result = append(result, map[string]interface{}{
+			"id":               flavor.ID,
+			"name":             flavor.Name,
+			"vcpus":            flavor.vcpus,
+			"ram":              flavor.RAM,
+			"generation":       flavorGeneration(flavor.Name),
+			"performance_type": flavor.OsExtraSpecs[specPerformanceType],
+		})
+	}
+	log.Printf("[DEBUG] Retrieved ECS flavors: %v", names)
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("names", names),
+		d.Set("flavors", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting ECS flavors fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ecs/v1/cloudservers"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceComputeInstanceV2() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"instance_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"instance_id", "name"},
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"availability_zone": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"flavor_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"flavor_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"image_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"key_pair": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"security_groups": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"host_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for k, v := range flavorCapabilitiesSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceComputeInstanceV2Read,
+		Schema:      s,
+	}
+}
+
+func dataSourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := config.ComputeV1Client(region)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	if instanceID == "" {
+		instanceID, err = findComputeInstanceByName(config, region, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	server, err := cloudservers.Get(client, instanceID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving ECS instance %s: %w", instanceID, err)
+	}
+	log.Printf("[DEBUG] Retrieved ECS instance %s: %#v", server.ID, server)
+	d.SetId(server.ID)
+
+	specs, err := getFlavorSpecs(config, region, server.AvailabilityZone, server.Flavor.ID)
+	if err != nil {
+		// flavor can be withdrawn from sale, it's not listed then
+		log.Printf("[WARN] Unable to retrieve capabilities of the instance %s: %s", server.ID, err)
+	}
+	if specs == nil {
+		specs = map[string]string{}
+	}
+	// instance metadata is more precise for the instances created before the flavor was migrated
+	if server.Metadata.VirtualEnvType != "" {
+		specs[specVirtualizationEnv] = server.Metadata.VirtualEnvType
+	}
+
+	var securityGroups []string
+	for _, group := range server.SecurityGroups {
+		securityGroups = append(securityGroups, group.Name)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("instance_id", server.ID),
+		d.Set("name", server.Name),
+		d.Set("status", server.Status),
+		d.Set("availability_zone", server.AvailabilityZone),
+		d.Set("flavor_id", server.Flavor.ID),
+		d.Set("flavor_name", server.Flavor.Name),
+		d.Set("image_id", server.Image.ID),
+		d.Set("key_pair", server.KeyName),
+		d.Set("security_groups", securityGroups),
+		d.Set("host_id", server.HostID),
+		setFlavorCapabilities(d, specs),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting ECS instance fields: %w", err)
+	}
+
+	return nil
+}
+
+func findComputeInstanceByName(config *cfg.Config, region, name string) (string, error) {
+	client, err := config.ComputeV2Client(region)
+	if err != nil {
+		return "", fmt.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+	pages, err := servers.List(client, servers.ListOpts{Name: name}).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("error listing compute instances: %w", err)
+	}
+	allServers, err := servers.ExtractServers(pages)
+	if err != nil {
+		return "", fmt.Errorf("error extracting compute instances: %w", err)
+	}
+
+	// name filter is a regular expression, so exact match is checked here
+	var found []string
+	for _, server := range allServers {
+		if server.Name == name {
+			found = append(found, server.ID)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("no compute instance found with name %s", name)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("more than one compute instance found with name %s", name)
+	}
+}
@@ -0,0 +1,54 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/limits"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceComputeQuotasV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeQuotasV2Read,
+		Schema: common.QuotaSchema(
+			"instances", "cores", "ram", "security_groups", "server_groups", "floating_ips",
+		),
+	}
+}
+
+func dataSourceComputeQuotasV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+
+	limit, err := limits.Get(client, limits.GetOpts{}).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving compute quotas: %w", err)
+	}
+	quotas := limit.Absolute
+
+	d.SetId(client.ProjectID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		common.SetQuota(d, "instances", quotas.MaxTotalInstances, quotas.TotalInstancesUsed),
+		common.SetQuota(d, "cores", quotas.MaxTotalCores, quotas.TotalCoresUsed),
+		common.SetQuota(d, "ram", quotas.MaxTotalRAMSize, quotas.TotalRAMUsed),
+		common.SetQuota(d, "security_groups", quotas.MaxSecurityGroups, quotas.TotalSecurityGroupsUsed),
+		common.SetQuota(d, "server_groups", quotas.MaxServerGroups, quotas.TotalServerGroupsUsed),
+		common.SetQuota(d, "floating_ips", quotas.MaxTotalFloatingIps, quotas.TotalFloatingIpsUsed),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting compute quotas: %w", err)
+	}
+
+	return nil
+}
@@ -21,10 +21,10 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/schedulerhints"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/secgroups"
-	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/startstop"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/flavors"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/images"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ecs/v1/cloudservers"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
 
@@ -161,6 +161,13 @@ func ResourceComputeInstanceV2() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
 					},
 				},
 			},
@@ -248,6 +255,17 @@ func ResourceComputeInstanceV2() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: common.ValidateTags,
+						},
 					},
 				},
 			},
@@ -317,7 +335,18 @@ func ResourceComputeInstanceV2() *schema.Resource {
 				}, true),
 				DiffSuppressFunc: suppressPowerStateDiffs,
 			},
-			"tags": common.TagsSchema(),
+			"shutdown_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+			"reboot_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resize_policy": resizePolicySchema(),
+			"tags":          common.TagsSchema(),
 			"all_metadata": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -339,6 +368,7 @@ func ResourceComputeInstanceV2() *schema.Resource {
 					},
 				},
 			},
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -404,9 +434,17 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	var prepared preparedVolumes
 	if vL, ok := d.GetOk("block_device"); ok {
-		blockDevices, err := ResourceInstanceBlockDevicesV2(d, vL.([]interface{}))
+		bds, created, err := prepareBlockDevices(ctx, d, config, vL.([]interface{}))
+		prepared = created
+		if err != nil {
+			prepared.cleanup(d, config)
+			return diag.FromErr(err)
+		}
+		blockDevices, err := ResourceInstanceBlockDevicesV2(d, bds)
 		if err != nil {
+			prepared.cleanup(d, config)
 			return diag.FromErr(err)
 		}
 
@@ -438,6 +476,7 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 	}
 
 	if err != nil {
+		prepared.cleanup(d, config)
 		return fmterr.Errorf("error creating OpenTelekomCloud server: %w", err)
 	}
 	log.Printf("[INFO] Instance ID: %s", server.ID)
@@ -449,7 +488,7 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"BUILD"},
 		Target:     []string{"ACTIVE"},
-		Refresh:    ServerV2StateRefreshFunc(client, server.ID),
+		Refresh:    common.LogStateTransitions("ECS instance "+server.ID, ServerV2StateRefreshFunc(client, server.ID)),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -465,25 +504,29 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 
 	vmState := d.Get("power_state").(string)
 	if strings.ToLower(vmState) == "shutoff" {
-		err = startstop.Stop(client, d.Id()).ExtractErr()
+		computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
 		if err != nil {
-			return fmterr.Errorf("error stopping server instance: %w", err)
-		}
-		stopStateConf := &resource.StateChangeConf{
-			Target:     []string{"SHUTOFF"},
-			Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+			return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
 		}
-
-		log.Printf("[DEBUG] Waiting for instance (%s) to stop", d.Id())
-		_, err = stopStateConf.WaitForStateContext(ctx)
-		if err != nil {
+		shutdownTimeout := time.Duration(d.Get("shutdown_timeout").(int)) * time.Second
+		if err := stopInstance(ctx, client, computeV1Client, d.Id(), shutdownTimeout, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmterr.Errorf("error waiting for instance (%s) to become inactive(shutoff): %w", d.Id(), err)
 		}
 	}
 
+	// security groups of the NICs can be set only on the created ports
+	instanceNetworks, err := FlattenInstanceNetworks(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var macs []string
+	for _, network := range instanceNetworks {
+		macs = append(macs, network["mac"].(string))
+	}
+	if err := updateInstanceNetworkSecurityGroups(d, meta, macs, false); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if common.HasFilledOpt(d, "auto_recovery") {
 		ar := d.Get("auto_recovery").(bool)
 		log.Printf("[DEBUG] Set auto recovery of instance to %t", ar)
@@ -506,6 +549,10 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "ecs", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceComputeInstanceV2Read(ctx, d, meta)
 }
 
@@ -533,6 +580,10 @@ func resourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if err := flattenInstanceNetworkSecurityGroups(d, meta, networks); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Determine the best IPv4 and IPv6 addresses to access the instance with
 	hostv4, hostv6 := GetInstanceAccessAddresses(d, networks)
 
@@ -660,11 +711,27 @@ func resourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData,
 	tagMap := common.TagsToMap(resourceTags)
 	mErr = multierror.Append(mErr, d.Set("tags", tagMap))
 
+	// scheduler hints are returned only by the ECS API
+	getResult := cloudservers.Get(computeClient, d.Id())
+	cloudServer, err := getResult.Extract()
+	if err != nil {
+		return fmterr.Errorf("error fetching OpenTelekomCloud CloudServer: %w", err)
+	}
+	if hints := flattenInstanceSchedulerHints(d, cloudServer.OsSchedulerHints, false); hints != nil {
+		mErr = multierror.Append(mErr, d.Set("scheduler_hints", hints))
+	}
+	epsID, err := cloudServerEnterpriseProject(getResult)
+	if err != nil {
+		return fmterr.Errorf("error fetching enterprise project of CloudServer: %w", err)
+	}
+	mErr = multierror.Append(mErr, d.Set("enterprise_project_id", epsID))
+
 	if err := mErr.ErrorOrNil(); err != nil {
 		return fmterr.Errorf("error setting opentelekomcloud_compute_instance_v2 values: %w", err)
 	}
 
-	return nil
+	return instanceDeprecationWarnings(config, config.GetRegion(d),
+		d.Get("availability_zone").(string), d.Get("flavor_id").(string), d.Get("image_id").(string))
 }
 
 func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -687,44 +754,28 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 	}
 
 	if d.HasChange("power_state") {
-		powerStateNew := d.Get("power_state").(string)
-		if strings.ToLower(powerStateNew) == "shutoff" {
-			err = startstop.Stop(client, d.Id()).ExtractErr()
+		powerStateNew := strings.ToLower(d.Get("power_state").(string))
+		if powerStateNew == "shutoff" {
+			computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
 			if err != nil {
-				return fmterr.Errorf("error stopping compute instance: %w", err)
+				return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
 			}
-			stopStateConf := &resource.StateChangeConf{
-				Target:     []string{"SHUTOFF"},
-				Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-
-			log.Printf("[DEBUG] Waiting for instance (%s) to stop", d.Id())
-			_, err = stopStateConf.WaitForStateContext(ctx)
-			if err != nil {
-				return fmterr.Errorf("error waiting for instance (%s) to become inactive(shutoff): %s", d.Id(), err)
+			shutdownTimeout := time.Duration(d.Get("shutdown_timeout").(int)) * time.Second
+			if err := stopInstance(ctx, client, computeV1Client, d.Id(), shutdownTimeout, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmterr.Errorf("error waiting for instance (%s) to become inactive(shutoff): %w", d.Id(), err)
 			}
 		}
-		if strings.ToLower(powerStateNew) == "active" {
-			err = startstop.Start(client, d.Id()).ExtractErr()
-			if err != nil {
-				return fmterr.Errorf("error starting compute instance: %w", err)
-			}
-			startStateConf := &resource.StateChangeConf{
-				Target:     []string{"ACTIVE"},
-				Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
+		if powerStateNew == "active" {
+			if err := startInstance(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmterr.Errorf("error waiting for instance (%s) to become active: %w", d.Id(), err)
 			}
+		}
+	}
 
-			log.Printf("[DEBUG] Waiting for instance (%s) to start/unshelve", d.Id())
-			_, err = startStateConf.WaitForStateContext(ctx)
-			if err != nil {
-				return fmterr.Errorf("error waiting for instance (%s) to become active: %s", d.Id(), err)
-			}
+	// a stopped instance boots on the next start anyway
+	if d.HasChange("reboot_trigger") && strings.ToLower(d.Get("power_state").(string)) == "active" {
+		if err := rebootInstance(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for instance (%s) to reboot: %w", d.Id(), err)
 		}
 	}
 
@@ -799,6 +850,16 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if d.HasChange("network") {
+		var macs []string
+		for i := range d.Get("network").([]interface{}) {
+			macs = append(macs, d.Get(fmt.Sprintf("network.%d.mac", i)).(string))
+		}
+		if err := updateInstanceNetworkSecurityGroups(d, meta, macs, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("admin_pass") {
 		if newPwd, ok := d.Get("admin_pass").(string); ok {
 			err := servers.ChangeAdminPassword(client, d.Id(), newPwd).ExtractErr()
@@ -821,51 +882,18 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 			}
 		}
 
-		resizeOpts := &servers.ResizeOpts{
-			FlavorRef: newFlavorId,
-		}
-		log.Printf("[DEBUG] Resize configuration: %#v", resizeOpts)
-		err = servers.Resize(client, d.Id(), resizeOpts).ExtractErr()
+		computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
 		if err != nil {
-			return fmterr.Errorf("error resizing OpenTelekomCloud server: %w", err)
-		}
-
-		// Wait for the instance to finish resizing.
-		log.Printf("[DEBUG] Waiting for instance (%s) to finish resizing", d.Id())
-
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"RESIZE"},
-			Target:     []string{"VERIFY_RESIZE"},
-			Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForStateContext(ctx)
-		if err != nil {
-			return fmterr.Errorf("error waiting for instance (%s) to resize: %s", d.Id(), err)
-		}
-
-		// Confirm resize.
-		log.Printf("[DEBUG] Confirming resize")
-		err = servers.ConfirmResize(client, d.Id()).ExtractErr()
-		if err != nil {
-			return fmterr.Errorf("error confirming resize of OpenTelekomCloud server: %w", err)
+			return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
 		}
-
-		stateConf = &resource.StateChangeConf{
-			Pending:    []string{"VERIFY_RESIZE"},
-			Target:     []string{"ACTIVE"},
-			Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+		opts := resizeOpts{
+			FlavorID:        newFlavorId,
+			Policy:          d.Get("resize_policy").(string),
+			ShutdownTimeout: time.Duration(d.Get("shutdown_timeout").(int)) * time.Second,
+			Timeout:         d.Timeout(schema.TimeoutUpdate),
 		}
-
-		_, err = stateConf.WaitForStateContext(ctx)
-		if err != nil {
-			return fmterr.Errorf("error waiting for instance (%s) to confirm resize: %s", d.Id(), err)
+		if err := resizeInstance(ctx, client, computeV1Client, d.Id(), opts); err != nil {
+			return fmterr.Errorf("error resizing OpenTelekomCloud server (%s): %w", d.Id(), err)
 		}
 	}
 
@@ -889,6 +917,10 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "ecs", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceComputeInstanceV2Read(ctx, d, meta)
 }
 
@@ -900,7 +932,12 @@ func resourceComputeInstanceV2Delete(ctx context.Context, d *schema.ResourceData
 	}
 
 	if d.Get("stop_before_destroy").(bool) {
-		if err := startstop.Stop(client, d.Id()).ExtractErr(); err != nil {
+		computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+		}
+		shutdownTimeout := time.Duration(d.Get("shutdown_timeout").(int)) * time.Second
+		if err := stopInstance(ctx, client, computeV1Client, d.Id(), shutdownTimeout, d.Timeout(schema.TimeoutDelete)); err != nil {
 			log.Printf("[WARN] Error stopping OpenTelekomCloud instance: %s", err)
 		}
 	}
@@ -1048,6 +1085,48 @@ func resourceInstanceSchedulerHintsV2(_ *schema.ResourceData, schedulerHintsRaw
 	return schedulerHints
 }
 
+// flattenInstanceSchedulerHints merges the scheduler hints reported by the API into the ones
+// from the state. Hints missing in the state are only added when `addMissing` is set,
+// as the other hints (e.g. `same_host`) are never returned by the API.
+func flattenInstanceSchedulerHints(d *schema.ResourceData, apiHints cloudservers.OsSchedulerHints, addMissing bool) []map[string]interface{} {
+	hints := map[string]interface{}{
+		"group":              "",
+		"different_host":     []interface{}{},
+		"same_host":          []interface{}{},
+		"query":              []interface{}{},
+		"target_cell":        "",
+		"build_near_host_ip": "",
+		"tenancy":            "",
+		"deh_id":             "",
+	}
+	if existing := d.Get("scheduler_hints").(*schema.Set).List(); len(existing) > 0 {
+		for k, v := range existing[0].(map[string]interface{}) {
+			hints[k] = v
+		}
+	} else if !addMissing {
+		return nil
+	}
+
+	found := false
+	if len(apiHints.Group) > 0 {
+		hints["group"] = apiHints.Group[0]
+		found = true
+	}
+	if len(apiHints.Tenancy) > 0 {
+		hints["tenancy"] = apiHints.Tenancy[0]
+		found = true
+	}
+	if len(apiHints.DedicatedHostID) > 0 {
+		hints["deh_id"] = apiHints.DedicatedHostID[0]
+		found = true
+	}
+	if !found && addMissing {
+		return nil
+	}
+
+	return []map[string]interface{}{hints}
+}
+
 func getImageIDFromConfig(client *golangsdk.ServiceClient, d *schema.ResourceData) (string, error) {
 	// If block_device was used, an Image does not need to be specified, unless an image/local
 	// combination was used. This emulates normal boot behavior. Otherwise, ignore the image altogether.
@@ -1199,6 +1278,20 @@ func resourceComputeInstanceV2ImportState(ctx context.Context, d *schema.Resourc
 		return nil, fmt.Errorf("error setting metadata")
 	}
 
+	computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating ComputeV1 client: %w", err)
+	}
+	cloudServer, err := cloudservers.Get(computeV1Client, d.Id()).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read scheduler hints for opentelekomcloud_compute_instance_v2 %s: %s", d.Id(), err)
+	}
+	if hints := flattenInstanceSchedulerHints(d, cloudServer.OsSchedulerHints, true); hints != nil {
+		if err := d.Set("scheduler_hints", hints); err != nil {
+			return nil, fmt.Errorf("error setting scheduler_hints")
+		}
+	}
+
 	results[0] = d
 
 	return results, nil
@@ -2,10 +2,13 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/servergroups"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -17,7 +20,7 @@ func ResourceComputeServerGroupV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceComputeServerGroupV2Create,
 		ReadContext:   resourceComputeServerGroupV2Read,
-		UpdateContext: nil,
+		UpdateContext: resourceComputeServerGroupV2Update,
 		DeleteContext: resourceComputeServerGroupV2Delete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -40,10 +43,23 @@ func ResourceComputeServerGroupV2() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"affinity", "anti-affinity",
+					}, false),
+				},
+			},
+			"fault_domains": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"members": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
+				Optional: true,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
@@ -64,24 +80,100 @@ func resourceComputeServerGroupV2Create(ctx context.Context, d *schema.ResourceD
 	}
 
 	createOpts := ServerGroupCreateOpts{
-		servergroups.CreateOpts{
+		CreateOpts: servergroups.CreateOpts{
 			Name:     d.Get("name").(string),
 			Policies: resourceServerGroupPoliciesV2(d),
 		},
-		common.MapValueSpecs(d),
+		ValueSpecs: common.MapValueSpecs(d),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	newSG, err := servergroups.Create(computeClient, createOpts).Extract()
-	if err != nil {
-		return fmterr.Errorf("error creating ServerGroup: %s", err)
+	var newSG *servergroups.ServerGroup
+	if faultDomains := d.Get("fault_domains").([]interface{}); len(faultDomains) > 0 {
+		// fault domains are supported by ECS API only
+		createOpts.FaultDomain = &ServerGroupFaultDomain{
+			Names: common.ExpandToStringSlice(faultDomains),
+		}
+		computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %s", err)
+		}
+		body, err := createOpts.ToServerGroupCreateMap()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		r := servergroups.CreateResult{}
+		_, r.Err = computeV1Client.Post(computeV1Client.ServiceURL("cloudservers", "os-server-groups"), body, &r.Body, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		newSG, err = r.Extract()
+		if err != nil {
+			return fmterr.Errorf("error creating ServerGroup: %s", err)
+		}
+	} else {
+		newSG, err = servergroups.Create(computeClient, createOpts).Extract()
+		if err != nil {
+			return fmterr.Errorf("error creating ServerGroup: %s", err)
+		}
 	}
 
 	d.SetId(newSG.ID)
 
+	if members := d.Get("members").(*schema.Set).List(); len(members) > 0 {
+		if err := updateServerGroupMembers(config, d, "add_member", members); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceComputeServerGroupV2Read(ctx, d, meta)
+}
+
+func resourceComputeServerGroupV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+
+	if d.HasChange("members") {
+		oldMembersRaw, newMembersRaw := d.GetChange("members")
+		oldMembers := oldMembersRaw.(*schema.Set)
+		newMembers := newMembersRaw.(*schema.Set)
+
+		if err := updateServerGroupMembers(config, d, "remove_member", oldMembers.Difference(newMembers).List()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := updateServerGroupMembers(config, d, "add_member", newMembers.Difference(oldMembers).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceComputeServerGroupV2Read(ctx, d, meta)
 }
 
+// updateServerGroupMembers adds or removes the instances using ECS server group action
+func updateServerGroupMembers(config *cfg.Config, d *schema.ResourceData, action string, members []interface{}) error {
+	if len(members) == 0 {
+		return nil
+	}
+	client, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ComputeV1 client: %s", err)
+	}
+
+	for _, member := range members {
+		body := map[string]interface{}{
+			action: map[string]string{
+				"instance_uuid": member.(string),
+			},
+		}
+		log.Printf("[DEBUG] Server group %s action: %#v", d.Id(), body)
+		_, err := client.Post(client.ServiceURL("cloudservers", "os-server-groups", d.Id(), "action"), body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmt.Errorf("error performing %s for instance %s in server group %s: %s", action, member, d.Id(), err)
+		}
+	}
+	return nil
+}
+
 func resourceComputeServerGroupV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	computeClient, err := config.ComputeV2Client(config.GetRegion(d))
@@ -119,6 +211,24 @@ func resourceComputeServerGroupV2Read(_ context.Context, d *schema.ResourceData,
 
 	d.Set("region", config.GetRegion(d))
 
+	// fault domains are returned by ECS API only
+	computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %s", err)
+	}
+	r := golangsdk.Result{}
+	_, r.Err = computeV1Client.Get(computeV1Client.ServiceURL("cloudservers", "os-server-groups", d.Id()), &r.Body, nil)
+	var ecsGroup struct {
+		FaultDomain *ServerGroupFaultDomain `json:"fault_domain"`
+	}
+	if err := r.ExtractIntoStructPtr(&ecsGroup, "server_group"); err != nil {
+		log.Printf("[WARN] Unable to read fault domains of server group %s: %s", d.Id(), err)
+	} else if ecsGroup.FaultDomain != nil {
+		if err := d.Set("fault_domains", ecsGroup.FaultDomain.Names); err != nil {
+			return fmterr.Errorf("[DEBUG] Error saving fault domains to state for OpenTelekomCloud server group (%s): %s", d.Id(), err)
+		}
+	}
+
 	return nil
 }
 
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/blockstorage/v3/volumes"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/volumeattach"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -23,6 +25,7 @@ func ResourceComputeVolumeAttachV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceComputeVolumeAttachV2Create,
 		ReadContext:   resourceComputeVolumeAttachV2Read,
+		UpdateContext: resourceComputeVolumeAttachV2Update,
 		DeleteContext: resourceComputeVolumeAttachV2Delete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -59,6 +62,25 @@ func ResourceComputeVolumeAttachV2() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: common.SuppressDiffAll,
 			},
+
+			"device_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"VBD", "SCSI"}, false),
+			},
+
+			"multiattach": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"force_detach": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -73,6 +95,21 @@ func resourceComputeVolumeAttachV2Create(ctx context.Context, d *schema.Resource
 	instanceId := d.Get("instance_id").(string)
 	volumeId := d.Get("volume_id").(string)
 
+	// attachments of the shareable volume are serialized as EVS fails on concurrent ones
+	defer common.LockParents(common.Parent{Kind: common.ParentVolume, ID: volumeId})()
+
+	blockStorageClient, err := config.BlockStorageV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud block storage client: %s", err)
+	}
+	volume, err := volumes.Get(blockStorageClient, volumeId).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving OpenTelekomCloud volume %s: %s", volumeId, err)
+	}
+	if err := checkVolumeAttachable(volume, instanceId, d.Get("device_type").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var device string
 	if v, ok := d.GetOk("device"); ok {
 		device = v.(string)
@@ -138,6 +175,51 @@ func resourceComputeVolumeAttachV2Read(_ context.Context, d *schema.ResourceData
 	d.Set("device", attachment.Device)
 	d.Set("region", config.GetRegion(d))
 
+	blockStorageClient, err := config.BlockStorageV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud block storage client: %s", err)
+	}
+	volume, err := volumes.Get(blockStorageClient, attachment.VolumeID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving OpenTelekomCloud volume %s: %s", attachment.VolumeID, err)
+	}
+	d.Set("device_type", volumeDeviceType(volume))
+	d.Set("multiattach", volume.Multiattach)
+
+	return nil
+}
+
+func resourceComputeVolumeAttachV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only `force_detach` can be updated, it's used on the resource deletion
+	return resourceComputeVolumeAttachV2Read(ctx, d, meta)
+}
+
+// volumeDeviceType returns the disk bus of the volume: `SCSI` for passthrough volumes, `VBD` otherwise
+func volumeDeviceType(volume *volumes.Volume) string {
+	if volume.Metadata["hw:passthrough"] == "true" {
+		return "SCSI"
+	}
+	return "VBD"
+}
+
+// checkVolumeAttachable checks if the volume can be attached to the instance
+func checkVolumeAttachable(volume *volumes.Volume, instanceId, deviceType string) error {
+	if deviceType != "" && deviceType != volumeDeviceType(volume) {
+		return fmt.Errorf("volume %s has %s device type, but %s is requested, the device type can be set only on volume creation",
+			volume.ID, volumeDeviceType(volume), deviceType)
+	}
+	if len(volume.Attachments) == 0 {
+		return nil
+	}
+	for _, attachment := range volume.Attachments {
+		if attachment.ServerID == instanceId {
+			return fmt.Errorf("volume %s is already attached to the instance %s", volume.ID, instanceId)
+		}
+	}
+	if !volume.Multiattach {
+		return fmt.Errorf("volume %s is already attached to the instance %s and is not shareable (multiattach)",
+			volume.ID, volume.Attachments[0].ServerID)
+	}
 	return nil
 }
 
@@ -153,6 +235,8 @@ func resourceComputeVolumeAttachV2Delete(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	defer common.LockParents(common.Parent{Kind: common.ParentVolume, ID: d.Get("volume_id").(string)})()
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{""},
 		Target:     []string{"DETACHED"},
@@ -162,13 +246,101 @@ func resourceComputeVolumeAttachV2Delete(ctx context.Context, d *schema.Resource
 		MinTimeout: 15 * time.Second,
 	}
 
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmterr.Errorf("error detaching OpenTelekomCloud volume: %s", err)
+	_, detachErr := stateConf.WaitForStateContext(ctx)
+
+	blockStorageClient, err := config.BlockStorageV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud block storage client: %s", err)
+	}
+	volumeId := d.Get("volume_id").(string)
+	volume, err := volumes.Get(blockStorageClient, volumeId).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok && detachErr == nil {
+			return nil
+		}
+		return fmterr.Errorf("error retrieving OpenTelekomCloud volume %s: %s", volumeId, err)
+	}
+
+	// the attachment can be already removed from the instance while the volume
+	// still references it, such orphaned attachments are cleaned up the same way
+	attachment := findVolumeAttachment(volume, instanceId)
+	if attachment == nil {
+		if detachErr != nil {
+			return fmterr.Errorf("error detaching OpenTelekomCloud volume: %s", detachErr)
+		}
+		return nil
+	}
+
+	if !d.Get("force_detach").(bool) {
+		if detachErr == nil && volume.Status != "detaching" {
+			return nil
+		}
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("volume %s is stuck in %s state", volumeId, volume.Status),
+			Detail: fmt.Sprintf("The volume is still attached to the instance %s (attachment %s). "+
+				"Set `force_detach = true` for the resource and destroy it again to reset the attachment. "+
+				"Forced detach can lead to data loss if the volume is still used by the instance.",
+				instanceId, attachment.AttachmentID),
+		}}
+	}
+
+	log.Printf("[WARN] Forcing detach of the volume %s from the instance %s", volumeId, instanceId)
+	if err := forceDetachVolume(blockStorageClient, volumeId, attachment.AttachmentID); err != nil {
+		return fmterr.Errorf("error forcing detach of OpenTelekomCloud volume %s: %s", volumeId, err)
+	}
+
+	forceConf := &resource.StateChangeConf{
+		Pending:    []string{"ATTACHED"},
+		Target:     []string{"DETACHED"},
+		Refresh:    volumeAttachmentRefreshFunc(blockStorageClient, volumeId, instanceId),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := forceConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for OpenTelekomCloud volume %s to be force detached: %s", volumeId, err)
 	}
 
 	return nil
 }
 
+// findVolumeAttachment returns the attachment of the volume to the instance, if any
+func findVolumeAttachment(volume *volumes.Volume, instanceId string) *volumes.Attachment {
+	for _, attachment := range volume.Attachments {
+		if attachment.ServerID == instanceId {
+			return &attachment
+		}
+	}
+	return nil
+}
+
+// forceDetachVolume resets the attachment of the volume on the block storage side
+func forceDetachVolume(client *golangsdk.ServiceClient, volumeId, attachmentId string) error {
+	body := map[string]interface{}{
+		"os-force_detach": map[string]interface{}{
+			"attachment_id": attachmentId,
+		},
+	}
+	_, err := client.Post(client.ServiceURL("volumes", volumeId, "action"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return err
+}
+
+func volumeAttachmentRefreshFunc(client *golangsdk.ServiceClient, volumeId, instanceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volume, err := volumes.Get(client, volumeId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		if findVolumeAttachment(volume, instanceId) != nil {
+			return volume, "ATTACHED", nil
+		}
+		return volume, "DETACHED", nil
+	}
+}
+
 func resourceComputeVolumeAttachV2AttachFunc(
 	computeClient *golangsdk.ServiceClient, instanceId, attachmentId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
@@ -75,13 +74,11 @@ func ResourceEcsInstanceV1() *schema.Resource {
 			"password": {
 				Type:      schema.TypeString,
 				Optional:  true,
-				ForceNew:  true,
 				Sensitive: true,
 			},
 			"key_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -180,6 +177,8 @@ func ResourceEcsInstanceV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"resize_policy":         resizePolicySchema(),
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -191,19 +190,22 @@ func resourceEcsInstanceV1Create(ctx context.Context, d *schema.ResourceData, me
 		return fmterr.Errorf(errCreateClient, err)
 	}
 
-	createOpts := &cloudservers.CreateOpts{
-		Name:             d.Get("name").(string),
-		ImageRef:         d.Get("image_id").(string),
-		FlavorRef:        d.Get("flavor").(string),
-		KeyName:          d.Get("key_name").(string),
-		VpcId:            d.Get("vpc_id").(string),
-		SecurityGroups:   resourceInstanceSecGroupsV1(d),
-		AvailabilityZone: d.Get("availability_zone").(string),
-		Nics:             resourceInstanceNicsV1(d),
-		RootVolume:       resourceInstanceRootVolumeV1(d),
-		DataVolumes:      resourceInstanceDataVolumesV1(d),
-		AdminPass:        d.Get("password").(string),
-		UserData:         []byte(d.Get("user_data").(string)),
+	createOpts := CloudServerCreateOpts{
+		CreateOpts: cloudservers.CreateOpts{
+			Name:             d.Get("name").(string),
+			ImageRef:         d.Get("image_id").(string),
+			FlavorRef:        d.Get("flavor").(string),
+			KeyName:          d.Get("key_name").(string),
+			VpcId:            d.Get("vpc_id").(string),
+			SecurityGroups:   resourceInstanceSecGroupsV1(d),
+			AvailabilityZone: d.Get("availability_zone").(string),
+			Nics:             resourceInstanceNicsV1(d),
+			RootVolume:       resourceInstanceRootVolumeV1(d),
+			DataVolumes:      resourceInstanceDataVolumesV1(d),
+			AdminPass:        d.Get("password").(string),
+			UserData:         []byte(d.Get("user_data").(string)),
+		},
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -253,10 +255,15 @@ func resourceEcsInstanceV1Read(ctx context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf(errCreateClient, err)
 	}
 
-	server, err := cloudservers.Get(client, d.Id()).Extract()
+	getResult := cloudservers.Get(client, d.Id())
+	server, err := getResult.Extract()
 	if err != nil {
 		return diag.FromErr(common.CheckDeleted(d, err, "CloudServer"))
 	}
+	epsID, err := cloudServerEnterpriseProject(getResult)
+	if err != nil {
+		return fmterr.Errorf("error fetching enterprise project of CloudServer: %w", err)
+	}
 
 	mErr := multierror.Append(
 		d.Set("name", server.Name),
@@ -325,12 +332,13 @@ func resourceEcsInstanceV1Read(ctx context.Context, d *schema.ResourceData, meta
 	}
 	mErr = multierror.Append(mErr,
 		d.Set("auto_recovery", ar),
+		d.Set("enterprise_project_id", epsID),
 	)
 	if err := mErr.ErrorOrNil(); err != nil {
 		return fmterr.Errorf("error setting ECS attributes: %w", err)
 	}
 
-	return nil
+	return instanceDeprecationWarnings(config, config.GetRegion(d), server.AvailabilityZone, server.Flavor.ID, server.Image.ID)
 }
 
 func resourceEcsInstanceV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -386,50 +394,17 @@ func resourceEcsInstanceV1Update(ctx context.Context, d *schema.ResourceData, me
 	if d.HasChange("flavor") {
 		newFlavorId := d.Get("flavor").(string)
 
-		resizeOpts := &servers.ResizeOpts{
-			FlavorRef: newFlavorId,
-		}
-		log.Printf("[DEBUG] Resize configuration: %#v", resizeOpts)
-
-		if err := servers.Resize(client, d.Id(), resizeOpts).ExtractErr(); err != nil {
-			return fmterr.Errorf("error resizing OpenTelekomCloud server: %w", err)
-		}
-
-		// Wait for the instance to finish resizing.
-		log.Printf("[DEBUG] Waiting for instance (%s) to finish resizing", d.Id())
-
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"RESIZE"},
-			Target:     []string{"VERIFY_RESIZE"},
-			Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForStateContext(ctx)
+		computeV1Client, err := config.ComputeV1Client(config.GetRegion(d))
 		if err != nil {
-			return fmterr.Errorf("error waiting for instance (%s) to resize: %w", d.Id(), err)
-		}
-
-		// Confirm resize.
-		log.Printf("[DEBUG] Confirming resize")
-		if err := servers.ConfirmResize(client, d.Id()).ExtractErr(); err != nil {
-			return fmterr.Errorf("error confirming resize of OpenTelekomCloud server: %w", err)
+			return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
 		}
-
-		stateConf = &resource.StateChangeConf{
-			Pending:    []string{"VERIFY_RESIZE"},
-			Target:     []string{"ACTIVE"},
-			Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+		opts := resizeOpts{
+			FlavorID: newFlavorId,
+			Policy:   d.Get("resize_policy").(string),
+			Timeout:  d.Timeout(schema.TimeoutUpdate),
 		}
-
-		_, err = stateConf.WaitForStateContext(ctx)
-		if err != nil {
-			return fmterr.Errorf("error waiting for instance (%s) to confirm resize: %w", d.Id(), err)
+		if err := resizeInstance(ctx, client, computeV1Client, d.Id(), opts); err != nil {
+			return fmterr.Errorf("error resizing OpenTelekomCloud server (%s): %w", d.Id(), err)
 		}
 	}
 
@@ -444,6 +419,18 @@ func resourceEcsInstanceV1Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("password") {
+		if err := resetEcsPassword(config, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("key_name") {
+		if err := replaceEcsKeypair(ctx, config, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("auto_recovery") {
 		ar := d.Get("auto_recovery").(bool)
 		log.Printf("[DEBUG] Update auto recovery of instance to %t", ar)
@@ -452,6 +439,10 @@ func resourceEcsInstanceV1Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "ecs", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceEcsInstanceV1Read(ctx, d, meta)
 }
 
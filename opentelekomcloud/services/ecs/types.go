@@ -3,6 +3,7 @@ package ecs
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ecs/v1/cloudservers"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 )
@@ -10,7 +11,13 @@ import (
 // ServerGroupCreateOpts represents the attributes used when creating a new router.
 type ServerGroupCreateOpts struct {
 	servergroups.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	FaultDomain *ServerGroupFaultDomain `json:"fault_domain,omitempty"`
+	ValueSpecs  map[string]string       `json:"value_specs,omitempty"`
+}
+
+// ServerGroupFaultDomain represents the fault domains used by the server group policy.
+type ServerGroupFaultDomain struct {
+	Names []string `json:"names"`
 }
 
 // ToServerGroupCreateMap casts a CreateOpts struct to a map.
@@ -30,3 +37,43 @@ type KeyPairCreateOpts struct {
 func (opts KeyPairCreateOpts) ToKeyPairCreateMap() (map[string]interface{}, error) {
 	return common.BuildRequest(opts, "keypair")
 }
+
+// CloudServerCreateOpts represents the attributes used when creating a new ECS.
+type CloudServerCreateOpts struct {
+	cloudservers.CreateOpts
+	EnterpriseProjectID string `json:"-"`
+}
+
+// ToServerCreateMap casts a CreateOpts struct to a map.
+// It overrides cloudservers.ToServerCreateMap to add the enterprise project to the extendparam.
+func (opts CloudServerCreateOpts) ToServerCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.EnterpriseProjectID == "" {
+		return b, nil
+	}
+
+	server := b["server"].(map[string]interface{})
+	extendParam, ok := server["extendparam"].(map[string]interface{})
+	if !ok {
+		extendParam = make(map[string]interface{})
+		server["extendparam"] = extendParam
+	}
+	extendParam["enterprise_project_id"] = opts.EnterpriseProjectID
+
+	return b, nil
+}
+
+// cloudServerEnterpriseProject returns the enterprise project of the ECS,
+// which isn't a part of cloudservers.CloudServer.
+func cloudServerEnterpriseProject(r cloudservers.GetResult) (string, error) {
+	var server struct {
+		EnterpriseProjectID string `json:"enterprise_project_id"`
+	}
+	if err := r.ExtractIntoStructPtr(&server, "server"); err != nil {
+		return "", err
+	}
+	return server.EnterpriseProjectID, nil
+}
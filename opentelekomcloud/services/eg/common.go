@@ -0,0 +1,25 @@
+package eg
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+const (
+	egClientError = "error creating OpenTelekomCloud EGv1 client: %w"
+)
+
+// jsonStringSchema returns optional JSON string stored in normalized form
+func jsonStringSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: common.ValidateJsonString,
+		StateFunc: func(v interface{}) string {
+			json, _ := common.NormalizeJsonString(v)
+			return json
+		},
+	}
+}
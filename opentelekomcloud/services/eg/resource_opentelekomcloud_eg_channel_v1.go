@@ -0,0 +1,164 @@
+package eg
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceEgChannelV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEgChannelV1Create,
+		ReadContext:   resourceEgChannelV1Read,
+		UpdateContext: resourceEgChannelV1Update,
+		DeleteContext: resourceEgChannelV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"provider_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type egChannel struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	ProviderType string `json:"provider_type"`
+	CreatedTime  string `json:"created_time"`
+	UpdatedTime  string `json:"updated_time"`
+}
+
+func resourceEgChannelV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EgV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(egClientError, err)
+	}
+
+	body := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+	}
+	log.Printf("[DEBUG] Creating EG channel: %#v", body)
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("channels"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var channel egChannel
+	if err := r.ExtractInto(&channel); err != nil {
+		return fmterr.Errorf("error creating EG channel: %w", err)
+	}
+	d.SetId(channel.ID)
+
+	return resourceEgChannelV1Read(ctx, d, meta)
+}
+
+func resourceEgChannelV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EgV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(egClientError, err)
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("channels", d.Id()), &r.Body, nil)
+	var channel egChannel
+	if err := r.ExtractInto(&channel); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "EG channel"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", channel.Name),
+		d.Set("description", channel.Description),
+		d.Set("provider_type", channel.ProviderType),
+		d.Set("created_at", channel.CreatedTime),
+		d.Set("updated_at", channel.UpdatedTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting EG channel fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEgChannelV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EgV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(egClientError, err)
+	}
+
+	if d.HasChange("description") {
+		body := map[string]interface{}{
+			"description": d.Get("description"),
+		}
+		_, err = client.Put(client.ServiceURL("channels", d.Id()), body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating EG channel: %w", err)
+		}
+	}
+
+	return resourceEgChannelV1Read(ctx, d, meta)
+}
+
+func resourceEgChannelV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EgV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(egClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("channels", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "EG channel"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,532 @@
+package eg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const (
+	targetFunctionGraph = "HC.FunctionGraph"
+	targetSMN           = "HC.SMN"
+)
+
+func ResourceEgSubscriptionV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEgSubscriptionV1Create,
+		ReadContext:   resourceEgSubscriptionV1Read,
+		UpdateContext: resourceEgSubscriptionV1Update,
+		DeleteContext: resourceEgSubscriptionV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"provider_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"OFFICIAL", "CUSTOM", "PARTNER",
+							}, false),
+						},
+						"detail": jsonStringSchema(),
+						"filter": jsonStringSchema(),
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"provider_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "OFFICIAL",
+							ValidateFunc: validation.StringInSlice([]string{
+								"OFFICIAL", "CUSTOM",
+							}, false),
+						},
+						"detail": jsonStringSchema(),
+						"function_graph": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"urn": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"invoke_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "ASYNC",
+										ValidateFunc: validation.StringInSlice([]string{
+											"SYNC", "ASYNC",
+										}, false),
+									},
+									"agency_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"smn": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"urn": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"agency_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"subject": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"transform": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"ORIGINAL", "VARIABLE", "CONSTANT",
+										}, false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"template": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type egTransform struct {
+	Type     string `json:"type"`
+	Value    string `json:"value,omitempty"`
+	Template string `json:"template,omitempty"`
+}
+
+type egSource struct {
+	ID           string                 `json:"id,omitempty"`
+	Name         string                 `json:"name"`
+	ProviderType string                 `json:"provider_type"`
+	Detail       map[string]interface{} `json:"detail,omitempty"`
+	Filter       map[string]interface{} `json:"filter,omitempty"`
+}
+
+type egTarget struct {
+	ID           string                 `json:"id,omitempty"`
+	Name         string                 `json:"name"`
+	ProviderType string                 `json:"provider_type"`
+	Detail       map[string]interface{} `json:"detail"`
+	Transform    *egTransform           `json:"transform,omitempty"`
+}
+
+type egSubscription struct {
+	ID          string     `json:"id,omitempty"`
+	ChannelID   string     `json:"channel_id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description"`
+	Status      string     `json:"status,omitempty"`
+	CreatedTime string     `json:"created_time,omitempty"`
+	Sources     []egSource `json:"sources"`
+	Targets     []egTarget `json:"targets"`
+}
+
+func unmarshalJsonMap(v interface{}) (map[string]interface{}, error) {
+	s := v.(string)
+	if s == "" {
+		return nil, nil
+	}
+	result := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(s), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func marshalJsonMap(m map[string]interface{}) string {
+	if len(m) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(m)
+	return string(b)
+}
+
+func expandEgSources(d *schema.ResourceData) ([]egSource, error) {
+	var sources []egSource
+	for i, raw := range d.Get("source").([]interface{}) {
+		s := raw.(map[string]interface{})
+		detail, err := unmarshalJsonMap(s["detail"])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing `source.%d.detail`: %w", i, err)
+		}
+		filter, err := unmarshalJsonMap(s["filter"])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing `source.%d.filter`: %w", i, err)
+		}
+		sources = append(sources, egSource{
+			ID:           s["id"].(string),
+			Name:         s["name"].(string),
+			ProviderType: s["provider_type"].(string),
+			Detail:       detail,
+			Filter:       filter,
+		})
+	}
+	return sources, nil
+}
+
+func expandEgTargets(d *schema.ResourceData) ([]egTarget, error) {
+	var targets []egTarget
+	for i, raw := range d.Get("target").([]interface{}) {
+		t := raw.(map[string]interface{})
+		target := egTarget{
+			ID:           t["id"].(string),
+			Name:         t["name"].(string),
+			ProviderType: t["provider_type"].(string),
+		}
+
+		fg := t["function_graph"].([]interface{})
+		smn := t["smn"].([]interface{})
+		switch {
+		case len(fg) > 0 && len(smn) > 0:
+			return nil, fmt.Errorf("only one of `function_graph` and `smn` can be set for `target.%d`", i)
+		case len(fg) > 0:
+			fgMap := fg[0].(map[string]interface{})
+			target.Name = targetFunctionGraph
+			target.Detail = map[string]interface{}{
+				"urn":         fgMap["urn"],
+				"invoke_type": fgMap["invoke_type"],
+				"agency_name": fgMap["agency_name"],
+			}
+		case len(smn) > 0:
+			smnMap := smn[0].(map[string]interface{})
+			target.Name = targetSMN
+			target.Detail = map[string]interface{}{
+				"urn":         smnMap["urn"],
+				"agency_name": smnMap["agency_name"],
+			}
+			if subject := smnMap["subject"].(string); subject != "" {
+				target.Detail["subject_transform"] = map[string]interface{}{
+					"type":  "CONSTANT",
+					"value": subject,
+				}
+			}
+		default:
+			detail, err := unmarshalJsonMap(t["detail"])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing `target.%d.detail`: %w", i, err)
+			}
+			if target.Name == "" || detail == nil {
+				return nil, fmt.Errorf("`name` and `detail` are required for `target.%d` "+
+					"if neither `function_graph` nor `smn` is set", i)
+			}
+			target.Detail = detail
+		}
+
+		if transform := t["transform"].([]interface{}); len(transform) > 0 {
+			tr := transform[0].(map[string]interface{})
+			target.Transform = &egTransform{
+				Type:     tr["type"].(string),
+				Value:    tr["value"].(string),
+				Template: tr["template"].(string),
+			}
+		} else {
+			target.Transform = &egTransform{Type: "ORIGINAL"}
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func flattenEgTargets(targets []egTarget) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, t := range targets {
+		target := map[string]interface{}{
+			"id":            t.ID,
+			"name":          t.Name,
+			"provider_type": t.ProviderType,
+			"detail":        marshalJsonMap(t.Detail),
+		}
+		switch t.Name {
+		case targetFunctionGraph:
+			target["function_graph"] = []map[string]interface{}{{
+				"urn":         t.Detail["urn"],
+				"invoke_type": t.Detail["invoke_type"],
+				"agency_name": t.Detail["agency_name"],
+			}}
+		case targetSMN:
+			smn := map[string]interface{}{
+				"urn":         t.Detail["urn"],
+				"agency_name": t.Detail["agency_name"],
+			}
+			if subject, ok := t.Detail["subject_transform"].(map[string]interface{}); ok {
+				smn["subject"] = subject["value"]
+			}
+			target["smn"] = []map[string]interface{}{smn}
+		}
+		if t.Transform != nil {
+			target["transform"] = []map[string]interface{}{{
+				"type":     t.Transform.Type,
+				"value":    t.Transform.Value,
+				"template": t.Transform.Template,
+			}}
+		}
+		result = append(result, target)
+	}
+	return result
+}
+
+func switchEgSubscription(client *golangsdk.ServiceClient, id string, enabled bool) error {
+	operation := "DISABLE"
+	if enabled {
+		operation = "ENABLE"
+	}
+	body := map[string]interface{}{
+		"subscriptions": []map[string]string{{"id": id}},
+		"operation":     operation,
+	}
+	_, err := client.Post(client.ServiceURL("subscriptions", "operation"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func resourceEgSubscriptionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EgV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(egClientError, err)
+	}
+
+	sources, err := expandEgSources(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targets, err := expandEgTargets(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createOpts := egSubscription{
+		ChannelID:   d.Get("channel_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Sources:     sources,
+		Targets:     targets,
+	}
+	log.Printf("[DEBUG] Creating EG subscription: %#v", createOpts)
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("subscriptions"), createOpts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var subscription egSubscription
+	if err := r.ExtractInto(&subscription); err != nil {
+		return fmterr.Errorf("error creating EG subscription: %w", err)
+	}
+	d.SetId(subscription.ID)
+
+	if !d.Get("enabled").(bool) {
+		if err := switchEgSubscription(client, d.Id(), false); err != nil {
+			return fmterr.Errorf("error disabling EG subscription: %w", err)
+		}
+	}
+
+	return resourceEgSubscriptionV1Read(ctx, d, meta)
+}
+
+func resourceEgSubscriptionV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EgV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(egClientError, err)
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("subscriptions", d.Id()), &r.Body, nil)
+	var subscription egSubscription
+	if err := r.ExtractInto(&subscription); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "EG subscription"))
+	}
+
+	var sources []map[string]interface{}
+	for _, s := range subscription.Sources {
+		sources = append(sources, map[string]interface{}{
+			"id":            s.ID,
+			"name":          s.Name,
+			"provider_type": s.ProviderType,
+			"detail":        marshalJsonMap(s.Detail),
+			"filter":        marshalJsonMap(s.Filter),
+		})
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("channel_id", subscription.ChannelID),
+		d.Set("name", subscription.Name),
+		d.Set("description", subscription.Description),
+		d.Set("enabled", subscription.Status == "ENABLED"),
+		d.Set("status", subscription.Status),
+		d.Set("created_at", subscription.CreatedTime),
+		d.Set("source", sources),
+		d.Set("target", flattenEgTargets(subscription.Targets)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting EG subscription fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEgSubscriptionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EgV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(egClientError, err)
+	}
+
+	if d.HasChanges("description", "source", "target") {
+		sources, err := expandEgSources(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		targets, err := expandEgTargets(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updateOpts := egSubscription{
+			Description: d.Get("description").(string),
+			Sources:     sources,
+			Targets:     targets,
+		}
+		_, err = client.Put(client.ServiceURL("subscriptions", d.Id()), updateOpts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating EG subscription: %w", err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := switchEgSubscription(client, d.Id(), d.Get("enabled").(bool)); err != nil {
+			return fmterr.Errorf("error switching EG subscription: %w", err)
+		}
+	}
+
+	return resourceEgSubscriptionV1Read(ctx, d, meta)
+}
+
+func resourceEgSubscriptionV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EgV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(egClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("subscriptions", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "EG subscription"))
+	}
+
+	d.SetId("")
+	return nil
+}
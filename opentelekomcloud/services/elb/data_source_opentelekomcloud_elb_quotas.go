@@ -0,0 +1,60 @@
+package elb
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elb/quotas"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// elbQuotaNames maps ELB API quota types to the attribute names
+var elbQuotaNames = map[string]string{
+	"elb":      "loadbalancers",
+	"listener": "listeners",
+}
+
+func DataSourceELBQuotas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceELBQuotasRead,
+		Schema:      common.QuotaSchema("loadbalancers", "listeners"),
+	}
+}
+
+func dataSourceELBQuotasRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ELB client: %w", err)
+	}
+
+	resourceQuotas, err := quotas.Get(client).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving ELB quotas: %w", err)
+	}
+	elbQuotas := make([]common.ResourceQuota, len(resourceQuotas))
+	for i, quota := range resourceQuotas {
+		elbQuotas[i] = common.ResourceQuota{
+			Type:  quota.Type,
+			Used:  quota.Used,
+			Quota: quota.Quota,
+		}
+	}
+
+	d.SetId(client.ProjectID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		common.SetResourceQuotas(d, elbQuotas, elbQuotaNames),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting ELB quotas: %w", err)
+	}
+
+	return nil
+}
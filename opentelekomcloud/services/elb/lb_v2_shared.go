@@ -27,6 +27,18 @@ var lbPendingDeleteStatuses = []string{"ERROR", "PENDING_UPDATE", "PENDING_DELET
 
 var lbSkipLBStatuses = []string{"ERROR", "ACTIVE"}
 
+// LoadBalancerCreateOpts represents the attributes used when creating a new load balancer.
+type LoadBalancerCreateOpts struct {
+	loadbalancers.CreateOpts
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// ToLoadBalancerCreateMap casts a CreateOpts struct to a map.
+// It overrides loadbalancers.ToLoadBalancerCreateMap to add the EnterpriseProjectID field.
+func (opts LoadBalancerCreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "loadbalancer")
+}
+
 func waitForLBV2Listener(ctx context.Context, networkingClient *golangsdk.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for listener %s to become %s.", id, target)
 
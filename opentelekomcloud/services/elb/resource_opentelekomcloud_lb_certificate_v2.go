@@ -60,7 +60,34 @@ func ResourceCertificateV2() *schema.Resource {
 			"private_key": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				Sensitive:        true,
 				DiffSuppressFunc: common.SuppressStrippedNewLines,
+				ConflictsWith:    []string{"private_key_encrypted", "private_key_secret"},
+			},
+
+			"private_key_encrypted": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"private_key_secret"},
+			},
+
+			"private_key_secret": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "latest",
+						},
+					},
+				},
 			},
 
 			"certificate": {
@@ -97,16 +124,21 @@ func resourceCertificateV2Create(ctx context.Context, d *schema.ResourceData, me
 		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
 	}
 
+	privateKey, err := certificatePrivateKey(config, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createOpts := certificates.CreateOpts{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 		Domain:      d.Get("domain").(string),
-		PrivateKey:  d.Get("private_key").(string),
+		PrivateKey:  privateKey,
 		Certificate: d.Get("certificate").(string),
 		Type:        d.Get("type").(string),
 	}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	log.Printf("[DEBUG] Creating certificate %s", createOpts.Name)
 	c, err := certificates.Create(networkingClient, createOpts).Extract()
 	if err != nil {
 		return fmterr.Errorf("error creating Certificate: %s", err)
@@ -136,15 +168,65 @@ func resourceCertificateV2Read(_ context.Context, d *schema.ResourceData, meta i
 		d.Set("description", c.Description),
 		d.Set("domain", c.Domain),
 		d.Set("certificate", c.Certificate),
-		d.Set("private_key", c.PrivateKey),
 		d.Set("type", c.Type),
 		d.Set("create_time", c.CreateTime),
 		d.Set("update_time", c.UpdateTime),
 		d.Set("region", config.GetRegion(d)),
 	)
+	// the private key is not saved if it's passed encrypted
+	if !isPrivateKeyProtected(d) {
+		mErr = multierror.Append(mErr, d.Set("private_key", c.PrivateKey))
+	}
 	return diag.FromErr(mErr.ErrorOrNil())
 }
 
+func isPrivateKeyProtected(d *schema.ResourceData) bool {
+	return d.Get("private_key_encrypted").(string) != "" || len(d.Get("private_key_secret").([]interface{})) > 0
+}
+
+// certificatePrivateKey returns the private key decrypting it with KMS or reading it from CSMS secret if required
+func certificatePrivateKey(config *cfg.Config, d *schema.ResourceData) (string, error) {
+	if cipherText := d.Get("private_key_encrypted").(string); cipherText != "" {
+		client, err := config.KmsKeyV1Client(config.GetRegion(d))
+		if err != nil {
+			return "", fmt.Errorf("error creating OpenTelekomCloud KMS client: %w", err)
+		}
+		body := map[string]interface{}{
+			"cipher_text": cipherText,
+		}
+		r := golangsdk.Result{}
+		_, r.Err = client.Post(client.ServiceURL(client.ProjectID, "kms", "decrypt-data"), body, &r.Body, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		var decrypted struct {
+			PlainText string `json:"plain_text"`
+		}
+		if err := r.ExtractInto(&decrypted); err != nil {
+			return "", fmt.Errorf("error decrypting private key with KMS: %w", err)
+		}
+		return decrypted.PlainText, nil
+	}
+
+	if secretRaw := d.Get("private_key_secret").([]interface{}); len(secretRaw) > 0 {
+		secret := secretRaw[0].(map[string]interface{})
+		client, err := config.CsmsV1Client(config.GetRegion(d))
+		if err != nil {
+			return "", fmt.Errorf("error creating OpenTelekomCloud CSMS client: %w", err)
+		}
+		r := golangsdk.Result{}
+		_, r.Err = client.Get(client.ServiceURL("secrets", secret["name"].(string), "versions", secret["version"].(string)), &r.Body, nil)
+		var version struct {
+			SecretString string `json:"secret_string"`
+		}
+		if err := r.ExtractIntoStructPtr(&version, "version"); err != nil {
+			return "", fmt.Errorf("error reading private key from CSMS secret %s: %w", secret["name"], err)
+		}
+		return version.SecretString, nil
+	}
+
+	return d.Get("private_key").(string), nil
+}
+
 func resourceCertificateV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
@@ -162,14 +244,18 @@ func resourceCertificateV2Update(ctx context.Context, d *schema.ResourceData, me
 	if d.HasChange("domain") {
 		updateOpts.Domain = d.Get("domain").(string)
 	}
-	if d.HasChange("private_key") {
-		updateOpts.PrivateKey = d.Get("private_key").(string)
+	if d.HasChanges("private_key", "private_key_encrypted", "private_key_secret") {
+		privateKey, err := certificatePrivateKey(config, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updateOpts.PrivateKey = privateKey
 	}
 	if d.HasChange("certificate") {
 		updateOpts.Certificate = d.Get("certificate").(string)
 	}
 
-	log.Printf("[DEBUG] Updating certificate %s with options: %#v", d.Id(), updateOpts)
+	log.Printf("[DEBUG] Updating certificate %s", d.Id())
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
 	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
@@ -78,7 +78,8 @@ func ResourceLoadBalancerV2() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":                  common.TagsSchema(),
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -96,14 +97,17 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := loadbalancers.CreateOpts{
-		Name:         d.Get("name").(string),
-		Description:  d.Get("description").(string),
-		VipSubnetID:  d.Get("vip_subnet_id").(string),
-		TenantID:     d.Get("tenant_id").(string),
-		VipAddress:   d.Get("vip_address").(string),
-		AdminStateUp: &adminStateUp,
-		Provider:     lbProvider,
+	createOpts := LoadBalancerCreateOpts{
+		CreateOpts: loadbalancers.CreateOpts{
+			Name:         d.Get("name").(string),
+			Description:  d.Get("description").(string),
+			VipSubnetID:  d.Get("vip_subnet_id").(string),
+			TenantID:     d.Get("tenant_id").(string),
+			VipAddress:   d.Get("vip_address").(string),
+			AdminStateUp: &adminStateUp,
+			Provider:     lbProvider,
+		},
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -174,6 +178,10 @@ func resourceLoadBalancerV2Read(_ context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("error saving tags for OpenTelekomCloud LoadCalancer: %s", err)
 	}
 
+	if err := common.ReadEnterpriseProject(config, d, "loadbalancers", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -228,6 +236,10 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "loadbalancers", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceLoadBalancerV2Read(ctx, d, meta)
 }
 
@@ -0,0 +1,628 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const elbV3ClientError = "error creating OpenTelekomCloud ELB v3 client: %w"
+
+type loadBalancerV3Autoscaling struct {
+	Enable        bool   `json:"enable"`
+	MinL7FlavorID string `json:"min_l7_flavor_id,omitempty"`
+}
+
+type loadBalancerV3Eip struct {
+	EipID      string `json:"eip_id"`
+	EipAddress string `json:"eip_address"`
+}
+
+type loadBalancerV3 struct {
+	ID                       string                    `json:"id"`
+	Name                     string                    `json:"name"`
+	Description              string                    `json:"description"`
+	ProvisioningStatus       string                    `json:"provisioning_status"`
+	OperatingStatus          string                    `json:"operating_status"`
+	AdminStateUp             bool                      `json:"admin_state_up"`
+	VpcID                    string                    `json:"vpc_id"`
+	VipSubnetCidrID          string                    `json:"vip_subnet_cidr_id"`
+	VipAddress               string                    `json:"vip_address"`
+	VipPortID                string                    `json:"vip_port_id"`
+	ElbVirsubnetIDs          []string                  `json:"elb_virsubnet_ids"`
+	AvailabilityZoneList     []string                  `json:"availability_zone_list"`
+	L4FlavorID               string                    `json:"l4_flavor_id"`
+	L7FlavorID               string                    `json:"l7_flavor_id"`
+	IpTargetEnable           bool                      `json:"ip_target_enable"`
+	DeletionProtectionEnable bool                      `json:"deletion_protection_enable"`
+	Autoscaling              loadBalancerV3Autoscaling `json:"autoscaling"`
+	Eips                     []loadBalancerV3Eip       `json:"eips"`
+	Tags                     []tags.ResourceTag        `json:"tags"`
+	CreatedAt                string                    `json:"created_at"`
+}
+
+// loadBalancerV3LogTank is the configuration of access log delivery to LTS
+type loadBalancerV3LogTank struct {
+	ID             string `json:"id"`
+	LoadbalancerID string `json:"loadbalancer_id"`
+	LogGroupID     string `json:"log_group_id"`
+	LogTopicID     string `json:"log_topic_id"`
+}
+
+func ResourceLoadBalancerV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerV3Create,
+		ReadContext:   resourceLoadBalancerV3Read,
+		UpdateContext: resourceLoadBalancerV3Update,
+		DeleteContext: resourceLoadBalancerV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"l4_flavor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"l7_flavor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"autoscaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"min_l7_flavor_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ip_target_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags": common.TagsSchema(),
+			"public_ip": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"bandwidth_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"bandwidth_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 2000),
+						},
+						"bandwidth_charge_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"bandwidth", "traffic"}, false),
+						},
+						"bandwidth_share_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PER", "WHOLE"}, false),
+						},
+					},
+				},
+			},
+			"access_log": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"log_topic_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getLoadBalancerV3(client *golangsdk.ServiceClient, id string) (*loadBalancerV3, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("elb", "loadbalancers", id), &r.Body, nil)
+	lb := new(loadBalancerV3)
+	if err := r.ExtractIntoStructPtr(lb, "loadbalancer"); err != nil {
+		return nil, err
+	}
+	return lb, nil
+}
+
+func waitForLoadBalancerV3(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING_CREATE", "PENDING_UPDATE"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			lb, err := getLoadBalancerV3(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			if lb.ProvisioningStatus == "ERROR" {
+				return lb, lb.ProvisioningStatus, fmt.Errorf("load balancer is in ERROR state")
+			}
+			return lb, lb.ProvisioningStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func expandLoadBalancerV3Autoscaling(d *schema.ResourceData) *loadBalancerV3Autoscaling {
+	raw := d.Get("autoscaling").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	autoscaling := raw[0].(map[string]interface{})
+	return &loadBalancerV3Autoscaling{
+		Enable:        autoscaling["enabled"].(bool),
+		MinL7FlavorID: autoscaling["min_l7_flavor_id"].(string),
+	}
+}
+
+// expandLoadBalancerV3PublicIP adds either the existing EIP or the new EIP specification to the request
+func expandLoadBalancerV3PublicIP(d *schema.ResourceData, opts map[string]interface{}) {
+	raw := d.Get("public_ip").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return
+	}
+	publicIP := raw[0].(map[string]interface{})
+	if id := publicIP["id"].(string); id != "" {
+		opts["publicip_ids"] = []string{id}
+		return
+	}
+	opts["publicip"] = map[string]interface{}{
+		"ip_version":   4,
+		"network_type": publicIP["ip_type"].(string),
+		"bandwidth": map[string]interface{}{
+			"name":        publicIP["bandwidth_name"].(string),
+			"size":        publicIP["bandwidth_size"].(int),
+			"charge_mode": publicIP["bandwidth_charge_mode"].(string),
+			"share_type":  publicIP["bandwidth_share_type"].(string),
+		},
+	}
+}
+
+func resourceLoadBalancerV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(elbV3ClientError, err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	opts := map[string]interface{}{
+		"name":                       d.Get("name").(string),
+		"description":                d.Get("description").(string),
+		"vpc_id":                     d.Get("router_id").(string),
+		"vip_subnet_cidr_id":         d.Get("subnet_id").(string),
+		"vip_address":                d.Get("vip_address").(string),
+		"elb_virsubnet_ids":          common.ExpandToStringSlice(d.Get("network_ids").(*schema.Set).List()),
+		"availability_zone_list":     common.ExpandToStringSlice(d.Get("availability_zones").(*schema.Set).List()),
+		"l4_flavor_id":               d.Get("l4_flavor_id").(string),
+		"l7_flavor_id":               d.Get("l7_flavor_id").(string),
+		"ip_target_enable":           d.Get("ip_target_enable").(bool),
+		"admin_state_up":             &adminStateUp,
+		"deletion_protection_enable": d.Get("deletion_protection").(bool),
+	}
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		opts["tags"] = common.ExpandResourceTags(tagRaw)
+	}
+	if autoscaling := expandLoadBalancerV3Autoscaling(d); autoscaling != nil {
+		opts["autoscaling"] = autoscaling
+	}
+	expandLoadBalancerV3PublicIP(d, opts)
+	// empty values are not accepted by the API
+	for _, key := range []string{"vpc_id", "vip_subnet_cidr_id", "vip_address", "l4_flavor_id", "l7_flavor_id"} {
+		if opts[key] == "" {
+			delete(opts, key)
+		}
+	}
+	log.Printf("[DEBUG] Creating load balancer v3: %#v", opts)
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("elb", "loadbalancers"), map[string]interface{}{"loadbalancer": opts}, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	lb := new(loadBalancerV3)
+	if err := r.ExtractIntoStructPtr(lb, "loadbalancer"); err != nil {
+		return fmterr.Errorf("error creating load balancer v3: %w", err)
+	}
+	d.SetId(lb.ID)
+
+	if err := waitForLoadBalancerV3(ctx, client, lb.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for load balancer v3 %s to become active: %w", lb.ID, err)
+	}
+
+	if v, ok := d.GetOk("access_log"); ok {
+		accessLog := v.([]interface{})[0].(map[string]interface{})
+		if err := createLoadBalancerV3LogTank(client, lb.ID, accessLog); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceLoadBalancerV3Read(ctx, d, meta)
+}
+
+func listLoadBalancerV3LogTanks(client *golangsdk.ServiceClient, lbID string) ([]loadBalancerV3LogTank, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("elb", "logtanks")+"?loadbalancer_id="+lbID, &r.Body, nil)
+	var logTanks []loadBalancerV3LogTank
+	if err := r.ExtractIntoSlicePtr(&logTanks, "logtanks"); err != nil {
+		return nil, err
+	}
+	return logTanks, nil
+}
+
+func createLoadBalancerV3LogTank(client *golangsdk.ServiceClient, lbID string, accessLog map[string]interface{}) error {
+	opts := map[string]interface{}{
+		"logtank": map[string]interface{}{
+			"loadbalancer_id": lbID,
+			"log_group_id":    accessLog["log_group_id"].(string),
+			"log_topic_id":    accessLog["log_topic_id"].(string),
+		},
+	}
+	_, err := client.Post(client.ServiceURL("elb", "logtanks"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return fmt.Errorf("error enabling access log of load balancer v3 %s: %w", lbID, err)
+	}
+	return nil
+}
+
+func deleteLoadBalancerV3LogTank(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("elb", "logtanks", id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("error disabling access log %s: %w", id, err)
+	}
+	return nil
+}
+
+func resourceLoadBalancerV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(elbV3ClientError, err)
+	}
+
+	lb, err := getLoadBalancerV3(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "load balancer v3"))
+	}
+
+	var autoscaling []map[string]interface{}
+	if lb.Autoscaling.Enable || len(d.Get("autoscaling").([]interface{})) > 0 {
+		autoscaling = []map[string]interface{}{
+			{
+				"enabled":          lb.Autoscaling.Enable,
+				"min_l7_flavor_id": lb.Autoscaling.MinL7FlavorID,
+			},
+		}
+	}
+
+	var publicIP []map[string]interface{}
+	if len(lb.Eips) > 0 {
+		ip := map[string]interface{}{
+			"id":      lb.Eips[0].EipID,
+			"address": lb.Eips[0].EipAddress,
+		}
+		// the bandwidth of a created EIP is not returned with the load balancer
+		if known := d.Get("public_ip").([]interface{}); len(known) > 0 && known[0] != nil {
+			for _, key := range []string{"ip_type", "bandwidth_name", "bandwidth_size", "bandwidth_charge_mode", "bandwidth_share_type"} {
+				ip[key] = known[0].(map[string]interface{})[key]
+			}
+		}
+		publicIP = []map[string]interface{}{ip}
+	}
+
+	logTanks, err := listLoadBalancerV3LogTanks(client, lb.ID)
+	if err != nil {
+		return fmterr.Errorf("error reading access log of load balancer v3 %s: %w", lb.ID, err)
+	}
+	var accessLog []map[string]interface{}
+	for _, logTank := range logTanks {
+		if logTank.LoadbalancerID != lb.ID {
+			continue
+		}
+		accessLog = []map[string]interface{}{
+			{
+				"id":           logTank.ID,
+				"log_group_id": logTank.LogGroupID,
+				"log_topic_id": logTank.LogTopicID,
+			},
+		}
+		break
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", lb.Name),
+		d.Set("description", lb.Description),
+		d.Set("router_id", lb.VpcID),
+		d.Set("subnet_id", lb.VipSubnetCidrID),
+		d.Set("network_ids", lb.ElbVirsubnetIDs),
+		d.Set("vip_address", lb.VipAddress),
+		d.Set("vip_port_id", lb.VipPortID),
+		d.Set("availability_zones", lb.AvailabilityZoneList),
+		d.Set("l4_flavor_id", lb.L4FlavorID),
+		d.Set("l7_flavor_id", lb.L7FlavorID),
+		d.Set("autoscaling", autoscaling),
+		d.Set("ip_target_enable", lb.IpTargetEnable),
+		d.Set("admin_state_up", lb.AdminStateUp),
+		d.Set("deletion_protection", lb.DeletionProtectionEnable),
+		d.Set("public_ip", publicIP),
+		d.Set("access_log", accessLog),
+		d.Set("tags", common.TagsToMap(lb.Tags)),
+		d.Set("provisioning_status", lb.ProvisioningStatus),
+		d.Set("operating_status", lb.OperatingStatus),
+		d.Set("created_at", lb.CreatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting load balancer v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLoadBalancerV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(elbV3ClientError, err)
+	}
+
+	if d.HasChanges("name", "description", "network_ids", "l4_flavor_id", "l7_flavor_id", "autoscaling",
+		"ip_target_enable", "admin_state_up", "deletion_protection") {
+		opts := map[string]interface{}{
+			"name":                       d.Get("name").(string),
+			"description":                d.Get("description").(string),
+			"ip_target_enable":           d.Get("ip_target_enable").(bool),
+			"admin_state_up":             d.Get("admin_state_up").(bool),
+			"deletion_protection_enable": d.Get("deletion_protection").(bool),
+		}
+		if d.HasChange("network_ids") {
+			opts["elb_virsubnet_ids"] = common.ExpandToStringSlice(d.Get("network_ids").(*schema.Set).List())
+		}
+		if d.HasChange("l4_flavor_id") {
+			opts["l4_flavor_id"] = d.Get("l4_flavor_id").(string)
+		}
+		if d.HasChange("l7_flavor_id") {
+			opts["l7_flavor_id"] = d.Get("l7_flavor_id").(string)
+		}
+		if d.HasChange("autoscaling") {
+			autoscaling := expandLoadBalancerV3Autoscaling(d)
+			if autoscaling == nil {
+				autoscaling = &loadBalancerV3Autoscaling{Enable: false}
+			}
+			opts["autoscaling"] = autoscaling
+		}
+		log.Printf("[DEBUG] Updating load balancer v3 %s: %#v", d.Id(), opts)
+
+		_, err := client.Put(client.ServiceURL("elb", "loadbalancers", d.Id()), map[string]interface{}{"loadbalancer": opts}, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating load balancer v3 %s: %w", d.Id(), err)
+		}
+		if err := waitForLoadBalancerV3(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for load balancer v3 %s to become active: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := common.UpdateResourceTags(client, d, "elb/loadbalancers", d.Id()); err != nil {
+			return fmterr.Errorf("error updating tags of load balancer v3 %s: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("access_log") {
+		oldRaw, newRaw := d.GetChange("access_log")
+		if old := oldRaw.([]interface{}); len(old) > 0 && old[0] != nil {
+			if err := deleteLoadBalancerV3LogTank(client, old[0].(map[string]interface{})["id"].(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if newLog := newRaw.([]interface{}); len(newLog) > 0 && newLog[0] != nil {
+			if err := createLoadBalancerV3LogTank(client, d.Id(), newLog[0].(map[string]interface{})); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceLoadBalancerV3Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(elbV3ClientError, err)
+	}
+
+	if accessLog := d.Get("access_log").([]interface{}); len(accessLog) > 0 && accessLog[0] != nil {
+		if err := deleteLoadBalancerV3LogTank(client, accessLog[0].(map[string]interface{})["id"].(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting load balancer v3 %s", d.Id())
+	_, err = client.Delete(client.ServiceURL("elb", "loadbalancers", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting load balancer v3"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE", "PENDING_DELETE"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			lb, err := getLoadBalancerV3(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return d.Id(), "DELETED", nil
+				}
+				return nil, "", err
+			}
+			return lb, lb.ProvisioningStatus, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for load balancer v3 %s to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
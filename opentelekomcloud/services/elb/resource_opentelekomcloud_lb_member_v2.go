@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
 
@@ -17,6 +19,9 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
 
+// maxSlowStartSteps limits the number of weight updates during the member slow start
+const maxSlowStartSteps = 10
+
 func ResourceMemberV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMemberV2Create,
@@ -93,6 +98,20 @@ func ResourceMemberV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"slow_start_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
 		},
 	}
 }
@@ -119,16 +138,44 @@ func resourceMemberV2Create(ctx context.Context, d *schema.ResourceData, meta in
 		createOpts.SubnetID = v.(string)
 	}
 
+	// with slow start the member gets only a small part of traffic first
+	targetWeight := createOpts.Weight
+	slowStart := time.Duration(d.Get("slow_start_duration").(int)) * time.Second
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if slowStart > 0 && targetWeight > 1 {
+		if err := checkMemberV2Duration("slow_start_duration", slowStart, schema.TimeoutCreate, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+		createOpts.Weight = 1
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
-	// Wait for LB to become active before continuing
 	poolID := d.Get("pool_id").(string)
-	timeout := d.Timeout(schema.TimeoutCreate)
-	err = waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
+	member, err := createMemberV2(ctx, networkingClient, poolID, createOpts, timeout)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	d.SetId(member.ID)
+
+	if createOpts.Weight != targetWeight {
+		if err := rampMemberV2Weight(ctx, networkingClient, poolID, member.ID, createOpts.Weight, targetWeight, slowStart, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceMemberV2Read(ctx, d, meta)
+}
+
+func createMemberV2(ctx context.Context, networkingClient *golangsdk.ServiceClient, poolID string, createOpts pools.CreateMemberOpts, timeout time.Duration) (*pools.Member, error) {
+	// Wait for LB to become active before continuing
+	defer common.LockParents(common.Parent{Kind: common.ParentLBPool, ID: poolID})()
+	err := waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Printf("[DEBUG] Attempting to create member")
 	var member *pools.Member
 	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
@@ -140,24 +187,91 @@ func resourceMemberV2Create(ctx context.Context, d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return fmterr.Errorf("error creating member: %s", err)
+		return nil, fmt.Errorf("error creating member: %s", err)
 	}
 
 	// Wait for LB to become ACTIVE again
 	err = waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// memberWeightOpts is used instead of pools.UpdateMemberOpts, which omits zero weight
+type memberWeightOpts struct {
+	Weight int `json:"weight"`
+}
+
+func (opts memberWeightOpts) ToMemberUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "member")
+}
+
+func setMemberV2Weight(ctx context.Context, networkingClient *golangsdk.ServiceClient, poolID, memberID string, weight int, timeout time.Duration) error {
+	defer common.LockParents(common.Parent{Kind: common.ParentLBPool, ID: poolID})()
+	if err := waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout); err != nil {
+		return err
 	}
-	// Wait for LB member to become ACTIVE too
-	/*
-		err = waitForLBV2Member(networkingClient, poolID, "admin_state_up", "true", nil, timeout)
+
+	log.Printf("[DEBUG] Setting weight of member %s to %d", memberID, weight)
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := pools.UpdateMember(networkingClient, poolID, memberID, memberWeightOpts{Weight: weight}).Extract()
 		if err != nil {
-			return err
-		} */
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set weight of member %s: %s", memberID, err)
+	}
 
-	d.SetId(member.ID)
+	return waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
+}
 
-	return resourceMemberV2Read(ctx, d, meta)
+// checkMemberV2Duration fails before any change if the waiting inside the operation can't fit its timeout
+func checkMemberV2Duration(name string, duration time.Duration, timeoutKey string, timeout time.Duration) error {
+	if duration >= timeout {
+		return fmt.Errorf("%s (%s) must be shorter than the %s timeout (%s)", name, duration, timeoutKey, timeout)
+	}
+	return nil
+}
+
+// rampMemberV2Weight raises the weight of the member from the current value to the target in equal steps
+// spread over the slow start duration. Shared ELB has no slow start of its own.
+func rampMemberV2Weight(ctx context.Context, networkingClient *golangsdk.ServiceClient, poolID, memberID string, from, target int, duration, timeout time.Duration) error {
+	steps := target - from
+	if steps > maxSlowStartSteps {
+		steps = maxSlowStartSteps
+	}
+	interval := duration / time.Duration(steps)
+	for i := 1; i <= steps; i++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		weight := from + (target-from)*i/steps
+		if err := setMemberV2Weight(ctx, networkingClient, poolID, memberID, weight, timeout); err != nil {
+			return fmt.Errorf("error during slow start of member %s: %w", memberID, err)
+		}
+	}
+	return nil
+}
+
+// drainMemberV2 stops sending new requests to the member and waits for
+// the existing connections to finish
+func drainMemberV2(ctx context.Context, networkingClient *golangsdk.ServiceClient, poolID, memberID string, drainTimeout, timeout time.Duration) error {
+	if err := setMemberV2Weight(ctx, networkingClient, poolID, memberID, 0, timeout); err != nil {
+		return fmt.Errorf("error draining member %s: %w", memberID, err)
+	}
+	log.Printf("[DEBUG] Waiting %s for connections to member %s to drain", drainTimeout, memberID)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(drainTimeout):
+	}
+	return nil
 }
 
 func resourceMemberV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -194,29 +308,61 @@ func resourceMemberV2Update(ctx context.Context, d *schema.ResourceData, meta in
 		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
 	}
 
+	poolID := d.Get("pool_id").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
 	var updateOpts pools.UpdateMemberOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
+	// the raised weight is reached gradually with slow start as on creation
+	var ramp bool
+	var rampFrom int
+	slowStart := time.Duration(d.Get("slow_start_duration").(int)) * time.Second
 	if d.HasChange("weight") {
-		updateOpts.Weight = d.Get("weight").(int)
+		oldWeight, newWeight := d.GetChange("weight")
+		if slowStart > 0 && newWeight.(int) > oldWeight.(int) {
+			if err := checkMemberV2Duration("slow_start_duration", slowStart, schema.TimeoutUpdate, timeout); err != nil {
+				return diag.FromErr(err)
+			}
+			ramp = true
+			rampFrom = oldWeight.(int)
+		} else {
+			updateOpts.Weight = newWeight.(int)
+		}
 	}
 	if d.HasChange("admin_state_up") {
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
 
+	// slow start and draining settings are used by the provider only
+	if updateOpts != (pools.UpdateMemberOpts{}) {
+		if err := updateMemberV2(ctx, networkingClient, poolID, d.Id(), updateOpts, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if ramp {
+		if err := rampMemberV2Weight(ctx, networkingClient, poolID, d.Id(), rampFrom, d.Get("weight").(int), slowStart, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceMemberV2Read(ctx, d, meta)
+}
+
+func updateMemberV2(ctx context.Context, networkingClient *golangsdk.ServiceClient, poolID, memberID string, updateOpts pools.UpdateMemberOpts, timeout time.Duration) error {
 	// Wait for LB to become active before continuing
-	poolID := d.Get("pool_id").(string)
-	timeout := d.Timeout(schema.TimeoutUpdate)
-	err = waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
+	defer common.LockParents(common.Parent{Kind: common.ParentLBPool, ID: poolID})()
+	err := waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	log.Printf("[DEBUG] Updating member %s with options: %#v", d.Id(), updateOpts)
+	log.Printf("[DEBUG] Updating member %s with options: %#v", memberID, updateOpts)
 	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
-		_, err = pools.UpdateMember(networkingClient, poolID, d.Id(), updateOpts).Extract()
+		_, err = pools.UpdateMember(networkingClient, poolID, memberID, updateOpts).Extract()
 		if err != nil {
 			return common.CheckForRetryableError(err)
 		}
@@ -224,15 +370,10 @@ func resourceMemberV2Update(ctx context.Context, d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return fmterr.Errorf("Unable to update member %s: %s", d.Id(), err)
+		return fmt.Errorf("Unable to update member %s: %s", memberID, err)
 	}
 
-	err = waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return resourceMemberV2Read(ctx, d, meta)
+	return waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
 }
 
 func resourceMemberV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -242,9 +383,19 @@ func resourceMemberV2Delete(ctx context.Context, d *schema.ResourceData, meta in
 		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	// Wait for Pool to become active before continuing
 	poolID := d.Get("pool_id").(string)
 	timeout := d.Timeout(schema.TimeoutDelete)
+	if drainTimeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second; drainTimeout > 0 {
+		if err := checkMemberV2Duration("drain_timeout", drainTimeout, schema.TimeoutDelete, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := drainMemberV2(ctx, networkingClient, poolID, d.Id(), drainTimeout, timeout); err != nil {
+			return diag.FromErr(common.CheckDeleted(d, err, "member"))
+		}
+	}
+
+	// Wait for Pool to become active before continuing
+	defer common.LockParents(common.Parent{Kind: common.ParentLBPool, ID: poolID})()
 	err = waitForLBV2viaPool(ctx, networkingClient, poolID, "ACTIVE", timeout)
 	if err != nil {
 		return diag.FromErr(err)
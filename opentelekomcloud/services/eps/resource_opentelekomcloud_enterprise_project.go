@@ -0,0 +1,205 @@
+package eps
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const epsClientError = "error creating OpenTelekomCloud EPS client: %w"
+
+// enterpriseProject is not supported by the SDK, so EPS API is used directly
+type enterpriseProject struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      int    `json:"status"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+func ResourceEnterpriseProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnterpriseProjectCreate,
+		ReadContext:   resourceEnterpriseProjectRead,
+		UpdateContext: resourceEnterpriseProjectUpdate,
+		DeleteContext: resourceEnterpriseProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringNotInSlice([]string{"default"}, true),
+				),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getEnterpriseProject(client *golangsdk.ServiceClient, id string) (*enterpriseProject, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("enterprise-projects", id), &r.Body, nil)
+	project := new(enterpriseProject)
+	if err := r.ExtractIntoStructPtr(project, "enterprise_project"); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// setEnterpriseProjectEnabled enables or disables the enterprise project
+func setEnterpriseProjectEnabled(client *golangsdk.ServiceClient, id string, enable bool) error {
+	action := "disable"
+	if enable {
+		action = "enable"
+	}
+	_, err := client.Post(client.ServiceURL("enterprise-projects", id, "action"), map[string]interface{}{"action": action}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func resourceEnterpriseProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(epsClientError, err)
+	}
+
+	opts := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("enterprise-projects"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	project := new(enterpriseProject)
+	if err := r.ExtractIntoStructPtr(project, "enterprise_project"); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud enterprise project: %w", err)
+	}
+	d.SetId(project.ID)
+
+	if !d.Get("enable").(bool) {
+		if err := setEnterpriseProjectEnabled(client, d.Id(), false); err != nil {
+			return fmterr.Errorf("error disabling OpenTelekomCloud enterprise project: %w", err)
+		}
+	}
+
+	return resourceEnterpriseProjectRead(ctx, d, meta)
+}
+
+func resourceEnterpriseProjectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(epsClientError, err)
+	}
+
+	project, err := getEnterpriseProject(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "enterprise project"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("name", project.Name),
+		d.Set("description", project.Description),
+		d.Set("enable", project.Status == 1),
+		d.Set("status", project.Status),
+		d.Set("created_at", project.CreatedAt),
+		d.Set("updated_at", project.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting enterprise project fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEnterpriseProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(epsClientError, err)
+	}
+
+	if d.HasChanges("name", "description") {
+		opts := map[string]interface{}{
+			"name":        d.Get("name").(string),
+			"description": d.Get("description").(string),
+		}
+		_, err := client.Put(client.ServiceURL("enterprise-projects", d.Id()), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud enterprise project: %w", err)
+		}
+	}
+
+	if d.HasChange("enable") {
+		if err := setEnterpriseProjectEnabled(client, d.Id(), d.Get("enable").(bool)); err != nil {
+			return fmterr.Errorf("error changing OpenTelekomCloud enterprise project status: %w", err)
+		}
+	}
+
+	return resourceEnterpriseProjectRead(ctx, d, meta)
+}
+
+// resourceEnterpriseProjectDelete disables the enterprise project, as enterprise projects can't be deleted
+func resourceEnterpriseProjectDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(epsClientError, err)
+	}
+
+	project, err := getEnterpriseProject(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "enterprise project"))
+	}
+	if project.Status == 1 {
+		if err := setEnterpriseProjectEnabled(client, d.Id(), false); err != nil {
+			return fmterr.Errorf("error disabling OpenTelekomCloud enterprise project: %w", err)
+		}
+	}
+	log.Printf("[WARN] Enterprise project %s can't be deleted, it was disabled and removed from the state", d.Id())
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,52 @@
+package evs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/blockstorage/extensions/quotasets"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceBlockStorageQuotasV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBlockStorageQuotasV2Read,
+		Schema: common.QuotaSchema(
+			"volumes", "gigabytes", "snapshots", "backups", "backup_gigabytes",
+		),
+	}
+}
+
+func dataSourceBlockStorageQuotasV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.BlockStorageV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud block storage client: %w", err)
+	}
+
+	quotas, err := quotasets.GetUsage(client, client.ProjectID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving block storage quotas: %w", err)
+	}
+
+	d.SetId(client.ProjectID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		common.SetQuota(d, "volumes", quotas.Volumes.Limit, quotas.Volumes.InUse),
+		common.SetQuota(d, "gigabytes", quotas.Gigabytes.Limit, quotas.Gigabytes.InUse),
+		common.SetQuota(d, "snapshots", quotas.Snapshots.Limit, quotas.Snapshots.InUse),
+		common.SetQuota(d, "backups", quotas.Backups.Limit, quotas.Backups.InUse),
+		common.SetQuota(d, "backup_gigabytes", quotas.BackupGigabytes.Limit, quotas.BackupGigabytes.InUse),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting block storage quotas: %w", err)
+	}
+
+	return nil
+}
@@ -149,6 +149,7 @@ func ResourceBlockStorageVolumeV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -229,6 +230,10 @@ func resourceBlockStorageVolumeV2Create(ctx context.Context, d *schema.ResourceD
 	// Store the ID now
 	d.SetId(v.ID)
 
+	if err := common.MigrateEnterpriseProject(config, d, "disk", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceBlockStorageVolumeV2Read(ctx, d, meta)
 }
 
@@ -314,6 +319,10 @@ OUTER:
 		d.Set("wwn", v.WWN)
 	}
 
+	if err := common.ReadEnterpriseProject(config, d, "disk", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -361,6 +370,10 @@ func resourceBlockStorageVolumeV2Update(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "disk", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceBlockStorageVolumeV2Read(ctx, d, meta)
 }
 
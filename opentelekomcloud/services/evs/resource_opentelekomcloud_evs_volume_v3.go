@@ -138,6 +138,7 @@ func ResourceEvsStorageVolumeV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -154,16 +155,17 @@ func resourceEvsVolumeV3Create(ctx context.Context, d *schema.ResourceData, meta
 	}
 	tags := resourceContainerTags(d)
 	createOpts := &volumes.CreateOpts{
-		BackupID:         d.Get("backup_id").(string),
-		AvailabilityZone: d.Get("availability_zone").(string),
-		Description:      d.Get("description").(string),
-		Size:             d.Get("size").(int),
-		Name:             d.Get("name").(string),
-		SnapshotID:       d.Get("snapshot_id").(string),
-		ImageRef:         d.Get("image_id").(string),
-		VolumeType:       d.Get("volume_type").(string),
-		Multiattach:      d.Get("multiattach").(bool),
-		Tags:             tags,
+		BackupID:            d.Get("backup_id").(string),
+		AvailabilityZone:    d.Get("availability_zone").(string),
+		Description:         d.Get("description").(string),
+		Size:                d.Get("size").(int),
+		Name:                d.Get("name").(string),
+		SnapshotID:          d.Get("snapshot_id").(string),
+		ImageRef:            d.Get("image_id").(string),
+		VolumeType:          d.Get("volume_type").(string),
+		Multiattach:         d.Get("multiattach").(bool),
+		Tags:                tags,
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 	m := make(map[string]string)
 	if v, ok := d.GetOk("kms_id"); ok {
@@ -200,6 +202,7 @@ func resourceEvsVolumeV3Create(ctx context.Context, d *schema.ResourceData, meta
 		log.Printf("[INFO] Volume ID: %s", id)
 		// Store the ID now
 		d.SetId(id)
+
 		return resourceEvsVolumeV3Read(ctx, d, meta)
 	}
 	return fmterr.Errorf("unexpected conversion error in resourceEvsVolumeV3Create")
@@ -227,6 +230,7 @@ func resourceEvsVolumeV3Read(_ context.Context, d *schema.ResourceData, meta int
 		d.Set("snapshot_id", v.SnapshotID),
 		d.Set("volume_type", v.VolumeType),
 		d.Set("wwn", v.WWN),
+		d.Set("enterprise_project_id", v.EnterpriseProjectID),
 	)
 	if err := mErr.ErrorOrNil(); err != nil {
 		return diag.FromErr(err)
@@ -299,6 +303,10 @@ func resourceEvsVolumeV3Update(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "disk", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceEvsVolumeV3Read(ctx, d, meta)
 }
 
@@ -0,0 +1,103 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	gaussdbClientError = "error creating OpenTelekomCloud GaussDB client: %w"
+)
+
+// transitional statuses of the instances
+var gaussdbPendingStatuses = []string{"BUILD", "CREATING", "MODIFYING", "EXPANDING", "EXTENDING", "REBOOTING", "RESTORING", "BACKING UP"}
+
+type gaussdbDatastore struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+type gaussdbHA struct {
+	Mode            string `json:"mode"`
+	ReplicationMode string `json:"replication_mode"`
+	Consistency     string `json:"consistency"`
+}
+
+type gaussdbVolume struct {
+	Type string `json:"type"`
+	Size int    `json:"size"`
+}
+
+type gaussdbBackupStrategy struct {
+	StartTime string `json:"start_time"`
+	KeepDays  int    `json:"keep_days"`
+}
+
+type gaussdbNode struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Status           string `json:"status"`
+	Role             string `json:"role"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+type gaussdbInstance struct {
+	ID              string                `json:"id"`
+	Name            string                `json:"name"`
+	Status          string                `json:"status"`
+	Port            string                `json:"port"`
+	PrivateIPs      []string              `json:"private_ips"`
+	VpcID           string                `json:"vpc_id"`
+	SubnetID        string                `json:"subnet_id"`
+	SecurityGroupID string                `json:"security_group_id"`
+	FlavorRef       string                `json:"flavor_ref"`
+	Datastore       gaussdbDatastore      `json:"datastore"`
+	HA              gaussdbHA             `json:"ha"`
+	Volume          gaussdbVolume         `json:"volume"`
+	BackupStrategy  gaussdbBackupStrategy `json:"backup_strategy"`
+	Nodes           []gaussdbNode         `json:"nodes"`
+	DbUserName      string                `json:"db_user_name"`
+	TimeZone        string                `json:"time_zone"`
+	Created         string                `json:"created"`
+}
+
+func getGaussdbInstance(client *golangsdk.ServiceClient, id string) (*gaussdbInstance, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("instances")+"?id="+id, &r.Body, nil)
+	var instances []gaussdbInstance
+	if err := r.ExtractIntoSlicePtr(&instances, "instances"); err != nil {
+		return nil, err
+	}
+	for i := range instances {
+		if instances[i].ID == id {
+			return &instances[i], nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func waitForGaussdbInstance(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: gaussdbPendingStatuses,
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := getGaussdbInstance(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			if instance.Status == "FAILED" {
+				return instance, instance.Status, fmt.Errorf("instance is in FAILED status")
+			}
+			return instance, instance.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,150 @@
+package gaussdb
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+type gaussdbFlavor struct {
+	SpecCode         string   `json:"spec_code"`
+	Vcpus            string   `json:"vcpus"`
+	RAM              string   `json:"ram"`
+	Version          string   `json:"version"`
+	Mode             string   `json:"mode"`
+	AvailabilityZone []string `json:"availability_zone"`
+}
+
+func DataSourceGaussdbOpenGaussFlavorsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGaussdbOpenGaussFlavorsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ha_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					gaussdbModeDistributed, gaussdbModeCentralized,
+				}, false),
+			},
+			"vcpus": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"memory": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"spec_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGaussdbOpenGaussFlavorsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GaussDBOpenGaussV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gaussdbClientError, err)
+	}
+
+	query := url.Values{}
+	if v, ok := d.GetOk("version"); ok {
+		query.Set("version", v.(string))
+	}
+	if v, ok := d.GetOk("ha_mode"); ok {
+		query.Set("ha_mode", v.(string))
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("flavors")+"?"+query.Encode(), &r.Body, nil)
+	var allFlavors []gaussdbFlavor
+	if err := r.ExtractIntoSlicePtr(&allFlavors, "flavors"); err != nil {
+		return fmterr.Errorf("error listing GaussDB flavors: %w", err)
+	}
+
+	vcpus := d.Get("vcpus").(int)
+	memory := d.Get("memory").(int)
+	var ids []string
+	var flavors []map[string]interface{}
+	for _, flavor := range allFlavors {
+		// CPU and memory are returned as strings
+		flavorVcpus, _ := strconv.Atoi(flavor.Vcpus)
+		flavorMemory, _ := strconv.Atoi(flavor.RAM)
+		if vcpus != 0 && flavorVcpus != vcpus {
+			continue
+		}
+		if memory != 0 && flavorMemory != memory {
+			continue
+		}
+		ids = append(ids, flavor.SpecCode)
+		flavors = append(flavors, map[string]interface{}{
+			"spec_code":          flavor.SpecCode,
+			"vcpus":              flavorVcpus,
+			"memory":             flavorMemory,
+			"version":            flavor.Version,
+			"ha_mode":            flavor.Mode,
+			"availability_zones": flavor.AvailabilityZone,
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("flavors", flavors),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting GaussDB flavors fields: %w", err)
+	}
+
+	return nil
+}
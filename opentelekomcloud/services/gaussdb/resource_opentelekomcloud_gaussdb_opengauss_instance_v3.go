@@ -0,0 +1,558 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const (
+	gaussdbEngine = "GaussDB(for openGauss)"
+	// gaussdbModeDistributed is the HA mode of distributed instances with shards and coordinators
+	gaussdbModeDistributed = "enterprise"
+	// gaussdbModeCentralized is the HA mode of primary/standby instances
+	gaussdbModeCentralized = "centralization_standard"
+)
+
+func ResourceGaussdbOpenGaussInstanceV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussdbOpenGaussInstanceV3Create,
+		ReadContext:   resourceGaussdbOpenGaussInstanceV3Read,
+		UpdateContext: resourceGaussdbOpenGaussInstanceV3Update,
+		DeleteContext: resourceGaussdbOpenGaussInstanceV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			// shards, coordinators and storage can only be scaled out
+			for _, key := range []string{"sharding_num", "coordinator_num", "volume.0.size"} {
+				oldValue, newValue := d.GetChange(key)
+				if d.Id() != "" && newValue.(int) < oldValue.(int) {
+					return fmt.Errorf("%s can't be decreased from %d to %d", key, oldValue, newValue)
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"configuration_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"time_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"datastore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  gaussdbEngine,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"ha": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								gaussdbModeDistributed, gaussdbModeCentralized,
+							}, false),
+						},
+						"replication_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "sync",
+						},
+						"consistency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"strong", "eventual"}, false),
+						},
+					},
+				},
+			},
+			"volume": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(40),
+						},
+					},
+				},
+			},
+			"sharding_num": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 9),
+			},
+			"coordinator_num": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 9),
+			},
+			"replica_num": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3,
+			},
+			"backup_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"keep_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 732),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"db_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaussdbOpenGaussInstanceV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GaussDBOpenGaussV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gaussdbClientError, err)
+	}
+
+	ha := d.Get("ha").([]interface{})[0].(map[string]interface{})
+	volume := d.Get("volume").([]interface{})[0].(map[string]interface{})
+	datastore := map[string]interface{}{"type": gaussdbEngine}
+	if raw := d.Get("datastore").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		ds := raw[0].(map[string]interface{})
+		datastore["type"] = ds["engine"].(string)
+		if version := ds["version"].(string); version != "" {
+			datastore["version"] = version
+		}
+	}
+
+	opts := map[string]interface{}{
+		"name":              d.Get("name").(string),
+		"flavor_ref":        d.Get("flavor").(string),
+		"password":          d.Get("password").(string),
+		"availability_zone": d.Get("availability_zone").(string),
+		"vpc_id":            d.Get("vpc_id").(string),
+		"subnet_id":         d.Get("subnet_id").(string),
+		"security_group_id": d.Get("security_group_id").(string),
+		"datastore":         datastore,
+		"ha": map[string]interface{}{
+			"mode":             ha["mode"].(string),
+			"replication_mode": ha["replication_mode"].(string),
+			"consistency":      ha["consistency"].(string),
+		},
+		"volume": map[string]interface{}{
+			"type": volume["type"].(string),
+			"size": volume["size"].(int),
+		},
+	}
+	if ha["mode"].(string) == gaussdbModeDistributed {
+		opts["sharding_num"] = d.Get("sharding_num").(int)
+		opts["coordinator_num"] = d.Get("coordinator_num").(int)
+	}
+	if v, ok := d.GetOk("replica_num"); ok {
+		opts["replica_num"] = v.(int)
+	}
+	if v, ok := d.GetOk("port"); ok {
+		opts["port"] = strconv.Itoa(v.(int))
+	}
+	if v, ok := d.GetOk("configuration_id"); ok {
+		opts["configuration_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("time_zone"); ok {
+		opts["time_zone"] = v.(string)
+	}
+	if raw := d.Get("backup_strategy").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		opts["backup_strategy"] = expandGaussdbBackupStrategy(raw[0].(map[string]interface{}))
+	}
+	log.Printf("[DEBUG] Creating GaussDB instance %s", opts["name"])
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("instances"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := r.ExtractIntoStructPtr(&created, "instance"); err != nil {
+		return fmterr.Errorf("error creating GaussDB instance: %w", err)
+	}
+	d.SetId(created.ID)
+
+	if err := waitForGaussdbInstance(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for GaussDB instance %s to become active: %w", d.Id(), err)
+	}
+
+	return resourceGaussdbOpenGaussInstanceV3Read(ctx, d, meta)
+}
+
+func expandGaussdbBackupStrategy(raw map[string]interface{}) map[string]interface{} {
+	strategy := map[string]interface{}{
+		"start_time": raw["start_time"].(string),
+	}
+	if keepDays := raw["keep_days"].(int); keepDays != 0 {
+		strategy["keep_days"] = keepDays
+	}
+	return strategy
+}
+
+func resourceGaussdbOpenGaussInstanceV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GaussDBOpenGaussV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gaussdbClientError, err)
+	}
+
+	instance, err := getGaussdbInstance(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "GaussDB instance"))
+	}
+
+	nodes := make([]map[string]interface{}, len(instance.Nodes))
+	for i, node := range instance.Nodes {
+		nodes[i] = map[string]interface{}{
+			"id":                node.ID,
+			"name":              node.Name,
+			"status":            node.Status,
+			"role":              node.Role,
+			"availability_zone": node.AvailabilityZone,
+		}
+	}
+
+	// the numbers of shards and coordinators aren't returned by the API and are kept as configured
+	port, _ := strconv.Atoi(instance.Port)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", instance.Name),
+		d.Set("flavor", instance.FlavorRef),
+		d.Set("vpc_id", instance.VpcID),
+		d.Set("subnet_id", instance.SubnetID),
+		d.Set("security_group_id", instance.SecurityGroupID),
+		d.Set("port", port),
+		d.Set("time_zone", instance.TimeZone),
+		d.Set("datastore", []map[string]interface{}{
+			{
+				"engine":  instance.Datastore.Type,
+				"version": instance.Datastore.Version,
+			},
+		}),
+		d.Set("ha", []map[string]interface{}{
+			{
+				"mode":             instance.HA.Mode,
+				"replication_mode": instance.HA.ReplicationMode,
+				"consistency":      instance.HA.Consistency,
+			},
+		}),
+		d.Set("volume", []map[string]interface{}{
+			{
+				"type": instance.Volume.Type,
+				"size": instance.Volume.Size,
+			},
+		}),
+		d.Set("backup_strategy", []map[string]interface{}{
+			{
+				"start_time": instance.BackupStrategy.StartTime,
+				"keep_days":  instance.BackupStrategy.KeepDays,
+			},
+		}),
+		d.Set("status", instance.Status),
+		d.Set("private_ips", instance.PrivateIPs),
+		d.Set("db_user_name", instance.DbUserName),
+		d.Set("nodes", nodes),
+		d.Set("created_at", instance.Created),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting GaussDB instance fields: %w", err)
+	}
+
+	return nil
+}
+
+func gaussdbInstanceAction(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, action map[string]interface{}) error {
+	_, err := client.Post(client.ServiceURL("instances", d.Id(), "action"), action, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return err
+	}
+	return waitForGaussdbInstance(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
+
+func resourceGaussdbOpenGaussInstanceV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GaussDBOpenGaussV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gaussdbClientError, err)
+	}
+
+	if d.HasChange("name") {
+		opts := map[string]interface{}{"name": d.Get("name").(string)}
+		_, err := client.Put(client.ServiceURL("instances", d.Id(), "name"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error renaming GaussDB instance: %w", err)
+		}
+	}
+
+	if d.HasChange("password") {
+		opts := map[string]interface{}{"password": d.Get("password").(string)}
+		_, err := client.Post(client.ServiceURL("instances", d.Id(), "password"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error resetting password of GaussDB instance: %w", err)
+		}
+	}
+
+	if d.HasChange("backup_strategy") {
+		raw := d.Get("backup_strategy").([]interface{})
+		if len(raw) > 0 && raw[0] != nil {
+			policy := expandGaussdbBackupStrategy(raw[0].(map[string]interface{}))
+			policy["period"] = "1,2,3,4,5,6,7"
+			opts := map[string]interface{}{"backup_policy": policy}
+			_, err := client.Put(client.ServiceURL("instances", d.Id(), "backups", "policy"), opts, nil, &golangsdk.RequestOpts{
+				OkCodes: []int{200, 202},
+			})
+			if err != nil {
+				return fmterr.Errorf("error updating backup strategy of GaussDB instance: %w", err)
+			}
+		}
+	}
+
+	if d.HasChanges("sharding_num", "coordinator_num") {
+		oldShards, newShards := d.GetChange("sharding_num")
+		oldCoordinators, newCoordinators := d.GetChange("coordinator_num")
+		expand := make(map[string]interface{})
+		if delta := newShards.(int) - oldShards.(int); delta > 0 {
+			expand["shard"] = map[string]interface{}{"count": delta}
+		}
+		if delta := newCoordinators.(int) - oldCoordinators.(int); delta > 0 {
+			// new coordinators are placed in the first zone of the instance
+			az := strings.Split(d.Get("availability_zone").(string), ",")[0]
+			coordinators := make([]map[string]interface{}, delta)
+			for i := range coordinators {
+				coordinators[i] = map[string]interface{}{"az_code": az}
+			}
+			expand["coordinators"] = coordinators
+		}
+		log.Printf("[DEBUG] Scaling out GaussDB instance %s: %#v", d.Id(), expand)
+		if err := gaussdbInstanceAction(ctx, client, d, map[string]interface{}{"expand_cluster": expand}); err != nil {
+			return fmterr.Errorf("error scaling out GaussDB instance %s: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("volume.0.size") {
+		action := map[string]interface{}{
+			"enlarge_volume": map[string]interface{}{"size": d.Get("volume.0.size").(int)},
+		}
+		if err := gaussdbInstanceAction(ctx, client, d, action); err != nil {
+			return fmterr.Errorf("error enlarging volume of GaussDB instance %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceGaussdbOpenGaussInstanceV3Read(ctx, d, meta)
+}
+
+func resourceGaussdbOpenGaussInstanceV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GaussDBOpenGaussV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gaussdbClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("instances", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting GaussDB instance"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: append([]string{"ACTIVE", "DELETING"}, gaussdbPendingStatuses...),
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := getGaussdbInstance(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return d.Id(), "DELETED", nil
+				}
+				return nil, "", err
+			}
+			return instance, instance.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for GaussDB instance %s to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,104 @@
+package ges
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	gesClientError = "error creating OpenTelekomCloud GES client: %w"
+)
+
+// graph statuses are returned as numeric codes
+const (
+	gesStatusPreparing = "100"
+	gesStatusRunning   = "200"
+	gesStatusStopped   = "201"
+	gesStatusUpgrading = "204"
+	gesStatusResizing  = "205"
+	gesStatusAbnormal  = "300"
+	gesStatusFailed    = "303"
+	gesStatusDeleted   = "400"
+	gesStatusBackingUp = "800"
+)
+
+// gesGraphSize describes the graph size type of the `graph_size_type_index` parameter
+type gesGraphSize struct {
+	Index    string
+	Name     string
+	MaxEdges int
+}
+
+// gesGraphSizes is the list of the graph size types offered by the service
+var gesGraphSizes = []gesGraphSize{
+	{Index: "0", Name: "10K edges", MaxEdges: 10000},
+	{Index: "1", Name: "1M edges", MaxEdges: 1000000},
+	{Index: "2", Name: "10M edges", MaxEdges: 10000000},
+	{Index: "3", Name: "100M edges", MaxEdges: 100000000},
+	{Index: "4", Name: "1B edges", MaxEdges: 1000000000},
+}
+
+func gesGraphSizeIndexes() []string {
+	indexes := make([]string, len(gesGraphSizes))
+	for i, size := range gesGraphSizes {
+		indexes[i] = size.Index
+	}
+	return indexes
+}
+
+type gesEncryptInfo struct {
+	Enable      bool   `json:"enable"`
+	MasterKeyID string `json:"master_key_id,omitempty"`
+}
+
+type gesGraph struct {
+	ID                 string         `json:"id"`
+	Name               string         `json:"name"`
+	Description        string         `json:"description"`
+	Status             string         `json:"status"`
+	GraphSizeTypeIndex string         `json:"graph_size_type_index"`
+	VpcID              string         `json:"vpc_id"`
+	SubnetID           string         `json:"subnet_id"`
+	SecurityGroupID    string         `json:"security_group_id"`
+	AzCode             string         `json:"az_code"`
+	PrivateIP          string         `json:"private_ip"`
+	PublicIP           string         `json:"public_ip"`
+	IsMultiAz          bool           `json:"is_multi_az"`
+	EncryptInfo        gesEncryptInfo `json:"encrypt_info"`
+	Created            string         `json:"created"`
+}
+
+func getGesGraph(client *golangsdk.ServiceClient, id string) (*gesGraph, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("graphs", id), &r.Body, nil)
+	graph := new(gesGraph)
+	if err := r.ExtractIntoStructPtr(graph, "graph"); err != nil {
+		return nil, err
+	}
+	if graph.Status == gesStatusDeleted {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return graph, nil
+}
+
+func waitForGesGraph(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{gesStatusPreparing, gesStatusUpgrading, gesStatusResizing, gesStatusBackingUp},
+		Target:  []string{gesStatusRunning},
+		Refresh: func() (interface{}, string, error) {
+			graph, err := getGesGraph(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			return graph, graph.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
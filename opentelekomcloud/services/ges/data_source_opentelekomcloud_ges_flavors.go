@@ -0,0 +1,72 @@
+package ges
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+// DataSourceGesFlavors lists the graph size types matching the expected number of edges
+func DataSourceGesFlavors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGesFlavorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"min_edges": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"graph_size_type_index": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_edges": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGesFlavorsRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	minEdges := d.Get("min_edges").(int)
+
+	var ids []string
+	var flavors []map[string]interface{}
+	for _, size := range gesGraphSizes {
+		if size.MaxEdges < minEdges {
+			continue
+		}
+		ids = append(ids, size.Index)
+		flavors = append(flavors, map[string]interface{}{
+			"graph_size_type_index": size.Index,
+			"name":                  size.Name,
+			"max_edges":             size.MaxEdges,
+		})
+	}
+	if len(flavors) == 0 {
+		return fmterr.Errorf("no GES graph size type supports %d edges", minEdges)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	if err := d.Set("flavors", flavors); err != nil {
+		return fmterr.Errorf("error setting GES flavors: %w", err)
+	}
+	return nil
+}
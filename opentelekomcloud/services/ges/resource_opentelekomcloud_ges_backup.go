@@ -0,0 +1,211 @@
+package ges
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceGesBackup manages the manual backup of the GES graph
+func ResourceGesBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGesBackupCreate,
+		ReadContext:   resourceGesBackupRead,
+		DeleteContext: resourceGesBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGesBackupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"graph_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type gesBackup struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	BackupMethod string `json:"backup_method"`
+	GraphID      string `json:"graph_id"`
+	Status       string `json:"status"`
+	Size         int    `json:"size"`
+	Encrypted    bool   `json:"encrypted"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+}
+
+// getGesBackup searches the backup in the list, the service has no API to get the single backup
+func getGesBackup(client *golangsdk.ServiceClient, graphID, id string) (*gesBackup, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("graphs", graphID, "backups"), &r.Body, nil)
+	var backups []gesBackup
+	if err := r.ExtractIntoSlicePtr(&backups, "backup_list"); err != nil {
+		return nil, err
+	}
+	for _, backup := range backups {
+		if backup.ID == id {
+			return &backup, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceGesBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gesClientError, err)
+	}
+
+	graphID := d.Get("graph_id").(string)
+	// only one operation at a time can be done on the graph
+	if err := waitForGesGraph(ctx, client, graphID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for GES graph %s to become running: %w", graphID, err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("graphs", graphID, "backups"), nil, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var created struct {
+		ID string `json:"backup_id"`
+	}
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error creating GES backup: %w", err)
+	}
+	d.SetId(created.ID)
+	log.Printf("[DEBUG] Created GES backup %s of graph %s", d.Id(), graphID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"backing_up"},
+		Target:  []string{"success"},
+		Refresh: func() (interface{}, string, error) {
+			backup, err := getGesBackup(client, graphID, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			return backup, backup.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for GES backup %s to complete: %w", d.Id(), err)
+	}
+
+	return resourceGesBackupRead(ctx, d, meta)
+}
+
+func resourceGesBackupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gesClientError, err)
+	}
+
+	backup, err := getGesBackup(client, d.Get("graph_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "GES backup"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", backup.Name),
+		d.Set("backup_method", backup.BackupMethod),
+		d.Set("status", backup.Status),
+		d.Set("size", backup.Size),
+		d.Set("encrypted", backup.Encrypted),
+		d.Set("start_time", backup.StartTime),
+		d.Set("end_time", backup.EndTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting GES backup fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGesBackupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gesClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("graphs", d.Get("graph_id").(string), "backups", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "GES backup"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGesBackupImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for GES backup, must be <graph_id>/<backup_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("graph_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,278 @@
+package ges
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceGesGraph manages the graph instance of Graph Engine Service
+func ResourceGesGraph() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGesGraphCreate,
+		ReadContext:   resourceGesGraphRead,
+		UpdateContext: resourceGesGraphUpdate,
+		DeleteContext: resourceGesGraphDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(4, 50),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z][\w-]*$`), "must start with a letter and contain only letters, digits, hyphens and underscores"),
+				),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"graph_size_type_index": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(gesGraphSizeIndexes(), false),
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"eip_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enable_multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"keep_backup": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGesGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gesClientError, err)
+	}
+
+	graph := map[string]interface{}{
+		"name":                  d.Get("name").(string),
+		"description":           d.Get("description").(string),
+		"graph_size_type_index": d.Get("graph_size_type_index").(string),
+		"vpc_id":                d.Get("vpc_id").(string),
+		"subnet_id":             d.Get("subnet_id").(string),
+		"security_group_id":     d.Get("security_group_id").(string),
+		"enable_multi_az":       d.Get("enable_multi_az").(bool),
+	}
+	if eipID := d.Get("eip_id").(string); eipID != "" {
+		graph["public_ip"] = map[string]interface{}{
+			"public_bind_type": "bind_existing",
+			"eip_id":           eipID,
+		}
+	}
+	if keyID := d.Get("kms_key_id").(string); keyID != "" {
+		graph["encrypt_info"] = gesEncryptInfo{
+			Enable:      true,
+			MasterKeyID: keyID,
+		}
+	}
+	body := map[string]interface{}{"graph": graph}
+	log.Printf("[DEBUG] Creating GES graph: %#v", body)
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("graphs"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error creating GES graph: %w", err)
+	}
+	d.SetId(created.ID)
+
+	if err := waitForGesGraph(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for GES graph %s to become running: %w", d.Id(), err)
+	}
+
+	return resourceGesGraphRead(ctx, d, meta)
+}
+
+func resourceGesGraphRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gesClientError, err)
+	}
+
+	graph, err := getGesGraph(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "GES graph"))
+	}
+	log.Printf("[DEBUG] Retrieved GES graph %s: %#v", d.Id(), graph)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", graph.Name),
+		d.Set("description", graph.Description),
+		d.Set("graph_size_type_index", graph.GraphSizeTypeIndex),
+		d.Set("vpc_id", graph.VpcID),
+		d.Set("subnet_id", graph.SubnetID),
+		d.Set("security_group_id", graph.SecurityGroupID),
+		d.Set("enable_multi_az", graph.IsMultiAz),
+		d.Set("kms_key_id", graph.EncryptInfo.MasterKeyID),
+		d.Set("status", graph.Status),
+		d.Set("availability_zone", graph.AzCode),
+		d.Set("private_ip", graph.PrivateIP),
+		d.Set("public_ip", graph.PublicIP),
+		d.Set("created_at", graph.Created),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting GES graph fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGesGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gesClientError, err)
+	}
+
+	if d.HasChange("graph_size_type_index") {
+		body := map[string]interface{}{
+			"resize": map[string]interface{}{
+				"graph_size_type_index": d.Get("graph_size_type_index").(string),
+			},
+		}
+		log.Printf("[DEBUG] Resizing GES graph %s: %#v", d.Id(), body)
+		_, err = client.Post(client.ServiceURL("graphs", d.Id(), "resize"), body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error resizing GES graph %s: %w", d.Id(), err)
+		}
+		if err := waitForGesGraph(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmterr.Errorf("error waiting for GES graph %s to be resized: %w", d.Id(), err)
+		}
+	}
+
+	return resourceGesGraphRead(ctx, d, meta)
+}
+
+func resourceGesGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.GesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(gesClientError, err)
+	}
+
+	url := client.ServiceURL("graphs", d.Id())
+	if d.Get("keep_backup").(bool) {
+		url += "?keep_backup=true"
+	}
+	_, err = client.Delete(url, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "GES graph"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{gesStatusRunning, gesStatusStopped, gesStatusAbnormal, gesStatusFailed},
+		Target:  []string{gesStatusDeleted},
+		Refresh: func() (interface{}, string, error) {
+			graph, err := getGesGraph(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return graph, gesStatusDeleted, nil
+				}
+				return nil, "", err
+			}
+			return graph, graph.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for GES graph %s to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,197 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/federation/mappings"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/groups"
+)
+
+// providerProtocol is the federation protocol of the identity provider
+type providerProtocol struct {
+	ID        string `json:"id,omitempty"`
+	MappingID string `json:"mapping_id"`
+}
+
+// providerMetadata is the SAML metadata of the identity provider
+type providerMetadata struct {
+	DomainID     string `json:"domain_id"`
+	XAccountType string `json:"xaccount_type"`
+	Metadata     string `json:"metadata,omitempty"`
+	Data         string `json:"data,omitempty"`
+	EntityID     string `json:"entity_id,omitempty"`
+}
+
+// providerOIDCConfig is the OpenID Connect configuration of the identity provider
+type providerOIDCConfig struct {
+	AccessMode            string `json:"access_mode"`
+	IdpURL                string `json:"idp_url"`
+	ClientID              string `json:"client_id"`
+	AuthorizationEndpoint string `json:"authorization_endpoint,omitempty"`
+	Scope                 string `json:"scope,omitempty"`
+	ResponseType          string `json:"response_type,omitempty"`
+	ResponseMode          string `json:"response_mode,omitempty"`
+	SigningKey            string `json:"signing_key"`
+}
+
+func protocolURL(client *golangsdk.ServiceClient, providerID, protocol string) string {
+	return client.ServiceURL("OS-FEDERATION", "identity_providers", providerID, "protocols", protocol)
+}
+
+// metadataURL uses `v3-ext` API which is not present in the catalog
+func metadataURL(client *golangsdk.ServiceClient, providerID, protocol string) string {
+	url := client.ServiceURL("OS-FEDERATION", "identity_providers", providerID, "protocols", protocol, "metadata")
+	return strings.Replace(url, "/v3/", "/v3-ext/", 1)
+}
+
+// oidcConfigURL requires client created with `IdentityV30Client`
+func oidcConfigURL(client *golangsdk.ServiceClient, providerID string) string {
+	return client.ServiceURL("OS-FEDERATION", "identity-providers", providerID, "openid-connect-config")
+}
+
+func listProviderProtocols(client *golangsdk.ServiceClient, providerID string) ([]providerProtocol, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("OS-FEDERATION", "identity_providers", providerID, "protocols"), &r.Body, nil)
+	var protocols []providerProtocol
+	if err := r.ExtractIntoSlicePtr(&protocols, "protocols"); err != nil {
+		return nil, err
+	}
+	return protocols, nil
+}
+
+func createProviderProtocol(client *golangsdk.ServiceClient, providerID, protocol, mappingID string) error {
+	body := map[string]interface{}{
+		"protocol": providerProtocol{MappingID: mappingID},
+	}
+	_, err := client.Put(protocolURL(client, providerID, protocol), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func updateProviderProtocol(client *golangsdk.ServiceClient, providerID, protocol, mappingID string) error {
+	body := map[string]interface{}{
+		"protocol": providerProtocol{MappingID: mappingID},
+	}
+	_, err := client.Patch(protocolURL(client, providerID, protocol), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func getProviderProtocol(client *golangsdk.ServiceClient, providerID, protocol string) (*providerProtocol, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(protocolURL(client, providerID, protocol), &r.Body, nil)
+	p := new(providerProtocol)
+	if err := r.ExtractIntoStructPtr(p, "protocol"); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func deleteProviderProtocol(client *golangsdk.ServiceClient, providerID, protocol string) error {
+	_, err := client.Delete(protocolURL(client, providerID, protocol), nil)
+	return err
+}
+
+func uploadProviderMetadata(client *golangsdk.ServiceClient, providerID, protocol, domainID, metadata string) error {
+	body := providerMetadata{
+		DomainID: domainID,
+		Metadata: metadata,
+	}
+	_, err := client.Post(metadataURL(client, providerID, protocol), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func getProviderMetadata(client *golangsdk.ServiceClient, providerID, protocol string) (*providerMetadata, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(metadataURL(client, providerID, protocol), &r.Body, nil)
+	m := new(providerMetadata)
+	if err := r.ExtractInto(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func createProviderOIDCConfig(client *golangsdk.ServiceClient, providerID string, opts providerOIDCConfig) error {
+	body := map[string]interface{}{
+		"openid_connect_config": opts,
+	}
+	_, err := client.Post(oidcConfigURL(client, providerID), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func updateProviderOIDCConfig(client *golangsdk.ServiceClient, providerID string, opts providerOIDCConfig) error {
+	body := map[string]interface{}{
+		"openid_connect_config": opts,
+	}
+	_, err := client.Put(oidcConfigURL(client, providerID), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func getProviderOIDCConfig(client *golangsdk.ServiceClient, providerID string) (*providerOIDCConfig, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(oidcConfigURL(client, providerID), &r.Body, nil)
+	c := new(providerOIDCConfig)
+	if err := r.ExtractIntoStructPtr(c, "openid_connect_config"); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// checkFederatedGroup checks that federated users of the provider are mapped to the group
+func checkFederatedGroup(client *golangsdk.ServiceClient, providerID, groupID string) error {
+	group, err := groups.Get(client, groupID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving group %s: %w", groupID, err)
+	}
+
+	protocols, err := listProviderProtocols(client, providerID)
+	if err != nil {
+		return fmt.Errorf("error listing protocols of identity provider %s: %w", providerID, err)
+	}
+	for _, protocol := range protocols {
+		mapping, err := mappings.Get(client, protocol.MappingID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving mapping %s: %w", protocol.MappingID, err)
+		}
+		for _, rule := range mapping.Rules {
+			for _, local := range rule.Local {
+				if local.Group != nil && local.Group.Name == group.Name {
+					return nil
+				}
+				if mappedGroupsContain(local.Groups, group.Name) {
+					return nil
+				}
+			}
+		}
+	}
+	return fmt.Errorf("group %s is not mapped by any rule of identity provider %s", group.Name, providerID)
+}
+
+// mappedGroupsContain checks if `groups` value of the local rule contains the group name.
+// The value is either JSON array or comma-separated list of group names.
+func mappedGroupsContain(groupsValue, name string) bool {
+	if groupsValue == "" {
+		return false
+	}
+	var names []string
+	if err := json.Unmarshal([]byte(groupsValue), &names); err != nil {
+		names = strings.Split(groupsValue, ",")
+	}
+	for _, n := range names {
+		if strings.TrimSpace(n) == name {
+			return true
+		}
+	}
+	return false
+}
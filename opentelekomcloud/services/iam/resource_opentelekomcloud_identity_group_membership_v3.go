@@ -37,6 +37,12 @@ func ResourceIdentityGroupMembershipV3() *schema.Resource {
 				Required: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -85,8 +91,10 @@ func resourceIdentityGroupMembershipV3Read(_ context.Context, d *schema.Resource
 		return fmterr.Errorf("Unable to retrieve users: %s", err)
 	}
 
+	// authoritative membership reports all users of the group, so users added out of band are removed
+	authoritative := d.Get("authoritative").(bool)
 	for _, u := range allUsers {
-		if userList.Contains(u.ID) {
+		if authoritative || userList.Contains(u.ID) {
 			ul = append(ul, u.ID)
 		}
 	}
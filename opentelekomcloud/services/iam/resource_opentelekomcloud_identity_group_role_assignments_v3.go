@@ -0,0 +1,240 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/roles"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceIdentityGroupRoleAssignmentsV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityGroupRoleAssignmentsV3Create,
+		ReadContext:   resourceIdentityGroupRoleAssignmentsV3Read,
+		UpdateContext: resourceIdentityGroupRoleAssignmentsV3Update,
+		DeleteContext: resourceIdentityGroupRoleAssignmentsV3Delete,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_assignment": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type groupRoleAssignment struct {
+	RoleID    string
+	ProjectID string
+	DomainID  string
+}
+
+func expandGroupRoleAssignments(set *schema.Set) ([]groupRoleAssignment, error) {
+	assignments := make([]groupRoleAssignment, 0, set.Len())
+	for _, raw := range set.List() {
+		a := raw.(map[string]interface{})
+		assignment := groupRoleAssignment{
+			RoleID:    a["role_id"].(string),
+			ProjectID: a["project_id"].(string),
+			DomainID:  a["domain_id"].(string),
+		}
+		if (assignment.ProjectID == "") == (assignment.DomainID == "") {
+			return nil, fmt.Errorf("exactly one of `project_id` or `domain_id` must be set for role %s", assignment.RoleID)
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, nil
+}
+
+func assignGroupRoles(client *golangsdk.ServiceClient, groupID string, assignments []groupRoleAssignment) error {
+	for _, a := range assignments {
+		opts := roles.AssignOpts{
+			GroupID:   groupID,
+			ProjectID: a.ProjectID,
+			DomainID:  a.DomainID,
+		}
+		if err := roles.Assign(client, a.RoleID, opts).ExtractErr(); err != nil {
+			return fmt.Errorf("error assigning role %s: %w", a.RoleID, err)
+		}
+	}
+	return nil
+}
+
+func unassignGroupRoles(client *golangsdk.ServiceClient, groupID string, assignments []groupRoleAssignment) error {
+	for _, a := range assignments {
+		opts := roles.UnassignOpts{
+			GroupID:   groupID,
+			ProjectID: a.ProjectID,
+			DomainID:  a.DomainID,
+		}
+		if err := roles.Unassign(client, a.RoleID, opts).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error unassigning role %s: %w", a.RoleID, err)
+		}
+	}
+	return nil
+}
+
+func resourceIdentityGroupRoleAssignmentsV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmterr.Errorf(clientCreationFail, err)
+	}
+
+	assignments, err := expandGroupRoleAssignments(d.Get("role_assignment").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupID := d.Get("group_id").(string)
+	if err := assignGroupRoles(client, groupID, assignments); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(groupID)
+
+	return resourceIdentityGroupRoleAssignmentsV3Read(ctx, d, meta)
+}
+
+func resourceIdentityGroupRoleAssignmentsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmterr.Errorf(clientCreationFail, err)
+	}
+
+	if _, err := groups.Get(client, d.Id()).Extract(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] Group %s not found, removing role assignments from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmterr.Errorf("error retrieving group %s: %w", d.Id(), err)
+	}
+
+	// Role assignments can be listed only per scope, so all roles of every managed scope are read
+	type scope struct {
+		ProjectID string
+		DomainID  string
+	}
+	scopes := make(map[scope]bool)
+	for _, raw := range d.Get("role_assignment").(*schema.Set).List() {
+		a := raw.(map[string]interface{})
+		scopes[scope{ProjectID: a["project_id"].(string), DomainID: a["domain_id"].(string)}] = true
+	}
+
+	var result []map[string]interface{}
+	for s := range scopes {
+		opts := roles.ListAssignmentsOpts{
+			GroupID:        d.Id(),
+			ScopeProjectID: s.ProjectID,
+			ScopeDomainID:  s.DomainID,
+		}
+		pages, err := roles.ListAssignments(client, opts).AllPages()
+		if err != nil {
+			return fmterr.Errorf("error listing role assignments of group %s: %w", d.Id(), err)
+		}
+		assignments, err := roles.ExtractRoleAssignments(pages)
+		if err != nil {
+			return fmterr.Errorf("error extracting role assignments of group %s: %w", d.Id(), err)
+		}
+		for _, a := range assignments {
+			result = append(result, map[string]interface{}{
+				"role_id":    a.ID,
+				"project_id": s.ProjectID,
+				"domain_id":  s.DomainID,
+			})
+		}
+	}
+
+	if err := d.Set("group_id", d.Id()); err != nil {
+		return fmterr.Errorf("error setting group_id: %w", err)
+	}
+	if err := d.Set("role_assignment", result); err != nil {
+		return fmterr.Errorf("error setting role_assignment: %w", err)
+	}
+
+	return nil
+}
+
+func resourceIdentityGroupRoleAssignmentsV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmterr.Errorf(clientCreationFail, err)
+	}
+
+	if d.HasChange("role_assignment") {
+		o, n := d.GetChange("role_assignment")
+		oldSet := o.(*schema.Set)
+		newSet := n.(*schema.Set)
+
+		remove, err := expandGroupRoleAssignments(oldSet.Difference(newSet))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		add, err := expandGroupRoleAssignments(newSet.Difference(oldSet))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := unassignGroupRoles(client, d.Id(), remove); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := assignGroupRoles(client, d.Id(), add); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIdentityGroupRoleAssignmentsV3Read(ctx, d, meta)
+}
+
+func resourceIdentityGroupRoleAssignmentsV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmterr.Errorf(clientCreationFail, err)
+	}
+
+	assignments, err := expandGroupRoleAssignments(d.Get("role_assignment").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := unassignGroupRoles(client, d.Id(), assignments); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -35,13 +35,69 @@ func ResourceIdentityMappingV3() *schema.Resource {
 			},
 			"rules": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"rules", "rule"},
 				ValidateFunc: common.ValidateJsonString,
 				StateFunc: func(v interface{}) string {
 					jsonString, _ := common.NormalizeJsonString(v)
 					return jsonString
 				},
 			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"group": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"groups": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"remote": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"any_one_of": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"not_any_of": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"regex": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"links": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -58,10 +114,8 @@ func resourceIdentityMappingV3Create(ctx context.Context, d *schema.ResourceData
 		return fmterr.Errorf(clientCreationFail, err)
 	}
 
-	rulesRaw := d.Get("rules").(string)
-	rulesBytes := []byte(rulesRaw)
-	rules := make([]mappings.RuleOpts, 1)
-	if err := json.Unmarshal(rulesBytes, &rules); err != nil {
+	rules, err := expandMappingRules(d)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -103,6 +157,12 @@ func resourceIdentityMappingV3Read(_ context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	if len(d.Get("rule").([]interface{})) > 0 {
+		if err := d.Set("rule", flattenMappingRules(mapping.Rules)); err != nil {
+			return fmterr.Errorf("error setting identity mapping rule: %w", err)
+		}
+	}
+
 	if err := d.Set("mapping_id", mapping.ID); err != nil {
 		return diag.FromErr(err)
 	}
@@ -123,12 +183,10 @@ func resourceIdentityMappingV3Update(ctx context.Context, d *schema.ResourceData
 	changes := false
 	updateOpts := mappings.UpdateOpts{}
 
-	if d.HasChange("rules") {
+	if d.HasChanges("rules", "rule") {
 		changes = true
-		rulesRaw := d.Get("rules").(string)
-		rulesBytes := []byte(rulesRaw)
-		rules := make([]mappings.RuleOpts, 1)
-		if err := json.Unmarshal(rulesBytes, &rules); err != nil {
+		rules, err := expandMappingRules(d)
+		if err != nil {
 			return diag.FromErr(err)
 		}
 		updateOpts.Rules = rules
@@ -156,3 +214,82 @@ func resourceIdentityMappingV3Delete(_ context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+// expandMappingRules builds mapping rules either from JSON `rules` or from typed `rule` blocks
+func expandMappingRules(d *schema.ResourceData) ([]mappings.RuleOpts, error) {
+	typedRules := d.Get("rule").([]interface{})
+	if len(typedRules) == 0 {
+		rules := make([]mappings.RuleOpts, 1)
+		if err := json.Unmarshal([]byte(d.Get("rules").(string)), &rules); err != nil {
+			return nil, err
+		}
+		return rules, nil
+	}
+
+	rules := make([]mappings.RuleOpts, len(typedRules))
+	for i, raw := range typedRules {
+		rule := raw.(map[string]interface{})
+
+		for _, l := range rule["local"].([]interface{}) {
+			local := l.(map[string]interface{})
+			opts := mappings.LocalRuleOpts{
+				Groups: local["groups"].(string),
+			}
+			if user := local["user"].(string); user != "" {
+				opts.User = &mappings.UserOpts{Name: user}
+			}
+			if group := local["group"].(string); group != "" {
+				opts.Group = &mappings.GroupOpts{Name: group}
+			}
+			rules[i].Local = append(rules[i].Local, opts)
+		}
+
+		for _, r := range rule["remote"].([]interface{}) {
+			remote := r.(map[string]interface{})
+			opts := mappings.RemoteRuleOpts{
+				Type:     remote["type"].(string),
+				AnyOneOf: common.ExpandToStringSlice(remote["any_one_of"].([]interface{})),
+				NotAnyOf: common.ExpandToStringSlice(remote["not_any_of"].([]interface{})),
+			}
+			if regex := remote["regex"].(bool); regex {
+				opts.Regex = &regex
+			}
+			rules[i].Remote = append(rules[i].Remote, opts)
+		}
+	}
+	return rules, nil
+}
+
+func flattenMappingRules(rules []mappings.Rule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		locals := make([]map[string]interface{}, len(rule.Local))
+		for j, local := range rule.Local {
+			locals[j] = map[string]interface{}{
+				"groups": local.Groups,
+			}
+			if local.User != nil {
+				locals[j]["user"] = local.User.Name
+			}
+			if local.Group != nil {
+				locals[j]["group"] = local.Group.Name
+			}
+		}
+
+		remotes := make([]map[string]interface{}, len(rule.Remote))
+		for j, remote := range rule.Remote {
+			remotes[j] = map[string]interface{}{
+				"type":       remote.Type,
+				"any_one_of": remote.AnyOneOf,
+				"not_any_of": remote.NotAnyOf,
+				"regex":      remote.Regex,
+			}
+		}
+
+		result[i] = map[string]interface{}{
+			"local":  locals,
+			"remote": remotes,
+		}
+	}
+	return result
+}
@@ -2,13 +2,16 @@ package iam
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/federation/providers"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -39,6 +42,76 @@ func ResourceIdentityProviderV3() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"saml", "oidc"}, false),
+				RequiredWith: []string{"mapping_id"},
+			},
+			"mapping_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"protocol"},
+			},
+			"metadata": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"access_config"},
+			},
+			"access_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"metadata"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"program", "program_console"}, false),
+						},
+						"provider_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"signing_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: common.ValidateJsonString,
+							StateFunc: func(v interface{}) string {
+								jsonString, _ := common.NormalizeJsonString(v)
+								return jsonString
+							},
+						},
+						"authorization_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"scopes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"response_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "id_token",
+						},
+						"response_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"fragment", "form_post"}, false),
+						},
+					},
+				},
+			},
 
 			"remote_ids": {
 				Type:     schema.TypeSet,
@@ -75,9 +148,89 @@ func resourceIdentityProviderV3Create(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(p.ID)
 
+	if protocol := d.Get("protocol").(string); protocol != "" {
+		if err := createProviderProtocol(client, d.Id(), protocol, d.Get("mapping_id").(string)); err != nil {
+			return fmterr.Errorf("error creating identity provider protocol: %w", err)
+		}
+	}
+
+	if diagErr := setupProviderAccess(d, config); diagErr != nil {
+		return diagErr
+	}
+
 	return resourceIdentityProviderV3Read(ctx, d, meta)
 }
 
+// setupProviderAccess uploads SAML metadata or creates OIDC configuration of the provider
+func setupProviderAccess(d *schema.ResourceData, config *cfg.Config) diag.Diagnostics {
+	protocol := d.Get("protocol").(string)
+
+	if metadata := d.Get("metadata").(string); metadata != "" {
+		if protocol != "saml" {
+			return fmterr.Errorf("`metadata` can be used only with `saml` protocol")
+		}
+		client, err := config.IdentityV3Client()
+		if err != nil {
+			return fmterr.Errorf(clientCreationFail, err)
+		}
+		if err := uploadProviderMetadata(client, d.Id(), protocol, client.DomainID, metadata); err != nil {
+			return fmterr.Errorf("error uploading identity provider metadata: %w", err)
+		}
+	}
+
+	if _, ok := d.GetOk("access_config"); ok {
+		if protocol != "oidc" {
+			return fmterr.Errorf("`access_config` can be used only with `oidc` protocol")
+		}
+		client, err := config.IdentityV30Client()
+		if err != nil {
+			return fmterr.Errorf(clientCreationFail, err)
+		}
+		opts := expandProviderOIDCConfig(d)
+		oldConfig, _ := d.GetChange("access_config")
+		if len(oldConfig.([]interface{})) == 0 {
+			err = createProviderOIDCConfig(client, d.Id(), opts)
+		} else {
+			err = updateProviderOIDCConfig(client, d.Id(), opts)
+		}
+		if err != nil {
+			return fmterr.Errorf("error setting identity provider access config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func expandProviderOIDCConfig(d *schema.ResourceData) providerOIDCConfig {
+	accessConfig := d.Get("access_config.0").(map[string]interface{})
+	scopes := common.ExpandToStringSlice(accessConfig["scopes"].([]interface{}))
+	return providerOIDCConfig{
+		AccessMode:            accessConfig["access_type"].(string),
+		IdpURL:                accessConfig["provider_url"].(string),
+		ClientID:              accessConfig["client_id"].(string),
+		AuthorizationEndpoint: accessConfig["authorization_endpoint"].(string),
+		Scope:                 strings.Join(scopes, " "),
+		ResponseType:          accessConfig["response_type"].(string),
+		ResponseMode:          accessConfig["response_mode"].(string),
+		SigningKey:            accessConfig["signing_key"].(string),
+	}
+}
+
+func flattenProviderOIDCConfig(oidcConfig *providerOIDCConfig) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"access_type":            oidcConfig.AccessMode,
+			"provider_url":           oidcConfig.IdpURL,
+			"client_id":              oidcConfig.ClientID,
+			"authorization_endpoint": oidcConfig.AuthorizationEndpoint,
+			"scopes":                 strings.Fields(oidcConfig.Scope),
+			"response_type":          oidcConfig.ResponseType,
+			"response_mode":          oidcConfig.ResponseMode,
+			"signing_key":            oidcConfig.SigningKey,
+		},
+	}
+}
+
 func resourceIdentityProviderV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.IdentityV3Client(config.GetRegion(d))
@@ -102,6 +255,29 @@ func resourceIdentityProviderV3Read(_ context.Context, d *schema.ResourceData, m
 		d.Set("links", p.Links),
 	)
 
+	if protocol := d.Get("protocol").(string); protocol != "" {
+		pr, err := getProviderProtocol(client, d.Id(), protocol)
+		if err != nil {
+			return fmterr.Errorf("error reading identity provider protocol: %w", err)
+		}
+		mErr = multierror.Append(mErr, d.Set("mapping_id", pr.MappingID))
+
+		if protocol == "oidc" {
+			client30, err := config.IdentityV30Client()
+			if err != nil {
+				return fmterr.Errorf(clientCreationFail, err)
+			}
+			oidcConfig, err := getProviderOIDCConfig(client30, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); !ok {
+					return fmterr.Errorf("error reading identity provider access config: %w", err)
+				}
+			} else {
+				mErr = multierror.Append(mErr, d.Set("access_config", flattenProviderOIDCConfig(oidcConfig)))
+			}
+		}
+	}
+
 	if err := mErr.ErrorOrNil(); err != nil {
 		return fmterr.Errorf("error setting identity provider fields: %w", err)
 	}
@@ -132,6 +308,19 @@ func resourceIdentityProviderV3Update(ctx context.Context, d *schema.ResourceDat
 		return fmterr.Errorf(providerError, "updating", err)
 	}
 
+	if d.HasChange("mapping_id") {
+		err := updateProviderProtocol(client, d.Id(), d.Get("protocol").(string), d.Get("mapping_id").(string))
+		if err != nil {
+			return fmterr.Errorf("error updating identity provider protocol: %w", err)
+		}
+	}
+
+	if d.HasChanges("metadata", "access_config") {
+		if diagErr := setupProviderAccess(d, config); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	return resourceIdentityProviderV3Read(ctx, d, meta)
 }
 
@@ -142,6 +331,14 @@ func resourceIdentityProviderV3Delete(_ context.Context, d *schema.ResourceData,
 		return fmterr.Errorf(clientCreationFail, err)
 	}
 
+	if protocol := d.Get("protocol").(string); protocol != "" {
+		if err := deleteProviderProtocol(client, d.Id(), protocol); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmterr.Errorf("error deleting identity provider protocol: %w", err)
+			}
+		}
+	}
+
 	if err := providers.Delete(client, d.Id()).ExtractErr(); err != nil {
 		return fmterr.Errorf(providerError, "deleting", err)
 	}
@@ -59,6 +59,13 @@ func ResourceIdentityRoleAssignmentV3() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 			},
+
+			"identity_provider_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"group_id"},
+			},
 		},
 	}
 }
@@ -75,6 +82,13 @@ func resourceIdentityRoleAssignmentV3Create(ctx context.Context, d *schema.Resou
 	projectID := d.Get("project_id").(string)
 	roleID := d.Get("role_id").(string)
 	userID := d.Get("user_id").(string)
+
+	if providerID := d.Get("identity_provider_id").(string); providerID != "" {
+		if err := checkFederatedGroup(identityClient, providerID, groupID); err != nil {
+			return fmterr.Errorf("error checking federated group: %w", err)
+		}
+	}
+
 	opts := roles.AssignOpts{
 		DomainID:  domainID,
 		GroupID:   groupID,
@@ -103,6 +117,11 @@ func resourceIdentityRoleAssignmentV3Read(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return fmterr.Errorf("error getting role assignment: %s", err)
 	}
+	if roleAssignment.ID == "" {
+		log.Printf("[WARN] Role assignment %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	domainID, projectID, groupID, userID, _ := ExtractRoleAssignmentID(d.Id())
 
 	log.Printf("[DEBUG] Retrieved OpenStack role assignment: %#v", roleAssignment)
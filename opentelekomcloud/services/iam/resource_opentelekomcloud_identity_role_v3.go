@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -15,6 +17,11 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
 
+var (
+	roleActionRegexp   = regexp.MustCompile(`^([a-z0-9]+|\*):[a-zA-Z0-9*]+:[a-zA-Z0-9*]+$`)
+	roleResourceRegexp = regexp.MustCompile(`^[a-z0-9]+:[^:]*:[^:]*:[^:]+:.+$`)
+)
+
 func ResourceIdentityRoleV3() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIdentityRoleV3Create,
@@ -33,8 +40,9 @@ func ResourceIdentityRoleV3() *schema.Resource {
 			},
 
 			"display_layer": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"domain", "project"}, false),
 			},
 
 			"display_name": {
@@ -45,18 +53,53 @@ func ResourceIdentityRoleV3() *schema.Resource {
 			"statement": {
 				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 8,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"action": {
 							Type:     schema.TypeList,
 							Required: true,
+							MaxItems: 100,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
+								ValidateFunc: validation.StringMatch(roleActionRegexp,
+									"action must have `service:resource_type:action` format, e.g. `vpc:ports:create`"),
 							},
 						},
 						"effect": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+						},
+						"resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringMatch(roleResourceRegexp,
+									"resource must have `service:region:account_id:resource_type:resource_path` format, e.g. `obs:*:*:bucket:my-bucket`"),
+							},
+						},
+						"condition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -312,6 +355,26 @@ func expandIdentityRoleV3CreatePolicyStatement(d interface{}, arrayIndex map[str
 			transformed["Effect"] = effectProp
 		}
 
+		resourceProp, err := common.NavigateValue(d, []string{"statement", "resource"}, newArrayIndex)
+		if err != nil {
+			return nil, err
+		}
+		e, err = common.IsEmptyValue(reflect.ValueOf(resourceProp))
+		if err != nil {
+			return nil, err
+		}
+		if !e {
+			transformed["Resource"] = resourceProp
+		}
+
+		conditionProp, err := common.NavigateValue(d, []string{"statement", "condition"}, newArrayIndex)
+		if err != nil {
+			return nil, err
+		}
+		if conditions := expandIdentityRoleV3Condition(conditionProp); len(conditions) > 0 {
+			transformed["Condition"] = conditions
+		}
+
 		req = append(req, transformed)
 	}
 
@@ -473,6 +536,26 @@ func expandIdentityRoleV3UpdatePolicyStatement(d interface{}, arrayIndex map[str
 			transformed["Effect"] = effectProp
 		}
 
+		resourceProp, err := common.NavigateValue(d, []string{"statement", "resource"}, newArrayIndex)
+		if err != nil {
+			return nil, err
+		}
+		e, err = common.IsEmptyValue(reflect.ValueOf(resourceProp))
+		if err != nil {
+			return nil, err
+		}
+		if !e {
+			transformed["Resource"] = resourceProp
+		}
+
+		conditionProp, err := common.NavigateValue(d, []string{"statement", "condition"}, newArrayIndex)
+		if err != nil {
+			return nil, err
+		}
+		if conditions := expandIdentityRoleV3Condition(conditionProp); len(conditions) > 0 {
+			transformed["Condition"] = conditions
+		}
+
 		req = append(req, transformed)
 	}
 
@@ -616,11 +699,65 @@ func flattenIdentityRoleV3Statement(d interface{}, arrayIndex map[string]int, cu
 			return nil, fmt.Errorf("error reading Role:effect, err: %s", err)
 		}
 		r["effect"] = effectProp
+
+		// `Resource` and `Condition` are missing when they are not set
+		resourceProp, _ := common.NavigateValue(d, []string{"read", "policy", "Statement", "Resource"}, newArrayIndex)
+		r["resource"] = resourceProp
+
+		conditionProp, _ := common.NavigateValue(d, []string{"read", "policy", "Statement", "Condition"}, newArrayIndex)
+		r["condition"] = flattenIdentityRoleV3Condition(conditionProp)
 	}
 
 	return result, nil
 }
 
+// expandIdentityRoleV3Condition converts condition blocks to `{"operator": {"key": ["values"]}}` structure
+func expandIdentityRoleV3Condition(v interface{}) map[string]interface{} {
+	var conditions []interface{}
+	switch c := v.(type) {
+	case *schema.Set:
+		conditions = c.List()
+	case []interface{}:
+		conditions = c
+	}
+
+	result := make(map[string]interface{})
+	for _, raw := range conditions {
+		condition := raw.(map[string]interface{})
+		operator := condition["operator"].(string)
+		keys, ok := result[operator].(map[string]interface{})
+		if !ok {
+			keys = make(map[string]interface{})
+			result[operator] = keys
+		}
+		keys[condition["key"].(string)] = condition["values"]
+	}
+	return result
+}
+
+func flattenIdentityRoleV3Condition(v interface{}) []interface{} {
+	conditions, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var result []interface{}
+	for operator, rawKeys := range conditions {
+		keys, ok := rawKeys.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, values := range keys {
+			result = append(result, map[string]interface{}{
+				"operator": operator,
+				"key":      key,
+				"values":   values,
+			})
+		}
+	}
+	return result
+}
+
 func flattenIdentityRoleV3DisplayLayer(d interface{}, arrayIndex map[string]int, _ interface{}) (interface{}, error) {
 	v, err := common.NavigateValue(d, []string{"read", "type"}, arrayIndex)
 	if err != nil {
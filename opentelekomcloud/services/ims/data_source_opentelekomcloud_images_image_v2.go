@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/imageservice/v2/images"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -263,7 +264,7 @@ func dataSourceImagesImageV2Read(_ context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(mErr)
 	}
 
-	return nil
+	return common.ImageDeprecationWarning(image.Name, string(image.Status), image.Properties)
 }
 
 type imageSort []images.Image
@@ -0,0 +1,263 @@
+package ims
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/imageservice/v2/images"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceImagesImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceImagesImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"public", "private", "shared", "community",
+				}, false),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"size_min": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"size_max": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"visibility": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"container_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disk_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"min_disk_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"min_ram_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"checksum": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"properties": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// imageMatches checks that the image has all the tags and properties
+func imageMatches(image images.Image, tags []string, properties map[string]string) bool {
+	imageTags := make(map[string]bool, len(image.Tags))
+	for _, tag := range image.Tags {
+		imageTags[tag] = true
+	}
+	for _, tag := range tags {
+		if !imageTags[tag] {
+			return false
+		}
+	}
+	for key, value := range properties {
+		imageValue, ok := image.Properties[key]
+		if !ok || fmt.Sprint(imageValue) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourceImagesImagesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ImageV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud IMSv2 client: %w", err)
+	}
+
+	tags := common.ExpandToStringSlice(d.Get("tags").(*schema.Set).List())
+	listOpts := images.ListOpts{
+		Name:       d.Get("name").(string),
+		Visibility: resourceImagesImageV2VisibilityFromString(d.Get("visibility").(string)),
+		Owner:      d.Get("owner").(string),
+		Status:     images.ImageStatusActive,
+		SizeMin:    int64(d.Get("size_min").(int)),
+		SizeMax:    int64(d.Get("size_max").(int)),
+	}
+	// the API supports filtering by a single tag only, the rest are checked below
+	if len(tags) > 0 {
+		listOpts.Tag = tags[0]
+	}
+	log.Printf("[DEBUG] List Options: %#v", listOpts)
+
+	allPages, err := images.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to query images: %s", err)
+	}
+	allImages, err := images.ExtractImages(allPages)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve images: %s", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	properties := resourceImagesImageV2ExpandProperties(d.Get("properties").(map[string]interface{}))
+
+	var filteredImages []images.Image
+	for _, image := range allImages {
+		if nameRegex != nil && !nameRegex.MatchString(image.Name) {
+			continue
+		}
+		if !imageMatches(image, tags, properties) {
+			continue
+		}
+		filteredImages = append(filteredImages, image)
+	}
+
+	// newest images go first
+	sort.SliceStable(filteredImages, func(i, j int) bool {
+		return filteredImages[i].CreatedAt.After(filteredImages[j].CreatedAt)
+	})
+	if d.Get("most_recent").(bool) && len(filteredImages) > 1 {
+		filteredImages = filteredImages[:1]
+	}
+
+	ids := make([]string, 0, len(filteredImages))
+	result := make([]map[string]interface{}, 0, len(filteredImages))
+	for _, image := range filteredImages {
+		imageProperties := make(map[string]string, len(image.Properties))
+		for key, value := range image.Properties {
+			imageProperties[key] = fmt.Sprint(value)
+		}
+		ids = append(ids, image.ID)
+		result = append(result, map[string]interface{}{
+			"id":               image.ID,
+			"name":             image.Name,
+			"owner":            image.Owner,
+			"visibility":       string(image.Visibility),
+			"container_format": image.ContainerFormat,
+			"disk_format":      image.DiskFormat,
+			"min_disk_gb":      image.MinDiskGigabytes,
+			"min_ram_mb":       image.MinRAMMegabytes,
+			"size_bytes":       image.SizeBytes,
+			"checksum":         image.Checksum,
+			"tags":             image.Tags,
+			"properties":       imageProperties,
+			"created_at":       image.CreatedAt.String(),
+			"updated_at":       image.UpdatedAt.String(),
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("images", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting images fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package ims
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/imageservice/v2/images"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceImagesSharedImagesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceImagesSharedImagesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"member_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "pending",
+				ValidateFunc: validation.StringInSlice([]string{
+					"pending", "accepted", "rejected", "all",
+				}, false),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disk_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"min_disk_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceImagesSharedImagesV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ImageV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud IMSv2 client: %w", err)
+	}
+
+	listOpts := images.ListOpts{
+		Visibility:   images.ImageVisibilityShared,
+		MemberStatus: images.ImageMemberStatus(d.Get("member_status").(string)),
+		Owner:        d.Get("owner").(string),
+		Name:         d.Get("name").(string),
+	}
+	log.Printf("[DEBUG] List Options: %#v", listOpts)
+
+	allPages, err := images.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to query images: %s", err)
+	}
+	allImages, err := images.ExtractImages(allPages)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve images: %s", err)
+	}
+
+	var ids []string
+	var sharedImages []map[string]interface{}
+	for _, image := range allImages {
+		// own images shared with other projects are listed as well
+		if image.Owner == client.ProjectID {
+			continue
+		}
+		ids = append(ids, image.ID)
+		sharedImages = append(sharedImages, map[string]interface{}{
+			"id":          image.ID,
+			"name":        image.Name,
+			"owner":       image.Owner,
+			"status":      image.Status,
+			"disk_format": image.DiskFormat,
+			"min_disk_gb": image.MinDiskGigabytes,
+			"size_bytes":  image.SizeBytes,
+			"created_at":  image.CreatedAt.String(),
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("images", sharedImages),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting shared images fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package ims
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/imageservice/v2/members"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceImagesImageAccessAcceptV2 accepts the image shared with the current project.
+// Removing the resource rejects the image.
+func ResourceImagesImageAccessAcceptV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImagesImageAccessAcceptV2Create,
+		ReadContext:   resourceImagesImageAccessAcceptV2Read,
+		UpdateContext: resourceImagesImageAccessAcceptV2Update,
+		DeleteContext: resourceImagesImageAccessAcceptV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImagesImageAccessAcceptV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "accepted",
+				ValidateFunc: validation.StringInSlice([]string{
+					"accepted", "rejected",
+				}, false),
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesImageAccessAcceptV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ImageV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud IMSv2 client: %w", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := d.Get("member_id").(string)
+	if memberID == "" {
+		memberID = client.ProjectID
+	}
+
+	status := d.Get("status").(string)
+	log.Printf("[DEBUG] Setting status of image %s for member %s to %s", imageID, memberID, status)
+	if _, err := members.Update(client, imageID, memberID, members.UpdateOpts{Status: status}).Extract(); err != nil {
+		return fmterr.Errorf("error setting image member status: %w", err)
+	}
+	d.SetId(common.BuildComponentID(imageID, memberID))
+
+	return resourceImagesImageAccessAcceptV2Read(ctx, d, meta)
+}
+
+func resourceImagesImageAccessAcceptV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ImageV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud IMSv2 client: %w", err)
+	}
+
+	imageID, memberID := parseImageMemberID(d.Id())
+	member, err := members.Get(client, imageID, memberID).Extract()
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "image member"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("image_id", member.ImageID),
+		d.Set("member_id", member.MemberID),
+		d.Set("status", member.Status),
+		d.Set("created_at", member.CreatedAt.String()),
+		d.Set("updated_at", member.UpdatedAt.String()),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting image member fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceImagesImageAccessAcceptV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ImageV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud IMSv2 client: %w", err)
+	}
+
+	if d.HasChange("status") {
+		imageID, memberID := parseImageMemberID(d.Id())
+		updateOpts := members.UpdateOpts{Status: d.Get("status").(string)}
+		if _, err := members.Update(client, imageID, memberID, updateOpts).Extract(); err != nil {
+			return fmterr.Errorf("error updating image member status: %w", err)
+		}
+	}
+
+	return resourceImagesImageAccessAcceptV2Read(ctx, d, meta)
+}
+
+func resourceImagesImageAccessAcceptV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ImageV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud IMSv2 client: %w", err)
+	}
+
+	imageID, memberID := parseImageMemberID(d.Id())
+	if _, err := members.Update(client, imageID, memberID, members.UpdateOpts{Status: "rejected"}).Extract(); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "image member"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseImageMemberID(id string) (string, string) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return id, ""
+	}
+	return parts[0], parts[1]
+}
+
+func resourceImagesImageAccessAcceptV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, memberID := parseImageMemberID(d.Id()); memberID == "" {
+		return nil, fmt.Errorf("invalid format specified for image member, must be <image_id>/<member_id>")
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,221 @@
+package ims
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/imageservice/v2/images"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ims/v2/cloudimages"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceImsImageCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImsImageCopyCreate,
+		ReadContext:   resourceImsImageCopyRead,
+		DeleteContext: resourceImsImageCopyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"target_project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"agency_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// imsV1URL builds URL of IMS v1 API, which is served by the same endpoint as the v2 one
+func imsV1URL(client *golangsdk.ServiceClient, parts ...string) string {
+	v1Client := *client
+	v1Client.ResourceBase = v1Client.Endpoint + "v1/"
+	return v1Client.ServiceURL(parts...)
+}
+
+func resourceImsImageCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := config.ImageV2Client(region)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	sourceID := d.Get("source_image_id").(string)
+	targetRegion := d.Get("target_region").(string)
+	if targetRegion == "" {
+		targetRegion = region
+	}
+
+	opts := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+	}
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		opts["cmk_id"] = v.(string)
+	}
+
+	var copyURL string
+	if targetRegion == region {
+		copyURL = imsV1URL(client, "cloudimages", sourceID, "copy")
+	} else {
+		projectName := d.Get("target_project_name").(string)
+		if projectName == "" {
+			// default projects are named after the region
+			projectName = targetRegion
+		}
+		opts["region"] = targetRegion
+		opts["project_name"] = projectName
+		if v, ok := d.GetOk("agency_name"); ok {
+			opts["agency_name"] = v.(string)
+		}
+		copyURL = imsV1URL(client, "cloudimages", sourceID, "cross_region_copy")
+		if err := d.Set("target_project_name", projectName); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Copying image %s to region %s: %#v", sourceID, targetRegion, opts)
+	var r golangsdk.Result
+	_, r.Err = client.Post(copyURL, opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	job := new(cloudimages.JobResponse)
+	if err := r.ExtractInto(job); err != nil {
+		return fmterr.Errorf("error copying image %s: %s", sourceID, err)
+	}
+
+	if err := cloudimages.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutCreate)/time.Second), job.JobID); err != nil {
+		return fmterr.Errorf("error waiting for image %s to be copied: %s", sourceID, err)
+	}
+	entity, err := cloudimages.GetJobEntity(client, job.JobID, "image_id")
+	if err != nil {
+		return fmterr.Errorf("error getting ID of copied image: %s", err)
+	}
+	imageID := entity.(string)
+	d.SetId(imageID)
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("target_region", targetRegion),
+		d.Set("image_id", imageID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting image copy fields: %s", err)
+	}
+
+	return resourceImsImageCopyRead(ctx, d, meta)
+}
+
+func resourceImsImageCopyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	targetRegion := d.Get("target_region").(string)
+	client, err := config.ImageV2Client(targetRegion)
+	if err != nil {
+		// the token is scoped to the project of the provider, other regions may be not in its catalog
+		log.Printf("[WARN] Unable to read image %s in region %s, keeping it as is: %s", d.Id(), targetRegion, err)
+		return nil
+	}
+
+	img, err := GetCloudImage(client, d.Id())
+	if err != nil {
+		log.Printf("[WARN] Image %s not found in region %s, removing from state: %s", d.Id(), targetRegion, err)
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(
+		d.Set("image_id", img.ID),
+		d.Set("status", img.Status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting image copy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceImsImageCopyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	targetRegion := d.Get("target_region").(string)
+	client, err := config.ImageV2Client(targetRegion)
+	if err != nil {
+		d.SetId("")
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Copied image is not deleted",
+				Detail: fmt.Sprintf("Region %s is not reachable with the provider credentials (%s), "+
+					"delete image %s using a provider configured for that region", targetRegion, err, d.Id()),
+			},
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting copied image %s in region %s", d.Id(), targetRegion)
+	if err := images.Delete(client, d.Id()).Err; err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmterr.Errorf("error deleting image %s: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -3,8 +3,10 @@ package kms
 import (
 	"context"
 	"log"
+	"strconv"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
@@ -18,6 +20,11 @@ func DataSourceKmsDataKeyV1() *schema.Resource {
 		ReadContext: dataSourceKmsDataKeyV1Read,
 
 		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"key_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -33,18 +40,30 @@ func DataSourceKmsDataKeyV1() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"without_plaintext": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"cipher_text"},
+			},
 			"plain_text": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"cipher_text": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 		},
 	}
 }
 
+type decryptedDataKey struct {
+	DataKey       string `json:"data_key"`
+	DataKeyLength string `json:"datakey_length"`
+}
+
 func dataSourceKmsDataKeyV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 
@@ -53,20 +72,64 @@ func dataSourceKmsDataKeyV1Read(_ context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("error creating OpenTelekomCloud kms key client: %s", err)
 	}
 
+	keyID := d.Get("key_id").(string)
+
+	// existing DEK is decrypted, so the same key can be used on every run
+	if cipherText := d.Get("cipher_text").(string); cipherText != "" {
+		length, err := strconv.Atoi(d.Get("datakey_length").(string))
+		if err != nil {
+			return fmterr.Errorf("error parsing datakey_length: %w", err)
+		}
+		body := map[string]interface{}{
+			"key_id":                keyID,
+			"cipher_text":           cipherText,
+			"datakey_cipher_length": strconv.Itoa(length / 8),
+		}
+		if encryptionContext := d.Get("encryption_context").(string); encryptionContext != "" {
+			body["encryption_context"] = encryptionContext
+		}
+		log.Printf("[DEBUG] KMS decrypt data key for key: %s", keyID)
+		var dataKey decryptedDataKey
+		if err := keyAction(KmsDataKeyV1Client, "decrypt-datakey", body, &dataKey); err != nil {
+			return fmterr.Errorf("error decrypting KMS data key: %w", err)
+		}
+
+		d.SetId(time.Now().UTC().String())
+		mErr := multierror.Append(
+			d.Set("region", config.GetRegion(d)),
+			d.Set("plain_text", dataKey.DataKey),
+		)
+		if err := mErr.ErrorOrNil(); err != nil {
+			return fmterr.Errorf("error setting KMS data key fields: %w", err)
+		}
+		return nil
+	}
+
 	req := &keys.DataEncryptOpts{
-		KeyID:             d.Get("key_id").(string),
+		KeyID:             keyID,
 		EncryptionContext: d.Get("encryption_context").(string),
 		DatakeyLength:     d.Get("datakey_length").(string),
 	}
-	log.Printf("[DEBUG] KMS get data key for key: %s", d.Get("key_id").(string))
-	v, err := keys.DataEncryptGet(KmsDataKeyV1Client, req).ExtractDataKey()
+	log.Printf("[DEBUG] KMS get data key for key: %s", keyID)
+	var v *keys.DataKey
+	if d.Get("without_plaintext").(bool) {
+		v, err = keys.DataEncryptGetWithoutPlaintext(KmsDataKeyV1Client, req).ExtractDataKey()
+	} else {
+		v, err = keys.DataEncryptGet(KmsDataKeyV1Client, req).ExtractDataKey()
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(time.Now().UTC().String())
-	d.Set("plain_text", v.PlainText)
-	d.Set("cipher_text", v.CipherText)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("plain_text", v.PlainText),
+		d.Set("cipher_text", v.CipherText),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting KMS data key fields: %w", err)
+	}
 
 	return nil
 }
@@ -0,0 +1,88 @@
+package kms
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceKmsImportParametersV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKmsImportParametersV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"wrapping_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "RSAES_OAEP_SHA_256",
+				ValidateFunc: validation.StringInSlice([]string{
+					"RSAES_PKCS1_V1_5", "RSAES_OAEP_SHA_1", "RSAES_OAEP_SHA_256",
+				}, false),
+			},
+			"import_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsImportParametersV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	body := map[string]interface{}{
+		"key_id":             keyID,
+		"wrapping_algorithm": d.Get("wrapping_algorithm"),
+	}
+	var parameters struct {
+		ImportToken    string `json:"import_token"`
+		PublicKey      string `json:"public_key"`
+		ExpirationTime int64  `json:"expiration_time"`
+	}
+	if err := keyAction(client, "get-parameters-for-import", body, &parameters); err != nil {
+		return fmterr.Errorf("error getting import parameters of KMS key %s: %s", keyID, err)
+	}
+	d.SetId(keyID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("import_token", parameters.ImportToken),
+		d.Set("public_key", parameters.PublicKey),
+		d.Set("expiration_time", strconv.FormatInt(parameters.ExpirationTime, 10)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting KMS import parameters fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceKmsGrantV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKmsGrantV1Create,
+		ReadContext:   resourceKmsGrantV1Read,
+		DeleteContext: resourceKmsGrantV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKmsGrantV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"grantee_principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"operations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"create-datakey", "create-datakey-without-plaintext", "encrypt-datakey",
+						"decrypt-datakey", "describe-key", "retire-grant", "encrypt-data", "decrypt-data",
+					}, false),
+				},
+			},
+			"retiring_principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"issuing_principal": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type kmsGrant struct {
+	KeyID             string   `json:"key_id"`
+	GrantID           string   `json:"grant_id"`
+	Name              string   `json:"name"`
+	GranteePrincipal  string   `json:"grantee_principal"`
+	RetiringPrincipal string   `json:"retiring_principal"`
+	IssuingPrincipal  string   `json:"issuing_principal"`
+	Operations        []string `json:"operations"`
+	CreationDate      string   `json:"creation_date"`
+}
+
+func findKmsGrant(client *golangsdk.ServiceClient, keyID, grantID string) (*kmsGrant, error) {
+	marker := ""
+	for {
+		body := map[string]interface{}{
+			"key_id": keyID,
+			"limit":  "100",
+		}
+		if marker != "" {
+			body["marker"] = marker
+		}
+		var page struct {
+			Grants     []kmsGrant `json:"grants"`
+			NextMarker string     `json:"next_marker"`
+			Truncated  string     `json:"truncated"`
+		}
+		if err := keyAction(client, "list-grants", body, &page); err != nil {
+			return nil, err
+		}
+		for _, grant := range page.Grants {
+			if grant.GrantID == grantID {
+				return &grant, nil
+			}
+		}
+		if page.Truncated != "true" || page.NextMarker == "" {
+			return nil, nil
+		}
+		marker = page.NextMarker
+	}
+}
+
+func resourceKmsGrantV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	createOpts := map[string]interface{}{
+		"key_id":            keyID,
+		"grantee_principal": d.Get("grantee_principal"),
+		"operations":        common.ExpandToStringSlice(d.Get("operations").(*schema.Set).List()),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		createOpts["name"] = v
+	}
+	if v, ok := d.GetOk("retiring_principal"); ok {
+		createOpts["retiring_principal"] = v
+	}
+	log.Printf("[DEBUG] Creating KMS grant: %#v", createOpts)
+
+	var grant struct {
+		GrantID string `json:"grant_id"`
+	}
+	if err := keyAction(client, "create-grant", createOpts, &grant); err != nil {
+		return fmterr.Errorf("error creating KMS grant: %s", err)
+	}
+	d.SetId(grant.GrantID)
+
+	return resourceKmsGrantV1Read(ctx, d, meta)
+}
+
+func resourceKmsGrantV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	grant, err := findKmsGrant(client, d.Get("key_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "KMS grant"))
+	}
+	if grant == nil {
+		log.Printf("[WARN] KMS grant %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("key_id", grant.KeyID),
+		d.Set("name", grant.Name),
+		d.Set("grantee_principal", grant.GranteePrincipal),
+		d.Set("operations", grant.Operations),
+		d.Set("retiring_principal", grant.RetiringPrincipal),
+		d.Set("issuing_principal", grant.IssuingPrincipal),
+		d.Set("creation_date", grant.CreationDate),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting KMS grant fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceKmsGrantV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	body := map[string]interface{}{
+		"key_id":   d.Get("key_id"),
+		"grant_id": d.Id(),
+	}
+	if err := keyAction(client, "revoke-grant", body, nil); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "KMS grant"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKmsGrantV1Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for KMS grant, must be <key_id>/<grant_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("key_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,138 @@
+package kms
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceKmsKeyMaterialV1 imports key material to the key with `external` origin (BYOK).
+// Import token and key material are used only once, so their later changes are ignored.
+func ResourceKmsKeyMaterialV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKmsKeyMaterialV1Create,
+		ReadContext:   resourceKmsKeyMaterialV1Read,
+		DeleteContext: resourceKmsKeyMaterialV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"import_token": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressAfterImport,
+			},
+			"encrypted_key_material": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressAfterImport,
+			},
+			"expiration_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func suppressAfterImport(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func resourceKmsKeyMaterialV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	importOpts := map[string]interface{}{
+		"key_id":                 keyID,
+		"import_token":           d.Get("import_token"),
+		"encrypted_key_material": d.Get("encrypted_key_material"),
+	}
+	if v, ok := d.GetOk("expiration_time"); ok {
+		importOpts["expiration_time"] = v
+	}
+	log.Printf("[DEBUG] Importing key material of KMS key %s", keyID)
+
+	if err := keyAction(client, "import-key-material", importOpts, nil); err != nil {
+		return fmterr.Errorf("error importing key material: %s", err)
+	}
+	d.SetId(keyID)
+
+	return resourceKmsKeyMaterialV1Read(ctx, d, meta)
+}
+
+func resourceKmsKeyMaterialV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	key, err := keys.Get(client, d.Id()).ExtractKeyInfo()
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "KMS key"))
+	}
+
+	// key material has expired or was deleted, so it has to be imported again
+	if key.KeyState == PendingImportState || key.KeyState == PendingDeletionState {
+		log.Printf("[WARN] Key material of KMS key %s is not available, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("key_id", key.KeyID),
+		d.Set("key_state", key.KeyState),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting KMS key material fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceKmsKeyMaterialV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	if err := keyAction(client, "delete-imported-key-material", map[string]interface{}{"key_id": d.Id()}, nil); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "KMS key material"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -2,6 +2,7 @@ package kms
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
@@ -23,6 +25,10 @@ const (
 	EnabledState          = "2"
 	DisabledState         = "3"
 	PendingDeletionState  = "4"
+	PendingImportState    = "5"
+
+	originKMS      = "kms"
+	originExternal = "external"
 )
 
 func ResourceKmsKeyV1() *schema.Resource {
@@ -78,6 +84,26 @@ func ResourceKmsKeyV1() *schema.Resource {
 			},
 			"origin": {
 				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  originKMS,
+				ValidateFunc: validation.StringInSlice([]string{
+					originKMS, originExternal,
+				}, false),
+			},
+			"rotation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rotation_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(30, 365),
+			},
+			"rotation_number": {
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"pending_days": {
@@ -87,9 +113,30 @@ func ResourceKmsKeyV1() *schema.Resource {
 			},
 			"tags": common.TagsSchema(),
 		},
+
+		CustomizeDiff: validateKeyRotation,
 	}
 }
 
+// createKeyOpts extends keys.CreateOpts with the key origin
+type createKeyOpts struct {
+	KeyAlias       string `json:"key_alias" required:"true"`
+	KeyDescription string `json:"key_description,omitempty"`
+	Realm          string `json:"realm,omitempty"`
+	Origin         string `json:"origin,omitempty"`
+}
+
+func (opts createKeyOpts) ToKeyCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func validateKeyRotation(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("origin").(string) == originExternal && d.Get("rotation_enabled").(bool) {
+		return fmt.Errorf("rotation can't be enabled for the keys with `external` origin")
+	}
+	return nil
+}
+
 func resourceKmsKeyV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.KmsKeyV1Client(config.GetRegion(d))
@@ -97,10 +144,12 @@ func resourceKmsKeyV1Create(ctx context.Context, d *schema.ResourceData, meta in
 		return fmterr.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
 	}
 
-	createOpts := &keys.CreateOpts{
+	origin := d.Get("origin").(string)
+	createOpts := createKeyOpts{
 		KeyAlias:       d.Get("key_alias").(string),
 		KeyDescription: d.Get("key_description").(string),
 		Realm:          d.Get("realm").(string),
+		Origin:         origin,
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -113,9 +162,14 @@ func resourceKmsKeyV1Create(ctx context.Context, d *schema.ResourceData, meta in
 	// Wait for the key to become enabled.
 	log.Printf("[DEBUG] Waiting for key (%s) to become enabled", key.KeyID)
 
+	// external keys are waiting for the key material import
+	target := EnabledState
+	if origin == originExternal {
+		target = PendingImportState
+	}
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{WaitingForEnableState, DisabledState},
-		Target:     []string{EnabledState},
+		Target:     []string{target},
 		Refresh:    keyV1StateRefreshFunc(client, key.KeyID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
@@ -127,7 +181,7 @@ func resourceKmsKeyV1Create(ctx context.Context, d *schema.ResourceData, meta in
 		return fmterr.Errorf("error waiting for key (%s) to become ready: %s", key.KeyID, err)
 	}
 
-	if !d.Get("is_enabled").(bool) {
+	if !d.Get("is_enabled").(bool) && origin == originKMS {
 		disableKey, err := keys.DisableKey(client, key.KeyID).ExtractKeyInfo()
 		if err != nil {
 			return fmterr.Errorf("error disabling key: %s", err)
@@ -150,6 +204,17 @@ func resourceKmsKeyV1Create(ctx context.Context, d *schema.ResourceData, meta in
 	// Store the key ID now
 	d.SetId(key.KeyID)
 
+	if d.Get("rotation_enabled").(bool) {
+		if err := switchKeyRotation(client, key.KeyID, true); err != nil {
+			return fmterr.Errorf("error enabling key rotation: %s", err)
+		}
+		if v, ok := d.GetOk("rotation_interval"); ok {
+			if err := updateKeyRotationInterval(client, key.KeyID, v.(int)); err != nil {
+				return fmterr.Errorf("error setting key rotation interval: %s", err)
+			}
+		}
+	}
+
 	return resourceKmsKeyV1Read(ctx, d, meta)
 }
 
@@ -179,12 +244,28 @@ func resourceKmsKeyV1Read(_ context.Context, d *schema.ResourceData, meta interf
 		d.Set("key_description", key.KeyDescription),
 		d.Set("creation_date", key.CreationDate),
 		d.Set("scheduled_deletion_date", key.ScheduledDeletionDate),
-		d.Set("is_enabled", key.KeyState == EnabledState),
 		d.Set("default_key_flag", key.DefaultKeyFlag),
 		d.Set("expiration_time", key.ExpirationTime),
 		d.Set("origin", key.Origin),
 	)
 
+	// key without imported material can't be enabled, keep the configured value
+	if key.KeyState != PendingImportState {
+		mErr = multierror.Append(mErr, d.Set("is_enabled", key.KeyState == EnabledState))
+	}
+
+	if key.Origin != originExternal {
+		rotation, err := getKeyRotationStatus(client, d.Id())
+		if err != nil {
+			return fmterr.Errorf("error fetching OpenTelekomCloud KMS key rotation status: %s", err)
+		}
+		mErr = multierror.Append(mErr,
+			d.Set("rotation_enabled", rotation.Enabled),
+			d.Set("rotation_interval", rotation.Interval),
+			d.Set("rotation_number", rotation.Number),
+		)
+	}
+
 	if mErr.ErrorOrNil() != nil {
 		return diag.FromErr(mErr)
 	}
@@ -258,6 +339,18 @@ func resourceKmsKeyV1Update(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("rotation_enabled") {
+		if err := switchKeyRotation(client, d.Id(), d.Get("rotation_enabled").(bool)); err != nil {
+			return fmterr.Errorf("error switching key rotation: %s", err)
+		}
+	}
+
+	if d.HasChange("rotation_interval") && d.Get("rotation_enabled").(bool) {
+		if err := updateKeyRotationInterval(client, d.Id(), d.Get("rotation_interval").(int)); err != nil {
+			return fmterr.Errorf("error updating key rotation interval: %s", err)
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
 		if err := common.UpdateResourceTags(client, d, "kms", d.Id()); err != nil {
@@ -319,3 +412,45 @@ func keyV1StateRefreshFunc(client *golangsdk.ServiceClient, keyID string) resour
 		return v, v.KeyState, nil
 	}
 }
+
+type keyRotation struct {
+	Enabled  bool `json:"key_rotation_enabled"`
+	Interval int  `json:"rotation_interval"`
+	Number   int  `json:"number_of_rotations"`
+}
+
+// keyAction calls KMS action which is not supported by `kms/v1/keys` yet
+func keyAction(client *golangsdk.ServiceClient, action string, body interface{}, result interface{}) error {
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL(client.ProjectID, "kms", action), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if result == nil {
+		return r.Err
+	}
+	return r.ExtractInto(result)
+}
+
+func switchKeyRotation(client *golangsdk.ServiceClient, keyID string, enabled bool) error {
+	action := "disable-key-rotation"
+	if enabled {
+		action = "enable-key-rotation"
+	}
+	return keyAction(client, action, map[string]interface{}{"key_id": keyID}, nil)
+}
+
+func updateKeyRotationInterval(client *golangsdk.ServiceClient, keyID string, interval int) error {
+	body := map[string]interface{}{
+		"key_id":            keyID,
+		"rotation_interval": interval,
+	}
+	return keyAction(client, "update-key-rotation-interval", body, nil)
+}
+
+func getKeyRotationStatus(client *golangsdk.ServiceClient, keyID string) (*keyRotation, error) {
+	var rotation keyRotation
+	if err := keyAction(client, "get-key-rotation-status", map[string]interface{}{"key_id": keyID}, &rotation); err != nil {
+		return nil, err
+	}
+	return &rotation, nil
+}
@@ -0,0 +1,251 @@
+package lts
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// transferPeriods are the periods supported by the transfer for every unit
+var transferPeriods = map[string][]int{
+	"min":  {2, 5, 30},
+	"hour": {1, 3, 6, 12},
+}
+
+func ResourceLTSTransferV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTransferV2Create,
+		ReadContext:   resourceTransferV2Read,
+		UpdateContext: resourceTransferV2Update,
+		DeleteContext: resourceTransferV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: validateTransferPeriod,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"obs_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"period_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "min",
+				ValidateFunc: validation.StringInSlice([]string{"min", "hour"}, false),
+			},
+			"prefix_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dir_prefix_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"storage_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RAW",
+				ValidateFunc: validation.StringInSlice([]string{"RAW", "JSON"}, false),
+			},
+			"compress_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validation.StringInSlice([]string{"none", "gzip", "zip"}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+type logTransfer struct {
+	ID            string   `json:"log_dump_obs_id"`
+	LogGroupID    string   `json:"log_group_id"`
+	LogStreamIDs  []string `json:"log_stream_ids"`
+	ObsBucketName string   `json:"obs_bucket_name"`
+	Type          string   `json:"type"`
+	StorageFormat string   `json:"storage_format"`
+	CompressType  string   `json:"compress_type"`
+	SwitchOn      bool     `json:"switch_on"`
+	PrefixName    string   `json:"prefix_name"`
+	DirPrefixName string   `json:"dir_prefix_name"`
+	Period        int      `json:"period"`
+	PeriodUnit    string   `json:"period_unit"`
+}
+
+func validateTransferPeriod(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	unit := d.Get("period_unit").(string)
+	period := d.Get("period").(int)
+	for _, allowed := range transferPeriods[unit] {
+		if period == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("period %d is not supported for the `%s` unit, supported values are: %v",
+		period, unit, transferPeriods[unit])
+}
+
+func expandLogTransfer(d *schema.ResourceData) logTransfer {
+	return logTransfer{
+		LogGroupID:    d.Get("group_id").(string),
+		LogStreamIDs:  common.ExpandToStringSlice(d.Get("topic_ids").(*schema.Set).List()),
+		ObsBucketName: d.Get("obs_bucket_name").(string),
+		Type:          "cycle",
+		StorageFormat: d.Get("storage_format").(string),
+		CompressType:  d.Get("compress_type").(string),
+		SwitchOn:      d.Get("enabled").(bool),
+		PrefixName:    d.Get("prefix_name").(string),
+		DirPrefixName: d.Get("dir_prefix_name").(string),
+		Period:        d.Get("period").(int),
+		PeriodUnit:    d.Get("period_unit").(string),
+	}
+}
+
+func getLogTransfer(client *golangsdk.ServiceClient, id string) (*logTransfer, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("log-dump", "obs"), &r.Body, nil)
+	var transfers []logTransfer
+	if err := r.ExtractIntoSlicePtr(&transfers, "log_dump_obs"); err != nil {
+		return nil, err
+	}
+	for _, transfer := range transfers {
+		if transfer.ID == id {
+			return &transfer, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceTransferV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	createOpts := expandLogTransfer(d)
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("log-dump", "obs"), createOpts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var created logTransfer
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error creating log transfer: %s", err)
+	}
+
+	d.SetId(created.ID)
+	return resourceTransferV2Read(ctx, d, meta)
+}
+
+func resourceTransferV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	transfer, err := getLogTransfer(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "log transfer"))
+	}
+	log.Printf("[DEBUG] Retrieved log transfer %s: %#v", d.Id(), transfer)
+
+	compressType := transfer.CompressType
+	if compressType == "" {
+		compressType = "none"
+	}
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("group_id", transfer.LogGroupID),
+		d.Set("topic_ids", transfer.LogStreamIDs),
+		d.Set("obs_bucket_name", transfer.ObsBucketName),
+		d.Set("period", transfer.Period),
+		d.Set("period_unit", transfer.PeriodUnit),
+		d.Set("prefix_name", transfer.PrefixName),
+		d.Set("dir_prefix_name", transfer.DirPrefixName),
+		d.Set("storage_format", transfer.StorageFormat),
+		d.Set("compress_type", compressType),
+		d.Set("enabled", transfer.SwitchOn),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting log transfer fields: %s", err)
+	}
+	return nil
+}
+
+func resourceTransferV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	updateOpts := expandLogTransfer(d)
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+	_, err = client.Put(client.ServiceURL("log-dump", "obs", d.Id()), updateOpts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating log transfer: %s", err)
+	}
+
+	return resourceTransferV2Read(ctx, d, meta)
+}
+
+func resourceTransferV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("log-dump", "obs", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "Error deleting log transfer"))
+	}
+
+	d.SetId("")
+	return nil
+}
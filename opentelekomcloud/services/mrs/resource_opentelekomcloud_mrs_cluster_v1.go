@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/mrs/v1/cluster"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/mrs/v1/tags"
@@ -33,6 +34,7 @@ func ResourceMRSClusterV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -62,7 +64,6 @@ func ResourceMRSClusterV1() *schema.Resource {
 			"core_node_num": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 			"core_node_size": {
 				Type:     schema.TypeString,
@@ -174,6 +175,10 @@ func ResourceMRSClusterV1() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"agency_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"log_collection": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -327,8 +332,12 @@ func ResourceMRSClusterV1() *schema.Resource {
 						},
 						"fail_action": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
+							Default:  "errorout",
+							ValidateFunc: validation.StringInSlice([]string{
+								"continue", "errorout",
+							}, false),
 						},
 					},
 				},
@@ -624,7 +633,13 @@ func resourceClusterV1Create(ctx context.Context, d *schema.ResourceData, meta i
 		log.Printf("[DEBUG] Setting tags: %v", tagmap)
 		err = setTagForMrs(d, meta, clusterCreate.ClusterID, tagmap)
 		if err != nil {
-			log.Printf("[WARN] Error setting tags of MRS cluster:%s, err=%s", clusterCreate.ClusterID, err)
+			return fmterr.Errorf("error setting tags of MRS cluster %s: %s", clusterCreate.ClusterID, err)
+		}
+	}
+
+	if agency := d.Get("agency_name").(string); agency != "" {
+		if err := setAgencyForMrs(d, meta, agency); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -638,32 +653,98 @@ func resourceClusterV1Update(ctx context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf("error creating OpenTelekomCloud MRS client: %s", err)
 	}
 
-	oldTags, err := tags.Get(client, d.Id()).Extract()
-	if err != nil {
-		return fmterr.Errorf("error fetching OpenTelekomCloud MRS cluster tags: %s", err)
-	}
-	if len(oldTags.Tags) > 0 {
-		deleteopts := tags.BatchOpts{Action: tags.ActionDelete, Tags: oldTags.Tags}
-		deleteTags := tags.BatchAction(client, d.Id(), deleteopts)
-		if deleteTags.Err != nil {
-			return fmterr.Errorf("error updating OpenTelekomCloud MRS cluster tags: %s", deleteTags.Err)
+	if d.HasChange("tags") {
+		oldTagsRaw, newTagsRaw := d.GetChange("tags")
+		oldTags := oldTagsRaw.(map[string]interface{})
+		newTags := newTagsRaw.(map[string]interface{})
+
+		var removedTags []tags.Tag
+		for k, v := range oldTags {
+			if newValue, ok := newTags[k]; !ok || newValue != v {
+				removedTags = append(removedTags, tags.Tag{Key: k, Value: v.(string)})
+			}
+		}
+		if len(removedTags) > 0 {
+			deleteopts := tags.BatchOpts{Action: tags.ActionDelete, Tags: removedTags}
+			deleteTags := tags.BatchAction(client, d.Id(), deleteopts)
+			if deleteTags.Err != nil {
+				return fmterr.Errorf("error updating OpenTelekomCloud MRS cluster tags: %s", deleteTags.Err)
+			}
 		}
-	}
 
-	if common.HasFilledOpt(d, "tags") {
-		tagmap := d.Get("tags").(map[string]interface{})
-		if len(tagmap) > 0 {
-			log.Printf("[DEBUG] Setting tags: %v", tagmap)
-			err = setTagForMrs(d, meta, d.Id(), tagmap)
+		addedTags := make(map[string]interface{})
+		for k, v := range newTags {
+			if oldValue, ok := oldTags[k]; !ok || oldValue != v {
+				addedTags[k] = v
+			}
+		}
+		if len(addedTags) > 0 {
+			log.Printf("[DEBUG] Setting tags: %v", addedTags)
+			err = setTagForMrs(d, meta, d.Id(), addedTags)
 			if err != nil {
 				return fmterr.Errorf("error updating tags of MRS cluster:%s, err:%s", d.Id(), err)
 			}
 		}
 	}
 
+	if d.HasChange("agency_name") {
+		if err := setAgencyForMrs(d, meta, d.Get("agency_name").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("core_node_num") {
+		if err := resizeClusterV1(ctx, d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceClusterV1Read(ctx, d, meta)
 }
 
+// resizeClusterV1 scales the default core node group of the cluster in or out
+func resizeClusterV1(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oldNum, newNum := d.GetChange("core_node_num")
+	delta := newNum.(int) - oldNum.(int)
+	scaleType := "scale_out"
+	if delta < 0 {
+		scaleType = "scale_in"
+		delta = -delta
+	}
+
+	resizeOpts := map[string]interface{}{
+		"service_id": "",
+		"plan_id":    "",
+		"parameters": map[string]interface{}{
+			"order_id":   "",
+			"scale_type": scaleType,
+			"node_id":    "node_orderadd",
+			"node_group": "core_node_default_group",
+			"instances":  strconv.Itoa(delta),
+		},
+	}
+	log.Printf("[DEBUG] Resizing MRS cluster %s: %#v", d.Id(), resizeOpts)
+	_, err := client.Put(client.ServiceURL("cluster_infos", d.Id()), resizeOpts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmt.Errorf("error resizing MRS cluster %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"scaling-out", "scaling-in"},
+		Target:     []string{"running"},
+		Refresh:    ClusterStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for MRS cluster (%s) to be resized: %s", d.Id(), err)
+	}
+	return nil
+}
+
 func resourceClusterV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.MrsV1Client(config.GetRegion(d))
@@ -868,3 +949,23 @@ func setTagForMrs(d *schema.ResourceData, meta interface{}, instanceID string, t
 
 	return nil
 }
+
+// setAgencyForMrs binds the agency to ECS instances of the cluster, empty agency removes the mapping
+func setAgencyForMrs(d *schema.ResourceData, meta interface{}, agency string) error {
+	config := meta.(*cfg.Config)
+	client, err := config.MrsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud MRS v2 client: %s", err)
+	}
+
+	agencyOpts := map[string]interface{}{
+		"agency_name": agency,
+	}
+	_, err = client.Post(client.ServiceURL("clusters", d.Id(), "agency-mapping"), agencyOpts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting agency of MRS cluster %s: %s", d.Id(), err)
+	}
+	return nil
+}
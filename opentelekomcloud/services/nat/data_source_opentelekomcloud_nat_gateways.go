@@ -0,0 +1,101 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/natgateways"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceNatGateways() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNatGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"spec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: resourceNatGatewayV2ValidateSpec,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": common.TagsSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNatGatewaysRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud nat client: %w", err)
+	}
+
+	listOpts := natgateways.ListOpts{
+		Name:     d.Get("name").(string),
+		Status:   d.Get("status").(string),
+		Spec:     d.Get("spec").(string),
+		RouterID: d.Get("router_id").(string),
+	}
+	allPages, err := natgateways.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list Nat Gateways: %w", err)
+	}
+	gateways, err := natgateways.ExtractNatGateways(allPages)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve Nat Gateways: %w", err)
+	}
+
+	tagRaw := d.Get("tags").(map[string]interface{})
+	ids := make([]string, 0, len(gateways))
+	for _, gateway := range gateways {
+		if len(tagRaw) > 0 {
+			match, err := common.ResourceTagsMatch(client, "nat_gateways", gateway.ID, tagRaw)
+			if err != nil {
+				return fmterr.Errorf("error fetching tags of Nat Gateway %s: %w", gateway.ID, err)
+			}
+			if !match {
+				continue
+			}
+		}
+		ids = append(ids, gateway.ID)
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Nat Gateways fields: %w", err)
+	}
+
+	return nil
+}
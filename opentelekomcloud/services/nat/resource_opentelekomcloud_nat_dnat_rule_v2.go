@@ -222,12 +222,17 @@ func resourceNatDnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 	url = client.ServiceURL(url)
 
+	defer common.LockParents(common.Parent{Kind: common.ParentNatGateway, ID: d.Get("nat_gateway_id").(string)})()
+
 	r := golangsdk.Result{}
-	_, r.Err = client.Post(
-		url,
-		&map[string]interface{}{"dnat_rule": params},
-		&r.Body,
-		&golangsdk.RequestOpts{OkCodes: common.SuccessHTTPCodes})
+	r.Err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.Post(
+			url,
+			&map[string]interface{}{"dnat_rule": params},
+			&r.Body,
+			&golangsdk.RequestOpts{OkCodes: common.SuccessHTTPCodes})
+		return err
+	})
 	if r.Err != nil {
 		return fmterr.Errorf("error creating Dnat: %s", r.Err)
 	}
@@ -410,7 +415,7 @@ func resourceNatDnatRuleRead(_ context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceNatDnatRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceNatDnatRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.NatV2Client(config.GetRegion(d))
 	if err != nil {
@@ -424,11 +429,16 @@ func resourceNatDnatRuleDelete(_ context.Context, d *schema.ResourceData, meta i
 	url = client.ServiceURL(url)
 
 	log.Printf("[DEBUG] Deleting Dnat %q", d.Id())
+	defer common.LockParents(common.Parent{Kind: common.ParentNatGateway, ID: d.Get("nat_gateway_id").(string)})()
+
 	r := golangsdk.Result{}
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
-		OkCodes:      []int{204},
-		JSONResponse: nil,
-		MoreHeaders:  map[string]string{"Content-Type": "application/json"},
+	r.Err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := client.Delete(url, &golangsdk.RequestOpts{
+			OkCodes:      []int{204},
+			JSONResponse: nil,
+			MoreHeaders:  map[string]string{"Content-Type": "application/json"},
+		})
+		return err
 	})
 	if r.Err != nil {
 		return fmterr.Errorf("error deleting Dnat %q: %s", d.Id(), r.Err)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/natgateways"
 
@@ -70,6 +71,7 @@ func ResourceNatGatewayV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"tags": common.TagsSchema(),
 		},
 	}
 }
@@ -113,6 +115,15 @@ func resourceNatGatewayV2Create(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(natGateway.ID)
 
+	// set tags
+	tagRaw := d.Get("tags").(map[string]interface{})
+	if len(tagRaw) > 0 {
+		tagList := common.ExpandResourceTags(tagRaw)
+		if err := tags.Create(NatV2Client, "nat_gateways", d.Id(), tagList).ExtractErr(); err != nil {
+			return fmterr.Errorf("error setting tags of Nat Gateway %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceNatGatewayV2Read(ctx, d, meta)
 }
 
@@ -137,6 +148,16 @@ func resourceNatGatewayV2Read(_ context.Context, d *schema.ResourceData, meta in
 
 	d.Set("region", config.GetRegion(d))
 
+	// save tags
+	resourceTags, err := tags.Get(NatV2Client, "nat_gateways", d.Id()).Extract()
+	if err != nil {
+		return fmterr.Errorf("error fetching OpenTelekomCloud Nat Gateway tags: %s", err)
+	}
+	tagMap := common.TagsToMap(resourceTags)
+	if err := d.Set("tags", tagMap); err != nil {
+		return fmterr.Errorf("error saving tags for OpenTelekomCloud Nat Gateway: %s", err)
+	}
+
 	return nil
 }
 
@@ -147,23 +168,32 @@ func resourceNatGatewayV2Update(ctx context.Context, d *schema.ResourceData, met
 		return fmterr.Errorf("error creating OpenTelekomCloud nat client: %s", err)
 	}
 
-	var updateOpts natgateways.UpdateOpts
+	if d.HasChanges("name", "description", "spec") {
+		var updateOpts natgateways.UpdateOpts
 
-	if d.HasChange("name") {
-		updateOpts.Name = d.Get("name").(string)
-	}
-	if d.HasChange("description") {
-		updateOpts.Description = d.Get("description").(string)
-	}
-	if d.HasChange("spec") {
-		updateOpts.Spec = d.Get("spec").(string)
-	}
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("description") {
+			updateOpts.Description = d.Get("description").(string)
+		}
+		if d.HasChange("spec") {
+			updateOpts.Spec = d.Get("spec").(string)
+		}
 
-	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
 
-	_, err = natgateways.Update(NatV2Client, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmterr.Errorf("error updating Nat Gateway: %s", err)
+		_, err = natgateways.Update(NatV2Client, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmterr.Errorf("error updating Nat Gateway: %s", err)
+		}
+	}
+
+	// update tags
+	if d.HasChange("tags") {
+		if err := common.UpdateResourceTags(NatV2Client, d, "nat_gateways", d.Id()); err != nil {
+			return fmterr.Errorf("error updating tags of Nat Gateway %s: %s", d.Id(), err)
+		}
 	}
 
 	return resourceNatGatewayV2Read(ctx, d, meta)
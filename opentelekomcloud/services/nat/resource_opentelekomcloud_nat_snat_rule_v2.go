@@ -87,7 +87,13 @@ func resourceNatSnatRuleV2Create(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	snatRule, err := snatrules.Create(NatV2Client, createOpts).Extract()
+	defer common.LockParents(common.Parent{Kind: common.ParentNatGateway, ID: createOpts.NatGatewayID})()
+
+	var snatRule snatrules.SnatRule
+	err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		snatRule, err = snatrules.Create(NatV2Client, createOpts).Extract()
+		return err
+	})
 	if err != nil {
 		return fmterr.Errorf("error creatting Snat Rule: %s", err)
 	}
@@ -142,6 +148,8 @@ func resourceNatSnatRuleV2Delete(ctx context.Context, d *schema.ResourceData, me
 		return fmterr.Errorf("error creating OpenTelekomCloud nat client: %s", err)
 	}
 
+	defer common.LockParents(common.Parent{Kind: common.ParentNatGateway, ID: d.Get("nat_gateway_id").(string)})()
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ACTIVE"},
 		Target:     []string{"DELETED"},
@@ -195,6 +203,9 @@ func waitForSnatRuleDelete(NatV2Client *golangsdk.ServiceClient, nId string) res
 				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud Snat Rule %s", nId)
 				return n, "DELETED", nil
 			}
+			if common.IsConflict(err) {
+				return n, "ACTIVE", nil
+			}
 			return n, "ACTIVE", err
 		}
 
@@ -0,0 +1,119 @@
+package obs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceObsPresignedUrl() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceObsPresignedUrlRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validation.StringInSlice([]string{"GET", "PUT"}, false),
+			},
+			"expires": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(1, 7*24*3600),
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signed_headers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceObsPresignedUrlRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	method := d.Get("method").(string)
+	expires := d.Get("expires").(int)
+
+	input := &obs.CreateSignedUrlInput{
+		Method:  obs.HttpMethodType(method),
+		Bucket:  bucket,
+		Key:     key,
+		Expires: expires,
+	}
+	if v, ok := d.GetOk("content_type"); ok {
+		// the uploading client has to send exactly the same header
+		input.Headers = map[string]string{"Content-Type": v.(string)}
+	}
+
+	log.Printf("[DEBUG] Signing %s URL of OBS object %s/%s for %d seconds", method, bucket, key, expires)
+	expiresAt := time.Now().UTC().Add(time.Duration(expires) * time.Second)
+	out, err := client.CreateSignedUrl(input)
+	if err != nil {
+		return fmterr.Errorf("error signing URL of OBS object %s/%s: %s", bucket, key, err)
+	}
+
+	signedHeaders := make(map[string]string, len(out.ActualSignedRequestHeaders))
+	for name := range out.ActualSignedRequestHeaders {
+		signedHeaders[name] = out.ActualSignedRequestHeaders.Get(name)
+	}
+
+	d.SetId(fmt.Sprintf("%s %s/%s", method, bucket, key))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("url", out.SignedUrl),
+		d.Set("expires_at", expiresAt.Format(time.RFC3339)),
+		d.Set("signed_headers", signedHeaders),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting OBS presigned URL fields: %s", err)
+	}
+
+	return nil
+}
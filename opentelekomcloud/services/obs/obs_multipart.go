@@ -0,0 +1,211 @@
+package obs
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+)
+
+const (
+	// metaContentSha256 is the object metadata key holding SHA256 of the uploaded content
+	metaContentSha256 = "sha256"
+	// maxPartsCount is the maximum number of parts in the OBS multipart upload
+	maxPartsCount = 10000
+)
+
+// multipartUpload describes upload of the local file in parts
+type multipartUpload struct {
+	client      *obs.ObsClient
+	input       obs.PutObjectBasicInput
+	source      string
+	partSize    int64
+	concurrency int
+}
+
+// fileSha256 returns hex-encoded SHA256 of the file reading it as a stream
+func fileSha256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// partMD5 returns hex-encoded MD5 of the file part, which is equal to the part ETag
+func partMD5(file *os.File, offset, size int64) (string, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, io.NewSectionReader(file, offset, size)); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// upload uploads the file in parts, the parts already uploaded by the previous
+// unfinished upload of the same key are reused if their content matches
+func (u *multipartUpload) upload() (*obs.CompleteMultipartUploadOutput, error) {
+	file, err := os.Open(u.source)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := stat.Size()
+	partsCount := int((size + u.partSize - 1) / u.partSize)
+	if partsCount > maxPartsCount {
+		return nil, fmt.Errorf("file %s requires %d parts, maximum is %d, increase `part_size`",
+			u.source, partsCount, maxPartsCount)
+	}
+
+	uploadID, uploaded, err := u.findUnfinished()
+	if err != nil {
+		return nil, err
+	}
+	if uploadID == "" {
+		initInput := &obs.InitiateMultipartUploadInput{
+			ObjectOperationInput: u.input.ObjectOperationInput,
+			ContentType:          u.input.ContentType,
+		}
+		initOutput, err := u.client.InitiateMultipartUpload(initInput)
+		if err != nil {
+			return nil, err
+		}
+		uploadID = initOutput.UploadId
+		log.Printf("[DEBUG] Initiated multipart upload %s of %s", uploadID, u.input.Key)
+	} else {
+		log.Printf("[DEBUG] Resuming multipart upload %s of %s, %d parts found", uploadID, u.input.Key, len(uploaded))
+	}
+
+	parts := make([]obs.Part, partsCount)
+	partNumbers := make(chan int, partsCount)
+	for i := 1; i <= partsCount; i++ {
+		partNumbers <- i
+	}
+	close(partNumbers)
+
+	var mErr error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for w := 0; w < u.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for number := range partNumbers {
+				part, err := u.uploadPart(file, uploadID, number, size, uploaded[number])
+				mu.Lock()
+				if err != nil && mErr == nil {
+					mErr = fmt.Errorf("error uploading part %d: %w", number, err)
+				}
+				parts[number-1] = part
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if mErr != nil {
+		// upload is not aborted, so it can be resumed by the next apply
+		return nil, mErr
+	}
+
+	return u.client.CompleteMultipartUpload(&obs.CompleteMultipartUploadInput{
+		Bucket:   u.input.Bucket,
+		Key:      u.input.Key,
+		UploadId: uploadID,
+		Parts:    parts,
+	})
+}
+
+func (u *multipartUpload) uploadPart(file *os.File, uploadID string, number int, size int64, existing obs.Part) (obs.Part, error) {
+	offset := int64(number-1) * u.partSize
+	partSize := u.partSize
+	if offset+partSize > size {
+		partSize = size - offset
+	}
+
+	if existing.ETag != "" && existing.Size == partSize {
+		md5sum, err := partMD5(file, offset, partSize)
+		if err != nil {
+			return obs.Part{}, err
+		}
+		if strings.Trim(existing.ETag, `"`) == md5sum {
+			return obs.Part{PartNumber: number, ETag: existing.ETag}, nil
+		}
+	}
+
+	output, err := u.client.UploadPart(&obs.UploadPartInput{
+		Bucket:     u.input.Bucket,
+		Key:        u.input.Key,
+		PartNumber: number,
+		UploadId:   uploadID,
+		SourceFile: u.source,
+		Offset:     offset,
+		PartSize:   partSize,
+	})
+	if err != nil {
+		return obs.Part{}, err
+	}
+	return obs.Part{PartNumber: number, ETag: output.ETag}, nil
+}
+
+// findUnfinished returns the latest unfinished multipart upload of the key and its uploaded parts
+func (u *multipartUpload) findUnfinished() (string, map[int]obs.Part, error) {
+	output, err := u.client.ListMultipartUploads(&obs.ListMultipartUploadsInput{
+		Bucket: u.input.Bucket,
+		Prefix: u.input.Key,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var latest *obs.Upload
+	for i, upload := range output.Uploads {
+		if upload.Key != u.input.Key {
+			continue
+		}
+		if latest == nil || upload.Initiated.After(latest.Initiated) {
+			latest = &output.Uploads[i]
+		}
+	}
+	if latest == nil {
+		return "", nil, nil
+	}
+
+	parts := make(map[int]obs.Part)
+	marker := 0
+	for {
+		partsOutput, err := u.client.ListParts(&obs.ListPartsInput{
+			Bucket:           u.input.Bucket,
+			Key:              u.input.Key,
+			UploadId:         latest.UploadId,
+			PartNumberMarker: marker,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		for _, part := range partsOutput.Parts {
+			parts[part.PartNumber] = part
+		}
+		if !partsOutput.IsTruncated {
+			break
+		}
+		marker = partsOutput.NextPartNumberMarker
+	}
+	return latest.UploadId, parts, nil
+}
@@ -0,0 +1,103 @@
+package obs
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// doObsBucketRequest sends signed request to the bucket sub-resource, which is not supported by the SDK,
+// e.g. `?inventory`. Failed responses are returned as `obs.ObsError`.
+func doObsBucketRequest(config *cfg.Config, region, method, bucket string, subResources map[string]string, body []byte) ([]byte, error) {
+	endpoint, err := config.ObsEndpoint(region)
+	if err != nil {
+		return nil, err
+	}
+	cred, err := config.ObsCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OBS credentials: %s", err)
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OBS endpoint %s: %s", endpoint, err)
+	}
+
+	keys := make([]string, 0, len(subResources))
+	for k := range subResources {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	params := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v := subResources[k]; v != "" {
+			params = append(params, k+"="+v)
+			continue
+		}
+		params = append(params, k)
+	}
+	query := strings.Join(params, "&")
+
+	reqURL := fmt.Sprintf("%s://%s.%s/?%s", endpointURL.Scheme, bucket, endpointURL.Host, query)
+	req, err := http.NewRequest(method, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now().UTC().Format(http.TimeFormat)
+	req.Header.Set("Date", date)
+	contentMD5 := ""
+	contentType := ""
+	if len(body) > 0 {
+		sum := md5.Sum(body)
+		contentMD5 = base64.StdEncoding.EncodeToString(sum[:])
+		contentType = "application/xml"
+		req.Header.Set("Content-MD5", contentMD5)
+		req.Header.Set("Content-Type", contentType)
+	}
+	canonicalHeaders := ""
+	if cred.SecurityToken != "" {
+		req.Header.Set("x-obs-security-token", cred.SecurityToken)
+		canonicalHeaders = "x-obs-security-token:" + cred.SecurityToken + "\n"
+	}
+
+	stringToSign := strings.Join([]string{method, contentMD5, contentType, date, ""}, "\n") +
+		canonicalHeaders + fmt.Sprintf("/%s/?%s", bucket, query)
+	mac := hmac.New(sha1.New, []byte(cred.SecretKey))
+	mac.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	req.Header.Set("Authorization", fmt.Sprintf("OBS %s:%s", cred.AccessKey, signature))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		obsError := obs.ObsError{}
+		_ = xml.Unmarshal(respBody, &obsError)
+		obsError.StatusCode = resp.StatusCode
+		obsError.Status = resp.Status
+		return nil, obsError
+	}
+	return respBody, nil
+}
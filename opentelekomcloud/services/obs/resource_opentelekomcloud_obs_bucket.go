@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -72,6 +73,48 @@ func ResourceObsBucket() *schema.Resource {
 							Optional: true,
 							Default:  "logs/",
 						},
+						"agency": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"manage_target_acl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"target_grant": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grantee_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(obs.GranteeUser), string(obs.GranteeGroup),
+										}, false),
+									},
+									"grantee_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"grantee_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(obs.GroupAllUsers), string(obs.GroupAuthenticatedUsers), string(obs.GroupLogDelivery),
+										}, false),
+									},
+									"permission": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(obs.PermissionRead), string(obs.PermissionWrite), string(obs.PermissionFullControl),
+										}, false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -179,19 +222,77 @@ func ResourceObsBucket() *schema.Resource {
 								"website.0.index_document",
 								"website.0.error_document",
 								"website.0.routing_rules",
+								"website.0.routing_rule",
 							},
 							Optional: true,
 						},
 
 						"routing_rules": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: common.ValidateJsonString,
+							Type:          schema.TypeString,
+							Optional:      true,
+							ValidateFunc:  common.ValidateJsonString,
+							ConflictsWith: []string{"website.0.routing_rule"},
 							StateFunc: func(v interface{}) string {
 								jsonString, _ := common.NormalizeJsonString(v)
 								return jsonString
 							},
 						},
+
+						"routing_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key_prefix_equals": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"http_error_code_returned_equals": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"redirect": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"protocol": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+												},
+												"host_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"replace_key_prefix_with": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"replace_key_with": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"http_redirect_code": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -200,6 +301,10 @@ func ResourceObsBucket() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"allowed_origins": {
 							Type:     schema.TypeList,
 							Required: true,
@@ -208,7 +313,12 @@ func ResourceObsBucket() *schema.Resource {
 						"allowed_methods": {
 							Type:     schema.TypeList,
 							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"GET", "PUT", "HEAD", "POST", "DELETE",
+								}, false),
+							},
 						},
 						"allowed_headers": {
 							Type:     schema.TypeList,
@@ -221,9 +331,10 @@ func ResourceObsBucket() *schema.Resource {
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"max_age_seconds": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  100,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 					},
 				},
@@ -266,6 +377,7 @@ func ResourceObsBucket() *schema.Resource {
 					},
 				},
 			},
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -284,6 +396,7 @@ func resourceObsBucketCreate(ctx context.Context, d *schema.ResourceData, meta i
 		Bucket:       bucket,
 		ACL:          obs.AclType(acl),
 		StorageClass: obs.StorageClassType(class),
+		Epid:         d.Get("enterprise_project_id").(string),
 	}
 	opts.Location = config.GetRegion(d)
 	log.Printf("[DEBUG] OBS bucket create opts: %#v", opts)
@@ -363,6 +476,12 @@ func resourceObsBucketUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if !d.IsNewResource() {
+		if err := common.MigrateEnterpriseProject(config, d, "bucket", d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceObsBucketRead(ctx, d, meta)
 }
 
@@ -375,7 +494,7 @@ func resourceObsBucketRead(_ context.Context, d *schema.ResourceData, meta inter
 	}
 
 	log.Printf("[DEBUG] Read OBS bucket: %s", d.Id())
-	_, err = client.HeadBucket(d.Id())
+	metadata, err := client.GetBucketMetadata(&obs.GetBucketMetadataInput{Bucket: d.Id()})
 	if err != nil {
 		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
 			log.Printf("[WARN] OBS bucket(%s) not found", d.Id())
@@ -441,6 +560,14 @@ func resourceObsBucketRead(_ context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	if metadata.Epid != "" {
+		if err := d.Set("enterprise_project_id", metadata.Epid); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if err := common.ReadEnterpriseProject(config, d, "bucket", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -569,6 +696,30 @@ func resourceObsBucketLoggingUpdate(client *obs.ObsClient, d *schema.ResourceDat
 		if val := c["target_prefix"].(string); val != "" {
 			loggingStatus.TargetPrefix = val
 		}
+
+		// logs are delivered either on behalf of the agency, or by the log delivery group
+		loggingStatus.Agency = c["agency"].(string)
+		if loggingStatus.Agency == "" {
+			if c["manage_target_acl"].(bool) {
+				if err := grantObsLogDelivery(client, loggingStatus.TargetBucket); err != nil {
+					return err
+				}
+			} else if err := checkObsLogDelivery(client, loggingStatus.TargetBucket); err != nil {
+				return err
+			}
+		}
+
+		for _, raw := range c["target_grant"].(*schema.Set).List() {
+			grant := raw.(map[string]interface{})
+			loggingStatus.TargetGrants = append(loggingStatus.TargetGrants, obs.Grant{
+				Grantee: obs.Grantee{
+					Type: obs.GranteeType(grant["grantee_type"].(string)),
+					ID:   grant["grantee_id"].(string),
+					URI:  obs.GroupUriType(grant["grantee_uri"].(string)),
+				},
+				Permission: obs.PermissionType(grant["permission"].(string)),
+			})
+		}
 	}
 	log.Printf("[DEBUG] set logging of OBS bucket %s: %#v", bucket, loggingStatus)
 
@@ -577,6 +728,80 @@ func resourceObsBucketLoggingUpdate(client *obs.ObsClient, d *schema.ResourceDat
 		return GetObsError("error setting logging configuration of OBS bucket", bucket, err)
 	}
 
+	// OBS can silently ignore logging configuration, so check that it's really active
+	output, err := client.GetBucketLoggingConfiguration(bucket)
+	if err != nil {
+		return GetObsError("error getting logging configuration of OBS bucket", bucket, err)
+	}
+	if output.TargetBucket != loggingStatus.TargetBucket {
+		return fmt.Errorf("logging of OBS bucket %s to %q is not active, got target bucket %q",
+			bucket, loggingStatus.TargetBucket, output.TargetBucket)
+	}
+
+	return nil
+}
+
+// logDeliveryPermissions are required by the log delivery group to write logs into the target bucket
+var logDeliveryPermissions = []obs.PermissionType{obs.PermissionWrite, obs.PermissionReadAcp}
+
+// missingLogDeliveryPermissions returns the permissions the log delivery group misses in the bucket ACL
+func missingLogDeliveryPermissions(acl *obs.AccessControlPolicy) []obs.PermissionType {
+	granted := make(map[obs.PermissionType]bool)
+	for _, grant := range acl.Grants {
+		if strings.HasSuffix(string(grant.Grantee.URI), string(obs.GroupLogDelivery)) {
+			granted[grant.Permission] = true
+		}
+	}
+	var missing []obs.PermissionType
+	for _, permission := range logDeliveryPermissions {
+		if !granted[permission] && !granted[obs.PermissionFullControl] {
+			missing = append(missing, permission)
+		}
+	}
+	return missing
+}
+
+// checkObsLogDelivery checks that logs can be delivered into the target bucket
+func checkObsLogDelivery(client *obs.ObsClient, target string) error {
+	output, err := client.GetBucketAcl(target)
+	if err != nil {
+		return GetObsError("error getting ACL of OBS logging target bucket", target, err)
+	}
+	if missing := missingLogDeliveryPermissions(&output.AccessControlPolicy); len(missing) != 0 {
+		return fmt.Errorf("logs can't be delivered into OBS bucket %s: log delivery group misses %v permissions, "+
+			"set `acl = \"log-delivery-write\"` for the target bucket, `manage_target_acl = true` or `agency` for logging", target, missing)
+	}
+	return nil
+}
+
+// grantObsLogDelivery adds permissions of the log delivery group to ACL of the target bucket
+func grantObsLogDelivery(client *obs.ObsClient, target string) error {
+	output, err := client.GetBucketAcl(target)
+	if err != nil {
+		return GetObsError("error getting ACL of OBS logging target bucket", target, err)
+	}
+	missing := missingLogDeliveryPermissions(&output.AccessControlPolicy)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	input := &obs.SetBucketAclInput{
+		Bucket:              target,
+		AccessControlPolicy: output.AccessControlPolicy,
+	}
+	for _, permission := range missing {
+		input.Grants = append(input.Grants, obs.Grant{
+			Grantee: obs.Grantee{
+				Type: obs.GranteeGroup,
+				URI:  obs.GroupLogDelivery,
+			},
+			Permission: permission,
+		})
+	}
+	log.Printf("[DEBUG] granting log delivery permissions %v on OBS bucket %s", missing, target)
+	if _, err := client.SetBucketAcl(input); err != nil {
+		return GetObsError("error setting ACL of OBS logging target bucket", target, err)
+	}
 	return nil
 }
 
@@ -739,6 +964,10 @@ func resourceObsBucketCorsUpdate(client *obs.ObsClient, d *schema.ResourceData)
 				rule.MaxAgeSeconds = v.(int)
 				continue
 			}
+			if k == "id" {
+				rule.ID = v.(string)
+				continue
+			}
 			value, ok := asStringSlice(v)
 			if !ok {
 				continue
@@ -833,6 +1062,12 @@ func resourceObsBucketWebsitePut(client *obs.ObsClient, d *schema.ResourceData,
 		websiteConfiguration.RoutingRules = unmarshalledRules
 	}
 
+	if v, ok := website["routing_rule"]; ok {
+		for _, raw := range v.([]interface{}) {
+			websiteConfiguration.RoutingRules = append(websiteConfiguration.RoutingRules, expandWebsiteRoutingRule(raw.(map[string]interface{})))
+		}
+	}
+
 	log.Printf("[DEBUG] set website configuration of OBS bucket %s: %#v", bucket, websiteConfiguration)
 	_, err := client.SetBucketWebsiteConfiguration(websiteConfiguration)
 	if err != nil {
@@ -891,9 +1126,34 @@ func setObsBucketLogging(client *obs.ObsClient, d *schema.ResourceData) error {
 
 	if output.TargetBucket != "" {
 		logging["target_bucket"] = output.TargetBucket
+		logging["agency"] = output.Agency
+		// target ACL management is not a part of the bucket configuration
+		if rawLogging := d.Get("logging").(*schema.Set).List(); len(rawLogging) > 0 {
+			logging["manage_target_acl"] = rawLogging[0].(map[string]interface{})["manage_target_acl"]
+		}
 		if output.TargetPrefix != "" {
 			logging["target_prefix"] = output.TargetPrefix
 		}
+		var grants []map[string]interface{}
+		for _, grant := range output.TargetGrants {
+			granteeType := grant.Grantee.Type
+			if granteeType == "" {
+				granteeType = obs.GranteeGroup
+				if grant.Grantee.ID != "" {
+					granteeType = obs.GranteeUser
+				}
+			}
+			// group URI is returned in form of `http://acs.amazonaws.com/groups/global/AllUsers`
+			uri := string(grant.Grantee.URI)
+			uri = uri[strings.LastIndex(uri, "/")+1:]
+			grants = append(grants, map[string]interface{}{
+				"grantee_type": string(granteeType),
+				"grantee_id":   grant.Grantee.ID,
+				"grantee_uri":  uri,
+				"permission":   string(grant.Permission),
+			})
+		}
+		logging["target_grant"] = grants
 		lcList = append(lcList, logging)
 	}
 	log.Printf("[DEBUG] saving logging configuration of OBS bucket: %s: %#v", bucket, lcList)
@@ -986,7 +1246,57 @@ func setObsBucketLifecycleConfiguration(client *obs.ObsClient, d *schema.Resourc
 	return nil
 }
 
-func handleWebsite(src *obs.GetBucketWebsiteConfigurationOutput) (map[string]interface{}, error) {
+func expandWebsiteRoutingRule(raw map[string]interface{}) obs.RoutingRule {
+	rule := obs.RoutingRule{}
+	if conditions := raw["condition"].([]interface{}); len(conditions) > 0 && conditions[0] != nil {
+		condition := conditions[0].(map[string]interface{})
+		rule.Condition = obs.Condition{
+			KeyPrefixEquals:             condition["key_prefix_equals"].(string),
+			HttpErrorCodeReturnedEquals: condition["http_error_code_returned_equals"].(string),
+		}
+	}
+	if redirects := raw["redirect"].([]interface{}); len(redirects) > 0 && redirects[0] != nil {
+		redirect := redirects[0].(map[string]interface{})
+		rule.Redirect = obs.Redirect{
+			Protocol:             obs.ProtocolType(redirect["protocol"].(string)),
+			HostName:             redirect["host_name"].(string),
+			ReplaceKeyPrefixWith: redirect["replace_key_prefix_with"].(string),
+			ReplaceKeyWith:       redirect["replace_key_with"].(string),
+			HttpRedirectCode:     redirect["http_redirect_code"].(string),
+		}
+	}
+	return rule
+}
+
+func flattenWebsiteRoutingRules(rules []obs.RoutingRule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = map[string]interface{}{
+			"redirect": []map[string]interface{}{
+				{
+					"protocol":                string(rule.Redirect.Protocol),
+					"host_name":               rule.Redirect.HostName,
+					"replace_key_prefix_with": rule.Redirect.ReplaceKeyPrefixWith,
+					"replace_key_with":        rule.Redirect.ReplaceKeyWith,
+					"http_redirect_code":      rule.Redirect.HttpRedirectCode,
+				},
+			},
+		}
+		if rule.Condition.KeyPrefixEquals != "" || rule.Condition.HttpErrorCodeReturnedEquals != "" {
+			result[i]["condition"] = []map[string]interface{}{
+				{
+					"key_prefix_equals":               rule.Condition.KeyPrefixEquals,
+					"http_error_code_returned_equals": rule.Condition.HttpErrorCodeReturnedEquals,
+				},
+			}
+		}
+	}
+	return result
+}
+
+// handleWebsite converts website configuration to the schema, routing rules are set to `routing_rule`
+// if `structuredRules` is true and to the JSON `routing_rules` otherwise
+func handleWebsite(src *obs.GetBucketWebsiteConfigurationOutput, structuredRules bool) (map[string]interface{}, error) {
 	website := make(map[string]interface{})
 	website["index_document"] = src.IndexDocument.Suffix
 	website["error_document"] = src.ErrorDocument.Key
@@ -1016,7 +1326,9 @@ func handleWebsite(src *obs.GetBucketWebsiteConfigurationOutput) (map[string]int
 
 	// routing_rules
 	rawRules := src.RoutingRules
-	if len(rawRules) > 0 {
+	if len(rawRules) > 0 && structuredRules {
+		website["routing_rule"] = flattenWebsiteRoutingRules(rawRules)
+	} else if len(rawRules) > 0 {
 		rr, err := normalizeWebsiteRoutingRules(rawRules)
 		if err != nil {
 			return nil, fmt.Errorf("error while marshaling website routing rules: %s", err)
@@ -1045,7 +1357,8 @@ func setObsBucketWebsiteConfiguration(client *obs.ObsClient, d *schema.ResourceD
 
 	log.Printf("[DEBUG] getting website configuration of OBS bucket: %s, output: %#v", bucket, rawWebsite.BucketWebsiteConfiguration)
 
-	website, err := handleWebsite(rawWebsite)
+	structuredRules := len(d.Get("website.0.routing_rule").([]interface{})) > 0
+	website, err := handleWebsite(rawWebsite, structuredRules)
 	if err != nil {
 		return err
 	}
@@ -1084,6 +1397,7 @@ func setObsBucketCorsRules(client *obs.ObsClient, d *schema.ResourceData) error
 		rule["allowed_origins"] = ruleObject.AllowedOrigin
 		rule["allowed_methods"] = ruleObject.AllowedMethod
 		rule["max_age_seconds"] = ruleObject.MaxAgeSeconds
+		rule["id"] = ruleObject.ID
 		if ruleObject.AllowedHeader != nil {
 			rule["allowed_headers"] = ruleObject.AllowedHeader
 		}
@@ -0,0 +1,238 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceObsBucketInventory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketInventoryPut,
+		ReadContext:   resourceObsBucketInventoryRead,
+		UpdateContext: resourceObsBucketInventoryPut,
+		DeleteContext: resourceObsBucketInventoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObsBucketInventoryImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Daily", "Weekly"}, false),
+			},
+			"included_object_versions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "All",
+				ValidateFunc: validation.StringInSlice([]string{"All", "Current"}, false),
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "CSV",
+							ValidateFunc: validation.StringInSlice([]string{"CSV"}, false),
+						},
+					},
+				},
+			},
+			"optional_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Size", "LastModifiedDate", "ETag", "StorageClass",
+						"IsMultipartUploaded", "ReplicationStatus", "EncryptionStatus",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+type inventoryConfiguration struct {
+	XMLName                xml.Name             `xml:"InventoryConfiguration"`
+	ID                     string               `xml:"Id"`
+	IsEnabled              bool                 `xml:"IsEnabled"`
+	FilterPrefix           string               `xml:"Filter>Prefix,omitempty"`
+	Destination            inventoryDestination `xml:"Destination"`
+	Frequency              string               `xml:"Schedule>Frequency"`
+	IncludedObjectVersions string               `xml:"IncludedObjectVersions"`
+	OptionalFields         []string             `xml:"OptionalFields>Field,omitempty"`
+}
+
+type inventoryDestination struct {
+	Format string `xml:"Format"`
+	Bucket string `xml:"Bucket"`
+	Prefix string `xml:"Prefix,omitempty"`
+}
+
+func inventorySubResources(name string) map[string]string {
+	return map[string]string{
+		"inventory": "",
+		"id":        name,
+	}
+}
+
+func resourceObsBucketInventoryPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	destination := d.Get("destination").([]interface{})[0].(map[string]interface{})
+	inventory := inventoryConfiguration{
+		ID:           name,
+		IsEnabled:    d.Get("enabled").(bool),
+		FilterPrefix: d.Get("filter_prefix").(string),
+		Destination: inventoryDestination{
+			Format: destination["format"].(string),
+			Bucket: destination["bucket"].(string),
+			Prefix: destination["prefix"].(string),
+		},
+		Frequency:              d.Get("frequency").(string),
+		IncludedObjectVersions: d.Get("included_object_versions").(string),
+		OptionalFields:         common.ExpandToStringSlice(d.Get("optional_fields").(*schema.Set).List()),
+	}
+	body, err := xml.Marshal(inventory)
+	if err != nil {
+		return fmterr.Errorf("error building inventory configuration: %w", err)
+	}
+	log.Printf("[DEBUG] Set inventory of OBS bucket %s: %s", bucket, body)
+
+	_, err = doObsBucketRequest(config, config.GetRegion(d), "PUT", bucket, inventorySubResources(name), body)
+	if err != nil {
+		return diag.FromErr(GetObsError("error setting inventory configuration of OBS bucket", bucket, err))
+	}
+	d.SetId(common.BuildComponentID(bucket, name))
+
+	return resourceObsBucketInventoryRead(ctx, d, meta)
+}
+
+func resourceObsBucketInventoryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	body, err := doObsBucketRequest(config, config.GetRegion(d), "GET", bucket, inventorySubResources(name), nil)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] Inventory %s of OBS bucket %s not found, removing from state", name, bucket)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(GetObsError("error getting inventory configuration of OBS bucket", bucket, err))
+	}
+
+	var inventory inventoryConfiguration
+	if err := xml.Unmarshal(body, &inventory); err != nil {
+		return fmterr.Errorf("error parsing inventory configuration: %w", err)
+	}
+
+	destination := []map[string]interface{}{
+		{
+			"bucket": inventory.Destination.Bucket,
+			"prefix": inventory.Destination.Prefix,
+			"format": inventory.Destination.Format,
+		},
+	}
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("enabled", inventory.IsEnabled),
+		d.Set("frequency", inventory.Frequency),
+		d.Set("included_object_versions", inventory.IncludedObjectVersions),
+		d.Set("filter_prefix", inventory.FilterPrefix),
+		d.Set("destination", destination),
+		d.Set("optional_fields", inventory.OptionalFields),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting OBS bucket inventory fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceObsBucketInventoryDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	bucket := d.Get("bucket").(string)
+
+	_, err := doObsBucketRequest(config, config.GetRegion(d), "DELETE", bucket, inventorySubResources(d.Get("name").(string)), nil)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(GetObsError("error deleting inventory configuration of OBS bucket", bucket, err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceObsBucketInventoryImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for OBS bucket inventory, must be <bucket>/<name>")
+	}
+	mErr := multierror.Append(
+		d.Set("bucket", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,256 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceObsBucketNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketNotificationPut,
+		ReadContext:   resourceObsBucketNotificationRead,
+		UpdateContext: resourceObsBucketNotificationPut,
+		DeleteContext: resourceObsBucketNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObsBucketNotificationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"topic", "function_graph"},
+				Elem:         notificationSchema("topic_urn"),
+			},
+			"function_graph": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     notificationSchema("function_urn"),
+			},
+		},
+	}
+}
+
+func notificationSchema(targetKey string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			targetKey: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"ObjectCreated:*", "ObjectCreated:Put", "ObjectCreated:Post", "ObjectCreated:Copy",
+						"ObjectCreated:CompleteMultipartUpload",
+						"ObjectRemoved:*", "ObjectRemoved:Delete", "ObjectRemoved:DeleteMarkerCreated",
+					}, false),
+				},
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// notificationConfiguration is OBS notification configuration, FunctionGraph targets
+// are not supported by `obs.BucketNotification`
+type notificationConfiguration struct {
+	XMLName                     xml.Name                    `xml:"NotificationConfiguration"`
+	TopicConfigurations         []notificationTarget        `xml:"TopicConfiguration"`
+	FunctionGraphConfigurations []functionGraphNotification `xml:"FunctionGraphConfiguration"`
+}
+
+type notificationTarget struct {
+	ID          string           `xml:"Id,omitempty"`
+	FilterRules []obs.FilterRule `xml:"Filter>Object>FilterRule,omitempty"`
+	Topic       string           `xml:"Topic"`
+	Events      []string         `xml:"Event"`
+}
+
+type functionGraphNotification struct {
+	ID            string           `xml:"Id,omitempty"`
+	FilterRules   []obs.FilterRule `xml:"Filter>Object>FilterRule,omitempty"`
+	FunctionGraph string           `xml:"FunctionGraph"`
+	Events        []string         `xml:"Event"`
+}
+
+var notificationSubResources = map[string]string{"notification": ""}
+
+func expandNotificationFilterRules(raw map[string]interface{}) []obs.FilterRule {
+	var rules []obs.FilterRule
+	if prefix := raw["filter_prefix"].(string); prefix != "" {
+		rules = append(rules, obs.FilterRule{Name: "prefix", Value: prefix})
+	}
+	if suffix := raw["filter_suffix"].(string); suffix != "" {
+		rules = append(rules, obs.FilterRule{Name: "suffix", Value: suffix})
+	}
+	return rules
+}
+
+func flattenNotification(id, targetKey, target string, events []string, rules []obs.FilterRule) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":      id,
+		targetKey: target,
+		"events":  events,
+	}
+	for _, rule := range rules {
+		switch rule.Name {
+		case "prefix":
+			result["filter_prefix"] = rule.Value
+		case "suffix":
+			result["filter_suffix"] = rule.Value
+		}
+	}
+	return result
+}
+
+func resourceObsBucketNotificationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	bucket := d.Get("bucket").(string)
+
+	notification := notificationConfiguration{}
+	for _, v := range d.Get("topic").([]interface{}) {
+		raw := v.(map[string]interface{})
+		notification.TopicConfigurations = append(notification.TopicConfigurations, notificationTarget{
+			ID:          raw["id"].(string),
+			FilterRules: expandNotificationFilterRules(raw),
+			Topic:       raw["topic_urn"].(string),
+			Events:      common.ExpandToStringSlice(raw["events"].(*schema.Set).List()),
+		})
+	}
+	for _, v := range d.Get("function_graph").([]interface{}) {
+		raw := v.(map[string]interface{})
+		notification.FunctionGraphConfigurations = append(notification.FunctionGraphConfigurations, functionGraphNotification{
+			ID:            raw["id"].(string),
+			FilterRules:   expandNotificationFilterRules(raw),
+			FunctionGraph: raw["function_urn"].(string),
+			Events:        common.ExpandToStringSlice(raw["events"].(*schema.Set).List()),
+		})
+	}
+
+	body, err := xml.Marshal(notification)
+	if err != nil {
+		return fmterr.Errorf("error building notification configuration: %w", err)
+	}
+	log.Printf("[DEBUG] Set notification of OBS bucket %s: %s", bucket, body)
+
+	_, err = doObsBucketRequest(config, config.GetRegion(d), "PUT", bucket, notificationSubResources, body)
+	if err != nil {
+		return diag.FromErr(GetObsError("error setting notification configuration of OBS bucket", bucket, err))
+	}
+	d.SetId(bucket)
+
+	return resourceObsBucketNotificationRead(ctx, d, meta)
+}
+
+func resourceObsBucketNotificationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	bucket := d.Id()
+
+	body, err := doObsBucketRequest(config, config.GetRegion(d), "GET", bucket, notificationSubResources, nil)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] OBS bucket %s not found, removing notification from state", bucket)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(GetObsError("error getting notification configuration of OBS bucket", bucket, err))
+	}
+
+	var notification notificationConfiguration
+	if err := xml.Unmarshal(body, &notification); err != nil {
+		return fmterr.Errorf("error parsing notification configuration: %w", err)
+	}
+	if len(notification.TopicConfigurations) == 0 && len(notification.FunctionGraphConfigurations) == 0 {
+		log.Printf("[WARN] Notification of OBS bucket %s is empty, removing from state", bucket)
+		d.SetId("")
+		return nil
+	}
+
+	var topics []map[string]interface{}
+	for _, topic := range notification.TopicConfigurations {
+		topics = append(topics, flattenNotification(topic.ID, "topic_urn", topic.Topic, topic.Events, topic.FilterRules))
+	}
+	var functions []map[string]interface{}
+	for _, function := range notification.FunctionGraphConfigurations {
+		functions = append(functions, flattenNotification(function.ID, "function_urn", function.FunctionGraph, function.Events, function.FilterRules))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("bucket", bucket),
+		d.Set("topic", topics),
+		d.Set("function_graph", functions),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting OBS bucket notification fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceObsBucketNotificationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	bucket := d.Id()
+
+	// empty configuration disables the notifications
+	body, err := xml.Marshal(notificationConfiguration{})
+	if err != nil {
+		return fmterr.Errorf("error building notification configuration: %w", err)
+	}
+	_, err = doObsBucketRequest(config, config.GetRegion(d), "PUT", bucket, notificationSubResources, body)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(GetObsError("error deleting notification configuration of OBS bucket", bucket, err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceObsBucketNotificationImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("bucket", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
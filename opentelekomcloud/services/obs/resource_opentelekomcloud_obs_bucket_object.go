@@ -3,6 +3,9 @@ package obs
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"log"
 	"os"
 	"strings"
@@ -24,6 +27,8 @@ func ResourceObsBucketObject() *schema.Resource {
 		UpdateContext: resourceObsBucketObjectPut,
 		DeleteContext: resourceObsBucketObjectDelete,
 
+		CustomizeDiff: resourceObsBucketObjectContentDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -79,6 +84,22 @@ func ResourceObsBucketObject() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      64,
+				ValidateFunc: validation.IntBetween(1, 5120),
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -92,7 +113,7 @@ func ResourceObsBucketObject() *schema.Resource {
 }
 
 func resourceObsBucketObjectPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var resp *obs.PutObjectOutput
+	var versionID string
 	var err error
 
 	config := meta.(*cfg.Config)
@@ -103,7 +124,7 @@ func resourceObsBucketObjectPut(ctx context.Context, d *schema.ResourceData, met
 
 	if source, ok := d.GetOk("source"); ok {
 		// check source file whether exist
-		_, err := os.Stat(source.(string))
+		stat, err := os.Stat(source.(string))
 		if err != nil {
 			if os.IsNotExist(err) {
 				return fmterr.Errorf("source file %s does not exist", source)
@@ -111,13 +132,19 @@ func resourceObsBucketObjectPut(ctx context.Context, d *schema.ResourceData, met
 			return diag.FromErr(err)
 		}
 
-		// put source file
-		resp, err = putFileToObject(client, d)
+		partSize := int64(d.Get("part_size").(int)) * 1024 * 1024
+		if stat.Size() > partSize {
+			// upload large files in parts, so they are not read into memory at once
+			versionID, err = putMultipartFileToObject(client, d, partSize)
+		} else {
+			// put source file
+			versionID, err = putFileToObject(client, d)
+		}
 	}
 
 	if _, ok := d.GetOk("content"); ok {
 		// put content
-		resp, err = putContentToObject(client, d)
+		versionID, err = putContentToObject(client, d)
 	}
 
 	bucket := d.Get("bucket").(string)
@@ -126,9 +153,9 @@ func resourceObsBucketObjectPut(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(GetObsError("error putting object to OBS bucket", bucket, err))
 	}
 
-	log.Printf("[DEBUG] Response of putting %s to OBS Bucket %s: %#v", key, bucket, resp)
-	if resp.VersionId != "null" {
-		err = d.Set("version_id", resp.VersionId)
+	log.Printf("[DEBUG] Put %s to OBS Bucket %s, version: %s", key, bucket, versionID)
+	if versionID != "null" {
+		err = d.Set("version_id", versionID)
 	} else {
 		err = d.Set("version_id", "")
 	}
@@ -141,6 +168,42 @@ func resourceObsBucketObjectPut(ctx context.Context, d *schema.ResourceData, met
 	return resourceObsBucketObjectRead(ctx, d, meta)
 }
 
+// objectContentSha256 returns SHA256 of the configured object content, which doesn't depend
+// on the way object is uploaded, unlike `etag`
+func objectContentSha256(d schemaGetter) (string, error) {
+	if source, ok := d.GetOk("source"); ok {
+		return fileSha256(source.(string))
+	}
+	sum := sha256.Sum256([]byte(d.Get("content").(string)))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+type schemaGetter interface {
+	GetOk(string) (interface{}, bool)
+	Get(string) interface{}
+}
+
+// resourceObsBucketObjectContentDiff triggers update when the content hash changes
+func resourceObsBucketObjectContentDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("source") || !d.NewValueKnown("content") {
+		return nil
+	}
+	if source, ok := d.GetOk("source"); ok {
+		if _, err := os.Stat(source.(string)); err != nil {
+			// file can be created during apply
+			return nil
+		}
+	}
+	hash, err := objectContentSha256(d)
+	if err != nil {
+		return fmt.Errorf("error calculating object content hash: %w", err)
+	}
+	if old := d.Get("content_sha256").(string); old != "" && old != hash {
+		return d.SetNew("content_sha256", hash)
+	}
+	return nil
+}
+
 func basicInput(d *schema.ResourceData) obs.PutObjectBasicInput {
 	common := obs.PutObjectBasicInput{
 		ObjectOperationInput: obs.ObjectOperationInput{
@@ -158,6 +221,11 @@ func basicInput(d *schema.ResourceData) obs.PutObjectBasicInput {
 	if v, ok := d.GetOk("content_type"); ok {
 		common.ContentType = v.(string)
 	}
+	if hash, err := objectContentSha256(d); err == nil {
+		common.Metadata = map[string]string{metaContentSha256: hash}
+	} else {
+		log.Printf("[WARN] Unable to calculate content hash of %s: %s", common.Key, err)
+	}
 	var sseKmsHeader = obs.SseKmsHeader{}
 	if d.Get("encryption").(bool) {
 		sseKmsHeader.Encryption = obs.DEFAULT_SSE_KMS_ENCRYPTION
@@ -167,7 +235,7 @@ func basicInput(d *schema.ResourceData) obs.PutObjectBasicInput {
 	return common
 }
 
-func putContentToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.PutObjectOutput, error) {
+func putContentToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (string, error) {
 	content := d.Get("content").(string)
 
 	putInput := &obs.PutObjectInput{
@@ -179,17 +247,42 @@ func putContentToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.
 	body := bytes.NewReader([]byte(content))
 	putInput.Body = body
 
-	return obsClient.PutObject(putInput)
+	resp, err := obsClient.PutObject(putInput)
+	if err != nil {
+		return "", err
+	}
+	return resp.VersionId, nil
 }
 
-func putFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.PutObjectOutput, error) {
+func putFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (string, error) {
 	putInput := &obs.PutFileInput{
 		PutObjectBasicInput: basicInput(d),
 	}
 	putInput.SourceFile = d.Get("source").(string)
 
 	log.Printf("[DEBUG] putting %s to OBS Bucket %s, opts: %#v", putInput.Key, putInput.Bucket, putInput)
-	return obsClient.PutFile(putInput)
+	resp, err := obsClient.PutFile(putInput)
+	if err != nil {
+		return "", err
+	}
+	return resp.VersionId, nil
+}
+
+func putMultipartFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData, partSize int64) (string, error) {
+	upload := &multipartUpload{
+		client:      obsClient,
+		input:       basicInput(d),
+		source:      d.Get("source").(string),
+		partSize:    partSize,
+		concurrency: d.Get("upload_concurrency").(int),
+	}
+
+	log.Printf("[DEBUG] putting %s to OBS Bucket %s in parts of %d bytes", upload.input.Key, upload.input.Bucket, partSize)
+	resp, err := upload.upload()
+	if err != nil {
+		return "", err
+	}
+	return resp.VersionId, nil
 }
 
 func resourceObsBucketObjectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -235,6 +328,15 @@ func resourceObsBucketObjectRead(_ context.Context, d *schema.ResourceData, meta
 		d.Set("etag", strings.Trim(object.ETag, `"`)),
 	)
 
+	metadata, err := client.GetObjectMetadata(&obs.GetObjectMetadataInput{Bucket: bucket, Key: key})
+	if err != nil {
+		return diag.FromErr(GetObsError("error getting object metadata of OBS bucket", bucket, err))
+	}
+	// objects uploaded by the previous versions of the provider don't have the hash
+	if hash := metadata.Metadata[metaContentSha256]; hash != "" {
+		mErr = multierror.Append(mErr, d.Set("content_sha256", hash))
+	}
+
 	if err := mErr.ErrorOrNil(); err != nil {
 		return fmterr.Errorf("error setting OBS bucket attributes: %s", err)
 	}
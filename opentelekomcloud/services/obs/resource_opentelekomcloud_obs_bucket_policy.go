@@ -2,12 +2,19 @@ package obs
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -15,6 +22,14 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
 
+const (
+	policyVersion        = "2008-10-17"
+	policyResourcePrefix = "arn:aws:s3:::"
+	policyIAMPrefix      = "arn:aws:iam::"
+)
+
+var policyUserRegexp = regexp.MustCompile(`^[^/]+/[^/]+$`)
+
 func ResourceObsBucketPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceObsBucketPolicyPut,
@@ -22,6 +37,18 @@ func ResourceObsBucketPolicy() *schema.Resource {
 		UpdateContext: resourceObsBucketPolicyPut,
 		DeleteContext: resourceObsBucketPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObsBucketPolicyImport,
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			// the rendered policy changes together with the statements
+			if d.HasChange("statement") {
+				return d.SetNewComputed("policy")
+			}
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -30,14 +57,257 @@ func ResourceObsBucketPolicy() *schema.Resource {
 			},
 			"policy": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"policy", "statement"},
 				ValidateFunc:     common.ValidateJsonString,
 				DiffSuppressFunc: common.SuppressEquivalentAwsPolicyDiffs,
 			},
+			"statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Allow",
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+						},
+						"principal": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"domains": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"users": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringMatch(policyUserRegexp, "must be in format `domain_id/user_id`"),
+										},
+									},
+								},
+							},
+						},
+						"actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resources": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"condition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Sid       string                         `json:"Sid,omitempty"`
+	Effect    string                         `json:"Effect"`
+	Principal interface{}                    `json:"Principal"`
+	Action    []string                       `json:"Action"`
+	Resource  []string                       `json:"Resource"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+func sortedStrings(set *schema.Set) []string {
+	values := common.ExpandToStringSlice(set.List())
+	sort.Strings(values)
+	return values
+}
+
+func expandPolicyPrincipal(raw []interface{}) interface{} {
+	if len(raw) == 0 || raw[0] == nil {
+		return "*"
+	}
+	principal := raw[0].(map[string]interface{})
+	if principal["all"].(bool) {
+		return "*"
+	}
+	var ids []string
+	for _, domain := range sortedStrings(principal["domains"].(*schema.Set)) {
+		ids = append(ids, fmt.Sprintf("%s%s:root", policyIAMPrefix, domain))
+	}
+	for _, user := range sortedStrings(principal["users"].(*schema.Set)) {
+		parts := strings.SplitN(user, "/", 2)
+		ids = append(ids, fmt.Sprintf("%s%s:user/%s", policyIAMPrefix, parts[0], parts[1]))
+	}
+	return map[string][]string{"AWS": ids}
+}
+
+// buildBucketPolicy renders the statements into the policy document. The output is
+// stable for the same statements, so no diff is produced by whitespaces or ordering
+func buildBucketPolicy(statements []interface{}) (string, error) {
+	document := policyDocument{Version: policyVersion}
+	for _, v := range statements {
+		raw := v.(map[string]interface{})
+		statement := policyStatement{
+			Sid:       raw["sid"].(string),
+			Effect:    raw["effect"].(string),
+			Principal: expandPolicyPrincipal(raw["principal"].([]interface{})),
+			Action:    sortedStrings(raw["actions"].(*schema.Set)),
+		}
+		for _, res := range sortedStrings(raw["resources"].(*schema.Set)) {
+			statement.Resource = append(statement.Resource, policyResourcePrefix+strings.TrimPrefix(res, policyResourcePrefix))
+		}
+		for _, c := range raw["condition"].(*schema.Set).List() {
+			condition := c.(map[string]interface{})
+			if statement.Condition == nil {
+				statement.Condition = make(map[string]map[string][]string)
+			}
+			operator := condition["operator"].(string)
+			if statement.Condition[operator] == nil {
+				statement.Condition[operator] = make(map[string][]string)
+			}
+			key := condition["key"].(string)
+			statement.Condition[operator][key] = append(statement.Condition[operator][key], sortedStrings(condition["values"].(*schema.Set))...)
+		}
+		document.Statement = append(document.Statement, statement)
+	}
+	policy, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+	return string(policy), nil
+}
+
+// stringOrSlice reads policy elements which can be either a string or a list of strings
+func stringOrSlice(v interface{}) []string {
+	switch value := v.(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		result := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+func flattenPolicyPrincipal(v interface{}) []map[string]interface{} {
+	principal := map[string]interface{}{"all": false}
+	var ids []string
+	switch value := v.(type) {
+	case string:
+		ids = []string{value}
+	case map[string]interface{}:
+		ids = stringOrSlice(value["AWS"])
+	}
+	var domains, users []string
+	for _, id := range ids {
+		if id == "*" {
+			principal["all"] = true
+			continue
+		}
+		id = strings.TrimPrefix(id, policyIAMPrefix)
+		parts := strings.SplitN(id, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if parts[1] == "root" {
+			domains = append(domains, parts[0])
+		} else {
+			users = append(users, parts[0]+"/"+strings.TrimPrefix(parts[1], "user/"))
+		}
+	}
+	principal["domains"] = domains
+	principal["users"] = users
+	return []map[string]interface{}{principal}
+}
+
+func flattenBucketPolicy(policy string) ([]map[string]interface{}, error) {
+	var document struct {
+		Statement []map[string]interface{} `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, err
+	}
+	statements := make([]map[string]interface{}, len(document.Statement))
+	for i, raw := range document.Statement {
+		var resources []string
+		for _, res := range stringOrSlice(raw["Resource"]) {
+			resources = append(resources, strings.TrimPrefix(res, policyResourcePrefix))
+		}
+		var conditions []map[string]interface{}
+		if operators, ok := raw["Condition"].(map[string]interface{}); ok {
+			for operator, keysRaw := range operators {
+				keys, ok := keysRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				for key, values := range keys {
+					conditions = append(conditions, map[string]interface{}{
+						"operator": operator,
+						"key":      key,
+						"values":   stringOrSlice(values),
+					})
+				}
+			}
+		}
+		sid, _ := raw["Sid"].(string)
+		effect, _ := raw["Effect"].(string)
+		statements[i] = map[string]interface{}{
+			"sid":       sid,
+			"effect":    effect,
+			"principal": flattenPolicyPrincipal(raw["Principal"]),
+			"actions":   stringOrSlice(raw["Action"]),
+			"resources": resources,
+			"condition": conditions,
+		}
+	}
+	return statements, nil
+}
+
 func resourceObsBucketPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.NewObjectStorageClient(config.GetRegion(d))
@@ -46,6 +316,12 @@ func resourceObsBucketPolicyPut(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	policy := d.Get("policy").(string)
+	if statements, ok := d.GetOk("statement"); ok {
+		policy, err = buildBucketPolicy(statements.([]interface{}))
+		if err != nil {
+			return fmterr.Errorf("error building OBS policy: %s", err)
+		}
+	}
 	bucket := d.Get("bucket").(string)
 
 	log.Printf("[DEBUG] OBS bucket: %s, put policy: %s", bucket, policy)
@@ -73,7 +349,7 @@ func resourceObsBucketPolicyPut(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(bucket)
 
-	return nil
+	return resourceObsBucketPolicyRead(ctx, d, meta)
 }
 
 func resourceObsBucketPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -87,11 +363,34 @@ func resourceObsBucketPolicyRead(_ context.Context, d *schema.ResourceData, meta
 	pol, err := client.GetBucketPolicy(d.Id())
 
 	if err != nil {
-		return fmterr.Errorf("error getting bucket policy")
+		// the policy is gone together with the bucket or was removed outside of terraform,
+		// ACL of the bucket is not affected by both
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] OBS bucket policy of %s not found (%s), removing from state", d.Id(), obsError.Code)
+			d.SetId("")
+			return nil
+		}
+		return fmterr.Errorf("error getting bucket policy: %s", err)
 	}
 
-	if err := d.Set("policy", pol.Policy); err != nil {
-		return diag.FromErr(err)
+	policy, err := common.NormalizeJsonString(pol.Policy)
+	if err != nil {
+		return fmterr.Errorf("policy contains an invalid JSON: %s", err)
+	}
+
+	mErr := multierror.Append(
+		d.Set("bucket", d.Id()),
+		d.Set("policy", policy),
+	)
+	if _, ok := d.GetOk("statement"); ok {
+		statements, err := flattenBucketPolicy(policy)
+		if err != nil {
+			return fmterr.Errorf("error reading OBS policy statements: %s", err)
+		}
+		mErr = multierror.Append(mErr, d.Set("statement", statements))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting OBS bucket policy fields: %s", err)
 	}
 
 	return nil
@@ -117,3 +416,10 @@ func resourceObsBucketPolicyDelete(_ context.Context, d *schema.ResourceData, me
 	}
 	return nil
 }
+
+func resourceObsBucketPolicyImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("bucket", d.Id()); err != nil {
+		return nil, fmt.Errorf("error setting bucket: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,184 @@
+package osm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const osmClientError = "error creating OpenTelekomCloud OSM client: %w"
+
+type serviceTicket struct {
+	ID          string `json:"incident_id"`
+	Description string `json:"simple_description"`
+	Status      string `json:"status_id"`
+	Created     string `json:"create_time"`
+}
+
+// ResourceServiceTicket files a quota increase request with the support,
+// the ticket is created once and stays in the support system after the resource is destroyed
+func ResourceServiceTicket() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceTicketCreate,
+		ReadContext:   resourceServiceTicketRead,
+		DeleteContext: resourceServiceTicketDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"quotas": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"limit": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"justification": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"business_type_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"contact_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"contact_phone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ticket_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// quotaTicketDescription lists the requested limits followed by the justification
+func quotaTicketDescription(quotas []interface{}, justification string) string {
+	var b strings.Builder
+	b.WriteString("Quota increase request:\n")
+	for _, raw := range quotas {
+		quota := raw.(map[string]interface{})
+		_, _ = fmt.Fprintf(&b, "- %s: %d\n", quota["resource"].(string), quota["limit"].(int))
+	}
+	b.WriteString("\nJustification: ")
+	b.WriteString(justification)
+	return b.String()
+}
+
+func resourceServiceTicketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := config.OsmV2Client(region)
+	if err != nil {
+		return fmterr.Errorf(osmClientError, err)
+	}
+
+	opts := map[string]interface{}{
+		"simple_description": quotaTicketDescription(d.Get("quotas").([]interface{}), d.Get("justification").(string)),
+		"region_id":          region,
+	}
+	if v, ok := d.GetOk("business_type_id"); ok {
+		opts["business_type_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("contact_email"); ok {
+		opts["remind_mail"] = v.(string)
+	}
+	if v, ok := d.GetOk("contact_phone"); ok {
+		opts["remind_mobile"] = v.(string)
+	}
+	log.Printf("[DEBUG] Creating service ticket: %#v", opts)
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("servicerequest", "cases"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	var created struct {
+		ID string `json:"incident_id"`
+	}
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error creating service ticket: %w", err)
+	}
+	d.SetId(created.ID)
+
+	return resourceServiceTicketRead(ctx, d, meta)
+}
+
+func resourceServiceTicketRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.OsmV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(osmClientError, err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("servicerequest", "cases", d.Id()), &r.Body, nil)
+	var ticket serviceTicket
+	if err := r.ExtractIntoStructPtr(&ticket, "incident_info"); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "service ticket"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ticket_id", ticket.ID),
+		d.Set("status", ticket.Status),
+		d.Set("created_at", ticket.Created),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting service ticket fields: %w", err)
+	}
+
+	return nil
+}
+
+// resourceServiceTicketDelete only removes the ticket from the state, filed tickets can't be withdrawn via API
+func resourceServiceTicketDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
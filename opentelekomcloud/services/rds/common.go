@@ -1,5 +1,22 @@
 package rds
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	errCreateClient = "error creating RDSv3 client: %w"
+
+	// rdsPageLimit is the maximum page size of the database and account lists
+	rdsPageLimit = 100
 )
+
+// parseInstanceObjectID parses the ID of the database or account in the format `instance_id/name`
+func parseInstanceObjectID(id string) (instanceID, name string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, expected `instance_id/name`, got %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,212 @@
+package rds
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceRdsInstancesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRdsInstancesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"datastore_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"datastore_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": common.TagsSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datastore_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datastore_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"public_ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsInstancesV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	listOpts := instances.ListRdsInstanceOpts{
+		Name:          d.Get("name").(string),
+		Type:          d.Get("type").(string),
+		DataStoreType: d.Get("datastore_type").(string),
+		VpcId:         d.Get("vpc_id").(string),
+		SubnetId:      d.Get("subnet_id").(string),
+	}
+	pages, err := instances.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing RDSv3 instances: %w", err)
+	}
+	allInstances, err := instances.ExtractRdsInstances(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting RDSv3 instances: %w", err)
+	}
+
+	version := d.Get("datastore_version").(string)
+	status := d.Get("status").(string)
+	tagList := common.ExpandResourceTags(d.Get("tags").(map[string]interface{}))
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, instance := range allInstances.Instances {
+		if version != "" && instance.DataStore.Version != version {
+			continue
+		}
+		if status != "" && instance.Status != status {
+			continue
+		}
+		if !hasAllTags(instance, tagList) {
+			continue
+		}
+		ids = append(ids, instance.Id)
+		result = append(result, map[string]interface{}{
+			"id":                instance.Id,
+			"name":              instance.Name,
+			"type":              instance.Type,
+			"status":            instance.Status,
+			"datastore_type":    instance.DataStore.Type,
+			"datastore_version": instance.DataStore.Version,
+			"flavor":            instance.FlavorRef,
+			"private_ips":       instance.PrivateIps,
+			"public_ips":        instance.PublicIps,
+			"port":              instance.Port,
+			"vpc_id":            instance.VpcId,
+			"subnet_id":         instance.SubnetId,
+			"security_group_id": instance.SecurityGroupId,
+			"tags":              common.TagsToMap(instance.Tags),
+		})
+	}
+	log.Printf("[DEBUG] Found %d RDSv3 instances matching the filters", len(ids))
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("instances", result),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting RDSv3 instances fields: %w", err)
+	}
+
+	return nil
+}
+
+func hasAllTags(instance instances.RdsInstanceResponse, tagList []tags.ResourceTag) bool {
+	for _, tag := range tagList {
+		if !common.Contains(instance.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
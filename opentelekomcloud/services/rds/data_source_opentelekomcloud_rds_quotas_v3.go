@@ -0,0 +1,61 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// rdsQuotaNames maps RDS API quota types to the attribute names
+var rdsQuotaNames = map[string]string{
+	"instance": "instances",
+	"vcpus":    "vcpus",
+	"ram":      "ram",
+	"volume":   "volume_size",
+}
+
+func DataSourceRdsQuotasV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRdsQuotasV3Read,
+		Schema:      common.QuotaSchema("instances", "vcpus", "ram", "volume_size"),
+	}
+}
+
+func dataSourceRdsQuotasV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud RDSv3 client: %w", err)
+	}
+
+	// RDS quotas are not supported by the SDK
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("quotas"), &r.Body, nil)
+	var res struct {
+		Quotas struct {
+			Resources []common.ResourceQuota `json:"resources"`
+		} `json:"quotas"`
+	}
+	if err := r.ExtractInto(&res); err != nil {
+		return fmterr.Errorf("error retrieving RDS quotas: %w", err)
+	}
+
+	d.SetId(client.ProjectID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		common.SetResourceQuotas(d, res.Quotas.Resources, rdsQuotaNames),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting RDS quotas: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type rdsAccountPrivilege struct {
+	Name     string `json:"name"`
+	Readonly bool   `json:"readonly"`
+}
+
+type rdsAccount struct {
+	Name      string                `json:"name"`
+	Databases []rdsAccountPrivilege `json:"databases"`
+}
+
+func ResourceRdsAccountV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsAccountV3Create,
+		ReadContext:   resourceRdsAccountV3Read,
+		UpdateContext: resourceRdsAccountV3Update,
+		DeleteContext: resourceRdsAccountV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 32),
+			},
+			"privileges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listRdsAccounts(client *golangsdk.ServiceClient, instanceID string) ([]rdsAccount, error) {
+	var accounts []rdsAccount
+	for page := 1; ; page++ {
+		query := url.Values{
+			"page":  []string{strconv.Itoa(page)},
+			"limit": []string{strconv.Itoa(rdsPageLimit)},
+		}
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "db_user", "detail")+"?"+query.Encode(), &r.Body, nil)
+		var pageAccounts []rdsAccount
+		if err := r.ExtractIntoSlicePtr(&pageAccounts, "users"); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, pageAccounts...)
+		if len(pageAccounts) < rdsPageLimit {
+			return accounts, nil
+		}
+	}
+}
+
+func expandRdsAccountPrivileges(raw []interface{}) map[string]bool {
+	privileges := make(map[string]bool, len(raw))
+	for _, v := range raw {
+		privilege := v.(map[string]interface{})
+		privileges[privilege["database_name"].(string)] = privilege["readonly"].(bool)
+	}
+	return privileges
+}
+
+func grantRdsPrivilege(client *golangsdk.ServiceClient, instanceID, account, database string, readonly bool) error {
+	opts := map[string]interface{}{
+		"db_name": database,
+		"users": []rdsAccountPrivilege{
+			{Name: account, Readonly: readonly},
+		},
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "db_privilege"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func revokeRdsPrivilege(client *golangsdk.ServiceClient, instanceID, account, database string) error {
+	opts := map[string]interface{}{
+		"db_name": database,
+		"users": []map[string]string{
+			{"name": account},
+		},
+	}
+	_, err := client.DeleteWithBody(client.ServiceURL("instances", instanceID, "db_privilege"), opts, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	return err
+}
+
+// updateRdsAccountPrivileges revokes the removed privileges and grants the new or changed ones,
+// changing access mode of the database requires granting it again
+func updateRdsAccountPrivileges(client *golangsdk.ServiceClient, instanceID, account string, oldRaw, newRaw []interface{}) error {
+	oldPrivileges := expandRdsAccountPrivileges(oldRaw)
+	newPrivileges := expandRdsAccountPrivileges(newRaw)
+
+	for database, oldReadonly := range oldPrivileges {
+		if newReadonly, ok := newPrivileges[database]; ok && newReadonly == oldReadonly {
+			continue
+		}
+		if err := revokeRdsPrivilege(client, instanceID, account, database); err != nil {
+			return fmt.Errorf("error revoking privilege on database %s: %w", database, err)
+		}
+	}
+	for database, newReadonly := range newPrivileges {
+		if oldReadonly, ok := oldPrivileges[database]; ok && newReadonly == oldReadonly {
+			continue
+		}
+		if err := grantRdsPrivilege(client, instanceID, account, database, newReadonly); err != nil {
+			return fmt.Errorf("error granting privilege on database %s: %w", database, err)
+		}
+	}
+	return nil
+}
+
+func resourceRdsAccountV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	opts := map[string]interface{}{
+		"name":     name,
+		"password": d.Get("password").(string),
+	}
+	log.Printf("[DEBUG] Creating RDS account %s in instance %s", name, instanceID)
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("instances", instanceID, "db_user"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if r.Err != nil {
+		return fmterr.Errorf("error creating RDS account: %w", r.Err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, name))
+
+	if err := waitForRdsJob(client, r, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for RDS account to be created: %w", err)
+	}
+
+	if err := updateRdsAccountPrivileges(client, instanceID, name, nil, d.Get("privileges").(*schema.Set).List()); err != nil {
+		return fmterr.Errorf("error setting privileges of RDS account %s: %w", name, err)
+	}
+
+	return resourceRdsAccountV3Read(ctx, d, meta)
+}
+
+func resourceRdsAccountV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID, name, err := parseInstanceObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accounts, err := listRdsAccounts(client, instanceID)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "RDS account"))
+	}
+	var found *rdsAccount
+	for i := range accounts {
+		if accounts[i].Name == name {
+			found = &accounts[i]
+			break
+		}
+	}
+	if found == nil {
+		return diag.FromErr(common.CheckDeleted(d, golangsdk.ErrDefault404{}, "RDS account"))
+	}
+
+	privileges := make([]map[string]interface{}, len(found.Databases))
+	for i, database := range found.Databases {
+		privileges[i] = map[string]interface{}{
+			"database_name": database.Name,
+			"readonly":      database.Readonly,
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instanceID),
+		d.Set("name", found.Name),
+		d.Set("privileges", privileges),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting RDS account fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRdsAccountV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID, name, err := parseInstanceObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("password") {
+		opts := map[string]interface{}{
+			"name":     name,
+			"password": d.Get("password").(string),
+		}
+		_, err := client.Post(client.ServiceURL("instances", instanceID, "db_user", "resetpwd"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error resetting password of RDS account %s: %w", name, err)
+		}
+	}
+
+	if d.HasChange("privileges") {
+		oldRaw, newRaw := d.GetChange("privileges")
+		err := updateRdsAccountPrivileges(client, instanceID, name, oldRaw.(*schema.Set).List(), newRaw.(*schema.Set).List())
+		if err != nil {
+			return fmterr.Errorf("error updating privileges of RDS account %s: %w", name, err)
+		}
+	}
+
+	return resourceRdsAccountV3Read(ctx, d, meta)
+}
+
+func resourceRdsAccountV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID, name, err := parseInstanceObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.DeleteWithResponse(client.ServiceURL("instances", instanceID, "db_user", name), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if r.Err != nil {
+		return diag.FromErr(common.CheckDeleted(d, r.Err, "error deleting RDS account"))
+	}
+	if err := waitForRdsJob(client, r, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for RDS account to be deleted: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,235 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type rdsDatabase struct {
+	Name         string `json:"name"`
+	CharacterSet string `json:"character_set"`
+	Owner        string `json:"owner"`
+	LcCollate    string `json:"lc_collate"`
+	LcCtype      string `json:"lc_ctype"`
+}
+
+func ResourceRdsDatabaseV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsDatabaseV3Create,
+		ReadContext:   resourceRdsDatabaseV3Read,
+		DeleteContext: resourceRdsDatabaseV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"template0", "template1"}, false),
+			},
+			"lc_collate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"lc_ctype": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func listRdsDatabases(client *golangsdk.ServiceClient, instanceID string) ([]rdsDatabase, error) {
+	var databases []rdsDatabase
+	for page := 1; ; page++ {
+		query := url.Values{
+			"page":  []string{strconv.Itoa(page)},
+			"limit": []string{strconv.Itoa(rdsPageLimit)},
+		}
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "database", "detail")+"?"+query.Encode(), &r.Body, nil)
+		var pageDatabases []rdsDatabase
+		if err := r.ExtractIntoSlicePtr(&pageDatabases, "databases"); err != nil {
+			return nil, err
+		}
+		databases = append(databases, pageDatabases...)
+		if len(pageDatabases) < rdsPageLimit {
+			return databases, nil
+		}
+	}
+}
+
+// waitForRdsJob waits for the job of the asynchronous database or account operation, if any
+func waitForRdsJob(client *golangsdk.ServiceClient, r golangsdk.Result, timeout time.Duration) error {
+	var job struct {
+		JobID string `json:"job_id"`
+	}
+	if err := r.ExtractInto(&job); err != nil {
+		return err
+	}
+	if job.JobID == "" {
+		return nil
+	}
+	return instances.WaitForJobCompleted(client, int(timeout.Seconds()), job.JobID)
+}
+
+func resourceRdsDatabaseV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	opts := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	// the rest of the options are engine specific and are sent only if set
+	for _, key := range []string{"character_set", "owner", "template", "lc_collate", "lc_ctype"} {
+		if v, ok := d.GetOk(key); ok {
+			opts[key] = v.(string)
+		}
+	}
+	log.Printf("[DEBUG] Creating RDS database: %#v", opts)
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("instances", instanceID, "database"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if r.Err != nil {
+		return fmterr.Errorf("error creating RDS database: %w", r.Err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, opts["name"]))
+
+	if err := waitForRdsJob(client, r, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for RDS database to be created: %w", err)
+	}
+
+	return resourceRdsDatabaseV3Read(ctx, d, meta)
+}
+
+func resourceRdsDatabaseV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID, name, err := parseInstanceObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databases, err := listRdsDatabases(client, instanceID)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "RDS database"))
+	}
+	var found *rdsDatabase
+	for i := range databases {
+		if databases[i].Name == name {
+			found = &databases[i]
+			break
+		}
+	}
+	if found == nil {
+		return diag.FromErr(common.CheckDeleted(d, golangsdk.ErrDefault404{}, "RDS database"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instanceID),
+		d.Set("name", found.Name),
+		d.Set("character_set", found.CharacterSet),
+		d.Set("owner", found.Owner),
+		d.Set("lc_collate", found.LcCollate),
+		d.Set("lc_ctype", found.LcCtype),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting RDS database fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRdsDatabaseV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID, name, err := parseInstanceObjectID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.DeleteWithResponse(client.ServiceURL("instances", instanceID, "database", name), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if r.Err != nil {
+		return diag.FromErr(common.CheckDeleted(d, r.Err, "error deleting RDS database"))
+	}
+	if err := waitForRdsJob(client, r, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for RDS database to be deleted: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
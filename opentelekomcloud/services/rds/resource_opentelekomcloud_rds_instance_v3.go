@@ -234,6 +234,7 @@ func ResourceRdsInstanceV3() *schema.Resource {
 					ValidateFunc: common.ValidateIP,
 				},
 			},
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -337,6 +338,8 @@ func resourceRdsInstanceV3Create(ctx context.Context, d *schema.ResourceData, me
 		SubnetId:         d.Get("subnet_id").(string),
 		SecurityGroupId:  d.Get("security_group_id").(string),
 		ChargeInfo:       resourceRDSChangeMode(),
+
+		EnterpriseProjectId: d.Get("enterprise_project_id").(string),
 	}
 	createResult := instances.Create(client, createOpts)
 	r, err := createResult.Extract()
@@ -723,6 +726,10 @@ func resourceRdsInstanceV3Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "rds", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRdsInstanceV3Read(ctx, d, meta)
 }
 
@@ -759,6 +766,7 @@ func resourceRdsInstanceV3Read(_ context.Context, d *schema.ResourceData, meta i
 		d.Set("vpc_id", rdsInstance.VpcId),
 		d.Set("created", rdsInstance.Created),
 		d.Set("ha_replication_mode", rdsInstance.Ha.ReplicationMode),
+		d.Set("enterprise_project_id", rdsInstance.EnterpriseProjectId),
 	)
 
 	if me.ErrorOrNil() != nil {
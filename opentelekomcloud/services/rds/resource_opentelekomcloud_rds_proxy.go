@@ -0,0 +1,381 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type proxyInstanceWeight struct {
+	ID     string `json:"id"`
+	Weight int    `json:"weight"`
+}
+
+type proxyInfo struct {
+	ID             string `json:"pool_id"`
+	Status         string `json:"status"`
+	Address        string `json:"address"`
+	Port           int    `json:"port"`
+	FlavorRef      string `json:"flavor_ref"`
+	NodeNum        int    `json:"node_num"`
+	DelayThreshold int    `json:"delay_threshold_in_seconds"`
+}
+
+type proxyResponse struct {
+	Proxy             proxyInfo             `json:"proxy"`
+	MasterInstance    proxyInstanceWeight   `json:"master_instance"`
+	ReadonlyInstances []proxyInstanceWeight `json:"readonly_instances"`
+}
+
+func ResourceRdsProxy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsProxyCreate,
+		ReadContext:   resourceRdsProxyRead,
+		UpdateContext: resourceRdsProxyUpdate,
+		DeleteContext: resourceRdsProxyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.ForceNewIfChange("node_num", func(_ context.Context, old, new, _ interface{}) bool {
+			// proxy nodes can't be removed
+			return new.(int) < old.(int)
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor_ref": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"node_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(2, 16),
+			},
+			"delay_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 7200),
+			},
+			"master_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"readonly_weights": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"proxy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getRdsProxy(client *golangsdk.ServiceClient, instanceID string) (*proxyResponse, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "proxy"), &r.Body, nil)
+	proxy := new(proxyResponse)
+	if err := r.ExtractInto(proxy); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func waitForRdsProxyActive(ctx context.Context, client *golangsdk.ServiceClient, instanceID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			proxy, err := getRdsProxy(client, instanceID)
+			if err != nil {
+				return nil, "", err
+			}
+			switch proxy.Proxy.Status {
+			case "ACTIVE":
+				return proxy, "ACTIVE", nil
+			case "FAILED", "ABNORMAL":
+				return proxy, proxy.Proxy.Status, fmt.Errorf("proxy of RDS instance %s is %s", instanceID, proxy.Proxy.Status)
+			}
+			return proxy, "PENDING", nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func expandProxyReadonlyWeights(raw []interface{}) []proxyInstanceWeight {
+	weights := make([]proxyInstanceWeight, len(raw))
+	for i, v := range raw {
+		weight := v.(map[string]interface{})
+		weights[i] = proxyInstanceWeight{
+			ID:     weight["instance_id"].(string),
+			Weight: weight["weight"].(int),
+		}
+	}
+	return weights
+}
+
+func updateRdsProxyWeights(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	opts := map[string]interface{}{
+		"master_weight":      d.Get("master_weight").(int),
+		"readonly_instances": expandProxyReadonlyWeights(d.Get("readonly_weights").(*schema.Set).List()),
+	}
+	_, err := client.Put(client.ServiceURL("instances", d.Id(), "proxy", "weight"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func updateRdsProxyDelayThreshold(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	opts := map[string]interface{}{
+		"delay_threshold_in_seconds": d.Get("delay_threshold").(int),
+	}
+	_, err := client.Put(client.ServiceURL("instances", d.Id(), "proxy", "delay-threshold"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func resourceRdsProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	opts := map[string]interface{}{
+		"flavor_ref": d.Get("flavor_ref").(string),
+		"node_num":   d.Get("node_num").(int),
+	}
+	log.Printf("[DEBUG] Enabling proxy of RDS instance %s: %#v", instanceID, opts)
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "proxy"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return fmterr.Errorf("error enabling proxy of RDS instance %s: %w", instanceID, err)
+	}
+	d.SetId(instanceID)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForRdsProxyActive(ctx, client, d.Id(), timeout); err != nil {
+		return fmterr.Errorf("error waiting for proxy of RDS instance %s to become active: %w", d.Id(), err)
+	}
+
+	if _, ok := d.GetOk("delay_threshold"); ok {
+		if err := updateRdsProxyDelayThreshold(client, d); err != nil {
+			return fmterr.Errorf("error setting delay threshold of RDS proxy: %w", err)
+		}
+	}
+
+	_, masterSet := d.GetOk("master_weight")
+	_, readonlySet := d.GetOk("readonly_weights")
+	if masterSet || readonlySet {
+		if err := updateRdsProxyWeights(client, d); err != nil {
+			return fmterr.Errorf("error setting read weights of RDS proxy: %w", err)
+		}
+	}
+
+	return resourceRdsProxyRead(ctx, d, meta)
+}
+
+func resourceRdsProxyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	proxy, err := getRdsProxy(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "RDS proxy"))
+	}
+	if proxy.Proxy.ID == "" {
+		log.Printf("[WARN] Proxy of RDS instance %s is disabled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	readonlyWeights := make([]map[string]interface{}, len(proxy.ReadonlyInstances))
+	for i, instance := range proxy.ReadonlyInstances {
+		readonlyWeights[i] = map[string]interface{}{
+			"instance_id": instance.ID,
+			"weight":      instance.Weight,
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", d.Id()),
+		d.Set("flavor_ref", proxy.Proxy.FlavorRef),
+		d.Set("node_num", proxy.Proxy.NodeNum),
+		d.Set("delay_threshold", proxy.Proxy.DelayThreshold),
+		d.Set("master_weight", proxy.MasterInstance.Weight),
+		d.Set("readonly_weights", readonlyWeights),
+		d.Set("proxy_id", proxy.Proxy.ID),
+		d.Set("address", proxy.Proxy.Address),
+		d.Set("port", proxy.Proxy.Port),
+		d.Set("status", proxy.Proxy.Status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting RDS proxy fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRdsProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("flavor_ref") {
+		opts := map[string]interface{}{"flavor_ref": d.Get("flavor_ref").(string)}
+		_, err := client.Put(client.ServiceURL("instances", d.Id(), "proxy", "flavor"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error changing flavor of RDS proxy: %w", err)
+		}
+		if err := waitForRdsProxyActive(ctx, client, d.Id(), timeout); err != nil {
+			return fmterr.Errorf("error waiting for proxy of RDS instance %s to become active: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("node_num") {
+		opts := map[string]interface{}{"node_num": d.Get("node_num").(int)}
+		_, err := client.Post(client.ServiceURL("instances", d.Id(), "proxy", "scale"), opts, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+		if err != nil {
+			return fmterr.Errorf("error scaling RDS proxy: %w", err)
+		}
+		if err := waitForRdsProxyActive(ctx, client, d.Id(), timeout); err != nil {
+			return fmterr.Errorf("error waiting for proxy of RDS instance %s to become active: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("delay_threshold") {
+		if err := updateRdsProxyDelayThreshold(client, d); err != nil {
+			return fmterr.Errorf("error updating delay threshold of RDS proxy: %w", err)
+		}
+	}
+
+	if d.HasChanges("master_weight", "readonly_weights") {
+		if err := updateRdsProxyWeights(client, d); err != nil {
+			return fmterr.Errorf("error updating read weights of RDS proxy: %w", err)
+		}
+	}
+
+	return resourceRdsProxyRead(ctx, d, meta)
+}
+
+func resourceRdsProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("instances", d.Id(), "proxy"), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error disabling RDS proxy"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			proxy, err := getRdsProxy(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return proxy, "DELETED", nil
+				}
+				return nil, "", err
+			}
+			if proxy.Proxy.ID == "" {
+				return proxy, "DELETED", nil
+			}
+			return proxy, "PENDING", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for proxy of RDS instance %s to be disabled: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,238 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+type sqlLimit struct {
+	ID             string `json:"id"`
+	SqlType        string `json:"sql_type"`
+	Pattern        string `json:"pattern"`
+	MaxConcurrency int    `json:"max_concurrency"`
+	MaxWaiting     int    `json:"max_waiting"`
+}
+
+func ResourceRdsSqlLimit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsSqlLimitCreate,
+		ReadContext:   resourceRdsSqlLimitRead,
+		UpdateContext: resourceRdsSqlLimitUpdate,
+		DeleteContext: resourceRdsSqlLimitDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sql_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SELECT", "UPDATE", "DELETE"}, false),
+			},
+			"pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 50000),
+			},
+			"max_waiting": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+		},
+	}
+}
+
+// parseSqlLimitID parses the ID in the format `instance_id/db_name/limit_id`
+func parseSqlLimitID(id string) (instanceID, dbName, limitID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid ID format, expected `instance_id/db_name/limit_id`, got %s", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func listSqlLimits(client *golangsdk.ServiceClient, instanceID, dbName string) ([]sqlLimit, error) {
+	query := url.Values{"db_name": []string{dbName}}
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "sql-limit")+"?"+query.Encode(), &r.Body, nil)
+	var limits []sqlLimit
+	if err := r.ExtractIntoSlicePtr(&limits, "sql_limit_objects"); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+func resourceRdsSqlLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	opts := map[string]interface{}{
+		"db_name":         dbName,
+		"sql_type":        d.Get("sql_type").(string),
+		"pattern":         d.Get("pattern").(string),
+		"max_concurrency": d.Get("max_concurrency").(int),
+		"max_waiting":     d.Get("max_waiting").(int),
+	}
+	log.Printf("[DEBUG] Creating RDS SQL limit: %#v", opts)
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "sql-limit"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating RDS SQL limit: %w", err)
+	}
+
+	// the ID of the rule isn't returned, the rule is unique by the type and pattern
+	limits, err := listSqlLimits(client, instanceID, dbName)
+	if err != nil {
+		return fmterr.Errorf("error listing RDS SQL limits: %w", err)
+	}
+	for _, limit := range limits {
+		if limit.SqlType == opts["sql_type"] && limit.Pattern == opts["pattern"] {
+			d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, dbName, limit.ID))
+			return resourceRdsSqlLimitRead(ctx, d, meta)
+		}
+	}
+
+	return fmterr.Errorf("created RDS SQL limit is not found in database %s", dbName)
+}
+
+func resourceRdsSqlLimitRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID, dbName, limitID, err := parseSqlLimitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	limits, err := listSqlLimits(client, instanceID, dbName)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "RDS SQL limit"))
+	}
+	var found *sqlLimit
+	for i := range limits {
+		if limits[i].ID == limitID {
+			found = &limits[i]
+			break
+		}
+	}
+	if found == nil {
+		return diag.FromErr(common.CheckDeleted(d, golangsdk.ErrDefault404{}, "RDS SQL limit"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instanceID),
+		d.Set("db_name", dbName),
+		d.Set("sql_type", found.SqlType),
+		d.Set("pattern", found.Pattern),
+		d.Set("max_concurrency", found.MaxConcurrency),
+		d.Set("max_waiting", found.MaxWaiting),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting RDS SQL limit fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRdsSqlLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID, dbName, limitID, err := parseSqlLimitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := map[string]interface{}{
+		"db_name":         dbName,
+		"id":              limitID,
+		"max_concurrency": d.Get("max_concurrency").(int),
+		"max_waiting":     d.Get("max_waiting").(int),
+	}
+	_, err = client.Put(client.ServiceURL("instances", instanceID, "sql-limit"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating RDS SQL limit: %w", err)
+	}
+
+	return resourceRdsSqlLimitRead(ctx, d, meta)
+}
+
+func resourceRdsSqlLimitDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	instanceID, dbName, limitID, err := parseSqlLimitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := map[string]interface{}{
+		"db_name": dbName,
+		"id":      limitID,
+	}
+	_, err = client.DeleteWithBody(client.ServiceURL("instances", instanceID, "sql-limit"), opts, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting RDS SQL limit"))
+	}
+
+	d.SetId("")
+	return nil
+}
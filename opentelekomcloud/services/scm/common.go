@@ -0,0 +1,49 @@
+package scm
+
+import (
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	scmClientError = "error creating OpenTelekomCloud SCMv3 client: %w"
+)
+
+type scmCertificate struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Domain             string `json:"domain"`
+	Sans               string `json:"sans"`
+	SignatureAlgorithm string `json:"signature_algrithm"`
+	Type               string `json:"type"`
+	Brand              string `json:"brand"`
+	Status             string `json:"status"`
+	ExpireTime         string `json:"expire_time"`
+	ValidityPeriod     int    `json:"validity_period"`
+}
+
+func getScmCertificate(client *golangsdk.ServiceClient, id string) (*scmCertificate, error) {
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(client.ServiceURL("scm", "certificates", id), &r.Body, nil)
+	var certificate scmCertificate
+	if err := r.ExtractInto(&certificate); err != nil {
+		return nil, err
+	}
+	return &certificate, nil
+}
+
+// daysUntilExpiry returns the number of full days before the certificate expires,
+// negative value means the certificate is already expired
+func daysUntilExpiry(expireTime string, now time.Time) (int, error) {
+	// the time is returned as `2006-01-02 15:04:05.0`
+	const layout = "2006-01-02 15:04:05"
+	if len(expireTime) > len(layout) {
+		expireTime = expireTime[:len(layout)]
+	}
+	expire, err := time.Parse(layout, expireTime)
+	if err != nil {
+		return 0, err
+	}
+	return int(expire.Sub(now).Hours() / 24), nil
+}
@@ -0,0 +1,160 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// DataSourceScmCertificateV3 returns the certificate details including its expiry,
+// so the rotation can be planned in the configuration
+func DataSourceScmCertificateV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScmCertificateV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"certificate_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"certificate_id", "name"},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"brand": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signature_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"days_until_expiry": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"expired": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func findScmCertificateByName(client *golangsdk.ServiceClient, name string) (string, error) {
+	r := golangsdk.Result{}
+	listURL := client.ServiceURL("scm", "certificates") + "?limit=50&search_value=" + url.QueryEscape(name)
+	_, r.Err = client.Get(listURL, &r.Body, nil)
+	var certificates []scmCertificate
+	if err := r.ExtractIntoSlicePtr(&certificates, "certificates"); err != nil {
+		return "", fmt.Errorf("error listing SCM certificates: %w", err)
+	}
+
+	var ids []string
+	for _, certificate := range certificates {
+		if certificate.Name == name {
+			ids = append(ids, certificate.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no SCM certificate found with name %s", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("multiple SCM certificates found with name %s: %s", name, strings.Join(ids, ", "))
+	}
+}
+
+func dataSourceScmCertificateV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(scmClientError, err)
+	}
+
+	id := d.Get("certificate_id").(string)
+	if id == "" {
+		id, err = findScmCertificateByName(client, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	certificate, err := getScmCertificate(client, id)
+	if err != nil {
+		return fmterr.Errorf("error retrieving SCM certificate %s: %w", id, err)
+	}
+	log.Printf("[DEBUG] Retrieved SCM certificate %s: %#v", id, certificate)
+
+	days, err := daysUntilExpiry(certificate.ExpireTime, time.Now().UTC())
+	if err != nil {
+		return fmterr.Errorf("error parsing expiration time of SCM certificate %s: %w", id, err)
+	}
+
+	var sans []string
+	if certificate.Sans != "" {
+		sans = strings.Split(certificate.Sans, ";")
+	}
+
+	d.SetId(certificate.ID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("certificate_id", certificate.ID),
+		d.Set("name", certificate.Name),
+		d.Set("domain", certificate.Domain),
+		d.Set("sans", sans),
+		d.Set("type", certificate.Type),
+		d.Set("brand", certificate.Brand),
+		d.Set("signature_algorithm", certificate.SignatureAlgorithm),
+		d.Set("status", certificate.Status),
+		d.Set("expire_time", certificate.ExpireTime),
+		d.Set("days_until_expiry", days),
+		d.Set("expired", days < 0),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting SCM certificate fields: %w", err)
+	}
+
+	return nil
+}
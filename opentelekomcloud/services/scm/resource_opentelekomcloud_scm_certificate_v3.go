@@ -0,0 +1,234 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// pushServices maps target service names to the SCM ones
+var pushServices = map[string]string{
+	"elb": "Enhance_ELB",
+	"waf": "WAF",
+	"cdn": "CDN",
+}
+
+func ResourceScmCertificateV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScmCertificateV3Create,
+		ReadContext:   resourceScmCertificateV3Read,
+		UpdateContext: resourceScmCertificateV3Update,
+		DeleteContext: resourceScmCertificateV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"certificate": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: common.GetHashOrEmpty,
+			},
+			"certificate_chain": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				StateFunc: common.GetHashOrEmpty,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				StateFunc: common.GetHashOrEmpty,
+			},
+			"target": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"elb", "waf", "cdn",
+							}, false),
+						},
+						"projects": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"signature_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func pushScmCertificate(client *golangsdk.ServiceClient, id string, targets []interface{}) error {
+	for _, v := range targets {
+		target := v.(map[string]interface{})
+		service := pushServices[target["service"].(string)]
+		body := map[string]interface{}{
+			"target_service": service,
+		}
+		if projects := target["projects"].(*schema.Set).List(); len(projects) > 0 {
+			body["target_project"] = common.ExpandToStringSlice(projects)
+		}
+		log.Printf("[DEBUG] Pushing SCM certificate %s: %#v", id, body)
+		_, err := client.Post(client.ServiceURL("scm", "certificates", id, "push"), body, nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+		if err != nil {
+			return fmt.Errorf("error pushing SCM certificate to %s: %w", service, err)
+		}
+	}
+	return nil
+}
+
+func resourceScmCertificateV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(scmClientError, err)
+	}
+
+	body := map[string]interface{}{
+		"name":              d.Get("name"),
+		"certificate":       strings.TrimSpace(d.Get("certificate").(string)),
+		"certificate_chain": strings.TrimSpace(d.Get("certificate_chain").(string)),
+		"private_key":       strings.TrimSpace(d.Get("private_key").(string)),
+	}
+	r := golangsdk.Result{}
+	_, r.Err = client.Post(client.ServiceURL("scm", "certificates", "import"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	var created struct {
+		CertificateID string `json:"certificate_id"`
+	}
+	if err := r.ExtractInto(&created); err != nil {
+		return fmterr.Errorf("error importing SCM certificate: %w", err)
+	}
+	d.SetId(created.CertificateID)
+
+	if err := pushScmCertificate(client, d.Id(), d.Get("target").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScmCertificateV3Read(ctx, d, meta)
+}
+
+func resourceScmCertificateV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(scmClientError, err)
+	}
+
+	certificate, err := getScmCertificate(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "SCM certificate"))
+	}
+
+	var sans []string
+	if certificate.Sans != "" {
+		sans = strings.Split(certificate.Sans, ";")
+	}
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", certificate.Name),
+		d.Set("domain", certificate.Domain),
+		d.Set("sans", sans),
+		d.Set("signature_algorithm", certificate.SignatureAlgorithm),
+		d.Set("status", certificate.Status),
+		d.Set("expire_time", certificate.ExpireTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting SCM certificate fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceScmCertificateV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(scmClientError, err)
+	}
+
+	if d.HasChange("target") {
+		// pushed certificates can't be withdrawn, so only new targets are pushed
+		oldTargets, newTargets := d.GetChange("target")
+		added := newTargets.(*schema.Set).Difference(oldTargets.(*schema.Set))
+		if err := pushScmCertificate(client, d.Id(), added.List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScmCertificateV3Read(ctx, d, meta)
+}
+
+func resourceScmCertificateV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(scmClientError, err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("scm", "certificates", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "SCM certificate"))
+	}
+
+	d.SetId("")
+	return nil
+}
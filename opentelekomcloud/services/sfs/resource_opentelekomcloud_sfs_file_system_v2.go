@@ -2,7 +2,9 @@ package sfs
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sfs/v2/shares"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -19,6 +22,18 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
 
+const (
+	// shareUsedMetadataKey is the share metadata key containing used capacity in bytes
+	shareUsedMetadataKey = "share_used"
+
+	// system metadata keys used for the share encryption
+	cryptKeyIDMetadataKey    = "#sfs_crypt_key_id"
+	cryptDomainIDMetadataKey = "#sfs_crypt_domain_id"
+	cryptAliasMetadataKey    = "#sfs_crypt_alias"
+
+	gigabyte = 1024 * 1024 * 1024
+)
+
 func ResourceSFSFileSystemV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSFSFileSystemV2Create,
@@ -35,6 +50,8 @@ func ResourceSFSFileSystemV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: validateShareShrink,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -80,6 +97,16 @@ func ResourceSFSFileSystemV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"kms_key_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"access_level": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -135,6 +162,22 @@ func resourceSFSMetadataV2(d *schema.ResourceData) map[string]string {
 	return meta
 }
 
+// setShareCryptMetadata fills the share metadata required for the encryption with the KMS key
+func setShareCryptMetadata(config *cfg.Config, region, keyID string, metadata map[string]string) error {
+	kmsClient, err := config.KmsKeyV1Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMS client: %w", err)
+	}
+	key, err := keys.Get(kmsClient, keyID).ExtractKeyInfo()
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenTelekomCloud KMS key %s: %w", keyID, err)
+	}
+	metadata[cryptKeyIDMetadataKey] = key.KeyID
+	metadata[cryptDomainIDMetadataKey] = key.DomainID
+	metadata[cryptAliasMetadataKey] = key.KeyAlias
+	return nil
+}
+
 func resourceSFSFileSystemV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.SfsV2Client(config.GetRegion(d))
@@ -142,13 +185,20 @@ func resourceSFSFileSystemV2Create(ctx context.Context, d *schema.ResourceData,
 		return fmterr.Errorf("error creating OpenTelekomCloud File Share Client: %s", err)
 	}
 
+	metadata := resourceSFSMetadataV2(d)
+	if keyID := d.Get("kms_key_id").(string); keyID != "" {
+		if err := setShareCryptMetadata(config, config.GetRegion(d), keyID, metadata); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	createOpts := shares.CreateOpts{
 		ShareProto:       d.Get("share_proto").(string),
 		Size:             d.Get("size").(int),
 		Name:             d.Get("name").(string),
 		Description:      d.Get("description").(string),
 		IsPublic:         d.Get("is_public").(bool),
-		Metadata:         resourceSFSMetadataV2(d),
+		Metadata:         metadata,
 		AvailabilityZone: d.Get("availability_zone").(string),
 	}
 
@@ -228,6 +278,8 @@ func resourceSFSFileSystemV2Read(_ context.Context, d *schema.ResourceData, meta
 		d.Set("region", config.GetRegion(d)),
 		d.Set("export_location", share.ExportLocation),
 		d.Set("host", share.Host),
+		d.Set("kms_key_id", share.Metadata[cryptKeyIDMetadataKey]),
+		d.Set("kms_key_alias", share.Metadata[cryptAliasMetadataKey]),
 	)
 
 	// NOTE: This tries to remove system metadata.
@@ -296,6 +348,16 @@ func resourceSFSFileSystemV2Update(ctx context.Context, d *schema.ResourceData,
 	}
 	var updateOpts shares.UpdateOpts
 
+	// check shrink before applying any other change
+	if d.HasChange("size") {
+		oldSizeRaw, newSizeRaw := d.GetChange("size")
+		if newSizeRaw.(int) < oldSizeRaw.(int) {
+			if err := checkShareShrink(client, d.Id(), newSizeRaw.(int)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	if d.HasChange("description") || d.HasChange("name") {
 		updateOpts.DisplayName = d.Get("name").(string)
 		updateOpts.DisplayDescription = d.Get("description").(string)
@@ -386,6 +448,46 @@ func resourceSFSFileSystemV2Delete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// validateShareShrink fails the plan if the share is shrunk below its used capacity
+func validateShareShrink(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("size") {
+		return nil
+	}
+	oldSizeRaw, newSizeRaw := d.GetChange("size")
+	if newSizeRaw.(int) >= oldSizeRaw.(int) {
+		return nil
+	}
+
+	config := meta.(*cfg.Config)
+	client, err := config.SfsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud SFSv2 client: %w", err)
+	}
+	return checkShareShrink(client, d.Id(), newSizeRaw.(int))
+}
+
+// checkShareShrink checks that used capacity of the share fits into the new size
+func checkShareShrink(client *golangsdk.ServiceClient, shareID string, newSize int) error {
+	share, err := shares.Get(client, shareID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenTelekomCloud Share File: %w", err)
+	}
+	usedRaw, ok := share.Metadata[shareUsedMetadataKey]
+	if !ok {
+		log.Printf("[WARN] Used capacity of share %s is unknown, skipping shrink check", shareID)
+		return nil
+	}
+	used, err := strconv.ParseInt(usedRaw, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error parsing used capacity of share %s: %w", shareID, err)
+	}
+	if used > int64(newSize)*gigabyte {
+		usedGB := float64(used) / float64(gigabyte)
+		return fmt.Errorf("can't shrink share %s to %d GB: %.2f GB of the share is in use", shareID, newSize, usedGB)
+	}
+	return nil
+}
+
 func waitForSFSFileStatus(client *golangsdk.ServiceClient, shareID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		share, err := shares.Get(client, shareID).Extract()
@@ -0,0 +1,292 @@
+package sfs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// share replicas are available only in the experimental API since 2.11 microversion
+var shareReplicaHeaders = map[string]string{
+	"X-OpenStack-Manila-API-Version":      "2.11",
+	"X-OpenStack-Manila-API-Experimental": "true",
+}
+
+type shareReplica struct {
+	ID               string `json:"id"`
+	ShareID          string `json:"share_id"`
+	AvailabilityZone string `json:"availability_zone"`
+	Status           string `json:"status"`
+	ReplicaState     string `json:"replica_state"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+}
+
+func ResourceSFSShareReplica() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSFSShareReplicaCreate,
+		ReadContext:   resourceSFSShareReplicaRead,
+		UpdateContext: resourceSFSShareReplicaUpdate,
+		DeleteContext: resourceSFSShareReplicaDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"promote": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replica_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getShareReplica(client *golangsdk.ServiceClient, id string) (*shareReplica, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("share-replicas", id), &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: shareReplicaHeaders,
+	})
+	replica := new(shareReplica)
+	if err := r.ExtractIntoStructPtr(replica, "share_replica"); err != nil {
+		return nil, err
+	}
+	return replica, nil
+}
+
+func resourceSFSShareReplicaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SfsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud SFSv2 client: %w", err)
+	}
+
+	opts := map[string]interface{}{
+		"share_replica": map[string]interface{}{
+			"share_id":          d.Get("share_id").(string),
+			"availability_zone": d.Get("availability_zone").(string),
+		},
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("share-replicas"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{202},
+		MoreHeaders: shareReplicaHeaders,
+	})
+	replica := new(shareReplica)
+	if err := r.ExtractIntoStructPtr(replica, "share_replica"); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud SFS share replica: %w", err)
+	}
+	d.SetId(replica.ID)
+
+	if err := waitForShareReplicaSync(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for SFS share replica %s to be synced: %w", d.Id(), err)
+	}
+
+	if d.Get("promote").(bool) {
+		if err := promoteShareReplica(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSFSShareReplicaRead(ctx, d, meta)
+}
+
+func resourceSFSShareReplicaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SfsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud SFSv2 client: %w", err)
+	}
+
+	replica, err := getShareReplica(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "SFS share replica"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("share_id", replica.ShareID),
+		d.Set("availability_zone", replica.AvailabilityZone),
+		d.Set("status", replica.Status),
+		d.Set("replica_state", replica.ReplicaState),
+		d.Set("created_at", replica.CreatedAt),
+		d.Set("updated_at", replica.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting SFS share replica fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSFSShareReplicaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SfsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud SFSv2 client: %w", err)
+	}
+
+	if d.HasChange("promote") {
+		if d.Get("promote").(bool) {
+			if err := promoteShareReplica(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			log.Printf("[WARN] SFS share replica %s can't be demoted, promote another replica instead", d.Id())
+		}
+	}
+
+	return resourceSFSShareReplicaRead(ctx, d, meta)
+}
+
+func resourceSFSShareReplicaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SfsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud SFSv2 client: %w", err)
+	}
+
+	_, err = client.Delete(client.ServiceURL("share-replicas", d.Id()), &golangsdk.RequestOpts{
+		OkCodes:     []int{202},
+		MoreHeaders: shareReplicaHeaders,
+	})
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting SFS share replica"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    shareReplicaStatusRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for SFS share replica %s to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// promoteShareReplica makes the replica active, the former active replica becomes a secondary one
+func promoteShareReplica(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	_, err := client.Post(client.ServiceURL("share-replicas", id, "action"), map[string]interface{}{"promote": nil}, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{202},
+		MoreHeaders: shareReplicaHeaders,
+	})
+	if err != nil {
+		return fmt.Errorf("error promoting OpenTelekomCloud SFS share replica %s: %w", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"replication_change", "in_sync", "out_of_sync"},
+		Target:     []string{"active"},
+		Refresh:    shareReplicaStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for SFS share replica %s to be promoted: %w", id, err)
+	}
+	return nil
+}
+
+// waitForShareReplicaSync waits for the replica to be created and synced with the active one
+func waitForShareReplicaSync(ctx context.Context, client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "out_of_sync"},
+		Target:     []string{"in_sync", "active"},
+		Refresh:    shareReplicaStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func shareReplicaStatusRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replica, err := getShareReplica(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return replica, "deleted", nil
+			}
+			return nil, "", err
+		}
+		if replica.Status == "error" || replica.Status == "error_deleting" {
+			return replica, replica.Status, fmt.Errorf("share replica is in %s status", replica.Status)
+		}
+		return replica, replica.Status, nil
+	}
+}
+
+// shareReplicaStateRefreshFunc returns the replica state, or the status if the replica is not available yet
+func shareReplicaStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replica, err := getShareReplica(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if replica.Status == "error" || replica.ReplicaState == "error" {
+			return replica, "error", fmt.Errorf("share replica is in error state")
+		}
+		if replica.Status != "available" {
+			return replica, replica.Status, nil
+		}
+		return replica, replica.ReplicaState, nil
+	}
+}
@@ -2,6 +2,8 @@ package sfs
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"strconv"
 	"time"
@@ -32,9 +34,15 @@ func ResourceSFSTurboShareV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: common.MultipleCustomizeDiffs(
+			validateTurboPerformance,
+			validateTurboExpansion,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -66,6 +74,29 @@ func ResourceSFSTurboShareV1() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 				Default:  "STANDARD",
+				ValidateFunc: validation.StringInSlice([]string{
+					"STANDARD", "PERFORMANCE", "HPC", "HPC_CACHE",
+				}, false),
+			},
+			"enhanced": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"hpc_bandwidth": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"20M", "40M", "125M", "250M", "500M", "1000M",
+				}, false),
+			},
+			"hpc_cache_bandwidth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(2),
 			},
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -127,9 +158,13 @@ func resourceSFSTurboShareV1Create(ctx context.Context, d *schema.ResourceData,
 			CryptKeyID: d.Get("crypt_key_id").(string),
 		},
 	}
-
 	log.Printf("[DEBUG] Create SFS turbo with option: %+v", createOpts)
-	share, err := shares.Create(client, createOpts).Extract()
+	share, err := shares.Create(client, turboCreateOpts{
+		CreateOpts:        createOpts,
+		Enhanced:          d.Get("enhanced").(bool),
+		HPCBandwidth:      d.Get("hpc_bandwidth").(string),
+		HPCCacheBandwidth: d.Get("hpc_cache_bandwidth").(int),
+	}).Extract()
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud SFS Turbo: %s", err)
 	}
@@ -159,10 +194,15 @@ func resourceSFSTurboShareV1Read(_ context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("error creating OpenTelekomCloud SFSTurboV1 client: %s", err)
 	}
 
-	share, err := shares.Get(client, d.Id()).Extract()
+	getResult := shares.Get(client, d.Id())
+	share, err := getResult.Extract()
 	if err != nil {
 		return diag.FromErr(common.CheckDeleted(d, err, "Error deleting SFS Turbo"))
 	}
+	var performance turboPerformance
+	if err := getResult.ExtractInto(&performance); err != nil {
+		return fmterr.Errorf("error extracting SFS Turbo performance settings: %s", err)
+	}
 
 	mErr := multierror.Append(nil,
 		d.Set("name", share.Name),
@@ -177,12 +217,20 @@ func resourceSFSTurboShareV1Read(_ context.Context, d *schema.ResourceData, meta
 		d.Set("available_capacity", share.AvailCapacity),
 		d.Set("export_location", share.ExportLocation),
 		d.Set("crypt_key_id", share.CryptKeyID),
+		d.Set("enhanced", share.ExpandType == "bandwidth"),
+		d.Set("hpc_bandwidth", performance.HPCBandwidth),
 	)
 
 	if mErr.ErrorOrNil() != nil {
 		return diag.FromErr(mErr)
 	}
 
+	if bandwidth, err := strconv.Atoi(performance.HPCCacheBandwidth.String()); err == nil {
+		if err = d.Set("hpc_cache_bandwidth", bandwidth); err != nil {
+			return fmterr.Errorf("error reading HPC cache bandwidth of SFS Turbo: %s", err)
+		}
+	}
+
 	// n.Size is a string of float64, should convert it to int
 	if fSize, err := strconv.ParseFloat(share.Size, 64); err == nil {
 		if err = d.Set("size", int(fSize)); err != nil {
@@ -200,14 +248,12 @@ func resourceSFSTurboShareV1Update(ctx context.Context, d *schema.ResourceData,
 		return fmterr.Errorf("error creating OpenTelekomCloud SFSTurboV1 client: %s", err)
 	}
 
-	if d.HasChange("size") {
-		oldSize, newSize := d.GetChange("size")
-		if oldSize.(int) > newSize.(int) {
-			return fmterr.Errorf("shrinking OpenTelekomCloud SFS Turbo size is not supported")
+	if d.HasChanges("size", "hpc_cache_bandwidth") {
+		expandOpts := turboExpandOpts{
+			NewSize: d.Get("size").(int),
 		}
-
-		expandOpts := shares.ExpandOpts{
-			Extend: shares.ExtendOpts{NewSize: newSize.(int)},
+		if d.HasChange("hpc_cache_bandwidth") {
+			expandOpts.NewBandwidth = d.Get("hpc_cache_bandwidth").(int)
 		}
 
 		if err := shares.Expand(client, d.Id(), expandOpts).ExtractErr(); err != nil {
@@ -218,7 +264,7 @@ func resourceSFSTurboShareV1Update(ctx context.Context, d *schema.ResourceData,
 			Pending:    []string{"121"},
 			Target:     []string{"221", "232"},
 			Refresh:    waitForSFSTurboSubStatus(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			Delay:      10 * time.Second,
 			MinTimeout: 5 * time.Second,
 		}
@@ -245,7 +291,7 @@ func resourceSFSTurboShareV1Update(ctx context.Context, d *schema.ResourceData,
 			Pending:    []string{"121"},
 			Target:     []string{"221", "232"},
 			Refresh:    waitForSFSTurboSubStatus(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			Delay:      10 * time.Second,
 			MinTimeout: 5 * time.Second,
 		}
@@ -319,6 +365,102 @@ func waitForSFSTurboSubStatus(client *golangsdk.ServiceClient, shareID string) r
 		if share.SubStatus == "221" || share.SubStatus == "232" {
 			return share, share.SubStatus, nil
 		}
+		if failure, ok := turboFailedSubStatuses[share.SubStatus]; ok {
+			return share, share.SubStatus, fmt.Errorf("%s, sub-status: %s", failure, share.SubStatus)
+		}
 		return share, share.SubStatus, nil
 	}
 }
+
+// turboFailedSubStatuses describes sub-statuses of failed SFS Turbo modifications
+var turboFailedSubStatuses = map[string]string{
+	"321": "SFS Turbo expansion failed",
+	"332": "SFS Turbo security group change failed",
+}
+
+// turboPerformance contains performance settings of SFS Turbo missing in shares.Turbo
+type turboPerformance struct {
+	HPCBandwidth      string      `json:"hpc_bandwidth"`
+	HPCCacheBandwidth json.Number `json:"hpc_cache_bandwidth"`
+}
+
+// turboCreateOpts extends shares.CreateOpts with performance settings
+type turboCreateOpts struct {
+	shares.CreateOpts
+	Enhanced          bool
+	HPCBandwidth      string
+	HPCCacheBandwidth int
+}
+
+func (opts turboCreateOpts) ToShareCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToShareCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	share := b["share"].(map[string]interface{})
+	metadata, ok := share["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = make(map[string]interface{})
+	}
+	if opts.Enhanced {
+		metadata["expand_type"] = "bandwidth"
+	}
+	if opts.HPCBandwidth != "" {
+		metadata["hpc_bandwidth"] = opts.HPCBandwidth
+	}
+	if opts.HPCCacheBandwidth != 0 {
+		metadata["hpc_cache_bandwidth"] = strconv.Itoa(opts.HPCCacheBandwidth)
+	}
+	if len(metadata) != 0 {
+		share["metadata"] = metadata
+	}
+	return b, nil
+}
+
+// turboExpandOpts extends shares.ExpandOpts with HPC cache bandwidth
+type turboExpandOpts struct {
+	NewSize      int
+	NewBandwidth int
+}
+
+func (opts turboExpandOpts) ToShareExpandMap() (map[string]interface{}, error) {
+	extend := map[string]interface{}{
+		"new_size": opts.NewSize,
+	}
+	if opts.NewBandwidth != 0 {
+		extend["new_bandwidth"] = opts.NewBandwidth
+	}
+	return map[string]interface{}{"extend": extend}, nil
+}
+
+// validateTurboPerformance checks that performance settings match the share type
+func validateTurboPerformance(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	shareType := d.Get("share_type").(string)
+	if _, ok := d.GetOk("hpc_bandwidth"); ok && shareType != "HPC" {
+		return fmt.Errorf("`hpc_bandwidth` can be used only with `HPC` share type")
+	}
+	if _, ok := d.GetOk("hpc_cache_bandwidth"); ok && shareType != "HPC_CACHE" {
+		return fmt.Errorf("`hpc_cache_bandwidth` can be used only with `HPC_CACHE` share type")
+	}
+	if shareType == "HPC" && d.Get("hpc_bandwidth").(string) == "" {
+		return fmt.Errorf("`hpc_bandwidth` is required for `HPC` share type")
+	}
+	if d.Get("enhanced").(bool) && shareType != "STANDARD" && shareType != "PERFORMANCE" {
+		return fmt.Errorf("`enhanced` can be used only with `STANDARD` and `PERFORMANCE` share types")
+	}
+	return nil
+}
+
+// validateTurboExpansion checks that the size and HPC cache bandwidth of the existing share are not decreased
+func validateTurboExpansion(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if oldSize, newSize := d.GetChange("size"); oldSize.(int) > newSize.(int) {
+		return fmt.Errorf("shrinking OpenTelekomCloud SFS Turbo size is not supported")
+	}
+	if oldBandwidth, newBandwidth := d.GetChange("hpc_cache_bandwidth"); oldBandwidth.(int) > newBandwidth.(int) {
+		return fmt.Errorf("decreasing OpenTelekomCloud SFS Turbo HPC cache bandwidth is not supported")
+	}
+	return nil
+}
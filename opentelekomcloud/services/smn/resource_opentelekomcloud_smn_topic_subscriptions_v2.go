@@ -0,0 +1,236 @@
+package smn
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/subscriptions"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+// ResourceTopicSubscriptions manages the complete set of subscriptions of a topic.
+// Subscriptions not present in the configuration (e.g. created in console) are removed.
+func ResourceTopicSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTopicSubscriptionsCreate,
+		ReadContext:   resourceTopicSubscriptionsRead,
+		UpdateContext: resourceTopicSubscriptionsUpdate,
+		DeleteContext: resourceTopicSubscriptionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"topic_urn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subscription": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      resourceTopicSubscriptionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"email", "sms", "http", "https",
+							}, false),
+						},
+						"remark": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"subscription_urn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceTopicSubscriptionHash ignores computed fields, so subscriptions are matched by their configuration only
+func resourceTopicSubscriptionHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["protocol"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["endpoint"].(string)))
+	if m["remark"] != nil {
+		buf.WriteString(fmt.Sprintf("%s-", m["remark"].(string)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func createTopicSubscriptions(client *golangsdk.ServiceClient, topicURN string, set *schema.Set) error {
+	for _, raw := range set.List() {
+		s := raw.(map[string]interface{})
+		createOpts := subscriptions.CreateOps{
+			Endpoint: s["endpoint"].(string),
+			Protocol: s["protocol"].(string),
+			Remark:   s["remark"].(string),
+		}
+		log.Printf("[DEBUG] Creating subscription of topic %s: %#v", topicURN, createOpts)
+		if _, err := subscriptions.Create(client, createOpts, topicURN).Extract(); err != nil {
+			return fmt.Errorf("error creating subscription %s:%s: %w", createOpts.Protocol, createOpts.Endpoint, err)
+		}
+	}
+	return nil
+}
+
+func deleteTopicSubscriptions(client *golangsdk.ServiceClient, set *schema.Set) error {
+	for _, raw := range set.List() {
+		urn := raw.(map[string]interface{})["subscription_urn"].(string)
+		if urn == "" {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting subscription %s", urn)
+		if err := subscriptions.Delete(client, urn).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting subscription %s: %w", urn, err)
+		}
+	}
+	return nil
+}
+
+func resourceTopicSubscriptionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud smn client: %w", err)
+	}
+
+	topicURN := d.Get("topic_urn").(string)
+	existing, err := subscriptions.ListFromTopic(client, topicURN).Extract()
+	if err != nil {
+		return fmterr.Errorf("error listing subscriptions of topic %s: %w", topicURN, err)
+	}
+
+	// the resource is authoritative: everything not matching the configuration is removed
+	wanted := d.Get("subscription").(*schema.Set)
+	unknown := schema.NewSet(resourceTopicSubscriptionHash, nil)
+	for _, s := range existing {
+		item := flattenTopicSubscription(s)
+		if wanted.Contains(item) {
+			wanted.Remove(item)
+			continue
+		}
+		unknown.Add(item)
+	}
+	if err := deleteTopicSubscriptions(client, unknown); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := createTopicSubscriptions(client, topicURN, wanted); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(topicURN)
+
+	return resourceTopicSubscriptionsRead(ctx, d, meta)
+}
+
+func flattenTopicSubscription(s subscriptions.SubscriptionGet) map[string]interface{} {
+	return map[string]interface{}{
+		"endpoint":         s.Endpoint,
+		"protocol":         s.Protocol,
+		"remark":           s.Remark,
+		"subscription_urn": s.SubscriptionUrn,
+		"status":           s.Status,
+	}
+}
+
+func resourceTopicSubscriptionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud smn client: %w", err)
+	}
+
+	existing, err := subscriptions.ListFromTopic(client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "SMN topic"))
+	}
+
+	var subscriptionList []map[string]interface{}
+	for _, s := range existing {
+		subscriptionList = append(subscriptionList, flattenTopicSubscription(s))
+	}
+
+	mErr := multierror.Append(
+		d.Set("topic_urn", d.Id()),
+		d.Set("subscription", subscriptionList),
+		d.Set("project_name", config.GetProjectName(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting SMN topic subscriptions fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTopicSubscriptionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud smn client: %w", err)
+	}
+
+	if d.HasChange("subscription") {
+		oldRaw, newRaw := d.GetChange("subscription")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		if err := deleteTopicSubscriptions(client, oldSet.Difference(newSet)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := createTopicSubscriptions(client, d.Id(), newSet.Difference(oldSet)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTopicSubscriptionsRead(ctx, d, meta)
+}
+
+func resourceTopicSubscriptionsDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud smn client: %w", err)
+	}
+
+	if err := deleteTopicSubscriptions(client, d.Get("subscription").(*schema.Set)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
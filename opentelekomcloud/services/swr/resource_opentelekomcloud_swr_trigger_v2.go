@@ -0,0 +1,272 @@
+package swr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// ResourceSwrTriggerV2 updates the workload image when a new tag is pushed to the repository
+func ResourceSwrTriggerV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSwrTriggerCreate,
+		ReadContext:   resourceSwrTriggerRead,
+		UpdateContext: resourceSwrTriggerUpdate,
+		DeleteContext: resourceSwrTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSwrTriggerImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`),
+						"Only letters, digits, underscores (_), and hyphens (-) are allowed.",
+					),
+				),
+			},
+			"workload_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"deployments", "statefulsets"}, false),
+			},
+			"workload_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default",
+			},
+			"container": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "all",
+				ValidateFunc: validation.StringInSlice([]string{"all", "tag", "regular"}, false),
+			},
+			"condition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"creator_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type swrTrigger struct {
+	Name        string `json:"name"`
+	Action      string `json:"action"`
+	AppType     string `json:"app_type"`
+	Application string `json:"application"`
+	ClusterID   string `json:"cluster_id"`
+	ClusterName string `json:"cluster_name"`
+	ClusterNS   string `json:"cluster_ns"`
+	Condition   string `json:"condition"`
+	Container   string `json:"container,omitempty"`
+	Enable      string `json:"enable"`
+	TriggerMode string `json:"trigger_mode"`
+	TriggerType string `json:"trigger_type"`
+	CreatorName string `json:"creator_name,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+func triggersURL(client *golangsdk.ServiceClient, d *schema.ResourceData, parts ...string) string {
+	base := []string{"manage", "namespaces", organization(d), "repos", repository(d), "triggers"}
+	return client.ServiceURL(append(base, parts...)...)
+}
+
+func resourceSwrTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SwrV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ClientError, err)
+	}
+
+	triggerType := d.Get("type").(string)
+	condition := d.Get("condition").(string)
+	if triggerType == "all" {
+		condition = ".*"
+	} else if condition == "" {
+		return fmterr.Errorf("condition is required for the `%s` trigger type", triggerType)
+	}
+
+	opts := swrTrigger{
+		Name:        d.Get("name").(string),
+		Action:      "update",
+		AppType:     d.Get("workload_type").(string),
+		Application: d.Get("workload_name").(string),
+		ClusterID:   d.Get("cluster_id").(string),
+		ClusterName: d.Get("cluster_name").(string),
+		ClusterNS:   d.Get("namespace").(string),
+		Condition:   condition,
+		Container:   d.Get("container").(string),
+		Enable:      strconv.FormatBool(d.Get("enabled").(bool)),
+		TriggerMode: "cce",
+		TriggerType: triggerType,
+	}
+
+	_, err = client.Post(triggersURL(client, d), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error creating trigger: %w", err)
+	}
+	d.SetId(opts.Name)
+
+	return resourceSwrTriggerRead(ctx, d, meta)
+}
+
+func resourceSwrTriggerRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SwrV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ClientError, err)
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(triggersURL(client, d, d.Id()), &r.Body, nil)
+	var trigger swrTrigger
+	if err := r.ExtractInto(&trigger); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error reading trigger"))
+	}
+
+	enabled, _ := strconv.ParseBool(trigger.Enable)
+	mErr := multierror.Append(
+		d.Set("name", trigger.Name),
+		d.Set("workload_type", trigger.AppType),
+		d.Set("workload_name", trigger.Application),
+		d.Set("cluster_id", trigger.ClusterID),
+		d.Set("cluster_name", trigger.ClusterName),
+		d.Set("namespace", trigger.ClusterNS),
+		d.Set("container", trigger.Container),
+		d.Set("type", trigger.TriggerType),
+		d.Set("condition", trigger.Condition),
+		d.Set("enabled", enabled),
+		d.Set("creator_name", trigger.CreatorName),
+		d.Set("created", trigger.CreatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting resource fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSwrTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SwrV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ClientError, err)
+	}
+
+	opts := map[string]string{
+		"enable": strconv.FormatBool(d.Get("enabled").(bool)),
+	}
+	_, err = client.Patch(triggersURL(client, d, d.Id()), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return fmterr.Errorf("error updating trigger: %w", err)
+	}
+
+	return resourceSwrTriggerRead(ctx, d, meta)
+}
+
+func resourceSwrTriggerDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.SwrV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(ClientError, err)
+	}
+
+	_, err = client.Delete(triggersURL(client, d, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting trigger"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSwrTriggerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		err := fmt.Errorf("invalid format specified for SWR trigger import: format must be <organization>/<repository>/<trigger>")
+		return nil, err
+	}
+	d.SetId(parts[2])
+	mErr := multierror.Append(
+		d.Set("organization", parts[0]),
+		d.Set("repository", parts[1]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return schema.ImportStatePassthroughContext(ctx, d, meta)
+}
@@ -0,0 +1,206 @@
+package tms
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+const resourceInstancesPageLimit = 200
+
+type resourceTagFilter struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+type resourceInstancesFilterOpts struct {
+	ProjectID     string              `json:"project_id"`
+	ResourceTypes []string            `json:"resource_types"`
+	Tags          []resourceTagFilter `json:"tags,omitempty"`
+	WithoutAnyTag bool                `json:"without_any_tag,omitempty"`
+	Limit         int                 `json:"limit"`
+	Offset        int                 `json:"offset"`
+}
+
+type resourceInstanceTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type resourceInstance struct {
+	ProjectID    string                `json:"project_id"`
+	ProjectName  string                `json:"project_name"`
+	ResourceID   string                `json:"resource_id"`
+	ResourceName string                `json:"resource_name"`
+	ResourceType string                `json:"resource_type"`
+	Tags         []resourceInstanceTag `json:"tags"`
+}
+
+type resourceInstancesResponse struct {
+	Resources  []resourceInstance `json:"resources"`
+	TotalCount int                `json:"total_count"`
+}
+
+func DataSourceTmsResourceInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmsResourceInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"resource_types": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"without_any_tag": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandResourceTagFilters(raw map[string]interface{}) []resourceTagFilter {
+	filters := make([]resourceTagFilter, 0, len(raw))
+	for key, value := range raw {
+		filter := resourceTagFilter{Key: key, Values: []string{}}
+		if v := value.(string); v != "" {
+			filter.Values = append(filter.Values, v)
+		}
+		filters = append(filters, filter)
+	}
+	sort.Slice(filters, func(i, j int) bool { return filters[i].Key < filters[j].Key })
+	return filters
+}
+
+func listResourceInstances(client *golangsdk.ServiceClient, opts resourceInstancesFilterOpts) ([]resourceInstance, error) {
+	var instances []resourceInstance
+	opts.Limit = resourceInstancesPageLimit
+	for {
+		var r golangsdk.Result
+		_, r.Err = client.Post(client.ServiceURL("resource-instances", "filter"), opts, &r.Body, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+		var page resourceInstancesResponse
+		if err := r.ExtractInto(&page); err != nil {
+			return nil, err
+		}
+		instances = append(instances, page.Resources...)
+		opts.Offset += len(page.Resources)
+		if len(page.Resources) == 0 || opts.Offset >= page.TotalCount {
+			return instances, nil
+		}
+	}
+}
+
+func dataSourceTmsResourceInstancesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.TmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(tmsClientError, err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		projectID = client.ProjectID
+	}
+
+	var resourceTypes []string
+	for _, v := range d.Get("resource_types").([]interface{}) {
+		resourceTypes = append(resourceTypes, v.(string))
+	}
+
+	opts := resourceInstancesFilterOpts{
+		ProjectID:     projectID,
+		ResourceTypes: resourceTypes,
+		Tags:          expandResourceTagFilters(d.Get("tags").(map[string]interface{})),
+		WithoutAnyTag: d.Get("without_any_tag").(bool),
+	}
+	log.Printf("[DEBUG] Resource instances filter options: %#v", opts)
+
+	instances, err := listResourceInstances(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error querying OpenTelekomCloud resources by tags: %w", err)
+	}
+
+	ids := make([]string, len(instances))
+	resources := make([]map[string]interface{}, len(instances))
+	for i, instance := range instances {
+		ids[i] = instance.ResourceID
+		tagMap := make(map[string]string, len(instance.Tags))
+		for _, tag := range instance.Tags {
+			tagMap[tag.Key] = tag.Value
+		}
+		resources[i] = map[string]interface{}{
+			"resource_id":   instance.ResourceID,
+			"resource_name": instance.ResourceName,
+			"resource_type": instance.ResourceType,
+			"project_id":    instance.ProjectID,
+			"project_name":  instance.ProjectName,
+			"tags":          tagMap,
+		}
+	}
+
+	d.SetId(hashcode.Strings(append(ids, resourceTypes...)))
+
+	mErr := multierror.Append(
+		d.Set("project_id", projectID),
+		d.Set("resources", resources),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting resource instances fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package tms
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/tms/v1/tags"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+const tmsClientError = "error creating OpenTelekomCloud TMS client: %w"
+
+func ResourceTmsTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTmsTagCreate,
+		ReadContext:   resourceTmsTagRead,
+		DeleteContext: resourceTmsTagDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 36),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 43),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandPredefinedTags(d *schema.ResourceData) []tags.Tag {
+	tagsRaw := d.Get("tags").(*schema.Set).List()
+	tagList := make([]tags.Tag, len(tagsRaw))
+	for i, raw := range tagsRaw {
+		tag := raw.(map[string]interface{})
+		tagList[i] = tags.Tag{
+			Key:   tag["key"].(string),
+			Value: tag["value"].(string),
+		}
+	}
+	return tagList
+}
+
+// predefinedTagsID builds ID of the resource from the managed tags
+func predefinedTagsID(tagList []tags.Tag) string {
+	keys := make([]string, len(tagList))
+	for i, tag := range tagList {
+		keys[i] = fmt.Sprintf("%s=%s", tag.Key, tag.Value)
+	}
+	return hashcode.Strings(keys)
+}
+
+func resourceTmsTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.TmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(tmsClientError, err)
+	}
+
+	tagList := expandPredefinedTags(d)
+	opts := tags.BatchOpts{
+		Tags:   tagList,
+		Action: tags.ActionCreate,
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	if err := tags.BatchAction(client, "", opts).Err; err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud predefined tags: %w", err)
+	}
+	d.SetId(predefinedTagsID(tagList))
+
+	return resourceTmsTagRead(ctx, d, meta)
+}
+
+func resourceTmsTagRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.TmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(tmsClientError, err)
+	}
+
+	existing, err := tags.Get(client).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving OpenTelekomCloud predefined tags: %w", err)
+	}
+	existingTags := make(map[tags.Tag]bool, len(existing.Tags))
+	for _, tag := range existing.Tags {
+		existingTags[tag] = true
+	}
+
+	var tagsRaw []map[string]interface{}
+	for _, tag := range expandPredefinedTags(d) {
+		if !existingTags[tag] {
+			log.Printf("[WARN] Predefined tag %s=%s was deleted", tag.Key, tag.Value)
+			continue
+		}
+		tagsRaw = append(tagsRaw, map[string]interface{}{
+			"key":   tag.Key,
+			"value": tag.Value,
+		})
+	}
+	if len(tagsRaw) == 0 {
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("tags", tagsRaw); err != nil {
+		return fmterr.Errorf("error setting predefined tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTmsTagDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.TmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(tmsClientError, err)
+	}
+
+	opts := tags.BatchOpts{
+		Tags:   expandPredefinedTags(d),
+		Action: tags.ActionDelete,
+	}
+	if err := tags.BatchAction(client, "", opts).Err; err != nil {
+		return fmterr.Errorf("error deleting OpenTelekomCloud predefined tags: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,100 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceEips() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEipsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bandwidth_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": common.TagsSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEipsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	listOpts := eips.ListOpts{
+		Status:      d.Get("status").(string),
+		PortID:      d.Get("port_id").(string),
+		BandwidthID: d.Get("bandwidth_id").(string),
+	}
+	allEips, err := eips.List(client, listOpts)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve EIPs: %w", err)
+	}
+
+	tagRaw := d.Get("tags").(map[string]interface{})
+	var networkingV2Client *golangsdk.ServiceClient
+	if len(tagRaw) > 0 {
+		networkingV2Client, err = config.NetworkingV2Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+		}
+	}
+
+	ids := make([]string, 0, len(allEips))
+	for _, eip := range allEips {
+		if len(tagRaw) > 0 {
+			match, err := common.ResourceTagsMatch(networkingV2Client, "publicips", eip.ID, tagRaw)
+			if err != nil {
+				return fmterr.Errorf("error fetching tags of EIP %s: %w", eip.ID, err)
+			}
+			if !match {
+				continue
+			}
+		}
+		ids = append(ids, eip.ID)
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting EIPs fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package vpc
+
+import (
+	"context"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceVpcEipPoolV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcEipPoolV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_common": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type eipPool struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+	Description string `json:"description"`
+	Shared      bool   `json:"shared"`
+	IsCommon    bool   `json:"is_common"`
+	Size        int    `json:"size"`
+	Used        int    `json:"used"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// listEipPools returns EIP pools of the project, listing is not yet supported in `networking/v1/eips`
+func listEipPools(client *golangsdk.ServiceClient, query url.Values) ([]eipPool, error) {
+	reqURL := client.ServiceURL("eip", "publicip-pools")
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	r := golangsdk.Result{}
+	_, r.Err = client.Get(reqURL, &r.Body, nil)
+	var pools []eipPool
+	if err := r.ExtractIntoSlicePtr(&pools, "publicip_pools"); err != nil {
+		return nil, err
+	}
+	return pools, nil
+}
+
+func dataSourceVpcEipPoolV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %w", err)
+	}
+
+	query := url.Values{}
+	if name := d.Get("name").(string); name != "" {
+		query.Set("name", name)
+	}
+	if id := d.Get("pool_id").(string); id != "" {
+		query.Set("id", id)
+	}
+	pools, err := listEipPools(client, query)
+	if err != nil {
+		return fmterr.Errorf("error retrieving EIP pools: %w", err)
+	}
+
+	if len(pools) < 1 {
+		return fmterr.Errorf("your query returned no results, please change your search criteria and try again")
+	}
+	if len(pools) > 1 {
+		return fmterr.Errorf("multiple EIP pools matched; use additional constraints to reduce matches to a single pool")
+	}
+	pool := pools[0]
+
+	log.Printf("[DEBUG] Retrieved EIP pool %s: %#v", pool.ID, pool)
+	d.SetId(pool.ID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", pool.Name),
+		d.Set("pool_id", pool.ID),
+		d.Set("type", pool.Type),
+		d.Set("status", pool.Status),
+		d.Set("description", pool.Description),
+		d.Set("shared", pool.Shared),
+		d.Set("is_common", pool.IsCommon),
+		d.Set("size", pool.Size),
+		d.Set("used", pool.Used),
+		d.Set("available", pool.Size-pool.Used),
+		d.Set("created_at", pool.CreatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting EIP pool fields: %w", err)
+	}
+
+	return nil
+}
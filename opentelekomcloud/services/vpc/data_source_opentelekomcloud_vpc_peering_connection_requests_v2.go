@@ -0,0 +1,135 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/peerings"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+// DataSourceVpcPeeringConnectionRequestsV2 lists peering connections requested by other tenants to the VPCs of the current one
+func DataSourceVpcPeeringConnectionRequestsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcPeeringConnectionRequestsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"peer_tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "PENDING_ACCEPTANCE",
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requests": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVpcPeeringConnectionRequestsV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	peeringClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud Vpc Peering Connection Client: %s", err)
+	}
+
+	allPeerings, err := peerings.List(peeringClient, peerings.ListOpts{
+		Status: d.Get("status").(string),
+	})
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve vpc peering connections: %s", err)
+	}
+
+	peerTenantID := d.Get("peer_tenant_id").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	var ids []string
+	var requests []map[string]interface{}
+	for _, peering := range allPeerings {
+		// incoming requests are the ones created in the other tenant with the VPC of the current tenant as the accepter
+		if peering.AcceptVpcInfo.TenantId != peeringClient.ProjectID ||
+			peering.RequestVpcInfo.TenantId == peeringClient.ProjectID {
+			continue
+		}
+		if peerTenantID != "" && peering.RequestVpcInfo.TenantId != peerTenantID {
+			continue
+		}
+		if vpcID != "" && peering.AcceptVpcInfo.VpcId != vpcID {
+			continue
+		}
+		ids = append(ids, peering.ID)
+		requests = append(requests, map[string]interface{}{
+			"id":             peering.ID,
+			"name":           peering.Name,
+			"status":         peering.Status,
+			"vpc_id":         peering.AcceptVpcInfo.VpcId,
+			"peer_vpc_id":    peering.RequestVpcInfo.VpcId,
+			"peer_tenant_id": peering.RequestVpcInfo.TenantId,
+		})
+	}
+
+	d.SetId(hashcode.Strings(append(ids, peeringClient.ProjectID)))
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("requests", requests); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("region", config.GetRegion(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
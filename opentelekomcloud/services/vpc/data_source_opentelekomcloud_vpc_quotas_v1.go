@@ -0,0 +1,69 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// vpcQuotaNames maps VPC API quota types to the attribute names
+var vpcQuotaNames = map[string]string{
+	"vpc":               "vpcs",
+	"subnet":            "subnets",
+	"securityGroup":     "security_groups",
+	"securityGroupRule": "security_group_rules",
+	"publicIp":          "public_ips",
+	"vpcPeer":           "peerings",
+	"shareBandwidth":    "shared_bandwidths",
+	"shareBandwidthIP":  "shared_bandwidth_ips",
+	"firewall":          "firewalls",
+}
+
+func DataSourceVpcQuotasV1() *schema.Resource {
+	names := make([]string, 0, len(vpcQuotaNames))
+	for _, name := range vpcQuotaNames {
+		names = append(names, name)
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceVpcQuotasV1Read,
+		Schema:      common.QuotaSchema(names...),
+	}
+}
+
+func dataSourceVpcQuotasV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL(client.ProjectID, "quotas"), &r.Body, nil)
+	var res struct {
+		Quotas struct {
+			Resources []common.ResourceQuota `json:"resources"`
+		} `json:"quotas"`
+	}
+	if err := r.ExtractInto(&res); err != nil {
+		return fmterr.Errorf("error retrieving VPC quotas: %w", err)
+	}
+
+	d.SetId(client.ProjectID)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		common.SetResourceQuotas(d, res.Quotas.Resources, vpcQuotaNames),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting VPC quotas: %w", err)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -30,6 +31,7 @@ func DataSourceVpcSubnetIdsV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"tags": common.TagsSchema(),
 			"ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -57,6 +59,13 @@ func dataSourceVpcSubnetIdsV1Read(_ context.Context, d *schema.ResourceData, met
 		return fmterr.Errorf("unable to retrieve subnets: %w", err)
 	}
 
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		refinedSubnets, err = filterSubnetsByTags(config, d, refinedSubnets, tagRaw)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if len(refinedSubnets) == 0 {
 		return fmterr.Errorf("no matching subnet found for vpc with id %s", vpcID)
 	}
@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/go-multierror"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -52,6 +54,7 @@ func DataSourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": common.TagsSchema(),
 			"gateway_ip": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -108,6 +111,13 @@ func dataSourceVpcSubnetV1Read(_ context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf("unable to retrieve subnets: %w", err)
 	}
 
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		refinedSubnets, err = filterSubnetsByTags(config, d, refinedSubnets, tagRaw)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if refinedSubnets == nil || len(refinedSubnets) == 0 {
 		return fmterr.Errorf("no matching subnet found. Please change your search criteria and try again")
 	}
@@ -139,6 +149,28 @@ func dataSourceVpcSubnetV1Read(_ context.Context, d *schema.ResourceData, meta i
 	if mErr.ErrorOrNil() != nil {
 		return diag.FromErr(mErr)
 	}
+	if err := readNetworkingTags(d, config, "subnets"); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
+
+// filterSubnetsByTags keeps the subnets having all the given tags
+func filterSubnetsByTags(config *cfg.Config, d *schema.ResourceData, allSubnets []subnets.Subnet, tagRaw map[string]interface{}) ([]subnets.Subnet, error) {
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+	var refinedByTags []subnets.Subnet
+	for _, subnet := range allSubnets {
+		match, err := common.ResourceTagsMatch(client, "subnets", subnet.ID, tagRaw)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching tags of subnet %s: %w", subnet.ID, err)
+		}
+		if match {
+			refinedByTags = append(refinedByTags, subnet)
+		}
+	}
+	return refinedByTags, nil
+}
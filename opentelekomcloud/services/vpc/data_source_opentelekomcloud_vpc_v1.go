@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/vpcs"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -44,6 +46,7 @@ func DataSourceVirtualPrivateCloudVpcV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"tags": common.TagsSchema(),
 			"routes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -83,6 +86,13 @@ func dataSourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceDa
 		return fmterr.Errorf("Unable to retrieve vpcs: %s", err)
 	}
 
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		refinedVpcs, err = filterVpcsByTags(config, d, refinedVpcs, tagRaw)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if len(refinedVpcs) < 1 {
 		return fmterr.Errorf("Your query returned no results. " +
 			"Please change your search criteria and try again.")
@@ -116,6 +126,28 @@ func dataSourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceDa
 	if err := d.Set("routes", s); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := readNetworkingTags(d, config, "vpcs"); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
+
+// filterVpcsByTags keeps the VPCs having all the given tags
+func filterVpcsByTags(config *cfg.Config, d *schema.ResourceData, allVpcs []vpcs.Vpc, tagRaw map[string]interface{}) ([]vpcs.Vpc, error) {
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+	var refinedByTags []vpcs.Vpc
+	for _, vpc := range allVpcs {
+		match, err := common.ResourceTagsMatch(client, "vpcs", vpc.ID, tagRaw)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching tags of VPC %s: %w", vpc.ID, err)
+		}
+		if match {
+			refinedByTags = append(refinedByTags, vpc)
+		}
+	}
+	return refinedByTags, nil
+}
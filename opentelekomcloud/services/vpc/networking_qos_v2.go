@@ -0,0 +1,127 @@
+package vpc
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// QoS policies and rules are not supported by the SDK, so Neutron QoS API is used directly
+
+type qosPolicy struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+	Shared      bool   `json:"shared"`
+	TenantID    string `json:"tenant_id,omitempty"`
+}
+
+type qosBandwidthLimitRule struct {
+	ID           string `json:"id,omitempty"`
+	MaxKBps      int    `json:"max_kbps"`
+	MaxBurstKBps int    `json:"max_burst_kbps,omitempty"`
+	Direction    string `json:"direction,omitempty"`
+}
+
+type qosDSCPMarkingRule struct {
+	ID       string `json:"id,omitempty"`
+	DSCPMark int    `json:"dscp_mark"`
+}
+
+// qosRuleTypes are URL path elements and body keys of QoS rules
+var qosRuleTypes = map[string]string{
+	"bandwidth_limit_rules": "bandwidth_limit_rule",
+	"dscp_marking_rules":    "dscp_marking_rule",
+}
+
+func qosPolicyURL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"qos", "policies"}, parts...)...)
+}
+
+func createQoSPolicy(client *golangsdk.ServiceClient, opts qosPolicy) (*qosPolicy, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Post(qosPolicyURL(client), map[string]interface{}{"policy": opts}, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	policy := new(qosPolicy)
+	if err := r.ExtractIntoStructPtr(policy, "policy"); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func getQoSPolicy(client *golangsdk.ServiceClient, id string) (*qosPolicy, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(qosPolicyURL(client, id), &r.Body, nil)
+	policy := new(qosPolicy)
+	if err := r.ExtractIntoStructPtr(policy, "policy"); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func updateQoSPolicy(client *golangsdk.ServiceClient, id string, opts map[string]interface{}) error {
+	_, err := client.Put(qosPolicyURL(client, id), map[string]interface{}{"policy": opts}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteQoSPolicy(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(qosPolicyURL(client, id), nil)
+	return err
+}
+
+func createQoSRule(client *golangsdk.ServiceClient, policyID, ruleType string, opts, rule interface{}) error {
+	var r golangsdk.Result
+	body := map[string]interface{}{qosRuleTypes[ruleType]: opts}
+	_, r.Err = client.Post(qosPolicyURL(client, policyID, ruleType), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return r.ExtractIntoStructPtr(rule, qosRuleTypes[ruleType])
+}
+
+func getQoSRule(client *golangsdk.ServiceClient, policyID, ruleType, id string, rule interface{}) error {
+	var r golangsdk.Result
+	_, r.Err = client.Get(qosPolicyURL(client, policyID, ruleType, id), &r.Body, nil)
+	return r.ExtractIntoStructPtr(rule, qosRuleTypes[ruleType])
+}
+
+func updateQoSRule(client *golangsdk.ServiceClient, policyID, ruleType, id string, opts interface{}) error {
+	body := map[string]interface{}{qosRuleTypes[ruleType]: opts}
+	_, err := client.Put(qosPolicyURL(client, policyID, ruleType, id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteQoSRule(client *golangsdk.ServiceClient, policyID, ruleType, id string) error {
+	_, err := client.Delete(qosPolicyURL(client, policyID, ruleType, id), nil)
+	return err
+}
+
+// setQoSPolicyID attaches QoS policy to the port or network, empty policy ID detaches the policy
+func setQoSPolicyID(client *golangsdk.ServiceClient, resourceType, id, policyID string) error {
+	var value interface{}
+	if policyID != "" {
+		value = policyID
+	}
+	body := map[string]interface{}{
+		resourceType: map[string]interface{}{"qos_policy_id": value},
+	}
+	_, err := client.Put(client.ServiceURL(resourceType+"s", id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// getQoSPolicyID returns QoS policy ID of the port or network
+func getQoSPolicyID(client *golangsdk.ServiceClient, resourceType, id string) (string, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL(resourceType+"s", id), &r.Body, nil)
+	var res struct {
+		QoSPolicyID string `json:"qos_policy_id"`
+	}
+	if err := r.ExtractIntoStructPtr(&res, resourceType); err != nil {
+		return "", err
+	}
+	return res.QoSPolicyID, nil
+}
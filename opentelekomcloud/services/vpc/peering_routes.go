@@ -0,0 +1,122 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/routes"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+const peeringRouteType = "peering"
+
+func peeringRouteDestinationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: common.ValidateCIDR,
+		},
+	}
+}
+
+// peeringRoutes returns routes of the VPC using the peering connection as a next hop, mapped by destination
+func peeringRoutes(client *golangsdk.ServiceClient, vpcID, peeringID string) (map[string]string, error) {
+	pages, err := routes.List(client, routes.ListOpts{
+		Type:   peeringRouteType,
+		VPC_ID: vpcID,
+	}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing routes of VPC %s: %w", vpcID, err)
+	}
+	allRoutes, err := routes.ExtractRoutes(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting routes of VPC %s: %w", vpcID, err)
+	}
+
+	result := make(map[string]string)
+	for _, route := range allRoutes {
+		if route.NextHop == peeringID {
+			result[route.Destination] = route.RouteID
+		}
+	}
+	return result, nil
+}
+
+// syncPeeringRoutes replaces routes of the VPC via the peering connection to `oldDst` destinations
+// with the routes to `newDst` ones. Routes not listed in `oldDst` are left untouched.
+func syncPeeringRoutes(client *golangsdk.ServiceClient, vpcID, peeringID string, oldDst, newDst *schema.Set) error {
+	// the route table is changed by vpc_route_v2 as well
+	defer common.LockParents(common.Parent{Kind: common.ParentRouter, ID: vpcID})()
+
+	existing, err := peeringRoutes(client, vpcID, peeringID)
+	if err != nil {
+		return err
+	}
+
+	for destination, routeID := range existing {
+		if !oldDst.Contains(destination) || newDst.Contains(destination) {
+			continue
+		}
+		if err := routes.Delete(client, routeID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting route %s of VPC %s: %w", routeID, vpcID, err)
+		}
+		log.Printf("[DEBUG] Deleted route to %s via peering connection %s", destination, peeringID)
+	}
+
+	for _, raw := range newDst.List() {
+		destination := raw.(string)
+		if _, ok := existing[destination]; ok {
+			continue
+		}
+		opts := routes.CreateOpts{
+			Type:        peeringRouteType,
+			NextHop:     peeringID,
+			Destination: destination,
+			VPC_ID:      vpcID,
+		}
+		route, err := routes.Create(client, opts).Extract()
+		if err != nil {
+			return fmt.Errorf("error creating route to %s in VPC %s: %w", destination, vpcID, err)
+		}
+		log.Printf("[DEBUG] Created route %s to %s via peering connection %s", route.RouteID, destination, peeringID)
+	}
+
+	return nil
+}
+
+// setPeeringRouteDestinations refreshes `route_destinations` with the routes still present in the VPC.
+// Routes to the peering connection not managed by the resource are ignored.
+func setPeeringRouteDestinations(d *schema.ResourceData, client *golangsdk.ServiceClient, vpcID, peeringID string) error {
+	managed := d.Get("route_destinations").(*schema.Set)
+	if managed.Len() == 0 {
+		return nil
+	}
+	existing, err := peeringRoutes(client, vpcID, peeringID)
+	if err != nil {
+		return err
+	}
+	var destinations []string
+	for _, raw := range managed.List() {
+		if _, ok := existing[raw.(string)]; ok {
+			destinations = append(destinations, raw.(string))
+		}
+	}
+	return d.Set("route_destinations", destinations)
+}
+
+// deletePeeringRoutes removes routes to the peering connection managed by the resource
+func deletePeeringRoutes(d *schema.ResourceData, client *golangsdk.ServiceClient, vpcID string) error {
+	managed := d.Get("route_destinations").(*schema.Set)
+	if managed.Len() == 0 {
+		return nil
+	}
+	return syncPeeringRoutes(client, vpcID, d.Id(), managed, schema.NewSet(schema.HashString, nil))
+}
@@ -127,6 +127,11 @@ func ResourceNetworkingNetworkV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -204,6 +209,12 @@ func resourceNetworkingNetworkV2Create(ctx context.Context, d *schema.ResourceDa
 
 	d.SetId(FormatNidFromValS(strconv.FormatBool(asu), n.ID))
 
+	if policyID := d.Get("qos_policy_id").(string); policyID != "" {
+		if err := setQoSPolicyID(networkingClient, "network", n.ID, policyID); err != nil {
+			return fmterr.Errorf("error attaching QoS policy to OpenTelekomCloud Neutron network: %s", err)
+		}
+	}
+
 	return resourceNetworkingNetworkV2Read(ctx, d, meta)
 }
 
@@ -222,10 +233,16 @@ func resourceNetworkingNetworkV2Read(_ context.Context, d *schema.ResourceData,
 
 	log.Printf("[DEBUG] Retrieved Network %s: %+v", d.Id(), n)
 
+	qosPolicyID, err := getQoSPolicyID(networkingClient, "network", id)
+	if err != nil {
+		return fmterr.Errorf("error retrieving QoS policy of OpenTelekomCloud Neutron network: %s", err)
+	}
+
 	d.Set("name", n.Name)
 	d.Set("admin_state_up", asu)
 	d.Set("shared", strconv.FormatBool(n.Shared))
 	d.Set("tenant_id", n.TenantID)
+	d.Set("qos_policy_id", qosPolicyID)
 	d.Set("region", config.GetRegion(d))
 
 	d.SetId(FormatNidFromValS(asu, n.ID))
@@ -275,6 +292,12 @@ func resourceNetworkingNetworkV2Update(ctx context.Context, d *schema.ResourceDa
 		return fmterr.Errorf("error updating OpenTelekomCloud Neutron Network: %s", err)
 	}
 
+	if d.HasChange("qos_policy_id") {
+		if err := setQoSPolicyID(networkingClient, "network", id, d.Get("qos_policy_id").(string)); err != nil {
+			return fmterr.Errorf("error updating QoS policy of OpenTelekomCloud Neutron network: %s", err)
+		}
+	}
+
 	d.SetId(FormatNidFromValS(strconv.FormatBool(asu), id))
 	return resourceNetworkingNetworkV2Read(ctx, d, meta)
 }
@@ -3,15 +3,19 @@ package vpc
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/extradhcpopts"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/portsecurity"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
 
@@ -118,7 +122,6 @@ func ResourceNetworkingPortV2() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: false,
-				Computed: true,
 				Set:      allowedAddressPairsHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -134,6 +137,28 @@ func ResourceNetworkingPortV2() *schema.Resource {
 					},
 				},
 			},
+			"extra_dhcp_option": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ip_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validation.IntInSlice([]int{4, 6}),
+						},
+					},
+				},
+			},
 			"value_specs": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -149,6 +174,11 @@ func ResourceNetworkingPortV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -174,6 +204,9 @@ func resourceNetworkingPortV2Create(ctx context.Context, d *schema.ResourceData,
 	if noSecurityGroups && len(securityGroups) > 0 {
 		return fmterr.Errorf("cannot have both no_security_groups and security_group_ids set")
 	}
+	if err := checkPortSecurityDisabled(d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	createOpts := PortCreateOpts{
 		ports.CreateOpts{
@@ -209,12 +242,25 @@ func resourceNetworkingPortV2Create(ctx context.Context, d *schema.ResourceData,
 	// Add the port security attribute if specified.
 	if v, ok := d.GetOkExists("port_security_enabled"); ok {
 		portSecurityEnabled := v.(bool)
+		if !portSecurityEnabled {
+			// port without port security can't have any security groups, even the default one
+			securityGroups = []string{}
+			createOpts.SecurityGroups = &securityGroups
+			finalCreateOpts = createOpts
+		}
 		finalCreateOpts = portsecurity.PortCreateOptsExt{
 			CreateOptsBuilder:   finalCreateOpts,
 			PortSecurityEnabled: &portSecurityEnabled,
 		}
 	}
 
+	if dhcpOpts := resourcePortExtraDHCPOptsCreate(d); len(dhcpOpts) > 0 {
+		finalCreateOpts = extradhcpopts.CreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			ExtraDHCPOpts:     dhcpOpts,
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", finalCreateOpts)
 	p, err := ports.Create(client, finalCreateOpts).Extract()
 	if err != nil {
@@ -239,6 +285,12 @@ func resourceNetworkingPortV2Create(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(p.ID)
 
+	if policyID := d.Get("qos_policy_id").(string); policyID != "" {
+		if err := setQoSPolicyID(client, "port", p.ID, policyID); err != nil {
+			return fmterr.Errorf("error attaching QoS policy to OpenTelekomCloud Neutron port: %w", err)
+		}
+	}
+
 	return resourceNetworkingPortV2Read(ctx, d, meta)
 }
 
@@ -257,6 +309,11 @@ func resourceNetworkingPortV2Read(_ context.Context, d *schema.ResourceData, met
 
 	log.Printf("[DEBUG] Retrieved Port %s: %+v", d.Id(), port)
 
+	qosPolicyID, err := getQoSPolicyID(client, "port", d.Id())
+	if err != nil {
+		return fmterr.Errorf("error retrieving QoS policy of OpenTelekomCloud Neutron port: %w", err)
+	}
+
 	asu, _ := ExtractValSFromNid(d.Get("network_id").(string))
 	nid := FormatNidFromValS(asu, port.NetworkID)
 
@@ -270,6 +327,7 @@ func resourceNetworkingPortV2Read(_ context.Context, d *schema.ResourceData, met
 		d.Set("security_group_ids", port.SecurityGroups),
 		d.Set("device_id", port.DeviceID),
 		d.Set("port_security_enabled", port.PortSecurityEnabled),
+		d.Set("qos_policy_id", qosPolicyID),
 		d.Set("region", config.GetRegion(d)),
 	)
 
@@ -290,9 +348,20 @@ func resourceNetworkingPortV2Read(_ context.Context, d *schema.ResourceData, met
 		pairs = append(pairs, pair)
 	}
 
+	var dhcpOpts []map[string]interface{}
+	for _, opt := range port.ExtraDHCPOpts {
+		ipVersion, _ := strconv.Atoi(opt.IPVersion.String())
+		dhcpOpts = append(dhcpOpts, map[string]interface{}{
+			"name":       opt.OptName,
+			"value":      opt.OptValue,
+			"ip_version": ipVersion,
+		})
+	}
+
 	mErr = multierror.Append(mErr,
 		d.Set("all_fixed_ips", ips),
 		d.Set("allowed_address_pairs", pairs),
+		d.Set("extra_dhcp_option", dhcpOpts),
 	)
 
 	if mErr.ErrorOrNil() != nil {
@@ -309,6 +378,9 @@ func resourceNetworkingPortV2Update(ctx context.Context, d *schema.ResourceData,
 		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
 	}
 
+	// the address pairs are changed by networking_vip_associate_v2 as well
+	defer common.LockParents(common.Parent{Kind: common.ParentPort, ID: d.Id()})()
+
 	noSecurityGroups := d.Get("no_security_groups").(bool)
 	var hasChange bool
 
@@ -320,6 +392,7 @@ func resourceNetworkingPortV2Update(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("allowed_address_pairs") {
 		hasChange = true
+		// an empty list is sent as `[]` and clears the pairs of the port
 		aap := resourceAllowedAddressPairsV2(d)
 		updateOpts.AllowedAddressPairs = &aap
 	}
@@ -372,14 +445,33 @@ func resourceNetworkingPortV2Update(ctx context.Context, d *schema.ResourceData,
 	finalUpdateOpts = updateOpts
 
 	if d.HasChange("port_security_enabled") {
+		if err := checkPortSecurityDisabled(d); err != nil {
+			return diag.FromErr(err)
+		}
 		hasChange = true
 		portSecurityEnabled := d.Get("port_security_enabled").(bool)
+		if !portSecurityEnabled {
+			// security groups and address pairs have to be removed in the same request
+			securityGroups := []string{}
+			updateOpts.SecurityGroups = &securityGroups
+			pairs := []ports.AddressPair{}
+			updateOpts.AllowedAddressPairs = &pairs
+			finalUpdateOpts = updateOpts
+		}
 		finalUpdateOpts = portsecurity.PortUpdateOptsExt{
 			UpdateOptsBuilder:   finalUpdateOpts,
 			PortSecurityEnabled: &portSecurityEnabled,
 		}
 	}
 
+	if d.HasChange("extra_dhcp_option") {
+		hasChange = true
+		finalUpdateOpts = extradhcpopts.UpdateOptsExt{
+			UpdateOptsBuilder: finalUpdateOpts,
+			ExtraDHCPOpts:     resourcePortExtraDHCPOptsUpdate(d),
+		}
+	}
+
 	if hasChange {
 		log.Printf("[DEBUG] Updating Port %s with options: %+v", d.Id(), updateOpts)
 
@@ -388,6 +480,12 @@ func resourceNetworkingPortV2Update(ctx context.Context, d *schema.ResourceData,
 			return fmterr.Errorf("error updating OpenTelekomCloud Neutron port: %w", err)
 		}
 	}
+
+	if d.HasChange("qos_policy_id") {
+		if err := setQoSPolicyID(client, "port", d.Id(), d.Get("qos_policy_id").(string)); err != nil {
+			return fmterr.Errorf("error updating QoS policy of OpenTelekomCloud Neutron port: %w", err)
+		}
+	}
 	return resourceNetworkingPortV2Read(ctx, d, meta)
 }
 
@@ -522,4 +620,66 @@ func waitForNetworkPortDelete(client *golangsdk.ServiceClient, portID string) re
 type portWithPortSecurityExtensions struct {
 	ports.Port
 	portsecurity.PortSecurityExt
+	// extradhcpopts.ExtraDHCPOpt expects string IP version, but it's returned as a number
+	ExtraDHCPOpts []struct {
+		OptName   string      `json:"opt_name"`
+		OptValue  string      `json:"opt_value"`
+		IPVersion json.Number `json:"ip_version"`
+	} `json:"extra_dhcp_opts"`
+}
+
+// checkPortSecurityDisabled checks that port without port security has no security groups and address pairs
+func checkPortSecurityDisabled(d *schema.ResourceData) error {
+	if v, ok := d.GetOkExists("port_security_enabled"); !ok || v.(bool) {
+		return nil
+	}
+	if len(d.Get("security_group_ids").(*schema.Set).List()) > 0 && d.HasChange("security_group_ids") {
+		return fmt.Errorf("security_group_ids can't be set when port_security_enabled is false")
+	}
+	if len(d.Get("allowed_address_pairs").(*schema.Set).List()) > 0 {
+		return fmt.Errorf("allowed_address_pairs can't be set when port_security_enabled is false")
+	}
+	return nil
+}
+
+func resourcePortExtraDHCPOptsCreate(d *schema.ResourceData) []extradhcpopts.CreateExtraDHCPOpt {
+	rawOpts := d.Get("extra_dhcp_option").(*schema.Set).List()
+	opts := make([]extradhcpopts.CreateExtraDHCPOpt, len(rawOpts))
+	for i, raw := range rawOpts {
+		opt := raw.(map[string]interface{})
+		opts[i] = extradhcpopts.CreateExtraDHCPOpt{
+			OptName:   opt["name"].(string),
+			OptValue:  opt["value"].(string),
+			IPVersion: golangsdk.IPVersion(opt["ip_version"].(int)),
+		}
+	}
+	return opts
+}
+
+// resourcePortExtraDHCPOptsUpdate returns changed DHCP options, removed ones are sent with null value
+func resourcePortExtraDHCPOptsUpdate(d *schema.ResourceData) []extradhcpopts.UpdateExtraDHCPOpt {
+	oldRaw, newRaw := d.GetChange("extra_dhcp_option")
+	newNames := make(map[string]bool)
+	opts := []extradhcpopts.UpdateExtraDHCPOpt{}
+	for _, raw := range newRaw.(*schema.Set).List() {
+		opt := raw.(map[string]interface{})
+		value := opt["value"].(string)
+		newNames[opt["name"].(string)] = true
+		opts = append(opts, extradhcpopts.UpdateExtraDHCPOpt{
+			OptName:   opt["name"].(string),
+			OptValue:  &value,
+			IPVersion: golangsdk.IPVersion(opt["ip_version"].(int)),
+		})
+	}
+	for _, raw := range oldRaw.(*schema.Set).List() {
+		opt := raw.(map[string]interface{})
+		if newNames[opt["name"].(string)] {
+			continue
+		}
+		opts = append(opts, extradhcpopts.UpdateExtraDHCPOpt{
+			OptName:   opt["name"].(string),
+			IPVersion: golangsdk.IPVersion(opt["ip_version"].(int)),
+		})
+	}
+	return opts
 }
@@ -0,0 +1,169 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const bandwidthLimitRules = "bandwidth_limit_rules"
+
+func ResourceNetworkingQoSBandwidthLimitRuleV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingQoSBandwidthLimitRuleV2Create,
+		ReadContext:   resourceNetworkingQoSBandwidthLimitRuleV2Read,
+		UpdateContext: resourceNetworkingQoSBandwidthLimitRuleV2Update,
+		DeleteContext: resourceNetworkingQoSBandwidthLimitRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"max_kbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_burst_kbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "egress",
+				ValidateFunc: validation.StringInSlice([]string{"egress", "ingress"}, false),
+			},
+		},
+	}
+}
+
+// qosRuleID builds ID of the QoS rule containing both policy and rule IDs
+func qosRuleID(policyID, ruleID string) string {
+	return fmt.Sprintf("%s/%s", policyID, ruleID)
+}
+
+func parseQoSRuleID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid QoS rule ID %s, expected format is `<qos_policy_id>/<rule_id>`", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policyID := d.Get("qos_policy_id").(string)
+	opts := qosBandwidthLimitRule{
+		MaxKBps:      d.Get("max_kbps").(int),
+		MaxBurstKBps: d.Get("max_burst_kbps").(int),
+		Direction:    d.Get("direction").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	rule := new(qosBandwidthLimitRule)
+	if err := createQoSRule(client, policyID, bandwidthLimitRules, opts, rule); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud QoS bandwidth limit rule: %w", err)
+	}
+	d.SetId(qosRuleID(policyID, rule.ID))
+
+	return resourceNetworkingQoSBandwidthLimitRuleV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policyID, ruleID, err := parseQoSRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule := new(qosBandwidthLimitRule)
+	if err := getQoSRule(client, policyID, bandwidthLimitRules, ruleID, rule); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "QoS bandwidth limit rule"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("qos_policy_id", policyID),
+		d.Set("max_kbps", rule.MaxKBps),
+		d.Set("max_burst_kbps", rule.MaxBurstKBps),
+		d.Set("direction", rule.Direction),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting QoS bandwidth limit rule fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policyID, ruleID, err := parseQoSRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts := qosBandwidthLimitRule{
+		MaxKBps:      d.Get("max_kbps").(int),
+		MaxBurstKBps: d.Get("max_burst_kbps").(int),
+		Direction:    d.Get("direction").(string),
+	}
+	if err := updateQoSRule(client, policyID, bandwidthLimitRules, ruleID, opts); err != nil {
+		return fmterr.Errorf("error updating OpenTelekomCloud QoS bandwidth limit rule: %w", err)
+	}
+
+	return resourceNetworkingQoSBandwidthLimitRuleV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policyID, ruleID, err := parseQoSRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := deleteQoSRule(client, policyID, bandwidthLimitRules, ruleID); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud QoS bandwidth limit rule"))
+	}
+
+	return nil
+}
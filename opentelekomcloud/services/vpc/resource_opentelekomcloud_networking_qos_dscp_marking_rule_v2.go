@@ -0,0 +1,141 @@
+package vpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const dscpMarkingRules = "dscp_marking_rules"
+
+// validDSCPMarks are DSCP marks supported by Neutron
+var validDSCPMarks = []int{
+	0, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 46, 48, 56,
+}
+
+func ResourceNetworkingQoSDSCPMarkingRuleV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingQoSDSCPMarkingRuleV2Create,
+		ReadContext:   resourceNetworkingQoSDSCPMarkingRuleV2Read,
+		UpdateContext: resourceNetworkingQoSDSCPMarkingRuleV2Update,
+		DeleteContext: resourceNetworkingQoSDSCPMarkingRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dscp_mark": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice(validDSCPMarks),
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policyID := d.Get("qos_policy_id").(string)
+	opts := qosDSCPMarkingRule{
+		DSCPMark: d.Get("dscp_mark").(int),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	rule := new(qosDSCPMarkingRule)
+	if err := createQoSRule(client, policyID, dscpMarkingRules, opts, rule); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud QoS DSCP marking rule: %w", err)
+	}
+	d.SetId(qosRuleID(policyID, rule.ID))
+
+	return resourceNetworkingQoSDSCPMarkingRuleV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policyID, ruleID, err := parseQoSRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule := new(qosDSCPMarkingRule)
+	if err := getQoSRule(client, policyID, dscpMarkingRules, ruleID, rule); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "QoS DSCP marking rule"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("qos_policy_id", policyID),
+		d.Set("dscp_mark", rule.DSCPMark),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting QoS DSCP marking rule fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policyID, ruleID, err := parseQoSRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts := qosDSCPMarkingRule{
+		DSCPMark: d.Get("dscp_mark").(int),
+	}
+	if err := updateQoSRule(client, policyID, dscpMarkingRules, ruleID, opts); err != nil {
+		return fmterr.Errorf("error updating OpenTelekomCloud QoS DSCP marking rule: %w", err)
+	}
+
+	return resourceNetworkingQoSDSCPMarkingRuleV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policyID, ruleID, err := parseQoSRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := deleteQoSRule(client, policyID, dscpMarkingRules, ruleID); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud QoS DSCP marking rule"))
+	}
+
+	return nil
+}
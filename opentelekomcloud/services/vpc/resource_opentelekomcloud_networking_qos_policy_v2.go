@@ -0,0 +1,138 @@
+package vpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceNetworkingQoSPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingQoSPolicyV2Create,
+		ReadContext:   resourceNetworkingQoSPolicyV2Read,
+		UpdateContext: resourceNetworkingQoSPolicyV2Update,
+		DeleteContext: resourceNetworkingQoSPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"shared": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSPolicyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	opts := qosPolicy{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Shared:      d.Get("shared").(bool),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	policy, err := createQoSPolicy(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud QoS policy: %w", err)
+	}
+	d.SetId(policy.ID)
+
+	return resourceNetworkingQoSPolicyV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingQoSPolicyV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	policy, err := getQoSPolicy(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "QoS policy"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("name", policy.Name),
+		d.Set("description", policy.Description),
+		d.Set("shared", policy.Shared),
+		d.Set("tenant_id", policy.TenantID),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting QoS policy fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkingQoSPolicyV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	opts := make(map[string]interface{})
+	if d.HasChange("name") {
+		opts["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		opts["description"] = d.Get("description").(string)
+	}
+	if d.HasChange("shared") {
+		opts["shared"] = d.Get("shared").(bool)
+	}
+	if err := updateQoSPolicy(client, d.Id(), opts); err != nil {
+		return fmterr.Errorf("error updating OpenTelekomCloud QoS policy: %w", err)
+	}
+
+	return resourceNetworkingQoSPolicyV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingQoSPolicyV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	if err := deleteQoSPolicy(client, d.Id()); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud QoS policy"))
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -66,8 +67,18 @@ func resourceNetworkingRouterInterfaceV2Create(ctx context.Context, d *schema.Re
 		PortID:   d.Get("port_id").(string),
 	}
 
+	routerID := d.Get("router_id").(string)
+	defer common.LockParents(
+		common.Parent{Kind: common.ParentRouter, ID: routerID},
+		common.Parent{Kind: common.ParentSubnet, ID: createOpts.SubnetID},
+	)()
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	n, err := routers.AddInterface(networkingClient, d.Get("router_id").(string), createOpts).Extract()
+	var n *routers.InterfaceInfo
+	err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		n, err = routers.AddInterface(networkingClient, routerID, createOpts).Extract()
+		return err
+	})
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud Neutron router interface: %s", err)
 	}
@@ -122,6 +133,11 @@ func resourceNetworkingRouterInterfaceV2Delete(ctx context.Context, d *schema.Re
 		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
 	}
 
+	defer common.LockParents(
+		common.Parent{Kind: common.ParentRouter, ID: d.Get("router_id").(string)},
+		common.Parent{Kind: common.ParentSubnet, ID: d.Get("subnet_id").(string)},
+	)()
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ACTIVE"},
 		Target:     []string{"DELETED"},
@@ -179,11 +195,9 @@ func waitForRouterInterfaceDelete(networkingClient *golangsdk.ServiceClient, d *
 				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud Router Interface %s.", routerInterfaceId)
 				return r, "DELETED", nil
 			}
-			if errCode, ok := err.(golangsdk.ErrUnexpectedResponseCode); ok {
-				if errCode.Actual == 409 {
-					log.Printf("[DEBUG] Router Interface %s is still in use.", routerInterfaceId)
-					return r, "ACTIVE", nil
-				}
+			if common.IsConflict(err) {
+				log.Printf("[DEBUG] Router Interface %s is still in use.", routerInterfaceId)
+				return r, "ACTIVE", nil
 			}
 
 			return r, "ACTIVE", err
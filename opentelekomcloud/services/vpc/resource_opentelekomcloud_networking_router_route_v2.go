@@ -11,6 +11,7 @@ import (
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/routers"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -50,8 +51,7 @@ func ResourceNetworkingRouterRouteV2() *schema.Resource {
 func resourceNetworkingRouterRouteV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	routerId := d.Get("router_id").(string)
-	osMutexKV.Lock(routerId)
-	defer osMutexKV.Unlock(routerId)
+	defer common.LockParents(common.Parent{Kind: common.ParentRouter, ID: routerId})()
 
 	var destCidr string = d.Get("destination_cidr").(string)
 	var nextHop string = d.Get("next_hop").(string)
@@ -97,7 +97,10 @@ func resourceNetworkingRouterRouteV2Create(ctx context.Context, d *schema.Resour
 
 		log.Printf("[DEBUG] Updating Router %s with options: %+v", routerId, updateOpts)
 
-		_, err = routers.Update(networkingClient, routerId, updateOpts).Extract()
+		err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+			_, err := routers.Update(networkingClient, routerId, updateOpts).Extract()
+			return err
+		})
 		if err != nil {
 			return fmterr.Errorf("error updating OpenTelekomCloud Neutron Router: %s", err)
 		}
@@ -152,11 +155,10 @@ func resourceNetworkingRouterRouteV2Read(_ context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func resourceNetworkingRouterRouteV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceNetworkingRouterRouteV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	routerId := d.Get("router_id").(string)
-	osMutexKV.Lock(routerId)
-	defer osMutexKV.Unlock(routerId)
+	defer common.LockParents(common.Parent{Kind: common.ParentRouter, ID: routerId})()
 
 	config := meta.(*cfg.Config)
 
@@ -197,7 +199,10 @@ func resourceNetworkingRouterRouteV2Delete(_ context.Context, d *schema.Resource
 
 		log.Printf("[DEBUG] Updating Router %s with options: %+v", routerId, updateOpts)
 
-		_, err = routers.Update(networkingClient, routerId, updateOpts).Extract()
+		err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+			_, err := routers.Update(networkingClient, routerId, updateOpts).Extract()
+			return err
+		})
 		if err != nil {
 			return fmterr.Errorf("error updating OpenTelekomCloud Neutron Router: %s", err)
 		}
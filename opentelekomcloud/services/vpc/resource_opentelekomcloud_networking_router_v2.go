@@ -177,8 +177,7 @@ func resourceNetworkingRouterV2Read(_ context.Context, d *schema.ResourceData, m
 
 func resourceNetworkingRouterV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	routerId := d.Id()
-	osMutexKV.Lock(routerId)
-	defer osMutexKV.Unlock(routerId)
+	defer common.LockParents(common.Parent{Kind: common.ParentRouter, ID: routerId})()
 
 	config := meta.(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
@@ -148,7 +148,13 @@ func resourceNetworkingSecGroupRuleV2Create(ctx context.Context, d *schema.Resou
 
 	log.Printf("[DEBUG] Create OpenTelekomCloud Neutron security group: %#v", opts)
 
-	security_group_rule, err := rules.Create(networkingClient, opts).Extract()
+	defer common.LockParents(common.Parent{Kind: common.ParentSecurityGroup, ID: opts.SecGroupID})()
+
+	var security_group_rule *rules.SecGroupRule
+	err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		security_group_rule, err = rules.Create(networkingClient, opts).Extract()
+		return err
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -199,6 +205,8 @@ func resourceNetworkingSecGroupRuleV2Delete(ctx context.Context, d *schema.Resou
 		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
 	}
 
+	defer common.LockParents(common.Parent{Kind: common.ParentSecurityGroup, ID: d.Get("security_group_id").(string)})()
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ACTIVE"},
 		Target:     []string{"DELETED"},
@@ -320,6 +328,9 @@ func waitForSecGroupRuleDelete(networkingClient *golangsdk.ServiceClient, secGro
 				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud Neutron Security Group Rule %s", secGroupRuleId)
 				return r, "DELETED", nil
 			}
+			if common.IsConflict(err) {
+				return r, "ACTIVE", nil
+			}
 			return r, "ACTIVE", err
 		}
 
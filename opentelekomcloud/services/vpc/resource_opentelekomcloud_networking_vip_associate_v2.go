@@ -67,6 +67,14 @@ func resourceNetworkingPortIDs(d *schema.ResourceData) []string {
 	return portids
 }
 
+func portParents(vipID string, portIDs []string) []common.Parent {
+	parents := []common.Parent{{Kind: common.ParentPort, ID: vipID}}
+	for _, portID := range portIDs {
+		parents = append(parents, common.Parent{Kind: common.ParentPort, ID: portID})
+	}
+	return parents
+}
+
 func resourceNetworkingVIPAssociateV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	vipid := d.Get("vip_id").(string)
@@ -77,6 +85,9 @@ func resourceNetworkingVIPAssociateV2Create(ctx context.Context, d *schema.Resou
 		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %s", err)
 	}
 
+	// the address pairs of the VIP and the ports are read and written back as a whole
+	defer common.LockParents(portParents(vipid, portids)...)()
+
 	// port by port
 	fauxid := fmt.Sprintf("%s", vipid)
 	for _, portid := range portids {
@@ -128,7 +139,10 @@ func resourceNetworkingVIPAssociateV2Create(ctx context.Context, d *schema.Resou
 			}
 
 			log.Printf("[DEBUG] VIP Associate %s with options: %#v", vipid, associateOpts)
-			_, err = ports.Update(networkingClient, vipid, associateOpts).Extract()
+			err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+				_, err := ports.Update(networkingClient, vipid, associateOpts).Extract()
+				return err
+			})
 			if err != nil {
 				return fmterr.Errorf("error associate vip: %s", err)
 			}
@@ -144,7 +158,10 @@ func resourceNetworkingVIPAssociateV2Create(ctx context.Context, d *schema.Resou
 		}
 
 		log.Printf("[DEBUG] Port Update %s with options: %#v", vipid, portUpdateOpts)
-		_, err = ports.Update(networkingClient, portid, portUpdateOpts).Extract()
+		err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+			_, err := ports.Update(networkingClient, portid, portUpdateOpts).Extract()
+			return err
+		})
 		if err != nil {
 			return fmterr.Errorf("error update port: %s", err)
 		}
@@ -218,7 +235,7 @@ func resourceNetworkingVIPAssociateV2Read(_ context.Context, d *schema.ResourceD
 	return nil
 }
 
-func resourceNetworkingVIPAssociateV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceNetworkingVIPAssociateV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
 	if err != nil {
@@ -232,6 +249,8 @@ func resourceNetworkingVIPAssociateV2Delete(_ context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	defer common.LockParents(portParents(vipid, portids)...)()
+
 	// port by port
 	for _, portid := range portids {
 		// First get the port information
@@ -281,7 +300,10 @@ func resourceNetworkingVIPAssociateV2Delete(_ context.Context, d *schema.Resourc
 			}
 
 			log.Printf("[DEBUG] VIP Disassociate %s with options: %#v", vipid, disassociateOpts)
-			_, err = ports.Update(networkingClient, vipid, disassociateOpts).Extract()
+			err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+				_, err := ports.Update(networkingClient, vipid, disassociateOpts).Extract()
+				return err
+			})
 			if err != nil {
 				return fmterr.Errorf("error disassociate vip: %s", err)
 			}
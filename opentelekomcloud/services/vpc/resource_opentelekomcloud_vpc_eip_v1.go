@@ -9,6 +9,7 @@ import (
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/vpc/v1/publicips"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -94,6 +95,10 @@ func ResourceVpcEIPV1() *schema.Resource {
 							Computed: true,
 							ForceNew: true,
 						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -101,7 +106,8 @@ func ResourceVpcEIPV1() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":                  common.TagsSchema(),
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -113,16 +119,24 @@ func resourceVpcEIPV1Create(ctx context.Context, d *schema.ResourceData, meta in
 		return fmterr.Errorf("error creating NetworkingV1 client: %s", err)
 	}
 
-	createOpts := EIPCreateOpts{
-		eips.ApplyOpts{
-			IP:        resourcePublicIP(d),
-			Bandwidth: resourceBandWidth(d),
+	publicIP := resourcePublicIP(d)
+	bandwidth := resourceBandWidth(d)
+	createOpts := publicips.CreateOpts{
+		Publicip: publicips.PublicIPRequest{
+			Type:      publicIP.Type,
+			IpAddress: publicIP.Address,
 		},
-		common.MapValueSpecs(d),
+		Bandwidth: publicips.BandWidth{
+			Name:       bandwidth.Name,
+			Size:       bandwidth.Size,
+			ShareType:  bandwidth.ShareType,
+			ChargeMode: bandwidth.ChargeMode,
+		},
+		EnterpriseProjectId: d.Get("enterprise_project_id").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	eip, err := eips.Apply(client, createOpts).Extract()
+	eip, err := publicips.Create(client, createOpts).Extract()
 	if err != nil {
 		return fmterr.Errorf("error allocating EIP: %s", err)
 	}
@@ -156,10 +170,18 @@ func resourceVpcEIPV1Read(_ context.Context, d *schema.ResourceData, meta interf
 		return fmterr.Errorf("error creating NetworkingV1 client: %s", err)
 	}
 
-	eip, err := eips.Get(client, d.Id()).Extract()
+	getResult := eips.Get(client, d.Id())
+	eip, err := getResult.Extract()
 	if err != nil {
 		return diag.FromErr(common.CheckDeleted(d, err, "eIP"))
 	}
+	// enterprise project isn't a part of eips.PublicIp
+	var eipEps struct {
+		EnterpriseProjectID string `json:"enterprise_project_id"`
+	}
+	if err := getResult.ExtractIntoStructPtr(&eipEps, "publicip"); err != nil {
+		return fmterr.Errorf("error fetching enterprise project of eIP: %s", err)
+	}
 	bandWidth, err := bandwidths.Get(client, eip.BandwidthID).Extract()
 	if err != nil {
 		return fmterr.Errorf("error fetching bandwidth: %s", err)
@@ -184,6 +206,7 @@ func resourceVpcEIPV1Read(_ context.Context, d *schema.ResourceData, meta interf
 			"size":        eip.BandwidthSize,
 			"share_type":  eip.BandwidthShareType,
 			"charge_mode": bandWidth.ChargeMode,
+			"id":          eip.BandwidthID,
 		},
 	}
 	if err := d.Set("bandwidth", bw); err != nil {
@@ -192,6 +215,9 @@ func resourceVpcEIPV1Read(_ context.Context, d *schema.ResourceData, meta interf
 	if err := d.Set("region", config.GetRegion(d)); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("enterprise_project_id", eipEps.EnterpriseProjectID); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if err := readNetworkingTags(d, config, "publicips"); err != nil {
 		return diag.FromErr(err)
@@ -257,6 +283,10 @@ func resourceVpcEIPV1Update(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "eip", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceVpcEIPV1Read(ctx, d, meta)
 }
 
@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -26,6 +27,7 @@ func ResourceVpcPeeringConnectionAccepterV2() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -49,6 +51,7 @@ func ResourceVpcPeeringConnectionAccepterV2() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"route_destinations": peeringRouteDestinationsSchema(),
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,44 +87,35 @@ func resourceVPCPeeringAccepterV2Create(ctx context.Context, d *schema.ResourceD
 		return fmterr.Errorf("error retrieving OpenTelekomCloud Vpc Peering Connection: %s", err)
 	}
 
-	if n.Status != "PENDING_ACCEPTANCE" {
-		return fmterr.Errorf("VPC peering action not permitted: Can not accept/reject peering request not in PENDING_ACCEPTANCE state.")
+	accept := d.Get("accept").(bool)
+	routeDestinations := d.Get("route_destinations").(*schema.Set)
+	if !accept && routeDestinations.Len() > 0 {
+		return fmterr.Errorf("`route_destinations` can't be used for the rejected VPC peering connection")
 	}
 
-	var expectedStatus string
-
-	if _, ok := d.GetOk("accept"); ok {
-
-		expectedStatus = "ACTIVE"
-		_, err := peerings.Accept(peeringClient, id).ExtractResult()
-
-		if err != nil {
-			return fmterr.Errorf("Unable to accept VPC Peering Connection: %w", err)
+	expectedStatus := peeringAccepterStatus(accept)
+	switch n.Status {
+	case expectedStatus:
+		// already accepted/rejected, e.g. by the previous apply failed on route creation
+		log.Printf("[DEBUG] VPC peering connection %s is already in %s state", n.ID, n.Status)
+	case "PENDING_ACCEPTANCE":
+		if err := applyPeeringAccepterAction(ctx, peeringClient, n.ID, accept, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
 		}
-
-	} else {
-		expectedStatus = "REJECTED"
-
-		_, err := peerings.Reject(peeringClient, id).ExtractResult()
-
-		if err != nil {
-			return fmterr.Errorf("Unable to reject VPC Peering Connection: %w", err)
-		}
-	}
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"PENDING"},
-		Target:     []string{expectedStatus},
-		Refresh:    waitForVpcPeeringConnStatus(peeringClient, n.ID, expectedStatus),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+	default:
+		return fmterr.Errorf("VPC peering action not permitted: Can not accept/reject peering request not in PENDING_ACCEPTANCE state.")
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
 	d.SetId(n.ID)
 	log.Printf("[INFO] VPC Peering Connection status: %s", expectedStatus)
 
+	if routeDestinations.Len() > 0 {
+		emptyDst := schema.NewSet(schema.HashString, nil)
+		if err := syncPeeringRoutes(peeringClient, n.AcceptVpcInfo.VpcId, n.ID, emptyDst, routeDestinations); err != nil {
+			return fmterr.Errorf("error creating routes for VPC peering connection: %w", err)
+		}
+	}
+
 	return resourceVpcPeeringAccepterRead(ctx, d, meta)
 
 }
@@ -151,19 +145,63 @@ func resourceVpcPeeringAccepterRead(_ context.Context, d *schema.ResourceData, m
 	d.Set("peer_tenant_id", n.AcceptVpcInfo.TenantId)
 	d.Set("region", config.GetRegion(d))
 
+	if n.Status == "ACTIVE" {
+		if err := setPeeringRouteDestinations(d, peeringclient, n.AcceptVpcInfo.VpcId, n.ID); err != nil {
+			return fmterr.Errorf("error reading routes of VPC peering connection: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func resourceVPCPeeringAccepterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	peeringClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud peering client: %s", err)
+	}
 
+	accept := d.Get("accept").(bool)
 	if d.HasChange("accept") {
-		return fmterr.Errorf("VPC peering action not permitted: Can not accept/reject peering request not in pending_acceptance state.'")
+		n, err := peerings.Get(peeringClient, d.Id()).Extract()
+		if err != nil {
+			return fmterr.Errorf("error retrieving OpenTelekomCloud Vpc Peering Connection: %s", err)
+		}
+		if n.Status != "PENDING_ACCEPTANCE" && n.Status != peeringAccepterStatus(accept) {
+			return fmterr.Errorf("VPC peering action not permitted: Can not accept/reject peering request not in PENDING_ACCEPTANCE state.")
+		}
+		if n.Status == "PENDING_ACCEPTANCE" {
+			if err := applyPeeringAccepterAction(ctx, peeringClient, d.Id(), accept, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("route_destinations") {
+		oldDst, newDst := d.GetChange("route_destinations")
+		if !accept && newDst.(*schema.Set).Len() > 0 {
+			return fmterr.Errorf("`route_destinations` can't be used for the rejected VPC peering connection")
+		}
+		err := syncPeeringRoutes(peeringClient, d.Get("peer_vpc_id").(string), d.Id(), oldDst.(*schema.Set), newDst.(*schema.Set))
+		if err != nil {
+			return fmterr.Errorf("error updating routes of VPC peering connection: %w", err)
+		}
 	}
 
 	return resourceVpcPeeringAccepterRead(ctx, d, meta)
 }
 
-func resourceVPCPeeringAccepterDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func resourceVPCPeeringAccepterDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	peeringClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud peering client: %s", err)
+	}
+
+	if err := deletePeeringRoutes(d, peeringClient, d.Get("peer_vpc_id").(string)); err != nil {
+		return fmterr.Errorf("error deleting routes of VPC peering connection: %w", err)
+	}
+
 	log.Printf("[WARN] Will not delete VPC peering connection. Terraform will remove this resource from the state file, however resources may remain.")
 	d.SetId("")
 	return nil
@@ -183,3 +221,36 @@ func waitForVpcPeeringConnStatus(peeringClient *golangsdk.ServiceClient, peering
 		return n, "PENDING", nil
 	}
 }
+
+func peeringAccepterStatus(accept bool) string {
+	if accept {
+		return "ACTIVE"
+	}
+	return "REJECTED"
+}
+
+func applyPeeringAccepterAction(ctx context.Context, client *golangsdk.ServiceClient, id string, accept bool, timeout time.Duration) error {
+	if accept {
+		if _, err := peerings.Accept(client, id).ExtractResult(); err != nil {
+			return fmt.Errorf("unable to accept VPC Peering Connection: %w", err)
+		}
+	} else {
+		if _, err := peerings.Reject(client, id).ExtractResult(); err != nil {
+			return fmt.Errorf("unable to reject VPC Peering Connection: %w", err)
+		}
+	}
+
+	expectedStatus := peeringAccepterStatus(accept)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{expectedStatus},
+		Refresh:    waitForVpcPeeringConnStatus(client, id, expectedStatus),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for VPC Peering Connection to become %s: %w", expectedStatus, err)
+	}
+	return nil
+}
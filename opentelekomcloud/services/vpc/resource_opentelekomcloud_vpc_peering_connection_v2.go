@@ -63,6 +63,7 @@ func ResourceVpcPeeringConnectionV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"route_destinations": peeringRouteDestinationsSchema(),
 		},
 	}
 }
@@ -109,9 +110,25 @@ func resourceVPCPeeringV2Create(ctx context.Context, d *schema.ResourceData, met
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	peering, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmterr.Errorf("error waiting for OpenTelekomCloud Vpc Peering Connection to become available: %s", err)
+	}
 	d.SetId(n.ID)
 
+	routeDestinations := d.Get("route_destinations").(*schema.Set)
+	if routeDestinations.Len() > 0 {
+		if peering.(*peerings.Peering).Status != "ACTIVE" {
+			// routes can be added only after the peer tenant accepts the request
+			log.Printf("[WARN] VPC peering connection %s is not accepted yet, routes will be created on the next apply", n.ID)
+		} else {
+			emptyDst := schema.NewSet(schema.HashString, nil)
+			if err := syncPeeringRoutes(peeringClient, d.Get("vpc_id").(string), n.ID, emptyDst, routeDestinations); err != nil {
+				return fmterr.Errorf("error creating routes for VPC peering connection: %w", err)
+			}
+		}
+	}
+
 	return resourceVPCPeeringV2Read(ctx, d, meta)
 
 }
@@ -141,6 +158,14 @@ func resourceVPCPeeringV2Read(_ context.Context, d *schema.ResourceData, meta in
 	d.Set("peer_tenant_id", n.AcceptVpcInfo.TenantId)
 	d.Set("region", config.GetRegion(d))
 
+	if n.Status == "ACTIVE" {
+		if err := setPeeringRouteDestinations(d, peeringClient, n.RequestVpcInfo.VpcId, n.ID); err != nil {
+			return fmterr.Errorf("error reading routes of VPC peering connection: %w", err)
+		}
+	} else if err := d.Set("route_destinations", nil); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -151,13 +176,27 @@ func resourceVPCPeeringV2Update(ctx context.Context, d *schema.ResourceData, met
 		return fmterr.Errorf("error creating OpenTelekomCloud  Vpc Peering Connection Client: %s", err)
 	}
 
-	var updateOpts peerings.UpdateOpts
+	if d.HasChange("name") {
+		var updateOpts peerings.UpdateOpts
 
-	updateOpts.Name = d.Get("name").(string)
+		updateOpts.Name = d.Get("name").(string)
 
-	_, err = peerings.Update(peeringClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmterr.Errorf("error updating OpenTelekomCloud Vpc Peering Connection: %s", err)
+		_, err = peerings.Update(peeringClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud Vpc Peering Connection: %s", err)
+		}
+	}
+
+	if d.HasChange("route_destinations") {
+		if status := d.Get("status").(string); status != "ACTIVE" {
+			return fmterr.Errorf("routes can't be created for VPC peering connection in %s state, "+
+				"the peering connection has to be accepted first", status)
+		}
+		oldDst, newDst := d.GetChange("route_destinations")
+		err := syncPeeringRoutes(peeringClient, d.Get("vpc_id").(string), d.Id(), oldDst.(*schema.Set), newDst.(*schema.Set))
+		if err != nil {
+			return fmterr.Errorf("error updating routes of VPC peering connection: %w", err)
+		}
 	}
 
 	return resourceVPCPeeringV2Read(ctx, d, meta)
@@ -171,6 +210,10 @@ func resourceVPCPeeringV2Delete(ctx context.Context, d *schema.ResourceData, met
 		return fmterr.Errorf("error creating OpenTelekomCloud  Vpc Peering Connection Client: %s", err)
 	}
 
+	if err := deletePeeringRoutes(d, peeringClient, d.Get("vpc_id").(string)); err != nil {
+		return fmterr.Errorf("error deleting routes of VPC peering connection: %w", err)
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ACTIVE"},
 		Target:     []string{"DELETED"},
@@ -85,7 +85,14 @@ func resourceVpcRouteV2Create(ctx context.Context, d *schema.ResourceData, meta
 		VPC_ID:      d.Get("vpc_id").(string),
 	}
 
-	route, err := routes.Create(client, createOpts).Extract()
+	// the VPC is the router the route is added to
+	defer common.LockParents(common.Parent{Kind: common.ParentRouter, ID: createOpts.VPC_ID})()
+
+	var route *routes.Route
+	err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		route, err = routes.Create(client, createOpts).Extract()
+		return err
+	})
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud VPC route: %s", err)
 	}
@@ -137,7 +144,12 @@ func resourceVpcRouteV2Delete(ctx context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
 	}
 
-	if err = routes.Delete(client, d.Id()).ExtractErr(); err != nil {
+	defer common.LockParents(common.Parent{Kind: common.ParentRouter, ID: d.Get("vpc_id").(string)})()
+
+	err = common.RetryOnConflict(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		return routes.Delete(client, d.Id()).ExtractErr()
+	})
+	if err != nil {
 		return fmterr.Errorf("error deleting VPC route: %s", err)
 	}
 
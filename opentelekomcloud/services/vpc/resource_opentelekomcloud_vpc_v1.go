@@ -62,7 +62,8 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":                  common.TagsSchema(),
+			"enterprise_project_id": common.EnterpriseProjectIDSchema(),
 		},
 	}
 }
@@ -109,9 +110,12 @@ func resourceVirtualPrivateCloudV1Create(ctx context.Context, d *schema.Resource
 		return fmterr.Errorf("error creating OpenTelekomCloud vpc client: %s", err)
 	}
 
-	createOpts := vpcs.CreateOpts{
-		Name: d.Get("name").(string),
-		CIDR: d.Get("cidr").(string),
+	createOpts := VpcCreateOpts{
+		CreateOpts: vpcs.CreateOpts{
+			Name: d.Get("name").(string),
+			CIDR: d.Get("cidr").(string),
+		},
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 
 	n, err := vpcs.Create(vpcClient, createOpts).Extract()
@@ -167,7 +171,8 @@ func resourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceData
 		return fmterr.Errorf("error creating OpenTelekomCloud Vpc client: %s", err)
 	}
 
-	n, err := vpcs.Get(vpcClient, d.Id()).Extract()
+	getResult := vpcs.Get(vpcClient, d.Id())
+	n, err := getResult.Extract()
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
@@ -176,6 +181,13 @@ func resourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceData
 
 		return fmterr.Errorf("error retrieving OpenTelekomCloud Vpc: %s", err)
 	}
+	// enterprise project isn't a part of vpcs.Vpc
+	var vpcEps struct {
+		EnterpriseProjectID string `json:"enterprise_project_id"`
+	}
+	if err := getResult.ExtractIntoStructPtr(&vpcEps, "vpc"); err != nil {
+		return fmterr.Errorf("error fetching enterprise project of Vpc: %s", err)
+	}
 
 	d.Set("name", n.Name)
 	d.Set("cidr", n.CIDR)
@@ -187,6 +199,14 @@ func resourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	if vpcEps.EnterpriseProjectID != "" {
+		if err := d.Set("enterprise_project_id", vpcEps.EnterpriseProjectID); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if err := common.ReadEnterpriseProject(config, d, "vpcs", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -228,6 +248,10 @@ func resourceVirtualPrivateCloudV1Update(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	if err := common.MigrateEnterpriseProject(config, d, "vpcs", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceVirtualPrivateCloudV1Read(ctx, d, meta)
 }
 
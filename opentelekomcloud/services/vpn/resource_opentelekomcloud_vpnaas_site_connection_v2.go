@@ -144,6 +144,14 @@ func ResourceVpnSiteConnectionV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"tags": common.TagsSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -262,6 +270,8 @@ func resourceVpnSiteConnectionV2Read(_ context.Context, d *schema.ResourceData,
 	// d.Set("psk", conn.PSK)
 	d.Set("mtu", conn.MTU)
 	d.Set("peer_cidrs", conn.PeerCIDRs)
+	d.Set("status", conn.Status)
+	d.Set("auth_mode", conn.AuthMode)
 
 	// Set the dpd
 	var dpdMap map[string]interface{}
@@ -0,0 +1,199 @@
+package waf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/certificates"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/domains"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceWafCertificateV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafCertificateV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"hostname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"hostname", "name"},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"expires": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"days_until_expiry": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWafCertificateV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(wafClientError, err)
+	}
+
+	allDomains, err := listWafDomains(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var certificate *certificates.Certificate
+	if hostname := d.Get("hostname").(string); hostname != "" {
+		certificateID := ""
+		for _, domain := range allDomains {
+			if domain.HostName == hostname {
+				certificateID = domain.CertificateId
+				break
+			}
+		}
+		if certificateID == "" {
+			return fmterr.Errorf("no WAF certificate is bound to the domain %s", hostname)
+		}
+		certificate, err = certificates.Get(client, certificateID).Extract()
+		if err != nil {
+			return fmterr.Errorf("error retrieving OpenTelekomCloud WAF Certificate: %w", err)
+		}
+	} else {
+		certificate, err = findWafCertificateByName(client, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved WAF certificate %s: %#v", certificate.Id, certificate)
+	d.SetId(certificate.Id)
+
+	var boundDomains []map[string]interface{}
+	for _, domain := range allDomains {
+		if domain.CertificateId != certificate.Id {
+			continue
+		}
+		boundDomains = append(boundDomains, map[string]interface{}{
+			"id":        domain.Id,
+			"hostname":  domain.HostName,
+			"policy_id": domain.PolicyID,
+		})
+	}
+
+	expireTime := time.Unix(int64(certificate.ExpireTime/1000), 0).UTC()
+	daysLeft := int(time.Until(expireTime).Hours() / 24)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", certificate.Name),
+		d.Set("expires", expireTime.Format("2006-01-02 15:04:05 MST")),
+		d.Set("expire_time", certificate.ExpireTime),
+		d.Set("days_until_expiry", daysLeft),
+		d.Set("domains", boundDomains),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting WAF certificate fields: %w", err)
+	}
+
+	return nil
+}
+
+func findWafCertificateByName(client *golangsdk.ServiceClient, name string) (*certificates.Certificate, error) {
+	var found []certificates.Certificate
+	err := certificates.List(client, nil).EachPage(func(p pagination.Page) (bool, error) {
+		certs, err := certificates.ExtractCertificates(p)
+		if err != nil {
+			return false, fmt.Errorf("error extracting certificates: %w", err)
+		}
+		for _, c := range certs {
+			if c.Name == name {
+				found = append(found, c)
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing certificates: %w", err)
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no WAF certificate found with name %s", name)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("more than one WAF certificate found with name %s", name)
+	}
+}
+
+// listWafDomains returns all the WAF domains of the project.
+// Listing is not yet supported in `waf/v1/domains`, so it is done here.
+func listWafDomains(client *golangsdk.ServiceClient) ([]domains.Domain, error) {
+	const limit = 50
+
+	var allDomains []domains.Domain
+	for offset := 0; ; offset++ {
+		url := client.ServiceURL("instance") + "?offset=" + strconv.Itoa(offset) + "&limit=" + strconv.Itoa(limit)
+
+		r := golangsdk.Result{}
+		_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+			MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		})
+		if r.Err != nil {
+			return nil, fmt.Errorf("error listing OpenTelekomCloud WAF domains: %w", r.Err)
+		}
+
+		var page []domains.Domain
+		if err := r.ExtractIntoSlicePtr(&page, "items"); err != nil {
+			return nil, fmt.Errorf("error extracting WAF domains: %w", err)
+		}
+		allDomains = append(allDomains, page...)
+		if len(page) < limit {
+			return allDomains, nil
+		}
+	}
+}
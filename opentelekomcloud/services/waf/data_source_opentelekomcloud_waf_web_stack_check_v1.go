@@ -0,0 +1,292 @@
+package waf
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/certificates"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/domains"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// DataSourceWafWebStackCheckV1 verifies consistency of the `ELB listener -> WAF domain -> DNS record` chain
+func DataSourceWafWebStackCheckV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafWebStackCheckV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"waf_domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dns_zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dns_recordset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"fail_on_mismatch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"listener_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"certificate_valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dns_valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"backend_valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"passed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"issues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceWafWebStackCheckV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+
+	wafClient, err := config.WafV1Client(region)
+	if err != nil {
+		return fmterr.Errorf(wafClientError, err)
+	}
+	networkingClient, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+	dnsClient, err := config.DnsV2Client(region)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud DNSv2 client: %w", err)
+	}
+
+	domainID := d.Get("waf_domain_id").(string)
+	domain, err := domains.Get(wafClient, domainID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving WAF domain %s: %w", domainID, err)
+	}
+
+	listenerID := d.Get("listener_id").(string)
+	listener, err := listeners.Get(networkingClient, listenerID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving LB listener %s: %w", listenerID, err)
+	}
+
+	zoneID := d.Get("dns_zone_id").(string)
+	recordsetID := d.Get("dns_recordset_id").(string)
+	recordSet, err := recordsets.Get(dnsClient, zoneID, recordsetID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving DNS record set %s: %w", recordsetID, err)
+	}
+
+	hostname := normalizeHostname(domain.HostName)
+	var issues []string
+
+	// certificate of the listener should cover the protected domain
+	var certDomains []string
+	certificateValid := false
+	if listener.DefaultTlsContainerRef == "" {
+		issues = append(issues, fmt.Sprintf("listener %s has no default certificate", listener.ID))
+	} else {
+		certDomains, err = listenerCertificateDomains(networkingClient, listener.DefaultTlsContainerRef)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, certDomain := range certDomains {
+			if hostnameMatches(certDomain, hostname) {
+				certificateValid = true
+				break
+			}
+		}
+		if !certificateValid {
+			issues = append(issues, fmt.Sprintf("certificate %s of listener %s doesn't cover %s, certificate domains: %s",
+				listener.DefaultTlsContainerRef, listener.ID, hostname, strings.Join(certDomains, ", ")))
+		}
+	}
+
+	// DNS record should point the domain to the WAF CNAME
+	dnsValid := true
+	if name := normalizeHostname(recordSet.Name); name != hostname {
+		dnsValid = false
+		issues = append(issues, fmt.Sprintf("DNS record set name %s doesn't match WAF domain %s", name, hostname))
+	}
+	if recordSet.Type != "CNAME" {
+		dnsValid = false
+		issues = append(issues, fmt.Sprintf("DNS record set %s has type %s, CNAME is expected", recordSet.ID, recordSet.Type))
+	} else if !common.StrSliceContains(normalizeHostnames(recordSet.Records), normalizeHostname(domain.Cname)) {
+		dnsValid = false
+		issues = append(issues, fmt.Sprintf("DNS record set %s points to %s instead of WAF CNAME %s",
+			recordSet.ID, strings.Join(recordSet.Records, ", "), domain.Cname))
+	}
+
+	// WAF should forward the traffic to the listener
+	addresses, err := listenerAddresses(networkingClient, listener)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backendValid := false
+	for _, server := range domain.Server {
+		if server.Port == listener.ProtocolPort && common.StrSliceContains(addresses, server.Address) {
+			backendValid = true
+			break
+		}
+	}
+	if !backendValid {
+		issues = append(issues, fmt.Sprintf("none of WAF domain %s servers points to the listener %s (%s:%d)",
+			domain.Id, listener.ID, strings.Join(addresses, ", "), listener.ProtocolPort))
+	}
+
+	passed := len(issues) == 0
+	log.Printf("[DEBUG] Web stack check of %s passed: %t, issues: %v", hostname, passed, issues)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", listener.ID, domain.Id, recordSet.ID))
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("hostname", hostname),
+		d.Set("certificate_domains", certDomains),
+		d.Set("listener_addresses", addresses),
+		d.Set("certificate_valid", certificateValid),
+		d.Set("dns_valid", dnsValid),
+		d.Set("backend_valid", backendValid),
+		d.Set("passed", passed),
+		d.Set("issues", issues),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting web stack check fields: %w", err)
+	}
+
+	if !passed && d.Get("fail_on_mismatch").(bool) {
+		return fmterr.Errorf("web stack check of %s failed:\n  - %s", hostname, strings.Join(issues, "\n  - "))
+	}
+
+	return nil
+}
+
+// listenerCertificateDomains returns CN and SANs of the LB certificate
+func listenerCertificateDomains(client *golangsdk.ServiceClient, certificateID string) ([]string, error) {
+	certificate, err := certificates.Get(client, certificateID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving LB certificate %s: %w", certificateID, err)
+	}
+	block, _ := pem.Decode([]byte(certificate.Certificate))
+	if block == nil {
+		return nil, fmt.Errorf("LB certificate %s is not in PEM format", certificateID)
+	}
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing LB certificate %s: %w", certificateID, err)
+	}
+
+	var result []string
+	if parsed.Subject.CommonName != "" {
+		result = append(result, normalizeHostname(parsed.Subject.CommonName))
+	}
+	for _, name := range normalizeHostnames(parsed.DNSNames) {
+		if !common.StrSliceContains(result, name) {
+			result = append(result, name)
+		}
+	}
+	return result, nil
+}
+
+// listenerAddresses returns VIP address of the listener load balancer and EIPs bound to it
+func listenerAddresses(client *golangsdk.ServiceClient, listener *listeners.Listener) ([]string, error) {
+	var addresses []string
+	for _, lb := range listener.Loadbalancers {
+		loadBalancer, err := loadbalancers.Get(client, lb.ID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving load balancer %s: %w", lb.ID, err)
+		}
+		addresses = append(addresses, loadBalancer.VipAddress)
+
+		pages, err := floatingips.List(client, floatingips.ListOpts{PortID: loadBalancer.VipPortID}).AllPages()
+		if err != nil {
+			return nil, fmt.Errorf("error listing EIPs of load balancer %s: %w", lb.ID, err)
+		}
+		fips, err := floatingips.ExtractFloatingIPs(pages)
+		if err != nil {
+			return nil, fmt.Errorf("error extracting EIPs of load balancer %s: %w", lb.ID, err)
+		}
+		for _, fip := range fips {
+			addresses = append(addresses, fip.FloatingIP)
+		}
+	}
+	return addresses, nil
+}
+
+// hostnameMatches checks if the certificate domain (including wildcard) covers the hostname
+func hostnameMatches(pattern, hostname string) bool {
+	if pattern == hostname {
+		return true
+	}
+	if !strings.HasPrefix(pattern, "*.") {
+		return false
+	}
+	// wildcard covers exactly one label
+	parts := strings.SplitN(hostname, ".", 2)
+	return len(parts) == 2 && parts[1] == pattern[2:]
+}
+
+func normalizeHostname(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+func normalizeHostnames(names []string) []string {
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[i] = normalizeHostname(name)
+	}
+	return result
+}